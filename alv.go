@@ -0,0 +1,252 @@
+package ccadb_data
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// The audit types whose Audit Letter Validation (ALV) results the CCADB records for each CA certificate.
+const (
+	ALV_AUDIT_STANDARD        = "Standard"
+	ALV_AUDIT_BR              = "BR"
+	ALV_AUDIT_EV_SSL          = "EV SSL"
+	ALV_AUDIT_EV_CODE_SIGNING = "EV Code Signing"
+)
+
+// The CCADB API object and field that LoadALVResults reads, unless others are configured.
+const (
+	DEFAULT_ALV_OBJECT            = "Account"
+	DEFAULT_ALV_FINGERPRINT_FIELD = "SHA_256_Fingerprint__c"
+)
+
+// The audit types, in the order in which they are reported.
+var alvAuditTypes = []string{ALV_AUDIT_STANDARD, ALV_AUDIT_BR, ALV_AUDIT_EV_SSL, ALV_AUDIT_EV_CODE_SIGNING}
+
+// Names of the CSV columns that hold each audit type's ALV result, as they appear in CCADB's exports.
+var alvCSVHeaders = map[string]string{
+	ALV_AUDIT_STANDARD:        "Standard Audit ALV Found Cert",
+	ALV_AUDIT_BR:              "BR Audit ALV Found Cert",
+	ALV_AUDIT_EV_SSL:          "EV SSL Audit ALV Found Cert",
+	ALV_AUDIT_EV_CODE_SIGNING: "EV Code Signing Audit ALV Found Cert",
+}
+
+// The CCADB API fields that hold each audit type's ALV result, unless others are configured.
+var defaultALVResultFields = map[string]string{
+	ALV_AUDIT_STANDARD:        "Standard_Audit_ALV_Found_Cert__c",
+	ALV_AUDIT_BR:              "BR_Audit_ALV_Found_Cert__c",
+	ALV_AUDIT_EV_SSL:          "EV_SSL_Audit_ALV_Found_Cert__c",
+	ALV_AUDIT_EV_CODE_SIGNING: "EV_Code_Signing_Audit_ALV_Found_Cert__c",
+}
+
+// ALVConfig names the CCADB API object and fields that LoadALVResults reads the ALV results from. Empty fields are
+// replaced by the defaults, which match the CCADB's Salesforce schema at the time of writing.
+type ALVConfig struct {
+	// The object that holds the CA certificate records (DEFAULT_ALV_OBJECT by default).
+	Object string
+	// The field that holds each record's SHA-256 fingerprint (DEFAULT_ALV_FINGERPRINT_FIELD by default).
+	FingerprintField string
+	// The field that holds the ALV result of each audit type, keyed by audit type (e.g. ALV_AUDIT_BR). Audit types that
+	// aren't listed use their default fields, and an empty field skips that audit type.
+	ResultFields map[string]string
+}
+
+// withDefaults returns the configuration with its empty fields replaced by the defaults.
+func (config ALVConfig) withDefaults() ALVConfig {
+	config.Object = cmp.Or(config.Object, DEFAULT_ALV_OBJECT)
+	config.FingerprintField = cmp.Or(config.FingerprintField, DEFAULT_ALV_FINGERPRINT_FIELD)
+	resultFields := maps.Clone(defaultALVResultFields)
+	for auditType, field := range config.ResultFields {
+		if field == "" {
+			delete(resultFields, auditType)
+		} else {
+			resultFields[auditType] = field
+		}
+	}
+	config.ResultFields = resultFields
+	return config
+}
+
+// readALVResults parses the ALV results of every CSV record that has a valid SHA-256 fingerprint. It also returns the
+// audit types whose columns are present in the header, in the order in which they are reported. If the header has no
+// ALV columns, nil is returned.
+func readALVResults(records []csvRecord, filePath string) (map[[sha256.Size]byte]map[string]ALVResult, []string) {
+	if len(records) == 0 {
+		return nil, nil
+	}
+	sha256Idx := slices.Index(records[0].fields, csvHeaders[IDX_SHA256FINGERPRINT])
+	alvIdx := make(map[string]int)
+	var auditTypes []string
+	for _, auditType := range alvAuditTypes {
+		if idx := slices.Index(records[0].fields, alvCSVHeaders[auditType]); idx != -1 {
+			alvIdx[auditType] = idx
+			auditTypes = append(auditTypes, auditType)
+		}
+	}
+	if sha256Idx == -1 || len(auditTypes) == 0 {
+		return nil, nil
+	}
+
+	alvResultMap := make(map[[sha256.Size]byte]map[string]ALVResult)
+	for _, record := range records[1:] {
+		sha256Slice, err := hex.DecodeString(csvField(record.fields, sha256Idx))
+		if err != nil || len(sha256Slice) != sha256.Size {
+			logger.Warn("CSV data contains an invalid hex string", zap.String("value", csvField(record.fields, sha256Idx)), zap.String("file_path", filePath), zap.Int("line", record.line))
+			continue
+		}
+		results := make(map[string]ALVResult, len(auditTypes))
+		for _, auditType := range auditTypes {
+			results[auditType] = ParseALVResult(csvField(record.fields, alvIdx[auditType]))
+		}
+		var sha256Array [sha256.Size]byte
+		copy(sha256Array[:], sha256Slice)
+		alvResultMap[sha256Array] = results
+	}
+
+	return alvResultMap, auditTypes
+}
+
+// loadALVResultsCSV reads the ALV results from a CSV export, replacing any that were loaded before.
+func (s *Store) loadALVResultsCSV(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	records := readCSVRecords(data, "ALV results", 0)
+	alvResultMap, auditTypes := readALVResults(records, "ALV results")
+	if alvResultMap == nil {
+		return fmt.Errorf("%w: ALV results CSV has no %q header or no ALV result headers", ErrMalformedDataset, csvHeaders[IDX_SHA256FINGERPRINT])
+	}
+
+	s.alvMu.Lock()
+	s.alvResultMap, s.alvAuditTypes = alvResultMap, auditTypes
+	s.alvMu.Unlock()
+	logger.Info("Loaded ALV results", zap.Int("count", len(alvResultMap)))
+	return nil
+}
+
+// loadALVResults queries the ALV results of every CA certificate record through the CCADB API, replacing any that were
+// loaded before.
+func (s *Store) loadALVResults(ctx context.Context, c *APIClient, config ALVConfig) error {
+	config = config.withDefaults()
+	var auditTypes []string
+	fields := []string{config.FingerprintField}
+	for _, auditType := range alvAuditTypes {
+		if field, ok := config.ResultFields[auditType]; ok {
+			auditTypes = append(auditTypes, auditType)
+			fields = append(fields, field)
+		}
+	}
+	if len(auditTypes) == 0 {
+		return fmt.Errorf("No ALV result fields are configured")
+	}
+	soql := fmt.Sprintf("SELECT %s FROM %s WHERE %s != null", strings.Join(fields, ", "), config.Object, config.FingerprintField)
+	records, err := c.Query(ctx, soql)
+	if err != nil {
+		return err
+	}
+
+	alvResultMap := make(map[[sha256.Size]byte]map[string]ALVResult)
+	for _, record := range records {
+		value, _ := record.Field(config.FingerprintField).(string)
+		sha256Fingerprint, err := ParseSHA256Fingerprint(value)
+		if err != nil {
+			logger.Warn("CCADB API record has no valid SHA-256 fingerprint", zap.Error(err), zap.String("field", config.FingerprintField), zap.Any("record_url", record.Field("attributes.url")))
+			continue
+		}
+		results := make(map[string]ALVResult, len(auditTypes))
+		for _, auditType := range auditTypes {
+			results[auditType] = parseALVField(record.Field(config.ResultFields[auditType]))
+		}
+		alvResultMap[sha256Fingerprint] = results
+	}
+
+	s.alvMu.Lock()
+	s.alvResultMap, s.alvAuditTypes = alvResultMap, auditTypes
+	s.alvMu.Unlock()
+	logger.Info("Loaded ALV results", zap.Int("count", len(records)), zap.Int("certificate_count", len(alvResultMap)))
+	return nil
+}
+
+// parseALVField parses an ALV result field, which is either a picklist (a string) or a checkbox (a boolean).
+func parseALVField(value any) ALVResult {
+	switch v := value.(type) {
+	case string:
+		return ParseALVResult(v)
+	case bool:
+		if v {
+			return ALVResultFound
+		}
+		return ALVResultNotFound
+	case nil:
+		return ALVResultNone
+	default:
+		return ALVResultUnknown
+	}
+}
+
+// ALVFinding is a failed or missing ALV result of a CA certificate, as returned by ListALVFindings.
+type ALVFinding struct {
+	SHA256Fingerprint [sha256.Size]byte
+	CertificateName   string
+	CAOwner           string
+	AuditType         string
+	// ALVResultNotFound if the CA certificate wasn't found in the audit statement, or ALVResultNone or
+	// ALVResultUnknown if no valid result is recorded for an audit type that the CA certificate needs.
+	Result ALVResult
+}
+
+// requiredALVAuditTypes returns the audit types whose ALV results a CA certificate needs: a Standard audit, and a BR or
+// EV SSL audit if it is TLS or EV TLS capable.
+func requiredALVAuditTypes(ccc *CACertCapabilities) []string {
+	auditTypes := []string{ALV_AUDIT_STANDARD}
+	if ccc.TlsCapable {
+		auditTypes = append(auditTypes, ALV_AUDIT_BR)
+	}
+	if ccc.TlsEvCapable {
+		auditTypes = append(auditTypes, ALV_AUDIT_EV_SSL)
+	}
+	return auditTypes
+}
+
+// listALVFindings returns the failed ALV results of every CA certificate, and the missing ALV results of the CA
+// certificates that are valid at now, that CCADB doesn't consider to be revoked, that are included in (or trusted by) a
+// root program, and that have audits of their own, ordered by CA Owner, Certificate Name, SHA-256 fingerprint, and
+// audit type. A CA certificate that has no ALV results is missing every result. Audit types whose results weren't loaded are skipped, and nil is
+// returned if no ALV results have been loaded.
+func (s *Store) listALVFindings(now time.Time) []*ALVFinding {
+	s.alvMu.RLock()
+	defer s.alvMu.RUnlock()
+	if s.alvResultMap == nil {
+		return nil
+	}
+
+	var findings []*ALVFinding
+	for sha256Fingerprint, cr := range s.certificateRecords() {
+		results := s.alvResultMap[sha256Fingerprint]
+		var requiredAuditTypes []string
+		if ccc := s.caCertCapabilities(sha256Fingerprint); ccc != nil && !cr.AuditsSameAsParent && !cr.isRevoked() && !now.Before(cr.ValidFrom) && !now.After(cr.ValidTo) && slices.ContainsFunc(rootPrograms, cr.isTrustedBy) {
+			requiredAuditTypes = requiredALVAuditTypes(ccc)
+		}
+		for _, auditType := range alvAuditTypes {
+			result := results[auditType]
+			if result == ALVResultNotFound || (result != ALVResultFound && slices.Contains(requiredAuditTypes, auditType) && slices.Contains(s.alvAuditTypes, auditType)) {
+				findings = append(findings, &ALVFinding{SHA256Fingerprint: sha256Fingerprint, CertificateName: cr.CertificateName, CAOwner: cr.CAOwner, AuditType: auditType, Result: result})
+			}
+		}
+	}
+
+	slices.SortFunc(findings, func(a, b *ALVFinding) int {
+		return cmp.Or(cmp.Compare(a.CAOwner, b.CAOwner), cmp.Compare(a.CertificateName, b.CertificateName), compareSHA256Fingerprints(a.SHA256Fingerprint, b.SHA256Fingerprint), cmp.Compare(slices.Index(alvAuditTypes, a.AuditType), slices.Index(alvAuditTypes, b.AuditType)))
+	})
+	return findings
+}
@@ -0,0 +1,211 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// alvTestFingerprint decodes a hex SHA-256 fingerprint from the v5 fixture.
+func alvTestFingerprint(t *testing.T, hexFingerprint string) [sha256.Size]byte {
+	t.Helper()
+	var sha256Fingerprint [sha256.Size]byte
+	if n, err := hex.Decode(sha256Fingerprint[:], []byte(hexFingerprint)); err != nil || n != sha256.Size {
+		t.Fatalf("Invalid fingerprint %q", hexFingerprint)
+	}
+	return sha256Fingerprint
+}
+
+// alvFindingNames returns each finding as "Certificate Name/Audit Type/Result".
+func alvFindingNames(findings []*ALVFinding) string {
+	var names []string
+	for _, finding := range findings {
+		names = append(names, finding.CertificateName+"/"+finding.AuditType+"/"+finding.Result.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+func TestParseALVResult(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want ALVResult
+	}{
+		{"", ALVResultNone},
+		{"Pass", ALVResultFound},
+		{" FOUND ", ALVResultFound},
+		{"True", ALVResultFound},
+		{"Fail", ALVResultNotFound},
+		{"Not  Found", ALVResultNotFound},
+		{"false", ALVResultNotFound},
+		{"Not Run", ALVResultUnknown},
+	} {
+		if got := ParseALVResult(tc.s); got != tc.want {
+			t.Errorf("ParseALVResult(%q) = %v, want %v", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestLoadALVResultsCSV(t *testing.T) {
+	s, err := NewStore(os.DirFS("ccadbtest/fixtures/v5"))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	} else if findings := s.ListALVFindings(time.Now()); findings != nil {
+		t.Errorf("ListALVFindings() returned %d findings before any ALV results were loaded", len(findings))
+	}
+
+	if err = s.LoadALVResultsCSV(strings.NewReader("SHA-256 Fingerprint,CA Owner\n")); !errors.Is(err, ErrMalformedDataset) {
+		t.Errorf("LoadALVResultsCSV() with no ALV columns returned %v, want ErrMalformedDataset", err)
+	}
+
+	// Only the Standard and BR results are exported, so the other audit types are never reported as missing.
+	if err = s.LoadALVResultsCSV(strings.NewReader("\ufeffSHA-256 Fingerprint,Standard Audit ALV Found Cert,BR Audit ALV Found Cert\r\n" +
+		"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6,Pass,Fail\r\n" +
+		"9D7C3F1AA6AD2B2EC0D5CF1E246F8D9AE6CBC9FD0755AD37BB974B1F2FB603F3,False,\r\n" +
+		"Not a fingerprint,Pass,Pass\r\n")); err != nil {
+		t.Fatalf("LoadALVResultsCSV() returned %v", err)
+	}
+
+	results := s.GetALVResultsBySHA256(alvTestFingerprint(t, "96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6"))
+	if results[ALV_AUDIT_STANDARD] != ALVResultFound || results[ALV_AUDIT_BR] != ALVResultNotFound || len(results) != 2 {
+		t.Errorf("GetALVResultsBySHA256(ISRG Root X1) = %v", results)
+	}
+	if results = s.GetALVResultsBySHA256(alvTestFingerprint(t, "D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624")); results != nil {
+		t.Errorf("GetALVResultsBySHA256(Certum CA) = %v, want nil", results)
+	}
+
+	findings := alvFindingNames(s.ListALVFindings(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)))
+	for _, want := range []string{"ISRG Root X1/BR/Not Found", "R10/Standard/Not Found", "Certum CA/Standard/"} {
+		if !strings.Contains(findings, want) {
+			t.Errorf("ListALVFindings() = %q, which doesn't contain %q", findings, want)
+		}
+	}
+	// ISRG Root X1's Standard ALV passed, R10 is audited with its parent so its missing BR result isn't needed, and no EV
+	// SSL results were exported.
+	for _, unwanted := range []string{"ISRG Root X1/Standard/", "R10/BR/", "/EV SSL/"} {
+		if strings.Contains(findings, unwanted) {
+			t.Errorf("ListALVFindings() = %q, which contains %q", findings, unwanted)
+		}
+	}
+}
+
+// newALVTestServer returns a CCADB API that serves the given records, split across two pages, and that fails any query
+// that doesn't select soqlFields.
+func newALVTestServer(t *testing.T, soqlFields string, records []APIRecord) *httptest.Server {
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("POST /services/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "token", "instance_url": server.URL})
+	})
+	mux.HandleFunc("GET /services/data/"+DEFAULT_SALESFORCE_API_VERSION+"/query", func(w http.ResponseWriter, r *http.Request) {
+		if soql := r.URL.Query().Get("q"); !strings.HasPrefix(soql, "SELECT "+soqlFields+" FROM Account ") {
+			t.Errorf("Unexpected query %q", soql)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"done": false, "nextRecordsUrl": "/services/data/" + DEFAULT_SALESFORCE_API_VERSION + "/query/next", "records": records[:1]})
+	})
+	mux.HandleFunc("GET /services/data/"+DEFAULT_SALESFORCE_API_VERSION+"/query/next", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"done": true, "records": records[1:]})
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLoadALVResults(t *testing.T) {
+	server := newALVTestServer(t, "SHA_256_Fingerprint__c, Standard_ALV__c, BR_Audit_ALV_Found_Cert__c, EV_SSL_Audit_ALV_Found_Cert__c", []APIRecord{
+		{"SHA_256_Fingerprint__c": TEST_ISRG_ROOT_X1_SHA256, "Standard_ALV__c": "Pass", "BR_Audit_ALV_Found_Cert__c": "Fail", "EV_SSL_Audit_ALV_Found_Cert__c": nil},
+		{"SHA_256_Fingerprint__c": TEST_R10_SHA256, "Standard_ALV__c": false, "BR_Audit_ALV_Found_Cert__c": nil},
+		{"SHA_256_Fingerprint__c": "Not a fingerprint", "Standard_ALV__c": "Pass"},
+	})
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	c := NewAPIClient(server.Client(), APIClientConfig{LoginURL: server.URL, ClientID: "id", ClientSecret: "secret"})
+	config := ALVConfig{ResultFields: map[string]string{ALV_AUDIT_STANDARD: "Standard_ALV__c", ALV_AUDIT_EV_CODE_SIGNING: ""}}
+	if err = s.LoadALVResults(context.Background(), c, config); err != nil {
+		t.Fatalf("LoadALVResults() returned %v", err)
+	}
+
+	results := s.GetALVResultsBySHA256(alvTestFingerprint(t, TEST_ISRG_ROOT_X1_SHA256))
+	if results[ALV_AUDIT_STANDARD] != ALVResultFound || results[ALV_AUDIT_BR] != ALVResultNotFound || results[ALV_AUDIT_EV_SSL] != ALVResultNone || len(results) != 3 {
+		t.Errorf("GetALVResultsBySHA256(ISRG Root X1) = %v", results)
+	}
+	if results = s.GetALVResultsBySHA256(alvTestFingerprint(t, "D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624")); results != nil {
+		t.Errorf("GetALVResultsBySHA256(Certum CA) = %v, want nil", results)
+	}
+
+	// ISRG Root X1 failed its BR ALV, R10 failed its Standard ALV (although it is audited with its parent), and Certum CA
+	// and DigiCert Verified Mark Root CA are included by Mozilla and Apple respectively but have no ALV results.
+	want := "Certum CA/Standard/, DigiCert Verified Mark Root CA/Standard/, ISRG Root X1/BR/Not Found, R10/Standard/Not Found"
+	if findings := alvFindingNames(s.ListALVFindings(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))); findings != want {
+		t.Errorf("ListALVFindings() = %q, want %q", findings, want)
+	}
+}
+
+func TestALVResultsFromDataset(t *testing.T) {
+	// Add ALV columns to the fixture's All Certificate Records report.
+	fsys := os.DirFS("ccadbtest/fixtures/v5")
+	data, err := fs.ReadFile(fsys, CCADB_CSV_PATH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	records[0] = append(records[0], "Standard Audit ALV Found Cert", "EV SSL Audit ALV Found Cert")
+	for i, record := range records[1:] {
+		result := "Found"
+		if record[slices.Index(records[0], "Certificate Name")] == "R11" {
+			result = "Not Found"
+		}
+		records[i+1] = append(record, result, "")
+	}
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.WriteAll(records)
+
+	mapFS := make(fstest.MapFS)
+	if err = fs.WalkDir(fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := fs.ReadFile(fsys, filePath)
+		mapFS[filePath] = &fstest.MapFile{Data: data}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mapFS[CCADB_CSV_PATH] = &fstest.MapFile{Data: buf.Bytes()}
+	s, err := NewStore(mapFS)
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	// R11 failed its Standard ALV, and no BR results were exported. None of the fixture's CA certificates are EV TLS
+	// capable, so none are missing an EV SSL result.
+	if findings := alvFindingNames(s.ListALVFindings(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))); findings != "R11/Standard/Not Found" {
+		t.Errorf("ListALVFindings() = %q, want %q", findings, "R11/Standard/Not Found")
+	}
+	if results := s.GetALVResultsBySHA256(alvTestFingerprint(t, "591E9CE6C863D3A079E9FABE1478C7339A26B21269DDE795211361024AE31A44")); results[ALV_AUDIT_STANDARD] != ALVResultNotFound || results[ALV_AUDIT_EV_SSL] != ALVResultNone || len(results) != 2 {
+		t.Errorf("GetALVResultsBySHA256(R11) = %v", results)
+	}
+}
@@ -1,25 +1,753 @@
 package ccadb_data
 
-import "crypto/sha256"
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"time"
+)
 
-func GetCACertCapabilitiesBySHA256(sha256Fingerprint [sha256.Size]byte) *caCertCapabilities {
-	return caCertCapabilitiesMap[sha256Fingerprint]
+func GetCACertCapabilitiesBySHA256(sha256Fingerprint [sha256.Size]byte) *CACertCapabilities {
+	return GetDefaultStore().GetCACertCapabilitiesBySHA256(sha256Fingerprint)
 }
 
-func GetIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier string) *issuerCapabilities {
-	return issuerCapabilitiesMap[b64KeyIdentifier]
+func (s *Store) GetCACertCapabilitiesBySHA256(sha256Fingerprint [sha256.Size]byte) *CACertCapabilities {
+	ccc := s.caCertCapabilities(sha256Fingerprint)
+	observeLookup("GetCACertCapabilitiesBySHA256", ccc != nil)
+	return ccc
+}
+
+func GetCertificateRecordBySHA256(sha256Fingerprint [sha256.Size]byte) *CertificateRecord {
+	return GetDefaultStore().GetCertificateRecordBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) GetCertificateRecordBySHA256(sha256Fingerprint [sha256.Size]byte) *CertificateRecord {
+	cr := s.certificateRecord(sha256Fingerprint)
+	observeLookup("GetCertificateRecordBySHA256", cr != nil)
+	return cr
+}
+
+func GetSHA256FingerprintsByKeyIdentifier(b64KeyIdentifier string) [][sha256.Size]byte {
+	return GetDefaultStore().GetSHA256FingerprintsByKeyIdentifier(b64KeyIdentifier)
+}
+
+func (s *Store) GetSHA256FingerprintsByKeyIdentifier(b64KeyIdentifier string) [][sha256.Size]byte {
+	sha256Fingerprints, ok := s.sha256FingerprintsMap[b64KeyIdentifier]
+	if !ok {
+		sha256Fingerprints = s.sha256FingerprintsMap[canonicalKeyIdentifier(b64KeyIdentifier)]
+	}
+	observeLookup("GetSHA256FingerprintsByKeyIdentifier", len(sha256Fingerprints) > 0)
+	return sha256Fingerprints
+}
+
+func GetSHA256FingerprintsByKeyIdentifierBytes(keyIdentifier []byte) [][sha256.Size]byte {
+	return GetDefaultStore().GetSHA256FingerprintsByKeyIdentifierBytes(keyIdentifier)
+}
+
+func (s *Store) GetSHA256FingerprintsByKeyIdentifierBytes(keyIdentifier []byte) [][sha256.Size]byte {
+	var buf [KEY_IDENTIFIER_BUFFER_SIZE]byte
+	sha256Fingerprints := s.sha256FingerprintsMap[string(appendBase64KeyIdentifier(buf[:0], keyIdentifier))]
+	observeLookup("GetSHA256FingerprintsByKeyIdentifierBytes", len(sha256Fingerprints) > 0)
+	return sha256Fingerprints
+}
+
+func GetRootProgramStatusBySHA256(sha256Fingerprint [sha256.Size]byte, rootProgram string) string {
+	return GetDefaultStore().GetRootProgramStatusBySHA256(sha256Fingerprint, rootProgram)
+}
+
+func (s *Store) GetRootProgramStatusBySHA256(sha256Fingerprint [sha256.Size]byte, rootProgram string) string {
+	cr := s.certificateRecord(sha256Fingerprint)
+	observeLookup("GetRootProgramStatusBySHA256", cr != nil)
+	if cr != nil {
+		return cr.rootProgramStatus(rootProgram)
+	}
+	return ""
+}
+
+func WasIncludedInRootProgram(sha256Fingerprint [sha256.Size]byte, rootProgram string, date time.Time) (included bool, known bool) {
+	return GetDefaultStore().WasIncludedInRootProgram(sha256Fingerprint, rootProgram, date)
+}
+
+func (s *Store) WasIncludedInRootProgram(sha256Fingerprint [sha256.Size]byte, rootProgram string, date time.Time) (included bool, known bool) {
+	cr := s.certificateRecord(sha256Fingerprint)
+	observeLookup("WasIncludedInRootProgram", cr != nil)
+	if cr != nil {
+		return cr.wasIncluded(rootProgram, date, time.Now())
+	}
+	return false, false
+}
+
+func ListFingerprints(filter CapabilityFilter) [][sha256.Size]byte {
+	return GetDefaultStore().ListFingerprints(filter)
+}
+
+// ListFingerprints returns the SHA-256 fingerprints of every CA certificate that matches the filter, in ascending
+// order.
+func (s *Store) ListFingerprints(filter CapabilityFilter) [][sha256.Size]byte {
+	sha256Fingerprints := s.listFingerprintsWhere(Where().Filter(filter))
+	slices.SortFunc(sha256Fingerprints, compareSHA256Fingerprints)
+	observeLookup("ListFingerprints", len(sha256Fingerprints) > 0)
+	return sha256Fingerprints
+}
+
+func ListFingerprintsWhere(q *Query) [][sha256.Size]byte {
+	return GetDefaultStore().ListFingerprintsWhere(q)
+}
+
+// ListFingerprintsWhere returns the SHA-256 fingerprints of every CA certificate that matches the query, in ascending
+// order.
+func (s *Store) ListFingerprintsWhere(q *Query) [][sha256.Size]byte {
+	sha256Fingerprints := s.listFingerprintsWhere(q)
+	slices.SortFunc(sha256Fingerprints, compareSHA256Fingerprints)
+	observeLookup("ListFingerprintsWhere", len(sha256Fingerprints) > 0)
+	return sha256Fingerprints
+}
+
+func GetRootStoreConstraintsBySHA256(sha256Fingerprint [sha256.Size]byte) []*RootStoreConstraints {
+	return GetDefaultStore().GetRootStoreConstraintsBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) GetRootStoreConstraintsBySHA256(sha256Fingerprint [sha256.Size]byte) []*RootStoreConstraints {
+	rootStoreConstraints := s.rootStoreConstraintsMap[sha256Fingerprint]
+	observeLookup("GetRootStoreConstraintsBySHA256", len(rootStoreConstraints) > 0)
+	return rootStoreConstraints
+}
+
+func GetCTLogByID(logID [sha256.Size]byte) *CTLog {
+	return GetDefaultStore().GetCTLogByID(logID)
+}
+
+// GetCTLogByID returns the Certificate Transparency log identified by its log ID (the SHA-256 hash of its public key),
+// as described by the embedded CT log list.
+func (s *Store) GetCTLogByID(logID [sha256.Size]byte) *CTLog {
+	cl := s.ctLogMap[logID]
+	observeLookup("GetCTLogByID", cl != nil)
+	return cl
+}
+
+func CheckEmbeddedSCTs(issuerKeyHash [sha256.Size]byte, scts []EmbeddedSCT) *SCTCheck {
+	return GetDefaultStore().CheckEmbeddedSCTs(issuerKeyHash, scts)
+}
+
+// CheckEmbeddedSCTs checks a precertificate's issuer, identified by the issuer key hash (the SHA-256 hash of the
+// issuer's SubjectPublicKeyInfo) that its SCTs were signed over, and whether each of its embedded SCTs was issued by a
+// log that was acceptable at the SCT's timestamp.
+func (s *Store) CheckEmbeddedSCTs(issuerKeyHash [sha256.Size]byte, scts []EmbeddedSCT) *SCTCheck {
+	sc := &SCTCheck{}
+	for _, keyIdentifier := range s.keyIdentifiersBySPKISHA256[issuerKeyHash] {
+		for _, sha256Fingerprint := range s.sha256FingerprintsMap[keyIdentifier] {
+			if !slices.Contains(sc.IssuerSHA256Fingerprints, sha256Fingerprint) {
+				sc.IssuerSHA256Fingerprints = append(sc.IssuerSHA256Fingerprints, sha256Fingerprint)
+			}
+		}
+	}
+	slices.SortFunc(sc.IssuerSHA256Fingerprints, compareSHA256Fingerprints)
+	sc.IssuerDisclosed = len(sc.IssuerSHA256Fingerprints) > 0
+
+	for _, sct := range scts {
+		slc := &SCTLogCheck{EmbeddedSCT: sct, Log: s.ctLogMap[sct.LogID]}
+		slc.Acceptable = slc.Log != nil && slc.Log.WasAcceptableAt(sct.Timestamp)
+		sc.SCTs = append(sc.SCTs, slc)
+	}
+
+	observeLookup("CheckEmbeddedSCTs", sc.IssuerDisclosed)
+	return sc
+}
+
+func IsDistrustedForTLSAfter(sha256Fingerprint [sha256.Size]byte, issuanceDate time.Time) bool {
+	return GetDefaultStore().IsDistrustedForTLSAfter(sha256Fingerprint, issuanceDate)
+}
+
+// IsDistrustedForTLSAfter reports whether a TLS certificate issued at issuanceDate by the CA certificate is
+// distrusted by any root program, because a "distrust for TLS after" date or an SCT time constraint applies to the CA
+// certificate or one of its disclosed parents.
+func (s *Store) IsDistrustedForTLSAfter(sha256Fingerprint [sha256.Size]byte, issuanceDate time.Time) bool {
+	distrusted := s.isDistrustedAfter(sha256Fingerprint, issuanceDate, (*RootStoreConstraints).distrustsForTLS)
+	observeLookup("IsDistrustedForTLSAfter", s.certificateRecord(sha256Fingerprint) != nil)
+	return distrusted
+}
+
+func IsDistrustedForSMIMEAfter(sha256Fingerprint [sha256.Size]byte, issuanceDate time.Time) bool {
+	return GetDefaultStore().IsDistrustedForSMIMEAfter(sha256Fingerprint, issuanceDate)
+}
+
+// IsDistrustedForSMIMEAfter is the S/MIME equivalent of IsDistrustedForTLSAfter.
+func (s *Store) IsDistrustedForSMIMEAfter(sha256Fingerprint [sha256.Size]byte, issuanceDate time.Time) bool {
+	distrusted := s.isDistrustedAfter(sha256Fingerprint, issuanceDate, (*RootStoreConstraints).distrustsForSMIME)
+	observeLookup("IsDistrustedForSMIMEAfter", s.certificateRecord(sha256Fingerprint) != nil)
+	return distrusted
+}
+
+func NeedsAuditUpdate(sha256Fingerprint [sha256.Size]byte, asOf time.Time) (needsUpdate bool, known bool) {
+	return GetDefaultStore().NeedsAuditUpdate(sha256Fingerprint, asOf)
+}
+
+// NeedsAuditUpdate reports whether the CA certificate is valid and unrevoked at asOf, but its most recent standard
+// audit period (or its parent's, if it is covered by its parent's audits) ended more than AUDIT_UPDATE_MONTHS before
+// asOf, or no standard audit is disclosed at all. known is false if the CA certificate is not in the dataset.
+func (s *Store) NeedsAuditUpdate(sha256Fingerprint [sha256.Size]byte, asOf time.Time) (needsUpdate bool, known bool) {
+	cr := s.certificateRecord(sha256Fingerprint)
+	observeLookup("NeedsAuditUpdate", cr != nil)
+	if cr == nil {
+		return false, false
+	}
+	return s.needsAuditUpdate(sha256Fingerprint, cr, asOf), true
+}
+
+func NeedsAuditUpdateByKeyIdentifier(b64KeyIdentifier string, asOf time.Time) (needsUpdate bool, known bool) {
+	return GetDefaultStore().NeedsAuditUpdateByKeyIdentifier(b64KeyIdentifier, asOf)
+}
+
+// NeedsAuditUpdateByKeyIdentifier reports whether any of the CA certificates with the given key identifier needs an
+// updated audit as of asOf, as determined by NeedsAuditUpdate. known is false if no CA certificate has the key
+// identifier.
+func (s *Store) NeedsAuditUpdateByKeyIdentifier(b64KeyIdentifier string, asOf time.Time) (needsUpdate bool, known bool) {
+	sha256Fingerprints := s.sha256FingerprintsMap[b64KeyIdentifier]
+	if len(sha256Fingerprints) == 0 {
+		sha256Fingerprints = s.sha256FingerprintsMap[canonicalKeyIdentifier(b64KeyIdentifier)]
+	}
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		if cr := s.certificateRecord(sha256Fingerprint); cr != nil {
+			known = true
+			if s.needsAuditUpdate(sha256Fingerprint, cr, asOf) {
+				needsUpdate = true
+				break
+			}
+		}
+	}
+	observeLookup("NeedsAuditUpdateByKeyIdentifier", known)
+	return needsUpdate, known
+}
+
+func GetIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier string) *IssuerCapabilities {
+	return GetDefaultStore().GetIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier)
+}
+
+func (s *Store) GetIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier string) *IssuerCapabilities {
+	ic := s.issuerCapabilitiesMap[b64KeyIdentifier]
+	if ic == nil {
+		ic = s.issuerCapabilitiesMap[canonicalKeyIdentifier(b64KeyIdentifier)]
+	}
+	observeLookup("GetIssuerCapabilitiesByKeyIdentifier", ic != nil)
+	return ic
+}
+
+func GetIssuerCapabilitiesByKeyIdentifierBytes(keyIdentifier []byte) *IssuerCapabilities {
+	return GetDefaultStore().GetIssuerCapabilitiesByKeyIdentifierBytes(keyIdentifier)
+}
+
+func (s *Store) GetIssuerCapabilitiesByKeyIdentifierBytes(keyIdentifier []byte) *IssuerCapabilities {
+	var buf [KEY_IDENTIFIER_BUFFER_SIZE]byte
+	ic := s.issuerCapabilitiesMap[string(appendBase64KeyIdentifier(buf[:0], keyIdentifier))]
+	observeLookup("GetIssuerCapabilitiesByKeyIdentifierBytes", ic != nil)
+	return ic
 }
 
 func GetIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier string) ([sha256.Size]byte, bool) {
-	issuerSPKISHA256, ok := issuerSPKISHA256Map[b64KeyIdentifier]
+	return GetDefaultStore().GetIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier)
+}
+
+func (s *Store) GetIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier string) ([sha256.Size]byte, bool) {
+	issuerSPKISHA256, ok := s.issuerSPKISHA256Map[b64KeyIdentifier]
+	if !ok {
+		issuerSPKISHA256, ok = s.issuerSPKISHA256Map[canonicalKeyIdentifier(b64KeyIdentifier)]
+	}
+	observeLookup("GetIssuerSPKISHA256ByKeyIdentifier", ok)
+	return issuerSPKISHA256, ok
+}
+
+func GetIssuerSPKISHA256ByKeyIdentifierBytes(keyIdentifier []byte) ([sha256.Size]byte, bool) {
+	return GetDefaultStore().GetIssuerSPKISHA256ByKeyIdentifierBytes(keyIdentifier)
+}
+
+func (s *Store) GetIssuerSPKISHA256ByKeyIdentifierBytes(keyIdentifier []byte) ([sha256.Size]byte, bool) {
+	var buf [KEY_IDENTIFIER_BUFFER_SIZE]byte
+	issuerSPKISHA256, ok := s.issuerSPKISHA256Map[string(appendBase64KeyIdentifier(buf[:0], keyIdentifier))]
+	observeLookup("GetIssuerSPKISHA256ByKeyIdentifierBytes", ok)
 	return issuerSPKISHA256, ok
 }
 
 func GetCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) ([]byte, bool) {
-	der, ok := certificateDERMap[sha256Fingerprint]
+	return GetDefaultStore().GetCACertificateBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) GetCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) ([]byte, bool) {
+	if !s.certificatesLoaded.Load() {
+		observeLookup("GetCACertificateBySHA256", false)
+		return nil, false
+	}
+	der, ok := s.certificateDERMap[sha256Fingerprint]
+	observeLookup("GetCACertificateBySHA256", ok)
 	return der, ok
 }
 
+func GetParsedCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) *x509.Certificate {
+	return GetDefaultStore().GetParsedCACertificateBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) GetParsedCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) *x509.Certificate {
+	if !s.certificatesLoaded.Load() {
+		observeLookup("GetParsedCACertificateBySHA256", false)
+		return nil
+	}
+	cert := s.parsedCertificate(sha256Fingerprint)
+	observeLookup("GetParsedCACertificateBySHA256", cert != nil)
+	return cert
+}
+
+func GetCrossSignsBySPKISHA256(spkiSHA256 [sha256.Size]byte) []*CrossSign {
+	return GetDefaultStore().GetCrossSignsBySPKISHA256(spkiSHA256)
+}
+
+func (s *Store) GetCrossSignsBySPKISHA256(spkiSHA256 [sha256.Size]byte) []*CrossSign {
+	if !s.certificatesLoaded.Load() {
+		observeLookup("GetCrossSignsBySPKISHA256", false)
+		return nil
+	}
+	s.indexCrossSignsOnce.Do(s.indexCrossSigns)
+	crossSigns := s.crossSignsMap[spkiSHA256]
+	observeLookup("GetCrossSignsBySPKISHA256", len(crossSigns) > 0)
+	return crossSigns
+}
+
 func LoadAllCACertificates() {
-	readAllCACertificatePEMsCSVOnce.Do(readAllCACertificatePEMsCSV)
+	GetDefaultStore().LoadAllCACertificates()
+}
+
+func (s *Store) LoadAllCACertificates() {
+	s.readAllCACertificatePEMsCSVOnce.Do(s.readAllCACertificatePEMsCSV)
+}
+
+func PreloadParsedCACertificates() {
+	GetDefaultStore().PreloadParsedCACertificates()
+}
+
+// PreloadParsedCACertificates loads all CA certificates (see LoadAllCACertificates) and parses every one of them up
+// front, for batch consumers that will look up most of them. Otherwise, certificates are parsed on demand and only the
+// most recently used are kept.
+func (s *Store) PreloadParsedCACertificates() {
+	s.LoadAllCACertificates()
+	s.preloadParsedCACertificatesOnce.Do(s.preloadParsedCACertificates)
+}
+
+func LoadRawRecords() {
+	GetDefaultStore().LoadRawRecords()
+}
+
+func (s *Store) LoadRawRecords() {
+	s.readAllCertificateRecordsCSVRawOnce.Do(s.readAllCertificateRecordsCSVRaw)
+}
+
+func GetRawRecordBySHA256(sha256Fingerprint [sha256.Size]byte) *RawRecord {
+	return GetDefaultStore().GetRawRecordBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) GetRawRecordBySHA256(sha256Fingerprint [sha256.Size]byte) *RawRecord {
+	if !s.rawRecordsLoaded.Load() {
+		observeLookup("GetRawRecordBySHA256", false)
+		return nil
+	}
+	rawRecords := s.rawRecordMap[sha256Fingerprint]
+	observeLookup("GetRawRecordBySHA256", len(rawRecords) > 0)
+	if len(rawRecords) == 0 {
+		return nil
+	}
+	return rawRecords[0]
+}
+
+func GetRawRecordsBySHA256(sha256Fingerprint [sha256.Size]byte) []*RawRecord {
+	return GetDefaultStore().GetRawRecordsBySHA256(sha256Fingerprint)
+}
+
+// GetRawRecordsBySHA256 returns every raw CCADB CSV record for the CA certificate, of which there is more than one when
+// CCADB contains duplicate records. The primary record, which GetRawRecordBySHA256 returns and from which the typed
+// record is parsed, comes first, followed by the others in the order in which they appear in the report.
+func (s *Store) GetRawRecordsBySHA256(sha256Fingerprint [sha256.Size]byte) []*RawRecord {
+	if !s.rawRecordsLoaded.Load() {
+		observeLookup("GetRawRecordsBySHA256", false)
+		return nil
+	}
+	rawRecords := s.rawRecordMap[sha256Fingerprint]
+	observeLookup("GetRawRecordsBySHA256", len(rawRecords) > 0)
+	return rawRecords
+}
+
+func LoadAPIRecords(ctx context.Context, c *APIClient, soql, fingerprintField string) error {
+	return GetDefaultStore().LoadAPIRecords(ctx, c, soql, fingerprintField)
+}
+
+// LoadAPIRecords runs a SOQL query with a CCADB API client and attaches each returned record to the CA certificate whose
+// SHA-256 fingerprint is in fingerprintField (which may follow relationships, e.g. "Certificate__r.SHA_256__c"), so that
+// GetAPIRecordsBySHA256 can return it alongside the data from the CSV reports. Records without a valid fingerprint are
+// skipped. Each call adds to the records attached by earlier calls, so that several objects (e.g. certificate records
+// and their cases) can be loaded. The records aren't carried over when Refresh replaces the default Store.
+func (s *Store) LoadAPIRecords(ctx context.Context, c *APIClient, soql, fingerprintField string) error {
+	return s.loadAPIRecords(ctx, c, soql, fingerprintField)
+}
+
+func GetAPIRecordsBySHA256(sha256Fingerprint [sha256.Size]byte) []APIRecord {
+	return GetDefaultStore().GetAPIRecordsBySHA256(sha256Fingerprint)
+}
+
+// GetAPIRecordsBySHA256 returns the CCADB API records that LoadAPIRecords attached to the CA certificate, in the order
+// in which they were loaded, or nil if there are none.
+func (s *Store) GetAPIRecordsBySHA256(sha256Fingerprint [sha256.Size]byte) []APIRecord {
+	s.apiRecordsMu.RLock()
+	defer s.apiRecordsMu.RUnlock()
+	apiRecords := slices.Clone(s.apiRecordMap[sha256Fingerprint])
+	observeLookup("GetAPIRecordsBySHA256", len(apiRecords) > 0)
+	return apiRecords
+}
+
+func LoadALVResultsCSV(r io.Reader) error {
+	return GetDefaultStore().LoadALVResultsCSV(r)
+}
+
+// LoadALVResultsCSV reads the CCADB's Audit Letter Validation (ALV) results, i.e. whether each CA certificate was found
+// in each of its audit statements, from a CSV export that has a "SHA-256 Fingerprint" column and one or more ALV result
+// columns (e.g. "BR Audit ALV Found Cert"), replacing the results read from the dataset or by an earlier call. The
+// results aren't carried over when Refresh replaces the default Store.
+func (s *Store) LoadALVResultsCSV(r io.Reader) error {
+	return s.loadALVResultsCSV(r)
+}
+
+func LoadALVResults(ctx context.Context, c *APIClient, config ALVConfig) error {
+	return GetDefaultStore().LoadALVResults(ctx, c, config)
+}
+
+// LoadALVResults queries the ALV results with a CCADB API client, in the object and fields named by config, replacing
+// the results read from the dataset or by an earlier call. Like those read by LoadALVResultsCSV, the results aren't
+// carried over when Refresh replaces the default Store.
+func (s *Store) LoadALVResults(ctx context.Context, c *APIClient, config ALVConfig) error {
+	return s.loadALVResults(ctx, c, config)
+}
+
+func GetALVResultsBySHA256(sha256Fingerprint [sha256.Size]byte) map[string]ALVResult {
+	return GetDefaultStore().GetALVResultsBySHA256(sha256Fingerprint)
+}
+
+// GetALVResultsBySHA256 returns the ALV results of the CA certificate, keyed by audit type (e.g. ALV_AUDIT_STANDARD),
+// or nil if there are none.
+func (s *Store) GetALVResultsBySHA256(sha256Fingerprint [sha256.Size]byte) map[string]ALVResult {
+	s.alvMu.RLock()
+	defer s.alvMu.RUnlock()
+	results := maps.Clone(s.alvResultMap[sha256Fingerprint])
+	observeLookup("GetALVResultsBySHA256", results != nil)
+	return results
+}
+
+func ListALVFindings(now time.Time) []*ALVFinding {
+	return GetDefaultStore().ListALVFindings(now)
+}
+
+// ListALVFindings returns the CA certificates whose ALV results show that they weren't found in an audit statement, and
+// the CA certificates that are valid at now, unrevoked, included in (or trusted by) a root program, and audited in their
+// own right, but that have no valid result for an audit type that they need: Standard, and BR or EV SSL if they are TLS
+// or EV TLS capable. The findings are ordered by CA Owner, Certificate Name, SHA-256 fingerprint, and audit type. It
+// returns nil if no ALV results have been loaded.
+func (s *Store) ListALVFindings(now time.Time) []*ALVFinding {
+	return s.listALVFindings(now)
+}
+
+func SetInstrumentation(i Instrumentation) {
+	if i == nil {
+		instrumentation.Store(nil)
+	} else {
+		instrumentation.Store(&instrumentationHolder{Instrumentation: i})
+	}
+}
+
+func GetDatasetAge() (time.Duration, bool) {
+	return GetDefaultStore().DatasetAge()
+}
+
+// DatasetAge returns the time elapsed since the Store's dataset was fetched (see DatasetDate), and false if that isn't
+// known.
+func (s *Store) DatasetAge() (time.Duration, bool) {
+	if s.datasetDate.IsZero() {
+		return 0, false
+	}
+	return time.Since(s.datasetDate), true
+}
+
+func GetDatasetDate() (time.Time, bool) {
+	return GetDefaultStore().DatasetDate()
+}
+
+// DatasetDate returns when the Store's dataset was fetched, as recorded in its manifest by cmd/dataset_info or
+// FetchReports, and false if the dataset has no manifest or its manifest doesn't record the date.
+func (s *Store) DatasetDate() (time.Time, bool) {
+	return s.datasetDate, !s.datasetDate.IsZero()
+}
+
+func LookupCACertCapabilitiesBySHA256(sha256Fingerprint [sha256.Size]byte) (*CACertCapabilities, error) {
+	return GetDefaultStore().LookupCACertCapabilitiesBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) LookupCACertCapabilitiesBySHA256(sha256Fingerprint [sha256.Size]byte) (*CACertCapabilities, error) {
+	if s.loadErr != nil {
+		return nil, s.loadErr
+	} else if ccc := s.GetCACertCapabilitiesBySHA256(sha256Fingerprint); ccc != nil {
+		return ccc, nil
+	}
+	return nil, ErrUnknownFingerprint
+}
+
+func LookupCertificateRecordBySHA256(sha256Fingerprint [sha256.Size]byte) (*CertificateRecord, error) {
+	return GetDefaultStore().LookupCertificateRecordBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) LookupCertificateRecordBySHA256(sha256Fingerprint [sha256.Size]byte) (*CertificateRecord, error) {
+	if s.loadErr != nil {
+		return nil, s.loadErr
+	} else if cr := s.GetCertificateRecordBySHA256(sha256Fingerprint); cr != nil {
+		return cr, nil
+	}
+	return nil, ErrUnknownFingerprint
+}
+
+func LookupIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier string) (*IssuerCapabilities, error) {
+	return GetDefaultStore().LookupIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier)
+}
+
+func (s *Store) LookupIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier string) (*IssuerCapabilities, error) {
+	if s.loadErr != nil {
+		return nil, s.loadErr
+	} else if ic := s.GetIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier); ic != nil {
+		return ic, nil
+	}
+	return nil, ErrUnknownKeyIdentifier
+}
+
+func LookupIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier string) ([sha256.Size]byte, error) {
+	return GetDefaultStore().LookupIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier)
+}
+
+func (s *Store) LookupIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier string) ([sha256.Size]byte, error) {
+	if s.loadErr != nil {
+		return [sha256.Size]byte{}, s.loadErr
+	} else if issuerSPKISHA256, ok := s.GetIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier); ok {
+		return issuerSPKISHA256, nil
+	}
+	return [sha256.Size]byte{}, ErrUnknownKeyIdentifier
+}
+
+func LookupCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) ([]byte, error) {
+	return GetDefaultStore().LookupCACertificateBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) LookupCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) ([]byte, error) {
+	if !s.certificatesLoaded.Load() {
+		return nil, fmt.Errorf("%w: LoadAllCACertificates has not been called", ErrDatasetNotLoaded)
+	} else if s.certificatesErr != nil {
+		return nil, s.certificatesErr
+	} else if der, ok := s.GetCACertificateBySHA256(sha256Fingerprint); ok {
+		return der, nil
+	}
+	return nil, ErrUnknownFingerprint
+}
+
+func LookupParsedCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) (*x509.Certificate, error) {
+	return GetDefaultStore().LookupParsedCACertificateBySHA256(sha256Fingerprint)
+}
+
+func (s *Store) LookupParsedCACertificateBySHA256(sha256Fingerprint [sha256.Size]byte) (*x509.Certificate, error) {
+	if !s.certificatesLoaded.Load() {
+		return nil, fmt.Errorf("%w: LoadAllCACertificates has not been called", ErrDatasetNotLoaded)
+	} else if s.certificatesErr != nil {
+		return nil, s.certificatesErr
+	} else if cert := s.GetParsedCACertificateBySHA256(sha256Fingerprint); cert != nil {
+		return cert, nil
+	}
+	return nil, ErrUnknownFingerprint
+}
+
+func CanIssueForDNSName(b64KeyIdentifier string, dnsName string) (bool, error) {
+	return GetDefaultStore().CanIssueForDNSName(b64KeyIdentifier, dnsName)
+}
+
+// CanIssueForDNSName reports whether a disclosed, unrevoked, unexpired, TLS-capable CA certificate with the given key
+// identifier is permitted, by the name constraints in it and its disclosed parents, to issue for dnsName. Requires
+// LoadAllCACertificates to have been called first.
+func (s *Store) CanIssueForDNSName(b64KeyIdentifier string, dnsName string) (bool, error) {
+	if s.loadErr != nil {
+		return false, s.loadErr
+	} else if !s.certificatesLoaded.Load() {
+		return false, fmt.Errorf("%w: LoadAllCACertificates has not been called", ErrDatasetNotLoaded)
+	} else if s.certificatesErr != nil {
+		return false, s.certificatesErr
+	}
+
+	sha256Fingerprints := s.GetSHA256FingerprintsByKeyIdentifier(b64KeyIdentifier)
+	if len(sha256Fingerprints) == 0 {
+		return false, ErrUnknownKeyIdentifier
+	}
+	dnsName = normalizeDNSName(dnsName)
+	now := time.Now()
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		if s.canIssueForDNSName(sha256Fingerprint, dnsName, now) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func GetCAOwnersByKeyIdentifier(b64KeyIdentifier string) []*CAOwnership {
+	return GetDefaultStore().GetCAOwnersByKeyIdentifier(b64KeyIdentifier)
+}
+
+// GetCAOwnersByKeyIdentifier returns the distinct CA Owner and Subordinate CA Owner pairs of the CA certificates with
+// the given key identifier, ordered by CA Owner and then Subordinate CA Owner. It returns nil if no CA certificate has
+// the key identifier.
+func (s *Store) GetCAOwnersByKeyIdentifier(b64KeyIdentifier string) []*CAOwnership {
+	sha256Fingerprints := s.sha256FingerprintsMap[b64KeyIdentifier]
+	if len(sha256Fingerprints) == 0 {
+		sha256Fingerprints = s.sha256FingerprintsMap[canonicalKeyIdentifier(b64KeyIdentifier)]
+	}
+	owners := s.caOwnersOf(sha256Fingerprints)
+	observeLookup("GetCAOwnersByKeyIdentifier", len(owners) > 0)
+	return owners
+}
+
+func GetCAOwnersByKeyIdentifierBytes(keyIdentifier []byte) []*CAOwnership {
+	return GetDefaultStore().GetCAOwnersByKeyIdentifierBytes(keyIdentifier)
+}
+
+func (s *Store) GetCAOwnersByKeyIdentifierBytes(keyIdentifier []byte) []*CAOwnership {
+	return s.GetCAOwnersByKeyIdentifier(KeyIdentifierToBase64(keyIdentifier))
+}
+
+func GetUltimateRootOwner(sha256Fingerprint [sha256.Size]byte) (string, bool) {
+	return GetDefaultStore().GetUltimateRootOwner(sha256Fingerprint)
+}
+
+// GetUltimateRootOwner returns the CA Owner of the root certificate that the CA certificate chains up to, following the
+// disclosed parent links, so that subordinate CAs can be attributed to the CA Owner that controls their root rather
+// than to the organization that operates them. For a root certificate, this is its own CA Owner. It returns false if
+// the CA certificate is not in CCADB, or if its disclosed parents do not lead to a root certificate.
+func (s *Store) GetUltimateRootOwner(sha256Fingerprint [sha256.Size]byte) (string, bool) {
+	rootSHA256Fingerprint, ok := s.rootOf(sha256Fingerprint)
+	observeLookup("GetUltimateRootOwner", ok)
+	if !ok {
+		return "", false
+	}
+	return s.certificateRecord(rootSHA256Fingerprint).CAOwner, true
+}
+
+func IsExternallyOperated(sha256Fingerprint [sha256.Size]byte) bool {
+	return GetDefaultStore().IsExternallyOperated(sha256Fingerprint)
+}
+
+// IsExternallyOperated reports whether the CA certificate is operated by an organization other than its CA Owner, i.e.
+// whether its Subordinate CA Owner is set and differs from its CA Owner. It returns false if the CA certificate is not
+// in CCADB.
+func (s *Store) IsExternallyOperated(sha256Fingerprint [sha256.Size]byte) bool {
+	cr := s.certificateRecord(sha256Fingerprint)
+	observeLookup("IsExternallyOperated", cr != nil)
+	return cr != nil && cr.isExternallyOperated()
+}
+
+func ListExternallyOperatedCACertificates(now time.Time) []*ExternallyOperatedCACertificate {
+	return GetDefaultStore().ListExternallyOperatedCACertificates(now)
+}
+
+// ListExternallyOperatedCACertificates returns the externally operated intermediate certificates that are capable of
+// issuing TLS certificates, that are valid at now, and that CCADB doesn't consider to be revoked. They are ordered by
+// Subordinate CA Owner, CA Owner, Certificate Name, and SHA-256 fingerprint.
+func (s *Store) ListExternallyOperatedCACertificates(now time.Time) []*ExternallyOperatedCACertificate {
+	eoccs := s.listExternallyOperatedCACertificates(now)
+	observeLookup("ListExternallyOperatedCACertificates", len(eoccs) > 0)
+	return eoccs
+}
+
+func SearchRecords(query string) ([]*SearchResult, error) {
+	return GetDefaultStore().SearchRecords(query)
+}
+
+// SearchRecords returns the CA certificates whose Certificate Name, CA Owner, or Subordinate CA Owner matches the query,
+// or, once LoadAllCACertificates has been called, whose subject Common Name or Organization does. The query is a case
+// insensitive substring, or a case insensitive regular expression if it is enclosed in slashes (e.g. "/^ISRG Root/").
+// The results are ordered by CA Owner, Certificate Name, and SHA-256 fingerprint.
+func (s *Store) SearchRecords(query string) ([]*SearchResult, error) {
+	matches, err := compileSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	results := s.searchRecords(matches)
+	observeLookup("SearchRecords", len(results) > 0)
+	return results, nil
+}
+
+func GetIssuerStatusByKeyIdentifier(b64KeyIdentifier string) *IssuerStatus {
+	return GetDefaultStore().GetIssuerStatusByKeyIdentifier(b64KeyIdentifier)
+}
+
+// GetIssuerStatusByKeyIdentifier rolls up every CA certificate with the given key identifier: how many are valid,
+// expired, or revoked, when they expire, and which root programs trust the valid ones. It returns nil if no CA
+// certificate has the key identifier.
+func (s *Store) GetIssuerStatusByKeyIdentifier(b64KeyIdentifier string) *IssuerStatus {
+	sha256Fingerprints := s.sha256FingerprintsMap[b64KeyIdentifier]
+	if len(sha256Fingerprints) == 0 {
+		sha256Fingerprints = s.sha256FingerprintsMap[canonicalKeyIdentifier(b64KeyIdentifier)]
+	}
+	observeLookup("GetIssuerStatusByKeyIdentifier", len(sha256Fingerprints) > 0)
+	if len(sha256Fingerprints) == 0 {
+		return nil
+	}
+	return s.rollUpIssuerStatus(sha256Fingerprints, time.Now())
+}
+
+func ListExpiringCACertificates(now time.Time, within time.Duration) []*ExpiringCAOwner {
+	return GetDefaultStore().ListExpiringCACertificates(now, within)
+}
+
+// ListExpiringCACertificates returns the CA certificates that are valid at now but expire within the given duration,
+// that CCADB doesn't consider to be revoked, and that are trusted by at least one root program or are capable of
+// issuing TLS, S/MIME, or Code Signing certificates. They are grouped by CA Owner, in alphabetical order.
+func (s *Store) ListExpiringCACertificates(now time.Time, within time.Duration) []*ExpiringCAOwner {
+	expiringCAOwners := s.listExpiringCACertificates(now, within)
+	observeLookup("ListExpiringCACertificates", len(expiringCAOwners) > 0)
+	return expiringCAOwners
+}
+
+func AnalyzeBundle(pemBundle []byte, required CapabilityFilter) (*BundleAnalysis, error) {
+	return GetDefaultStore().AnalyzeBundle(pemBundle, required)
+}
+
+// AnalyzeBundle looks up each CA certificate in a PEM bundle (e.g. a server's chain file, or a truststore dump), and
+// reports which are not disclosed in CCADB, which CCADB considers to be revoked, and which lack any of the capabilities
+// required by required. Only the capability fields of required (TlsCapable, TlsEvCapable, SmimeCapable,
+// CodeSigningCapable, and HasVMCAudit) are used. Certificates that aren't CA certificates are skipped, and an error is
+// returned if the bundle contains no certificates at all.
+func (s *Store) AnalyzeBundle(pemBundle []byte, required CapabilityFilter) (*BundleAnalysis, error) {
+	analysis, err := s.analyzeBundle(pemBundle, required, time.Now())
+	observeLookup("AnalyzeBundle", err == nil && len(analysis.CACertificates) > 0)
+	return analysis, err
+}
+
+func GetManifestCheck() *ManifestCheck {
+	return GetDefaultStore().GetManifestCheck()
+}
+
+// GetManifestCheck returns the result of verifying the data files that the Store has read against the dataset's
+// manifest. Each list is in ascending order of path.
+func (s *Store) GetManifestCheck() *ManifestCheck {
+	s.manifestMu.Lock()
+	defer s.manifestMu.Unlock()
+	mc := &ManifestCheck{HasManifest: s.manifestChecksums != nil}
+	for _, filePath := range slices.Sorted(maps.Keys(s.manifestResults)) {
+		switch s.manifestResults[filePath] {
+		case manifestVerified:
+			mc.Verified = append(mc.Verified, filePath)
+		case manifestMismatched:
+			mc.Mismatched = append(mc.Mismatched, filePath)
+		case manifestUnlisted:
+			mc.Unlisted = append(mc.Unlisted, filePath)
+		}
+	}
+	return mc
 }
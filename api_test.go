@@ -0,0 +1,818 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// A hot-path lookup, which must not allocate.
+type hotLookup struct {
+	name   string
+	lookup func()
+}
+
+// hotLookups returns the lookups by SHA-256 fingerprint and by key identifier that CT-scale consumers make for every
+// certificate they see, both for ISRG Root X1 and for a CA certificate that is not in the embedded dataset.
+func hotLookups(tb testing.TB) []hotLookup {
+	s, err := NewStore(EmbeddedFS())
+	if err != nil {
+		tb.Fatalf("NewStore() returned %v", err)
+	}
+	sha256Fingerprint, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	cr := s.GetCertificateRecordBySHA256(sha256Fingerprint)
+	if cr == nil || cr.SubjectKeyIdentifier == "" {
+		tb.Fatal("ISRG Root X1 is missing from the embedded dataset, or has no Subject Key Identifier")
+	}
+	b64KeyIdentifier := cr.SubjectKeyIdentifier
+	keyIdentifier, _ := base64.StdEncoding.DecodeString(b64KeyIdentifier)
+	missingSHA256Fingerprint := sha256.Sum256(nil)
+	missingKeyIdentifier := make([]byte, len(keyIdentifier))
+	missingB64KeyIdentifier := base64.StdEncoding.EncodeToString(missingKeyIdentifier)
+	return []hotLookup{
+		{"GetCACertCapabilitiesBySHA256", func() { s.GetCACertCapabilitiesBySHA256(sha256Fingerprint) }},
+		{"GetCACertCapabilitiesBySHA256Miss", func() { s.GetCACertCapabilitiesBySHA256(missingSHA256Fingerprint) }},
+		{"GetCertificateRecordBySHA256", func() { s.GetCertificateRecordBySHA256(sha256Fingerprint) }},
+		{"GetRootProgramStatusBySHA256", func() { s.GetRootProgramStatusBySHA256(sha256Fingerprint, ROOT_PROGRAM_MOZILLA) }},
+		{"GetSHA256FingerprintsByKeyIdentifier", func() { s.GetSHA256FingerprintsByKeyIdentifier(b64KeyIdentifier) }},
+		{"GetSHA256FingerprintsByKeyIdentifierMiss", func() { s.GetSHA256FingerprintsByKeyIdentifier(missingB64KeyIdentifier) }},
+		{"GetSHA256FingerprintsByKeyIdentifierBytes", func() { s.GetSHA256FingerprintsByKeyIdentifierBytes(keyIdentifier) }},
+		{"GetIssuerCapabilitiesByKeyIdentifier", func() { s.GetIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier) }},
+		{"GetIssuerCapabilitiesByKeyIdentifierMiss", func() { s.GetIssuerCapabilitiesByKeyIdentifier(missingB64KeyIdentifier) }},
+		{"GetIssuerCapabilitiesByKeyIdentifierBytes", func() { s.GetIssuerCapabilitiesByKeyIdentifierBytes(keyIdentifier) }},
+		{"GetIssuerCapabilitiesByKeyIdentifierBytesMiss", func() { s.GetIssuerCapabilitiesByKeyIdentifierBytes(missingKeyIdentifier) }},
+		{"GetIssuerSPKISHA256ByKeyIdentifier", func() { s.GetIssuerSPKISHA256ByKeyIdentifier(b64KeyIdentifier) }},
+		{"GetIssuerSPKISHA256ByKeyIdentifierBytes", func() { s.GetIssuerSPKISHA256ByKeyIdentifierBytes(keyIdentifier) }},
+	}
+}
+
+// TestHotLookupsDoNotAllocate checks that lookups by SHA-256 fingerprint and by key identifier don't allocate, since
+// CT-scale consumers make them for every certificate they see.
+func TestHotLookupsDoNotAllocate(t *testing.T) {
+	for _, hl := range hotLookups(t) {
+		if allocs := testing.AllocsPerRun(100, hl.lookup); allocs != 0 {
+			t.Errorf("%s makes %.0f allocations per lookup", hl.name, allocs)
+		}
+	}
+}
+
+func BenchmarkHotLookups(b *testing.B) {
+	for _, hl := range hotLookups(b) {
+		b.Run(hl.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				hl.lookup()
+			}
+		})
+	}
+}
+
+// TestLookupStatistics checks that each lookup is counted under its own name, including the Bytes variants.
+func TestLookupStatistics(t *testing.T) {
+	var ls LookupStatistics
+	SetInstrumentation(&ls)
+	t.Cleanup(func() { SetInstrumentation(nil) })
+	for _, hl := range hotLookups(t) {
+		hl.lookup()
+	}
+
+	want := []string{
+		"GetCACertCapabilitiesBySHA256",
+		"GetCertificateRecordBySHA256",
+		"GetIssuerCapabilitiesByKeyIdentifier",
+		"GetIssuerCapabilitiesByKeyIdentifierBytes",
+		"GetIssuerSPKISHA256ByKeyIdentifier",
+		"GetIssuerSPKISHA256ByKeyIdentifierBytes",
+		"GetRootProgramStatusBySHA256",
+		"GetSHA256FingerprintsByKeyIdentifier",
+		"GetSHA256FingerprintsByKeyIdentifierBytes",
+	}
+	snapshot := ls.Snapshot()
+	if got := slices.Sorted(maps.Keys(snapshot)); !slices.Equal(got, want) {
+		t.Fatalf("Lookups were counted as %q, want %q", got, want)
+	}
+	if lc := snapshot["GetIssuerCapabilitiesByKeyIdentifierBytes"]; lc != (LookupCount{Lookups: 2, Hits: 1, Misses: 1}) {
+		t.Errorf("GetIssuerCapabilitiesByKeyIdentifierBytes was counted as %+v, want 2 lookups, 1 hit, and 1 miss", lc)
+	}
+}
+
+// TestCanIssueForDNSName checks how name constraints are applied to DNS names, including leading-dot constraints,
+// case folding, trailing dots, and wildcards.
+func TestCanIssueForDNSName(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		dnsName   string
+		permitted []string
+		excluded  []string
+		want      bool
+	}{
+		{"Unconstrained", "www.example.com", nil, nil, true},
+		{"PermittedExact", "example.com", []string{"example.com"}, nil, true},
+		{"PermittedSubdomain", "www.example.com", []string{"example.com"}, nil, true},
+		{"PermittedSuffixOnly", "badexample.com", []string{"example.com"}, nil, false},
+		{"PermittedOtherDomain", "example.org", []string{"example.com"}, nil, false},
+		{"PermittedAnyOf", "example.org", []string{"example.com", "example.org"}, nil, true},
+		{"LeadingDotExcludesDomain", "example.com", []string{".example.com"}, nil, false},
+		{"LeadingDotPermitsSubdomain", "www.example.com", []string{".example.com"}, nil, true},
+		{"NameCaseFolded", "WWW.Example.COM", []string{"example.com"}, nil, true},
+		{"ConstraintCaseFolded", "www.example.com", []string{"EXAMPLE.com"}, nil, true},
+		{"NameTrailingDot", "www.example.com.", []string{"example.com"}, nil, true},
+		{"ConstraintTrailingDot", "www.example.com", []string{"example.com."}, nil, true},
+		{"Excluded", "www.example.com", nil, []string{"example.com"}, false},
+		{"ExcludedSubtree", "x.bad.example.com", []string{"example.com"}, []string{"bad.example.com"}, false},
+		{"OutsideExcludedSubtree", "good.example.com", []string{"example.com"}, []string{"bad.example.com"}, true},
+		{"ExcludedLeadingDot", "example.com", nil, []string{".example.com"}, true},
+		{"ExcludedCaseFolded", "WWW.EXAMPLE.COM.", nil, []string{"Example.Com."}, false},
+		{"WildcardPermitted", "*.example.com", []string{".example.com"}, nil, true},
+		{"WildcardExcluded", "*.example.com", nil, []string{".example.com"}, false},
+		{"WildcardOtherDomain", "*.example.org", []string{"example.com"}, nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cert := &x509.Certificate{PermittedDNSDomains: tc.permitted, ExcludedDNSDomains: tc.excluded}
+			if got := permitsDNSName(cert, normalizeDNSName(tc.dnsName)); got != tc.want {
+				t.Errorf("%q with permitted %q and excluded %q gave %v, want %v", tc.dnsName, tc.permitted, tc.excluded, got, tc.want)
+			}
+		})
+	}
+
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	sha256Fingerprint, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	b64KeyIdentifier := s.GetCertificateRecordBySHA256(sha256Fingerprint).SubjectKeyIdentifier
+	if _, err := s.CanIssueForDNSName(b64KeyIdentifier, "example.com"); !errors.Is(err, ErrDatasetNotLoaded) {
+		t.Errorf("CanIssueForDNSName() before LoadAllCACertificates returned %v, want ErrDatasetNotLoaded", err)
+	}
+	s.LoadAllCACertificates()
+	if ok, err := s.CanIssueForDNSName(b64KeyIdentifier, "Example.COM."); err != nil || !ok {
+		t.Errorf("CanIssueForDNSName() for ISRG Root X1 returned %v, %v, want true", ok, err)
+	}
+	if _, err := s.CanIssueForDNSName(base64.StdEncoding.EncodeToString(make([]byte, 20)), "example.com"); !errors.Is(err, ErrUnknownKeyIdentifier) {
+		t.Errorf("CanIssueForDNSName() for an unknown key identifier returned %v, want ErrUnknownKeyIdentifier", err)
+	}
+}
+
+// TestCanonicalKeyIdentifier checks that key identifiers encoded as hex or Base64 are converted to the padded standard
+// Base64 encoding used by CCADB.
+func TestCanonicalKeyIdentifier(t *testing.T) {
+	// The key identifier's encodings contain '+' and '/', so that the URL-safe encodings differ from the standard ones.
+	keyIdentifier, _ := hex.DecodeString("FBFF3E00112233445566778899AABBCCDDEEFFFE")
+	want := base64.StdEncoding.EncodeToString(keyIdentifier)
+	for _, tc := range []struct {
+		name          string
+		keyIdentifier string
+		want          string
+	}{
+		{"StdBase64", want, want},
+		{"RawStdBase64", base64.RawStdEncoding.EncodeToString(keyIdentifier), want},
+		{"URLBase64", base64.URLEncoding.EncodeToString(keyIdentifier), want},
+		{"RawURLBase64", base64.RawURLEncoding.EncodeToString(keyIdentifier), want},
+		{"UpperCaseHex", "FBFF3E00112233445566778899AABBCCDDEEFFFE", want},
+		{"LowerCaseHex", "fbff3e00112233445566778899aabbccddeefffe", want},
+		{"MixedCaseHex", "FbFf3E00112233445566778899aAbBcCdDeEfFfE", want},
+		{"ColonSeparatedHex", "FB:FF:3E:00:11:22:33:44:55:66:77:88:99:AA:BB:CC:DD:EE:FF:FE", want},
+		{"LowerCaseColonSeparatedHex", "fb:ff:3e:00:11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff:fe", want},
+		{"Whitespace", " " + want + "\n", want},
+		{"Invalid", "not a key identifier!", "not a key identifier!"},
+		{"Empty", "", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := canonicalKeyIdentifier(tc.keyIdentifier); got != tc.want {
+				t.Errorf("canonicalKeyIdentifier(%q) returned %q, want %q", tc.keyIdentifier, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestGetIssuerCapabilitiesByKeyIdentifier checks that an issuer's capabilities are found by its key identifier in any
+// accepted encoding, and that an unknown key identifier is reported as such.
+func TestGetIssuerCapabilitiesByKeyIdentifier(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	sha256Fingerprint, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	b64KeyIdentifier := s.GetCertificateRecordBySHA256(sha256Fingerprint).SubjectKeyIdentifier
+	want := s.GetIssuerCapabilitiesByKeyIdentifier(b64KeyIdentifier)
+	if want == nil {
+		t.Fatalf("GetIssuerCapabilitiesByKeyIdentifier(%q) returned nil for ISRG Root X1", b64KeyIdentifier)
+	}
+	keyIdentifier, _ := base64.StdEncoding.DecodeString(b64KeyIdentifier)
+	hexKeyIdentifier := hex.EncodeToString(keyIdentifier)
+	var colonSeparated []string
+	for i := 0; i < len(hexKeyIdentifier); i += 2 {
+		colonSeparated = append(colonSeparated, strings.ToUpper(hexKeyIdentifier[i:i+2]))
+	}
+	for _, encoded := range []string{
+		hexKeyIdentifier,
+		strings.ToUpper(hexKeyIdentifier),
+		strings.Join(colonSeparated, ":"),
+		base64.RawStdEncoding.EncodeToString(keyIdentifier),
+		base64.URLEncoding.EncodeToString(keyIdentifier),
+		base64.RawURLEncoding.EncodeToString(keyIdentifier),
+	} {
+		if got, err := s.LookupIssuerCapabilitiesByKeyIdentifier(encoded); err != nil || got != want {
+			t.Errorf("LookupIssuerCapabilitiesByKeyIdentifier(%q) returned %v, %v, want %v", encoded, got, err, want)
+		}
+	}
+
+	unknown := base64.StdEncoding.EncodeToString(make([]byte, len(keyIdentifier)))
+	if got := s.GetIssuerCapabilitiesByKeyIdentifier(unknown); got != nil {
+		t.Errorf("GetIssuerCapabilitiesByKeyIdentifier(%q) returned %v, want nil", unknown, got)
+	}
+	if _, err := s.LookupIssuerCapabilitiesByKeyIdentifier(unknown); !errors.Is(err, ErrUnknownKeyIdentifier) {
+		t.Errorf("LookupIssuerCapabilitiesByKeyIdentifier(%q) returned %v, want ErrUnknownKeyIdentifier", unknown, err)
+	}
+}
+
+// TestListFingerprints checks which CA certificates each capability filter selects, and that they are in ascending
+// order.
+func TestListFingerprints(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	for _, tc := range []struct {
+		name   string
+		filter CapabilityFilter
+		want   []string
+	}{
+		{"TlsCapable", CapabilityFilter{TlsCapable: true}, []string{
+			"591E9CE6C863D3A079E9FABE1478C7339A26B21269DDE795211361024AE31A44", // R11
+			"5DFDB3CF31B26F23D87C09F3A0CEF642F64069A9FB7CFE29270BB5DC0F1E16BB", // E5
+			"76E9E288AAFC0E37F4390CBF946AAD997D5C1C901B3CE513D3D8FADBABE2AB85", // E6
+			"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6", // ISRG Root X1
+			"9D7C3F1AA6AD2B2EC0D5CF1E246F8D9AE6CBC9FD0755AD37BB974B1F2FB603F3", // R10
+		}},
+		{"TlsCapableRoot", CapabilityFilter{RecordType: RecordTypeRoot, TlsCapable: true}, []string{
+			"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6", // ISRG Root X1
+		}},
+		{"SmimeCapable", CapabilityFilter{SmimeCapable: true}, []string{
+			"3666F8049140FDC0A65E809B281A3BE3B10DAFEEFD76B9DDC272A93E83CA5B99", // Chambers of Commerce Root - 2008
+			"D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624", // Certum CA
+		}},
+		{"CodeSigningCapable", CapabilityFilter{CodeSigningCapable: true}, []string{
+			"3666F8049140FDC0A65E809B281A3BE3B10DAFEEFD76B9DDC272A93E83CA5B99", // Chambers of Commerce Root - 2008
+			"3B0B2D299AF774D6C332B2BFABB45F44D866432B9552EA094D529B6ED125048B", // Camerfirma Codesign II - 2014
+			"D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624", // Certum CA
+		}},
+		{"SeveralCapabilities", CapabilityFilter{RecordType: RecordTypeIntermediate, SmimeCapable: true, CodeSigningCapable: true}, []string{
+			"3666F8049140FDC0A65E809B281A3BE3B10DAFEEFD76B9DDC272A93E83CA5B99", // Chambers of Commerce Root - 2008
+		}},
+		{"HasVMCAudit", CapabilityFilter{HasVMCAudit: true}, []string{
+			"504386C9EE8932FECC95FADE427F69C3E2534B7310489E300FEE448E33C46B42", // DigiCert Verified Mark Root CA
+		}},
+		{"TlsEvCapable", CapabilityFilter{TlsEvCapable: true}, nil},
+		{"ValidAtRoot", CapabilityFilter{RecordType: RecordTypeRoot, ValidAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}, []string{
+			"504386C9EE8932FECC95FADE427F69C3E2534B7310489E300FEE448E33C46B42", // DigiCert Verified Mark Root CA
+			"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6", // ISRG Root X1
+			"D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624", // Certum CA
+			"F28630BABF256E567B5821069FCF13148AB9A23E28FC0D70615AAE6ED284F4C8", // A-Trust-Qual-02 (2014)
+		}},
+		{"ExcludeRevokedIntermediate", CapabilityFilter{RecordType: RecordTypeIntermediate, ExcludeRevoked: true}, []string{
+			"3666F8049140FDC0A65E809B281A3BE3B10DAFEEFD76B9DDC272A93E83CA5B99", // Chambers of Commerce Root - 2008
+			"3B0B2D299AF774D6C332B2BFABB45F44D866432B9552EA094D529B6ED125048B", // Camerfirma Codesign II - 2014
+			"591E9CE6C863D3A079E9FABE1478C7339A26B21269DDE795211361024AE31A44", // R11
+			"5DFDB3CF31B26F23D87C09F3A0CEF642F64069A9FB7CFE29270BB5DC0F1E16BB", // E5
+			"6D99FB265EB1C5B3744765FCBC648F3CD8E1BFFAFDC4C2F99B9D47CF7FF1C24F", // ISRG Root X1 (cross-certificate)
+			"76E9E288AAFC0E37F4390CBF946AAD997D5C1C901B3CE513D3D8FADBABE2AB85", // E6
+			"9D7C3F1AA6AD2B2EC0D5CF1E246F8D9AE6CBC9FD0755AD37BB974B1F2FB603F3", // R10
+		}},
+		{"MozillaRoot", CapabilityFilter{RecordType: RecordTypeRoot, RootProgram: ROOT_PROGRAM_MOZILLA}, []string{
+			"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6", // ISRG Root X1
+			"D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624", // Certum CA
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, sha256Fingerprint := range s.ListFingerprints(tc.filter) {
+				got = append(got, fmt.Sprintf("%X", sha256Fingerprint))
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("ListFingerprints(%+v) returned %q, want %q", tc.filter, got, tc.want)
+			}
+		})
+	}
+
+	if got := len(s.ListFingerprints(CapabilityFilter{})); got != 13 {
+		t.Errorf("ListFingerprints() with the zero filter returned %d fingerprints, want all 13", got)
+	}
+}
+
+// TestGetIssuerStatusByKeyIdentifier checks the rollup of ISRG Root X1's key, which is shared by the unexpired root
+// certificate and an expired cross-certificate, and of a key whose only CA certificate's parent is revoked.
+func TestGetIssuerStatusByKeyIdentifier(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	keyIdentifierOf := func(hexFingerprint string) string {
+		sha256Fingerprint, _ := HexFingerprintToArray(hexFingerprint)
+		return s.GetCertificateRecordBySHA256(sha256Fingerprint).SubjectKeyIdentifier
+	}
+
+	is := s.GetIssuerStatusByKeyIdentifier(keyIdentifierOf(TEST_ISRG_ROOT_X1_SHA256))
+	if is == nil {
+		t.Fatal("GetIssuerStatusByKeyIdentifier() returned nil for ISRG Root X1")
+	} else if is.CertificateCount != 2 || is.ValidCount != 1 || is.ExpiredCount != 1 || is.RevokedCount != 0 {
+		t.Errorf("ISRG Root X1's key has %d certificates (%d valid, %d expired, %d revoked), want 2 (1 valid, 1 expired, 0 revoked)", is.CertificateCount, is.ValidCount, is.ExpiredCount, is.RevokedCount)
+	} else if is.EarliestExpiry.Format(time.DateOnly) != "2024-09-30" || is.LatestExpiry.Format(time.DateOnly) != "2035-06-04" {
+		t.Errorf("ISRG Root X1's key expires between %s and %s, want 2024-09-30 and 2035-06-04", is.EarliestExpiry, is.LatestExpiry)
+	} else if !slices.Contains(is.TrustedBy, ROOT_PROGRAM_MOZILLA) || !is.IsOperational() {
+		t.Errorf("ISRG Root X1's key is trusted by %q and operational %t, want Mozilla and true", is.TrustedBy, is.IsOperational())
+	}
+
+	if is := s.GetIssuerStatusByKeyIdentifier(keyIdentifierOf(TEST_PARENT_CERT_REVOKED_SHA256)); is == nil {
+		t.Error("GetIssuerStatusByKeyIdentifier() returned nil for DigitalSign TSA CA")
+	} else if is.RevokedCount != is.CertificateCount || is.TrustedBy != nil || is.IsOperational() {
+		t.Errorf("DigitalSign TSA CA's key has %d of %d certificates revoked, is trusted by %q, and is operational %t, want all revoked, untrusted, and not operational", is.RevokedCount, is.CertificateCount, is.TrustedBy, is.IsOperational())
+	}
+
+	if is := s.GetIssuerStatusByKeyIdentifier(base64.StdEncoding.EncodeToString(make([]byte, 20))); is != nil {
+		t.Errorf("GetIssuerStatusByKeyIdentifier() returned %+v for an unknown key identifier, want nil", is)
+	}
+}
+
+// newTestCertificate returns a new self-signed certificate, which is a CA certificate if isCA is true.
+func newTestCertificate(t *testing.T, commonName string, isCA bool) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+// TestAnalyzeBundle checks that a bundle's CA certificates are reported as undisclosed, revoked, or lacking a required
+// capability, and that other certificates are skipped.
+func TestAnalyzeBundle(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	s.LoadAllCACertificates()
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: newTestCertificate(t, "Leaf", false)})
+	fingerprints := []string{TEST_R10_SHA256, TEST_PARENT_CERT_REVOKED_SHA256, "3B0B2D299AF774D6C332B2BFABB45F44D866432B9552EA094D529B6ED125048B"}
+	var r10 []byte
+	for _, hexFingerprint := range fingerprints {
+		sha256Fingerprint, _ := HexFingerprintToArray(hexFingerprint)
+		der, ok := s.GetCACertificateBySHA256(sha256Fingerprint)
+		if !ok {
+			t.Fatalf("CA certificate %s is missing from the fixture", hexFingerprint)
+		} else if hexFingerprint == TEST_R10_SHA256 {
+			r10 = der
+		}
+		bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	undisclosed := newTestCertificate(t, "Undisclosed CA", true)
+	bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: undisclosed})...)
+	fingerprints = append(fingerprints, fmt.Sprintf("%X", sha256.Sum256(undisclosed)))
+
+	ba, err := s.AnalyzeBundle(bundle, CapabilityFilter{TlsCapable: true})
+	if err != nil {
+		t.Fatalf("AnalyzeBundle() returned %v", err)
+	}
+	summarize := func(bcs []*BundleCertificate) []string {
+		var summaries []string
+		for _, bc := range bcs {
+			summaries = append(summaries, fmt.Sprintf("%d %X %q", bc.Index, bc.SHA256Fingerprint, bc.MissingCapabilities))
+		}
+		return summaries
+	}
+	for _, tc := range []struct {
+		name string
+		got  []*BundleCertificate
+		want []string
+	}{
+		{"CACertificates", ba.CACertificates, []string{
+			"1 " + fingerprints[0] + " []",
+			"2 " + fingerprints[1] + ` ["TLS"]`,
+			"3 " + fingerprints[2] + ` ["TLS"]`,
+			"4 " + fingerprints[3] + " []",
+		}},
+		{"Undisclosed", ba.Undisclosed, []string{"4 " + fingerprints[3] + " []"}},
+		{"Revoked", ba.Revoked, []string{"2 " + fingerprints[1] + ` ["TLS"]`}},
+		{"LackingCapabilities", ba.LackingCapabilities, []string{"2 " + fingerprints[1] + ` ["TLS"]`, "3 " + fingerprints[2] + ` ["TLS"]`}},
+	} {
+		if got := summarize(tc.got); !slices.Equal(got, tc.want) {
+			t.Errorf("%s = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+	if !ba.HasProblems() {
+		t.Error("HasProblems() = false, want true")
+	}
+
+	if ba, err := s.AnalyzeBundle(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: r10}), CapabilityFilter{TlsCapable: true}); err != nil || ba.HasProblems() {
+		t.Errorf("AnalyzeBundle() of R10 returned %+v, %v, want no problems", ba, err)
+	}
+	if _, err := s.AnalyzeBundle(nil, CapabilityFilter{}); err == nil {
+		t.Error("AnalyzeBundle() of an empty bundle didn't return an error")
+	}
+}
+
+// TestListFingerprintsWhere checks which CA certificates each Query condition selects, and that extending a Query
+// doesn't change it.
+func TestListFingerprintsWhere(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	isrg := Where().CAOwner("Internet Security Research Group")
+	for _, tc := range []struct {
+		name  string
+		query *Query
+		want  []string
+	}{
+		{"RecordType", isrg.RecordType(RecordTypeRoot), []string{"96BCEC06 ISRG Root X1"}},
+		{"CAOwner", isrg, []string{"591E9CE6 R11", "5DFDB3CF E5", "76E9E288 E6", "96BCEC06 ISRG Root X1", "9D7C3F1A R10"}},
+		{"TLSCapable", Where().TLSCapable().RecordType(RecordTypeRoot), []string{"96BCEC06 ISRG Root X1"}},
+		{"TLSEVCapable", Where().TLSEVCapable(), nil},
+		{"SMIMECapable", Where().SMIMECapable(), []string{"3666F804 Chambers of Commerce Root - 2008", "D8E0FEBC Certum CA"}},
+		{"CodeSigningCapable", Where().CodeSigningCapable().RecordType(RecordTypeIntermediate), []string{"3666F804 Chambers of Commerce Root - 2008", "3B0B2D29 Camerfirma Codesign II - 2014"}},
+		{"HasVMCAudit", Where().HasVMCAudit(), []string{"504386C9 DigiCert Verified Mark Root CA"}},
+		{"ValidAt", Where().ValidAt(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)).RecordType(RecordTypeRoot), []string{"504386C9 DigiCert Verified Mark Root CA", "96BCEC06 ISRG Root X1", "D8E0FEBC Certum CA", "F28630BA A-Trust-Qual-02"}},
+		{"NotExpired", Where().NotExpired().CAOwner("A-Trust"), nil},
+		{"NotRevoked", Where().NotRevoked().CAOwner("AC Camerfirma, S.A."), []string{"3666F804 Chambers of Commerce Root - 2008", "3B0B2D29 Camerfirma Codesign II - 2014"}},
+		{"IncludedIn", Where().IncludedIn(ROOT_PROGRAM_MOZILLA).RecordType(RecordTypeRoot), []string{"96BCEC06 ISRG Root X1", "D8E0FEBC Certum CA"}},
+		{"MissingCRLDisclosure", Where().MissingCRLDisclosure(), []string{"75C9D436 A-Trust-Qual-02", "F28630BA A-Trust-Qual-02"}},
+		{"Filter", Where().Filter(CapabilityFilter{SmimeCapable: true}).CodeSigningCapable(), []string{"3666F804 Chambers of Commerce Root - 2008", "D8E0FEBC Certum CA"}},
+		{"Not", Where().CAOwner("A-Trust").Not(Where().NotExpired()), []string{"75C9D436 A-Trust-Qual-02", "F28630BA A-Trust-Qual-02"}},
+		{"Or", Where().Or(Where().HasVMCAudit(), Where().CAOwner("A-Trust")), []string{"504386C9 DigiCert Verified Mark Root CA", "75C9D436 A-Trust-Qual-02", "F28630BA A-Trust-Qual-02"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, sha256Fingerprint := range s.ListFingerprintsWhere(tc.query) {
+				got = append(got, fmt.Sprintf("%X %s", sha256Fingerprint[:4], s.GetCertificateRecordBySHA256(sha256Fingerprint).CertificateName))
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("ListFingerprintsWhere() returned %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	if got := len(s.ListFingerprintsWhere(Where())); got != 13 {
+		t.Errorf("ListFingerprintsWhere(Where()) returned %d fingerprints, want all 13", got)
+	}
+}
+
+// TestNeedsAuditUpdate checks when CA certificates need an updated audit, including one that is covered by its
+// parent's audits.
+func TestNeedsAuditUpdate(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	// ISRG Root X1's most recent standard audit period ended on 2025-08-31, and R10 is covered by its audits.
+	beforeDue := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	afterDue := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name            string
+		hexFingerprint  string
+		asOf            time.Time
+		wantNeedsUpdate bool
+		wantKnown       bool
+	}{
+		{"Audited", TEST_ISRG_ROOT_X1_SHA256, beforeDue, false, true},
+		{"Overdue", TEST_ISRG_ROOT_X1_SHA256, afterDue, true, true},
+		{"ParentAudited", TEST_R10_SHA256, beforeDue, false, true},
+		{"ParentOverdue", TEST_R10_SHA256, afterDue, true, true},
+		{"Revoked", TEST_PARENT_CERT_REVOKED_SHA256, afterDue, false, true},
+		{"NoAuditDisclosed", "75C9D4361CB96E993ABD9620CF043BE9407A4633F202F0F4C0E17851CC6089CD", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), true, true},
+		{"Expired", "75C9D4361CB96E993ABD9620CF043BE9407A4633F202F0F4C0E17851CC6089CD", afterDue, false, true},
+		{"Unknown", fmt.Sprintf("%X", sha256.Sum256(nil)), afterDue, false, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sha256Fingerprint, _ := HexFingerprintToArray(tc.hexFingerprint)
+			if needsUpdate, known := s.NeedsAuditUpdate(sha256Fingerprint, tc.asOf); needsUpdate != tc.wantNeedsUpdate || known != tc.wantKnown {
+				t.Errorf("NeedsAuditUpdate() = %t, %t, want %t, %t", needsUpdate, known, tc.wantNeedsUpdate, tc.wantKnown)
+			}
+		})
+	}
+
+	// ISRG Root X1's key is shared by an expired cross-certificate, which doesn't need to be audited.
+	sha256Fingerprint, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	b64KeyIdentifier := s.GetCertificateRecordBySHA256(sha256Fingerprint).SubjectKeyIdentifier
+	if needsUpdate, known := s.NeedsAuditUpdateByKeyIdentifier(b64KeyIdentifier, beforeDue); needsUpdate || !known {
+		t.Errorf("NeedsAuditUpdateByKeyIdentifier() before the audit is due = %t, %t, want false, true", needsUpdate, known)
+	}
+	if needsUpdate, known := s.NeedsAuditUpdateByKeyIdentifier(b64KeyIdentifier, afterDue); !needsUpdate || !known {
+		t.Errorf("NeedsAuditUpdateByKeyIdentifier() after the audit is due = %t, %t, want true, true", needsUpdate, known)
+	}
+	if needsUpdate, known := s.NeedsAuditUpdateByKeyIdentifier(base64.StdEncoding.EncodeToString(make([]byte, 20)), afterDue); needsUpdate || known {
+		t.Errorf("NeedsAuditUpdateByKeyIdentifier() of an unknown key identifier = %t, %t, want false, false", needsUpdate, known)
+	}
+}
+
+// TestGetCAOwnersByKeyIdentifier checks that ISRG Root X1's key is attributed both to ISRG and to IdenTrust, which
+// cross-certified it.
+func TestGetCAOwnersByKeyIdentifier(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	sha256Fingerprint, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	b64KeyIdentifier := s.GetCertificateRecordBySHA256(sha256Fingerprint).SubjectKeyIdentifier
+	keyIdentifier, _ := base64.StdEncoding.DecodeString(b64KeyIdentifier)
+	want := []CAOwnership{
+		{CAOwner: "IdenTrust Services, LLC", SubordinateCAOwner: "Internet Security Research Group"},
+		{CAOwner: "Internet Security Research Group"},
+	}
+	for name, owners := range map[string][]*CAOwnership{
+		"GetCAOwnersByKeyIdentifier":      s.GetCAOwnersByKeyIdentifier(b64KeyIdentifier),
+		"GetCAOwnersByKeyIdentifierBytes": s.GetCAOwnersByKeyIdentifierBytes(keyIdentifier),
+	} {
+		var got []CAOwnership
+		for _, o := range owners {
+			got = append(got, *o)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("%s() returned %+v, want %+v", name, got, want)
+		}
+	}
+
+	if owners := s.GetCAOwnersByKeyIdentifierBytes(make([]byte, 20)); owners != nil {
+		t.Errorf("GetCAOwnersByKeyIdentifierBytes() of an unknown key identifier returned %+v, want nil", owners)
+	}
+}
+
+// TestIssuerCapabilitySources checks that only the CA certificates that contributed each of an issuer's merged
+// capabilities are recorded as its sources.
+func TestIssuerCapabilitySources(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	// ISRG Root X1's key is shared by the TLS capable root certificate and by a cross-certificate that is neither.
+	sha256Fingerprint, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	ic := s.GetIssuerCapabilitiesByKeyIdentifier(s.GetCertificateRecordBySHA256(sha256Fingerprint).SubjectKeyIdentifier)
+	if ic == nil {
+		t.Fatal("GetIssuerCapabilitiesByKeyIdentifier() returned nil for ISRG Root X1")
+	}
+	want := CapabilitySources{Root: [][sha256.Size]byte{sha256Fingerprint}, TlsCapable: [][sha256.Size]byte{sha256Fingerprint}}
+	if !reflect.DeepEqual(ic.Sources, want) {
+		t.Errorf("ISRG Root X1's capability sources = %X, want %X", ic.Sources, want)
+	}
+}
+
+// TestDuplicateRecords checks that a CA certificate's duplicate records are merged by the documented policy, and that
+// every one of them is retained as a raw record.
+func TestDuplicateRecords(t *testing.T) {
+	// Disclose ISRG Root X1 again, as an S/MIME capable intermediate certificate under another CA Owner, before its root
+	// certificate record.
+	fsys := fixtureMapFS(t, "v5")
+	records, err := csv.NewReader(bytes.NewReader(fsys[CCADB_CSV_PATH].Data)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := records[0]
+	var duplicate []string
+	for _, record := range records[1:] {
+		if record[slices.Index(header, "SHA-256 Fingerprint")] == TEST_ISRG_ROOT_X1_SHA256 {
+			duplicate = slices.Clone(record)
+		}
+	}
+	duplicate[slices.Index(header, "CA Owner")] = "Example Cross-Signer"
+	duplicate[slices.Index(header, "Certificate Record Type")] = "Intermediate Certificate"
+	duplicate[slices.Index(header, "S/MIME Capable")] = "True"
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.WriteAll(slices.Insert(records, 1, duplicate))
+	fsys[CCADB_CSV_PATH] = &fstest.MapFile{Data: buf.Bytes()}
+	s, err := NewStore(fsys, WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	sha256Fingerprint, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	if cr := s.GetCertificateRecordBySHA256(sha256Fingerprint); cr.CAOwner != "Internet Security Research Group" {
+		t.Errorf("Primary record's CA Owner = %q, want that of the root certificate record", cr.CAOwner)
+	}
+	if ccc := s.GetCACertCapabilitiesBySHA256(sha256Fingerprint); ccc.CertificateRecordType != RecordTypeRoot || !ccc.TlsCapable || !ccc.SmimeCapable {
+		t.Errorf("Merged capabilities = %+v, want a TLS and S/MIME capable root", ccc)
+	}
+
+	if rawRecords := s.GetRawRecordsBySHA256(sha256Fingerprint); rawRecords != nil {
+		t.Errorf("GetRawRecordsBySHA256() before LoadRawRecords returned %d records, want nil", len(rawRecords))
+	}
+	s.LoadRawRecords()
+	var got []string
+	for _, rr := range s.GetRawRecordsBySHA256(sha256Fingerprint) {
+		got = append(got, rr.Fields[slices.Index(rr.Header, "CA Owner")])
+	}
+	if want := []string{"Internet Security Research Group", "Example Cross-Signer"}; !slices.Equal(got, want) {
+		t.Errorf("GetRawRecordsBySHA256() returned records with CA Owners %q, want %q", got, want)
+	}
+}
+
+// searchResultStrings summarizes search results as "CA Owner/Certificate Name: matched fields".
+func searchResultStrings(results []*SearchResult) []string {
+	var summaries []string
+	for _, sr := range results {
+		summaries = append(summaries, sr.CAOwner+"/"+sr.CertificateName+": "+strings.Join(sr.MatchedFields, ", "))
+	}
+	return summaries
+}
+
+// TestSearchRecords checks which records each query matches, in which fields, and in what order, both before and
+// after the subject names are indexed by LoadAllCACertificates.
+func TestSearchRecords(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	for _, tc := range []struct {
+		name         string
+		query        string
+		loadSubjects bool
+		want         []string
+	}{
+		{"CertificateName", "isrg root", false, []string{
+			"IdenTrust Services, LLC/ISRG Root X1: Certificate Name",
+			"Internet Security Research Group/ISRG Root X1: Certificate Name",
+		}},
+		{"Owners", "INTERNET SECURITY", false, []string{
+			"IdenTrust Services, LLC/ISRG Root X1: Subordinate CA Owner",
+			"Internet Security Research Group/E5: CA Owner",
+			"Internet Security Research Group/E6: CA Owner",
+			"Internet Security Research Group/ISRG Root X1: CA Owner",
+			"Internet Security Research Group/R10: CA Owner",
+			"Internet Security Research Group/R11: CA Owner",
+		}},
+		{"SeveralFields", "  a-trust ", false, []string{
+			"A-Trust/A-Trust-Qual-02: Certificate Name, CA Owner",
+			"A-Trust/A-Trust-Qual-02: Certificate Name, CA Owner",
+		}},
+		{"Substring", "camerfirma", false, []string{
+			"AC Camerfirma, S.A./Camerfirma Codesign II - 2014: Certificate Name, CA Owner",
+			"AC Camerfirma, S.A./Chambers of Commerce Root - 2008: CA Owner",
+			"AC Camerfirma, S.A./DigitalSign TSA CA: CA Owner",
+		}},
+		{"Regexp", "/^r1[01]$/", false, []string{
+			"Internet Security Research Group/R10: Certificate Name",
+			"Internet Security Research Group/R11: Certificate Name",
+		}},
+		{"NoMatch", "nonexistent", false, nil},
+		{"SubjectOBeforeLoading", "let's encrypt", false, nil},
+		{"SubjectO", "let's encrypt", true, []string{
+			"Internet Security Research Group/E5: Subject O",
+			"Internet Security Research Group/E6: Subject O",
+			"Internet Security Research Group/R10: Subject O",
+			"Internet Security Research Group/R11: Subject O",
+		}},
+		{"SubjectCN", "/^E[56]$/", true, []string{
+			"Internet Security Research Group/E5: Certificate Name, Subject CN",
+			"Internet Security Research Group/E6: Certificate Name, Subject CN",
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.loadSubjects {
+				s.LoadAllCACertificates()
+			}
+			results, err := s.SearchRecords(tc.query)
+			if err != nil {
+				t.Fatalf("SearchRecords(%q) returned %v", tc.query, err)
+			} else if got := searchResultStrings(results); !slices.Equal(got, tc.want) {
+				t.Errorf("SearchRecords(%q) returned %q, want %q", tc.query, got, tc.want)
+			}
+		})
+	}
+
+	for _, query := range []string{"", "  ", "/[/"} {
+		if _, err := s.SearchRecords(query); err == nil {
+			t.Errorf("SearchRecords(%q) didn't return an error", query)
+		}
+	}
+}
+
+// TestSearchRecordsNormalization checks that non-ASCII names are found regardless of their Unicode normalization form,
+// case, or width.
+func TestSearchRecordsNormalization(t *testing.T) {
+	r10SHA256Fingerprint, _ := HexFingerprintToArray(TEST_R10_SHA256)
+	s, err := NewStore(editedFixtureMapFS(t, "v5", func(header, record []string) []string {
+		if record[slices.Index(header, "SHA-256 Fingerprint")] == TEST_R10_SHA256 {
+			record[slices.Index(header, "CA Owner")] = "TÜRKTRUST Bilgi İletişim"
+			record[slices.Index(header, "Certificate Name")] = "Straße CA"
+		}
+		return record
+	}), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	for _, tc := range []struct {
+		query string
+		want  string
+	}{
+		{"türktrust", SEARCH_FIELD_CA_OWNER},
+		{"TÜRKTRUST", SEARCH_FIELD_CA_OWNER},
+		{"TU\u0308RKTRUST", SEARCH_FIELD_CA_OWNER},
+		{"ＴÜＲＫＴＲＵＳＴ", SEARCH_FIELD_CA_OWNER},
+		{"İletişim", SEARCH_FIELD_CA_OWNER},
+		{"STRASSE", SEARCH_FIELD_CERTIFICATE_NAME},
+		{"straße", SEARCH_FIELD_CERTIFICATE_NAME},
+		{"/^stra(ß|ss)e ca$/", SEARCH_FIELD_CERTIFICATE_NAME},
+	} {
+		results, err := s.SearchRecords(tc.query)
+		if err != nil {
+			t.Errorf("SearchRecords(%q) returned %v", tc.query, err)
+		} else if len(results) != 1 || results[0].SHA256Fingerprint != r10SHA256Fingerprint || !slices.Equal(results[0].MatchedFields, []string{tc.want}) {
+			t.Errorf("SearchRecords(%q) returned %q, want R10 matched by %s", tc.query, searchResultStrings(results), tc.want)
+		}
+	}
+}
+
+// TestGetUltimateRootOwner checks that intermediate certificates are attributed to the CA Owner of their root
+// certificate, and that a cross-certificate whose parent isn't disclosed isn't attributed to anyone.
+func TestGetUltimateRootOwner(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	for _, tc := range []struct {
+		name           string
+		hexFingerprint string
+		wantOwner      string
+		wantOK         bool
+	}{
+		{"Root", TEST_ISRG_ROOT_X1_SHA256, "Internet Security Research Group", true},
+		{"Intermediate", TEST_R10_SHA256, "Internet Security Research Group", true},
+		{"UndisclosedParent", "6D99FB265EB1C5B3744765FCBC648F3CD8E1BFFAFDC4C2F99B9D47CF7FF1C24F", "", false},
+		{"Unknown", fmt.Sprintf("%X", sha256.Sum256(nil)), "", false},
+	} {
+		sha256Fingerprint, _ := HexFingerprintToArray(tc.hexFingerprint)
+		if owner, ok := s.GetUltimateRootOwner(sha256Fingerprint); owner != tc.wantOwner || ok != tc.wantOK {
+			t.Errorf("%s: GetUltimateRootOwner() = %q, %t, want %q, %t", tc.name, owner, ok, tc.wantOwner, tc.wantOK)
+		}
+	}
+}
+
+// TestExternallyOperatedCACertificates checks which CA certificates are externally operated, and which of those are
+// listed as TLS capable, valid, and unrevoked.
+func TestExternallyOperatedCACertificates(t *testing.T) {
+	const TEST_ISRG_ROOT_X1_CROSS_SHA256 = "6D99FB265EB1C5B3744765FCBC648F3CD8E1BFFAFDC4C2F99B9D47CF7FF1C24F"
+	// Make the cross-certificate TLS capable, and give R10 a Subordinate CA Owner that only differs from its CA Owner in
+	// case.
+	s, err := NewStore(editedFixtureMapFS(t, "v5", func(header, record []string) []string {
+		switch record[slices.Index(header, "SHA-256 Fingerprint")] {
+		case TEST_ISRG_ROOT_X1_CROSS_SHA256:
+			record[slices.Index(header, "TLS Capable")] = "True"
+		case TEST_R10_SHA256:
+			record[slices.Index(header, "Subordinate CA Owner")] = "INTERNET SECURITY RESEARCH GROUP"
+		}
+		return record
+	}), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	for hexFingerprint, want := range map[string]bool{
+		TEST_ISRG_ROOT_X1_CROSS_SHA256: true,
+		TEST_R10_SHA256:                false,
+		TEST_ISRG_ROOT_X1_SHA256:       false,
+	} {
+		sha256Fingerprint, _ := HexFingerprintToArray(hexFingerprint)
+		if got := s.IsExternallyOperated(sha256Fingerprint); got != want {
+			t.Errorf("IsExternallyOperated(%s) = %t, want %t", hexFingerprint, got, want)
+		}
+	}
+
+	// The cross-certificate is valid from 2021-01-20 to 2024-09-30.
+	eoccs := s.ListExternallyOperatedCACertificates(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	if len(eoccs) != 1 || fmt.Sprintf("%X", eoccs[0].SHA256Fingerprint) != TEST_ISRG_ROOT_X1_CROSS_SHA256 {
+		t.Fatalf("ListExternallyOperatedCACertificates() returned %+v, want the cross-certificate", eoccs)
+	} else if eocc := eoccs[0]; eocc.CAOwner != "IdenTrust Services, LLC" || eocc.SubordinateCAOwner != "Internet Security Research Group" || eocc.UltimateRootOwner != "" {
+		t.Errorf("Cross-certificate is owned by %q, operated by %q, and chains up to a root owned by %q", eocc.CAOwner, eocc.SubordinateCAOwner, eocc.UltimateRootOwner)
+	}
+	if eoccs := s.ListExternallyOperatedCACertificates(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)); eoccs != nil {
+		t.Errorf("ListExternallyOperatedCACertificates() after the cross-certificate expired returned %+v, want nil", eoccs)
+	}
+}
@@ -0,0 +1,244 @@
+package ccadb_data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// The Salesforce REST API version used by APIClient, unless another is configured.
+	DEFAULT_SALESFORCE_API_VERSION = "v62.0"
+	// The largest error response body from the Salesforce REST API that is included in an error.
+	MAX_API_ERROR_SIZE = 4096
+)
+
+// APIClientConfig configures an APIClient. CCADB API credentials are issued by the CCADB administrators to CAs and root
+// store operators, as a Salesforce connected app that uses the OAuth 2.0 client credentials flow.
+type APIClientConfig struct {
+	// The URL of the Salesforce instance or My Domain, e.g. "https://ccadb.my.salesforce.com".
+	LoginURL     string
+	ClientID     string
+	ClientSecret string
+	// The Salesforce REST API version, e.g. "v62.0" (the default).
+	APIVersion string
+}
+
+// APIClient is an authenticated client for the CCADB API (the Salesforce REST API of the CCADB), which exposes richer
+// per-record data to CAs and root store operators than the public CSV reports, such as case information and pending
+// updates. It is safe for concurrent use by multiple goroutines.
+type APIClient struct {
+	httpClient *http.Client
+	config     APIClientConfig
+
+	mu          sync.Mutex
+	accessToken string
+	instanceURL string
+}
+
+// APIRecord is a record returned by a CCADB API query, as decoded from JSON: field API names (e.g. "Name") map to
+// strings, numbers, booleans, nil, or, for relationships, nested records.
+type APIRecord map[string]any
+
+// Field returns the value of a field, following relationships separated by dots (e.g. "Root_Case__r.Name"), or nil if
+// the record has no such field.
+func (r APIRecord) Field(path string) any {
+	var value any = map[string]any(r)
+	for name := range strings.SplitSeq(path, ".") {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = fields[name]
+	}
+	return value
+}
+
+// NewAPIClient returns a CCADB API client that authenticates with config's credentials when it is first used. If
+// httpClient is nil, http.DefaultClient is used.
+func NewAPIClient(httpClient *http.Client, config APIClientConfig) *APIClient {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if config.APIVersion == "" {
+		config.APIVersion = DEFAULT_SALESFORCE_API_VERSION
+	}
+	config.LoginURL = strings.TrimSuffix(config.LoginURL, "/")
+	return &APIClient{httpClient: httpClient, config: config}
+}
+
+// authenticate obtains an access token using the OAuth 2.0 client credentials flow, and returns it along with the URL of
+// the Salesforce instance to send API requests to.
+func (c *APIClient) authenticate(ctx context.Context) (accessToken, instanceURL string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.accessToken != "" {
+		return c.accessToken, c.instanceURL, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}, "client_id": {c.config.ClientID}, "client_secret": {c.config.ClientSecret}}
+	tokenURL := c.config.LoginURL + "/services/oauth2/token"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var token struct {
+		AccessToken string `json:"access_token"`
+		InstanceURL string `json:"instance_url"`
+	}
+	if err = c.do(req, &token); err != nil {
+		return "", "", fmt.Errorf("CCADB API authentication failed: %w", err)
+	} else if token.AccessToken == "" {
+		return "", "", fmt.Errorf("CCADB API authentication failed: %s: No access token was issued", tokenURL)
+	}
+
+	c.accessToken = token.AccessToken
+	c.instanceURL = strings.TrimSuffix(token.InstanceURL, "/")
+	if c.instanceURL == "" {
+		c.instanceURL = c.config.LoginURL
+	}
+	logger.Debug("Authenticated to the CCADB API", zap.String("instance_url", c.instanceURL))
+	return c.accessToken, c.instanceURL, nil
+}
+
+// An error response from the Salesforce REST API.
+type apiError struct {
+	url        string
+	statusCode int
+	// The Salesforce error code (e.g. "INVALID_SESSION_ID") and message, if the response had them.
+	errorCode string
+	message   string
+}
+
+func (e *apiError) Error() string {
+	if e.errorCode == "" {
+		return fmt.Sprintf("%s: HTTP %d", e.url, e.statusCode)
+	}
+	return fmt.Sprintf("%s: HTTP %d: %s: %s", e.url, e.statusCode, e.errorCode, e.message)
+}
+
+// do sends a request and decodes its JSON response into v.
+func (c *APIClient) do(req *http.Request, v any) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		e := &apiError{url: req.URL.Redacted(), statusCode: resp.StatusCode}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, MAX_API_ERROR_SIZE))
+		// The REST API reports errors as an array, and the OAuth endpoint as a single object.
+		var restErrors []struct {
+			ErrorCode string `json:"errorCode"`
+			Message   string `json:"message"`
+		}
+		var oauthError struct {
+			Error            string `json:"error"`
+			ErrorDescription string `json:"error_description"`
+		}
+		if json.Unmarshal(body, &restErrors) == nil && len(restErrors) > 0 {
+			e.errorCode, e.message = restErrors[0].ErrorCode, restErrors[0].Message
+		} else if json.Unmarshal(body, &oauthError) == nil {
+			e.errorCode, e.message = oauthError.Error, oauthError.ErrorDescription
+		}
+		return e
+	}
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", req.URL.Redacted(), err)
+	}
+	return nil
+}
+
+// get sends an authenticated GET request for a path on the Salesforce instance, and decodes its JSON response into v.
+// If the access token has expired, the client authenticates again and retries once.
+func (c *APIClient) get(ctx context.Context, path string, v any) error {
+	for attempt := 1; ; attempt++ {
+		accessToken, instanceURL, err := c.authenticate(ctx)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, instanceURL+path, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+		req.Header.Set("Accept", "application/json")
+		err = c.do(req, v)
+		var e *apiError
+		if attempt == 1 && errors.As(err, &e) && e.statusCode == http.StatusUnauthorized {
+			c.mu.Lock()
+			if c.accessToken == accessToken {
+				c.accessToken = ""
+			}
+			c.mu.Unlock()
+			continue
+		}
+		return err
+	}
+}
+
+// Query runs a SOQL query (e.g. "SELECT Name, SHA_256_Fingerprint__c FROM Account"), following the result's pages, and
+// returns every matching record. The objects and fields that are available depend on the CCADB's Salesforce schema and
+// on the permissions of the credentials.
+func (c *APIClient) Query(ctx context.Context, soql string) ([]APIRecord, error) {
+	var records []APIRecord
+	path := "/services/data/" + c.config.APIVersion + "/query?q=" + url.QueryEscape(soql)
+	for path != "" {
+		var page struct {
+			Done           bool        `json:"done"`
+			NextRecordsURL string      `json:"nextRecordsUrl"`
+			Records        []APIRecord `json:"records"`
+		}
+		if err := c.get(ctx, path, &page); err != nil {
+			return nil, err
+		}
+		records = append(records, page.Records...)
+		path = ""
+		if !page.Done {
+			path = page.NextRecordsURL
+		}
+	}
+	return records, nil
+}
+
+// loadAPIRecords runs a SOQL query and attaches each returned record to the CA certificate whose SHA-256 fingerprint is
+// in fingerprintField, adding to the records attached by earlier calls.
+func (s *Store) loadAPIRecords(ctx context.Context, c *APIClient, soql, fingerprintField string) error {
+	records, err := c.Query(ctx, soql)
+	if err != nil {
+		return err
+	}
+
+	apiRecordMap := make(map[[sha256.Size]byte][]APIRecord)
+	for _, record := range records {
+		value, _ := record.Field(fingerprintField).(string)
+		sha256Fingerprint, err := ParseSHA256Fingerprint(value)
+		if err != nil {
+			logger.Warn("CCADB API record has no valid SHA-256 fingerprint", zap.Error(err), zap.String("field", fingerprintField), zap.Any("record_url", record.Field("attributes.url")))
+			continue
+		}
+		apiRecordMap[sha256Fingerprint] = append(apiRecordMap[sha256Fingerprint], record)
+	}
+
+	s.apiRecordsMu.Lock()
+	defer s.apiRecordsMu.Unlock()
+	if s.apiRecordMap == nil {
+		s.apiRecordMap = apiRecordMap
+	} else {
+		for sha256Fingerprint, records := range apiRecordMap {
+			s.apiRecordMap[sha256Fingerprint] = append(s.apiRecordMap[sha256Fingerprint], records...)
+		}
+	}
+	logger.Info("Loaded CCADB API records", zap.Int("count", len(records)), zap.Int("certificate_count", len(apiRecordMap)))
+	return nil
+}
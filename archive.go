@@ -0,0 +1,200 @@
+package ccadb_data
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"time"
+
+	"github.com/crtsh/ccadb_data/internal/memfs"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// Name of the manifest, which is the first entry in an archive.
+	ARCHIVE_MANIFEST_NAME = "MANIFEST.json"
+	// Version of the archive format, which is incremented when archives can no longer be read by older versions.
+	ARCHIVE_FORMAT_VERSION = 1
+)
+
+// The manifest of an offline archive, which describes the dataset and every data file in the archive.
+type ArchiveManifest struct {
+	FormatVersion int `json:"format_version"`
+	// When the archive was created.
+	CreatedAt time.Time `json:"created_at"`
+	// When the dataset was fetched, if known.
+	DatasetDate time.Time `json:"dataset_date,omitzero"`
+	// The data files, in the order in which they appear in the archive.
+	Files []ArchiveFile `json:"files"`
+}
+
+// A data file in an offline archive.
+type ArchiveFile struct {
+	// The file's path within the dataset layout, e.g. "data/AllCertificateRecordsSlim.csv".
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// archivePaths returns the paths of the data files in fsys that a Store reads, including the manifest.
+func archivePaths(fsys fs.FS) ([]string, error) {
+	var paths []string
+	for _, filePath := range []string{MANIFEST_PATH, CCADB_CSV_PATH, CCADB_CSV_V3_PATH, SLIM_CSV_PATH, SKI_SPKISHA256_PATH, DERIVED_SKI_PATH, CT_LOG_LIST_PATH} {
+		if _, err := fs.Stat(fsys, filePath); err == nil {
+			paths = append(paths, filePath)
+		}
+	}
+	for _, report := range constraintsReports {
+		if _, err := fs.Stat(fsys, report.filePath); err == nil {
+			paths = append(paths, report.filePath)
+		}
+	}
+	if !slices.ContainsFunc(paths, func(filePath string) bool {
+		return filePath == CCADB_CSV_PATH || filePath == CCADB_CSV_V3_PATH || filePath == SLIM_CSV_PATH
+	}) {
+		return nil, fmt.Errorf("%w: Dataset contains no All Certificate Records CSV file", ErrDatasetNotLoaded)
+	}
+
+	// The PEM reports are optional.
+	entries, err := fs.ReadDir(fsys, PEM_CSV_DIR)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			paths = append(paths, PEM_CSV_DIR+"/"+entry.Name())
+		}
+	}
+	return paths, nil
+}
+
+// WriteArchive writes the dataset in fsys, which uses the dataset layout read by NewStore, to w as an offline archive:
+// a Zstandard-compressed tar file whose first entry is the manifest, followed by every data file that a Store reads.
+// datasetDate is recorded in the manifest, and may be zero if it isn't known.
+func WriteArchive(w io.Writer, fsys fs.FS, datasetDate time.Time) (*ArchiveManifest, error) {
+	paths, err := archivePaths(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	// Checksum every file before writing any of them, since the manifest comes first.
+	manifest := &ArchiveManifest{FormatVersion: ARCHIVE_FORMAT_VERSION, CreatedAt: time.Now().UTC(), DatasetDate: datasetDate.UTC()}
+	for _, filePath := range paths {
+		data, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return nil, err
+		}
+		sha256Hash := sha256.Sum256(data)
+		manifest.Files = append(manifest.Files, ArchiveFile{Path: filePath, Size: int64(len(data)), SHA256: hex.EncodeToString(sha256Hash[:])})
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+	if err != nil {
+		return nil, err
+	}
+	tw := tar.NewWriter(zw)
+	writeEntry := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: manifest.CreatedAt, Format: tar.FormatPAX}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+	if err = writeEntry(ARCHIVE_MANIFEST_NAME, append(manifestData, '\n')); err != nil {
+		return nil, err
+	}
+	for _, file := range manifest.Files {
+		data, err := fs.ReadFile(fsys, file.Path)
+		if err != nil {
+			return nil, err
+		} else if sha256Hash := sha256.Sum256(data); hex.EncodeToString(sha256Hash[:]) != file.SHA256 {
+			return nil, fmt.Errorf("%s: File changed while the archive was being written", file.Path)
+		} else if err = writeEntry(file.Path, data); err != nil {
+			return nil, err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	} else if err = zw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// ReadArchive reads an offline archive written by WriteArchive into memory, and verifies that it contains exactly the
+// files listed in its manifest, with the listed sizes and SHA-256 checksums. It returns the dataset, which uses the
+// dataset layout read by NewStore, and the manifest. Errors in the archive's contents wrap ErrMalformedDataset.
+func ReadArchive(r io.Reader) (fs.FS, *ArchiveManifest, error) {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zr.Close()
+	tr := tar.NewReader(zr)
+
+	header, err := tr.Next()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: Archive could not be read: %w", ErrMalformedDataset, err)
+	} else if header.Name != ARCHIVE_MANIFEST_NAME {
+		return nil, nil, fmt.Errorf("%w: Archive does not start with %s", ErrMalformedDataset, ARCHIVE_MANIFEST_NAME)
+	}
+	var manifest ArchiveManifest
+	if err = json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s could not be parsed: %w", ErrMalformedDataset, ARCHIVE_MANIFEST_NAME, err)
+	} else if manifest.FormatVersion != ARCHIVE_FORMAT_VERSION {
+		return nil, nil, fmt.Errorf("%w: Unsupported archive format version %d", ErrMalformedDataset, manifest.FormatVersion)
+	}
+	files := make(map[string]ArchiveFile)
+	for _, file := range manifest.Files {
+		files[file.Path] = file
+	}
+
+	fsys := make(memfs.FS)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("%w: Archive could not be read: %w", ErrMalformedDataset, err)
+		}
+		file, ok := files[header.Name]
+		if _, archived := fsys[header.Name]; !ok || archived {
+			return nil, nil, fmt.Errorf("%w: %s is not listed in the manifest, or is listed once but archived twice", ErrMalformedDataset, header.Name)
+		} else if header.Size != file.Size {
+			return nil, nil, fmt.Errorf("%w: %s is %d bytes, but the manifest lists %d bytes", ErrMalformedDataset, header.Name, header.Size, file.Size)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s could not be read: %w", ErrMalformedDataset, header.Name, err)
+		} else if sha256Hash := sha256.Sum256(data); hex.EncodeToString(sha256Hash[:]) != file.SHA256 {
+			return nil, nil, fmt.Errorf("%w: %s does not match its SHA-256 checksum in the manifest", ErrMalformedDataset, header.Name)
+		}
+		fsys[header.Name] = data
+	}
+	for _, file := range manifest.Files {
+		if _, archived := fsys[file.Path]; !archived {
+			return nil, nil, fmt.Errorf("%w: %s is listed in the manifest but missing from the archive", ErrMalformedDataset, file.Path)
+		}
+	}
+	return fsys, &manifest, nil
+}
+
+// LoadFromArchive reads and verifies an offline archive written by WriteArchive (see ReadArchive), and loads a new Store
+// from the dataset in it.
+func LoadFromArchive(filePath string) (*Store, error) {
+	src, err := NewArchiveDataSource(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewStoreFromSource(src)
+}
@@ -0,0 +1,39 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"time"
+)
+
+// AUDIT_UPDATE_MONTHS is how long after the end of a CA certificate's most recent standard audit period an updated
+// audit is due. Audit periods may not exceed one year, and root programs expect the audit statement within a few
+// months of the period's end, so a period that ended more than about 14 months ago means that an audit is overdue.
+const AUDIT_UPDATE_MONTHS = 14
+
+// standardAuditPeriodEndDate returns the end of the period covered by the CA certificate's most recent standard audit,
+// following "Audits Same as Parent" up the chain of disclosed parents. It returns the zero time if no audit is
+// disclosed.
+func (s *Store) standardAuditPeriodEndDate(sha256Fingerprint [sha256.Size]byte) time.Time {
+	seen := make(map[[sha256.Size]byte]bool)
+	for current := sha256Fingerprint; current != [sha256.Size]byte{} && !seen[current]; {
+		seen[current] = true
+		cr := s.certificateRecord(current)
+		if cr == nil {
+			break
+		} else if !cr.AuditsSameAsParent {
+			return cr.StandardAuditPeriodEndDate
+		}
+		current = cr.ParentSHA256Fingerprint
+	}
+	return time.Time{}
+}
+
+// needsAuditUpdate reports whether the CA certificate needs an updated audit as of asOf. Only CA certificates that are
+// valid at asOf and that CCADB doesn't consider to be revoked need to be audited.
+func (s *Store) needsAuditUpdate(sha256Fingerprint [sha256.Size]byte, cr *CertificateRecord, asOf time.Time) bool {
+	if cr.isRevoked() || asOf.Before(cr.ValidFrom) || asOf.After(cr.ValidTo) {
+		return false
+	}
+	auditPeriodEndDate := s.standardAuditPeriodEndDate(sha256Fingerprint)
+	return auditPeriodEndDate.IsZero() || auditPeriodEndDate.AddDate(0, AUDIT_UPDATE_MONTHS, 0).Before(asOf)
+}
@@ -0,0 +1,108 @@
+// Package bucket loads CCADB datasets from cloud storage buckets, so that deployments can point a Store at a bucket
+// that their pipeline syncs CCADB snapshots into, e.g. by copying a directory that FetchReports has downloaded the
+// reports into. Amazon S3 (s3://) and Google Cloud Storage (gs://) URLs are supported:
+//
+//	src, err := bucket.NewDataSource(nil, "s3://example-bucket/ccadb")
+//	...
+//	ccadb_data.SetDefaultDataSource(src)
+//
+// The dataset is stored under the URL's prefix in the dataset layout read by ccadb_data.NewStore (e.g.
+// ccadb/data/AllCertificateRecordsSlim.csv), and should include its manifest, data/MANIFEST, from which the PEM reports
+// are found. Since several data files are optional, the credentials must be allowed to list the bucket, so that a
+// missing file is reported as not found rather than as access denied.
+//
+// Requests are made directly to each service's HTTP API, so that this package adds no dependencies. Requests to S3 are
+// signed with AWS Signature Version 4 using the credentials in the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and
+// AWS_SESSION_TOKEN environment variables (or are anonymous if there are none), for the region in AWS_REGION or
+// AWS_DEFAULT_REGION (by default, us-east-1); AWS_ENDPOINT_URL_S3 or AWS_ENDPOINT_URL selects an S3-compatible service.
+// Requests to Cloud Storage are authenticated by the client, e.g. one returned by golang.org/x/oauth2/google's
+// DefaultClient (or are anonymous if it doesn't add credentials); STORAGE_EMULATOR_HOST selects an emulator. Other
+// credential sources can be used by implementing ccadb_data.DataSource with the cloud provider's SDK instead.
+package bucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+const (
+	// The default AWS region, if none is configured.
+	DEFAULT_AWS_REGION = "us-east-1"
+	// The Cloud Storage XML API endpoint, which serves objects by bucket and object name.
+	GCS_ENDPOINT = "https://storage.googleapis.com"
+)
+
+// NewDataSource returns a DataSource that reads the dataset stored in the cloud storage bucket at rawURL, which is
+// s3://BUCKET/PREFIX or gs://BUCKET/PREFIX (the prefix is optional). A nil client means http.DefaultClient.
+func NewDataSource(client *http.Client, rawURL string) (ccadb_data.DataSource, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ccadb_data.ErrDatasetNotLoaded, err)
+	} else if u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return nil, fmt.Errorf("%w: %q is not a bucket URL", ccadb_data.ErrDatasetNotLoaded, rawURL)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	prefix := strings.Trim(u.Path, "/")
+
+	switch u.Scheme {
+	case "s3":
+		return newS3DataSource(client, u.Host, prefix)
+	case "gs":
+		endpoint := GCS_ENDPOINT
+		if emulatorHost := os.Getenv("STORAGE_EMULATOR_HOST"); emulatorHost != "" {
+			endpoint = "http://" + strings.TrimPrefix(emulatorHost, "http://")
+		}
+		return ccadb_data.NewHTTPDataSource(client, endpoint+escapePath(u.Host+"/"+prefix)), nil
+	default:
+		return nil, fmt.Errorf("%w: Unsupported bucket URL scheme %q", ccadb_data.ErrDatasetNotLoaded, u.Scheme)
+	}
+}
+
+// NewStore loads a Store from the dataset stored in the cloud storage bucket at rawURL (see NewDataSource), configured
+// by opts.
+func NewStore(client *http.Client, rawURL string, opts ...ccadb_data.StoreOption) (*ccadb_data.Store, error) {
+	src, err := NewDataSource(client, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return ccadb_data.NewStoreFromSource(src, opts...)
+}
+
+// escapePath returns the escaped form of a path of objects, with a leading slash and without empty segments, e.g.
+// "/bucket/prefix".
+func escapePath(path string) string {
+	var sb strings.Builder
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" {
+			sb.WriteByte('/')
+			sb.WriteString(escapeSegment(segment))
+		}
+	}
+	return sb.String()
+}
+
+// escapeSegment percent-encodes every byte of a path segment other than an unreserved character, as S3 requires when
+// signing a request.
+func escapeSegment(segment string) string {
+	var sb strings.Builder
+	for i := 0; i < len(segment); i++ {
+		if c := segment[i]; isUnreserved(c) {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+// isUnreserved reports whether c is an unreserved character (RFC 3986, section 2.3), which is never percent-encoded.
+func isUnreserved(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~'
+}
@@ -0,0 +1,141 @@
+package bucket
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+const (
+	// The SHA-256 hash of an empty request body, which is sent with every request to S3.
+	EMPTY_PAYLOAD_SHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	// The layout of the x-amz-date header, and of the date in the credential scope.
+	AWS_DATE_TIME_LAYOUT = "20060102T150405Z"
+	AWS_DATE_LAYOUT      = "20060102"
+)
+
+// AWS credentials, as read from the environment.
+type awsCredentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// newS3DataSource returns a DataSource that reads the objects under prefix in an S3 bucket, configured by the
+// environment.
+func newS3DataSource(client *http.Client, bucket, prefix string) (ccadb_data.DataSource, error) {
+	region := firstEnv("AWS_REGION", "AWS_DEFAULT_REGION")
+	if region == "" {
+		region = DEFAULT_AWS_REGION
+	}
+
+	// Use a virtual-hosted-style URL for AWS, unless the bucket name contains dots, which its TLS certificates don't
+	// cover. S3-compatible services are addressed with path-style URLs, which they all support.
+	var baseURL string
+	if endpoint := firstEnv("AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"); endpoint != "" {
+		baseURL = strings.TrimSuffix(endpoint, "/") + escapePath(bucket+"/"+prefix)
+	} else if strings.Contains(bucket, ".") {
+		baseURL = "https://s3." + region + ".amazonaws.com" + escapePath(bucket+"/"+prefix)
+	} else {
+		baseURL = "https://" + bucket + ".s3." + region + ".amazonaws.com" + escapePath(prefix)
+	}
+
+	credentials := awsCredentials{
+		accessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if credentials.accessKeyID != "" && credentials.secretAccessKey != "" {
+		signingClient := *client
+		signingClient.Transport = &s3SigningTransport{base: client.Transport, region: region, credentials: credentials}
+		client = &signingClient
+	}
+	return ccadb_data.NewHTTPDataSource(client, baseURL), nil
+}
+
+// firstEnv returns the value of the first of the environment variables that is set and not empty.
+func firstEnv(keys ...string) string {
+	for _, key := range keys {
+		if value := os.Getenv(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// An http.RoundTripper that signs each request to S3 with AWS Signature Version 4.
+type s3SigningTransport struct {
+	base        http.RoundTripper
+	region      string
+	credentials awsCredentials
+}
+
+func (t *s3SigningTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request.
+	req = req.Clone(req.Context())
+	t.sign(req, time.Now().UTC())
+	if t.base == nil {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// sign adds the headers that AWS Signature Version 4 requires to a request that has no body, signing the host and every
+// header that the request already has.
+func (t *s3SigningTransport) sign(req *http.Request, now time.Time) {
+	req.Header.Set("X-Amz-Date", now.Format(AWS_DATE_TIME_LAYOUT))
+	req.Header.Set("X-Amz-Content-Sha256", EMPTY_PAYLOAD_SHA256)
+	if t.credentials.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", t.credentials.sessionToken)
+	}
+
+	// The canonical URI escapes each segment of the path in the way that S3 requires, and the request must then be sent
+	// with exactly that path.
+	var segments []string
+	for _, segment := range strings.Split(req.URL.Path, "/") {
+		segments = append(segments, escapeSegment(segment))
+	}
+	canonicalURI := strings.Join(segments, "/")
+	req.URL.RawPath = canonicalURI
+
+	// The canonical headers are sorted by their lowercase names.
+	host := req.URL.Host
+	if req.Host != "" {
+		host = req.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+	names := slices.Sorted(maps.Keys(headers))
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{req.Method, canonicalURI, strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"), canonicalHeaders.String(), signedHeaders, EMPTY_PAYLOAD_SHA256}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := now.Format(AWS_DATE_LAYOUT) + "/" + t.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + now.Format(AWS_DATE_TIME_LAYOUT) + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := []byte("AWS4" + t.credentials.secretAccessKey)
+	for _, part := range []string{now.Format(AWS_DATE_LAYOUT), t.region, "s3", "aws4_request", stringToSign} {
+		key = hmacSHA256(key, part)
+	}
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+t.credentials.accessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+hex.EncodeToString(key))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
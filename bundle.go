@@ -0,0 +1,115 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+)
+
+// BundleCertificate is a CA certificate found in a PEM bundle, and what CCADB says about it, as held in BundleAnalysis.
+type BundleCertificate struct {
+	// Position of the certificate among the certificates in the bundle, from 0.
+	Index             int
+	SHA256Fingerprint [sha256.Size]byte
+	// Empty if the certificate could not be parsed.
+	Subject string
+	// Whether the CA certificate is disclosed in CCADB. The remaining fields are only set if it is.
+	Disclosed bool
+	// Whether CCADB considers the CA certificate to be revoked (including by a revoked parent).
+	Revoked bool
+	// Whether the CA certificate's Valid To date has passed.
+	Expired      bool
+	Capabilities *CACertCapabilities
+	// The required capabilities that the CA certificate lacks, e.g. "TLS" or "S/MIME".
+	MissingCapabilities []string
+}
+
+// BundleAnalysis is the result of analyzing a PEM bundle, as returned by AnalyzeBundle. Undisclosed, Revoked, and
+// LackingCapabilities point into CACertificates.
+type BundleAnalysis struct {
+	// The CA certificates in the bundle, in order. Certificates that aren't CA certificates, such as the end-entity
+	// certificate in a server's chain file, are skipped.
+	CACertificates []*BundleCertificate
+	// The CA certificates that aren't disclosed in CCADB.
+	Undisclosed []*BundleCertificate
+	// The disclosed CA certificates that CCADB considers to be revoked.
+	Revoked []*BundleCertificate
+	// The disclosed CA certificates that lack one or more of the required capabilities.
+	LackingCapabilities []*BundleCertificate
+}
+
+// HasProblems reports whether any CA certificate in the bundle is undisclosed, revoked, or lacks a required capability.
+func (ba *BundleAnalysis) HasProblems() bool {
+	return len(ba.Undisclosed) > 0 || len(ba.Revoked) > 0 || len(ba.LackingCapabilities) > 0
+}
+
+// missingCapabilities returns the names of the capabilities that the filter requires but that the CA certificate lacks.
+func (filter *CapabilityFilter) missingCapabilities(ccc *CACertCapabilities) []string {
+	var missing []string
+	for _, capability := range []struct {
+		name     string
+		required bool
+		capable  bool
+	}{
+		{"TLS", filter.TlsCapable, ccc.TlsCapable},
+		{"TLS EV", filter.TlsEvCapable, ccc.TlsEvCapable},
+		{"S/MIME", filter.SmimeCapable, ccc.SmimeCapable},
+		{"Code Signing", filter.CodeSigningCapable, ccc.CodeSigningCapable},
+		{"VMC Audit", filter.HasVMCAudit, ccc.HasVMCAudit},
+	} {
+		if capability.required && !capability.capable {
+			missing = append(missing, capability.name)
+		}
+	}
+	return missing
+}
+
+// analyzeBundle looks up every CA certificate in a PEM bundle, as of the given time.
+func (s *Store) analyzeBundle(pemBundle []byte, required CapabilityFilter, now time.Time) (*BundleAnalysis, error) {
+	ba := &BundleAnalysis{}
+	index := 0
+	for rest := pemBundle; ; index++ {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		} else if block.Type != "CERTIFICATE" {
+			index--
+			continue
+		}
+
+		bc := &BundleCertificate{Index: index, SHA256Fingerprint: sha256.Sum256(block.Bytes)}
+		ccc := s.caCertCapabilities(bc.SHA256Fingerprint)
+		cr := s.certificateRecord(bc.SHA256Fingerprint)
+		// A certificate that can't be parsed is only skipped if CCADB doesn't know it either.
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			if !cert.IsCA && ccc == nil {
+				continue
+			}
+			bc.Subject = cert.Subject.String()
+		} else if ccc == nil {
+			continue
+		}
+		ba.CACertificates = append(ba.CACertificates, bc)
+
+		if ccc == nil || cr == nil {
+			ba.Undisclosed = append(ba.Undisclosed, bc)
+			continue
+		}
+		bc.Disclosed = true
+		bc.Capabilities = ccc
+		bc.Expired = now.After(cr.ValidTo)
+		if bc.Revoked = cr.isRevoked(); bc.Revoked {
+			ba.Revoked = append(ba.Revoked, bc)
+		}
+		if bc.MissingCapabilities = required.missingCapabilities(ccc); len(bc.MissingCapabilities) > 0 {
+			ba.LackingCapabilities = append(ba.LackingCapabilities, bc)
+		}
+	}
+
+	if index == 0 {
+		return nil, errors.New("No certificates found in PEM bundle")
+	}
+	return ba, nil
+}
@@ -4,52 +4,125 @@ import (
 	"crypto/sha256"
 	"embed"
 	"encoding/base64"
-	"encoding/csv"
-	"encoding/hex"
 	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"runtime"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
+// The embedded data only includes the slim CCADB report and the key identifier CSVs. The full report and the PEM
+// reports are embedded by the full subpackage, so that importing this package doesn't force every binary to carry them.
+//
 //go:embed data/*
 var f embed.FS
 
-//go:embed cmd/ski_spki/data/*
-var pemFS embed.FS
-
-// Map of CA Certificate capabilities, indexed by SHA-256(Certificate).
-type caCertCapabilities struct {
-	CertificateRecordType string
+// CACertCapabilities is the CCADB-reported capabilities of a CA certificate, as returned by
+// GetCACertCapabilitiesBySHA256.
+type CACertCapabilities struct {
+	CertificateRecordType RecordType
 	TlsCapable            bool
 	TlsEvCapable          bool
 	SmimeCapable          bool
 	CodeSigningCapable    bool
 	HasVMCAudit           bool
+	// The capability columns as reported, which are TristateUnknown when a column is blank or holds some other text. The
+	// corresponding bool fields above are only true when these are TristateTrue.
+	TlsCapability         Tristate
+	TlsEvCapability       Tristate
+	SmimeCapability       Tristate
+	CodeSigningCapability Tristate
 }
 
-var caCertCapabilitiesMap map[[sha256.Size]byte]*caCertCapabilities
+// CertificateRecord is the CCADB record of a CA certificate, as returned by GetCertificateRecordBySHA256.
+type CertificateRecord struct {
+	CAOwner                 string
+	SubordinateCAOwner      string
+	CertificateName         string
+	ParentSHA256Fingerprint [sha256.Size]byte
+	RevocationStatus        RevocationStatus
+	AuthorityKeyIdentifier  string
+	SubjectKeyIdentifier    string
+	ValidFrom               time.Time
+	ValidTo                 time.Time
+	AppleStatus             string
+	ChromeStatus            string
+	MicrosoftStatus         string
+	MozillaStatus           string
+	// The disclosed CRLs: either the URLs of the full CRLs issued by this CA, or the URLs of its partitioned CRLs that
+	// together cover its full scope.
+	FullCRLURLs        []string
+	PartitionedCRLURLs []string
+	// Whether the CA certificate is covered by its parent's audits, in which case it has no audits of its own.
+	AuditsSameAsParent bool
+	// The end of the period covered by the CA certificate's most recent standard audit (e.g. WebTrust for CAs or ETSI EN
+	// 319 411-1), or the zero time if none is disclosed.
+	StandardAuditPeriodEndDate time.Time
+}
 
-// Map of Issuer capabilities, indexed by Base64(Key Identifier).
-type issuerCapabilities struct {
-	caCertCapabilities
+// IssuerCapabilities is the merged capabilities of the CA certificates that share a key identifier, as returned by
+// GetIssuerCapabilitiesByKeyIdentifier.
+type IssuerCapabilities struct {
+	CACertCapabilities
+	// The CA certificates that contributed each of the merged capabilities.
+	Sources CapabilitySources
 }
 
-var issuerCapabilitiesMap map[string]*issuerCapabilities
+// CapabilitySources is the SHA-256 fingerprints of the CA certificates that contributed each of an issuer's merged
+// capabilities, i.e. that are root certificates or that have the capability themselves, in ascending order, as held in
+// IssuerCapabilities.Sources.
+type CapabilitySources struct {
+	Root               [][sha256.Size]byte
+	TlsCapable         [][sha256.Size]byte
+	TlsEvCapable       [][sha256.Size]byte
+	SmimeCapable       [][sha256.Size]byte
+	CodeSigningCapable [][sha256.Size]byte
+	HasVMCAudit        [][sha256.Size]byte
+}
 
-// Map of Issuer SPKI SHA-256 hashes, indexed by Base64(Key Identifier).
-var issuerSPKISHA256Map map[string][sha256.Size]byte
+// CrossSign is a CA certificate that certifies the same public key as others but has a different issuer, as returned by
+// GetCrossSignsBySPKISHA256.
+type CrossSign struct {
+	SHA256Fingerprint [sha256.Size]byte
+	Subject           string
+	Issuer            string
+	NotBefore         time.Time
+	NotAfter          time.Time
+}
 
-// Map of certificate DER bytes, indexed by SHA-256(Certificate).
-var certificateDERMap map[[sha256.Size]byte][]byte
+// RawRecord is every field of a CA certificate's record in the All Certificate Records report, as returned by
+// GetRawRecordBySHA256. A CA certificate may have more than one.
+type RawRecord struct {
+	LineNumber int
+	Header     []string
+	Fields     []string
+}
+
+// A certificate read from a PEM CSV file.
+type pemCertificate struct {
+	sha256Fingerprint [sha256.Size]byte
+	der               []byte
+}
 
 const (
 	CCADB_CSV_PATH            = "data/AllCertificateRecordsCSVFormatV5"
+	CCADB_CSV_V3_PATH         = "data/AllCertificateRecordsCSVFormatV3"
+	SLIM_CSV_PATH             = "data/AllCertificateRecordsSlim.csv"
 	CCADB_RECORD_ROOT         = "Root Certificate"
 	CCADB_RECORD_INTERMEDIATE = "Intermediate Certificate"
+	CCADB_REVOKED             = "Revoked"
+	CCADB_PARENT_REVOKED      = "Parent Cert Revoked"
+	CCADB_NOT_REVOKED         = "Not Revoked"
 	SKI_SPKISHA256_PATH       = "data/ski_spkisha256.csv"
+	DERIVED_SKI_PATH          = "data/derived_ski.csv"
+	PEM_CSV_DIR               = "cmd/ski_spki/data"
 )
 
 const (
@@ -61,9 +134,53 @@ const (
 	IDX_SMIMECAPABLE
 	IDX_CODESIGNINGCAPABLE
 	IDX_VMCAUDITSTATEMENTDATE
+	IDX_VALIDFROM
+	IDX_VALIDTO
+	IDX_APPLESTATUS
+	IDX_CHROMESTATUS
+	IDX_MICROSOFTSTATUS
+	IDX_MOZILLASTATUS
+	IDX_CAOWNER
+	IDX_SUBORDINATECAOWNER
+	IDX_CERTIFICATENAME
+	IDX_PARENTSHA256FINGERPRINT
+	IDX_REVOCATIONSTATUS
+	IDX_AUTHORITYKEYIDENTIFIER
+	IDX_FULLCRLURLS
+	IDX_PARTITIONEDCRLURLS
+	IDX_AUDITSSAMEASPARENT
+	IDX_STANDARDAUDITPERIODENDDATE
 	MAX_IDX
 )
 
+// Names of the fields that we need, as they appear in the latest version of the CSV header.
+var csvHeaders = [MAX_IDX]string{
+	IDX_SHA256FINGERPRINT:          "SHA-256 Fingerprint",
+	IDX_SUBJECTKEYIDENTIFIER:       "Subject Key Identifier",
+	IDX_CERTIFICATERECORDTYPE:      "Certificate Record Type",
+	IDX_TLSCAPABLE:                 "TLS Capable",
+	IDX_TLSEVCAPABLE:               "TLS EV Capable",
+	IDX_SMIMECAPABLE:               "S/MIME Capable",
+	IDX_CODESIGNINGCAPABLE:         "Code Signing Capable",
+	IDX_VMCAUDITSTATEMENTDATE:      "VMC Audit Statement Date",
+	IDX_VALIDFROM:                  "Valid From (GMT)",
+	IDX_VALIDTO:                    "Valid To (GMT)",
+	IDX_APPLESTATUS:                "Apple Status",
+	IDX_CHROMESTATUS:               "Chrome Status",
+	IDX_MICROSOFTSTATUS:            "Microsoft Status",
+	IDX_MOZILLASTATUS:              "Mozilla Status",
+	IDX_CAOWNER:                    "CA Owner",
+	IDX_SUBORDINATECAOWNER:         "Subordinate CA Owner",
+	IDX_CERTIFICATENAME:            "Certificate Name",
+	IDX_PARENTSHA256FINGERPRINT:    "Parent SHA-256 Fingerprint",
+	IDX_REVOCATIONSTATUS:           "Revocation Status",
+	IDX_AUTHORITYKEYIDENTIFIER:     "Authority Key Identifier",
+	IDX_FULLCRLURLS:                "JSON Array of All Full CRL URLs",
+	IDX_PARTITIONEDCRLURLS:         "JSON Array of Partitioned CRLs",
+	IDX_AUDITSSAMEASPARENT:         "Audits Same as Parent",
+	IDX_STANDARDAUDITPERIODENDDATE: "Standard Audit Period End Date",
+}
+
 var logger *zap.Logger
 
 func init() {
@@ -81,152 +198,256 @@ func init() {
 		panic("Logger could not be initialized: " + err.Error())
 	}
 	defer logger.Sync()
+}
+
+// SetLogger replaces the logger that reports problems with the data, which by default writes JSON messages at "info"
+// level and above to stderr. A nil logger discards them. It must be called before any data is loaded, e.g. at the start
+// of main.
+func SetLogger(l *zap.Logger) {
+	if l == nil {
+		l = zap.NewNop()
+	}
+	logger = l
+}
 
-	// Initialize maps.
-	caCertCapabilitiesMap = make(map[[sha256.Size]byte]*caCertCapabilities)
-	issuerCapabilitiesMap = make(map[string]*issuerCapabilities)
-	issuerSPKISHA256Map = make(map[string][sha256.Size]byte)
+// ccadbCSVPath returns the path of the All Certificate Records CSV file. Some mirrors still publish only the V3 report,
+// and datasets that only contain the slim report (such as the embedded data) fall back to it.
+func (s *Store) ccadbCSVPath() string {
+	for _, filePath := range []string{CCADB_CSV_PATH, CCADB_CSV_V3_PATH, SLIM_CSV_PATH} {
+		if s.hasDataFile(filePath) {
+			return filePath
+		}
+	}
+	return CCADB_CSV_PATH
+}
 
-	// Read CSV data.
-	readAllCertificateRecordsCSV()
-	readSKIAndSHA256HashCSV(issuerSPKISHA256Map, SKI_SPKISHA256_PATH)
+// EmbeddedFS returns the embedded data, which uses the dataset layout read by NewStore. It contains the slim CCADB
+// report rather than the full one, so the raw records only contain the columns that are parsed into the typed lookup
+// API, and LoadAllCACertificates fails. Import the full subpackage to load the full dataset instead.
+func EmbeddedFS() fs.FS {
+	return f
 }
 
-func readAllCertificateRecordsCSV() {
+func (s *Store) readAllCertificateRecordsCSV() error {
 	// Read CCADB All Certificate Information CSV file.
-	ccadbCsvData, err := f.ReadFile(CCADB_CSV_PATH)
+	ccadbCsvPath := s.ccadbCSVPath()
+	ccadbCsvData, err := s.readDataFile(ccadbCsvPath)
 	if err != nil {
-		logger.Info("CSV file could not be read", zap.Error(err), zap.String("file_path", CCADB_CSV_PATH))
-		return
+		logger.Info("CSV file could not be read", zap.Error(err), zap.String("file_path", ccadbCsvPath))
+		return fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
 	}
 
 	// Parse CSV data.
-	reader := csv.NewReader(strings.NewReader(string(ccadbCsvData)))
-	reader.FieldsPerRecord = -1
-	reader.LazyQuotes = true
-	reader.TrimLeadingSpace = true
-	reader.ReuseRecord = true
-	records, err := reader.ReadAll()
-	if err != nil {
-		logger.Error("CSV file could not be parsed", zap.Error(err), zap.String("file_path", CCADB_CSV_PATH))
-		return
-	} else if len(records) == 0 {
-		logger.Error("CSV file is empty", zap.String("file_path", CCADB_CSV_PATH))
-		return
+	records := readCSVRecords(ccadbCsvData, ccadbCsvPath, 0)
+	if len(records) == 0 {
+		logger.Error("CSV file is empty", zap.String("file_path", ccadbCsvPath))
+		return fmt.Errorf("%w: %s: CSV file is empty", ErrMalformedDataset, ccadbCsvPath)
 	}
 
-	// Examine the CSV header to find the fields that we need.
+	// Determine the report format version, then examine the CSV header to find the fields that we need.
+	format := detectCSVFormat(records[0].fields)
 	var csvIdx [MAX_IDX]int
-	var greatestIdx int
-	for i, v := range records[0] {
-		switch v {
-		case "SHA-256 Fingerprint":
-			csvIdx[IDX_SHA256FINGERPRINT] = i
-		case "Subject Key Identifier":
-			csvIdx[IDX_SUBJECTKEYIDENTIFIER] = i
-		case "Certificate Record Type":
-			csvIdx[IDX_CERTIFICATERECORDTYPE] = i
-		case "TLS Capable":
-			csvIdx[IDX_TLSCAPABLE] = i
-		case "TLS EV Capable":
-			csvIdx[IDX_TLSEVCAPABLE] = i
-		case "S/MIME Capable":
-			csvIdx[IDX_SMIMECAPABLE] = i
-		case "Code Signing Capable":
-			csvIdx[IDX_CODESIGNINGCAPABLE] = i
-		case "VMC Audit Statement Date":
-			csvIdx[IDX_VMCAUDITSTATEMENTDATE] = i
-		default:
-			continue
-		}
-		if i > greatestIdx {
-			greatestIdx = i
+	for i, name := range csvHeaders {
+		if csvIdx[i] = format.headerIndex(records[0].fields, name); csvIdx[i] == -1 && !format.isAbsent(name) {
+			logger.Error("CSV data is missing one or more expected headers", zap.String("file_path", ccadbCsvPath), zap.String("header", name), zap.Int("format_version", format.Version))
+			return fmt.Errorf("%w: %s: CSV data is missing the %q header", ErrMalformedDataset, ccadbCsvPath, name)
 		}
 	}
-	for _, v := range csvIdx {
-		if v == 0 {
-			logger.Error("CSV data is missing one or more expected headers", zap.String("file_path", CCADB_CSV_PATH))
-			return
+
+	// Parse the records in contiguous shards, one per worker, then add them in file order, so that the outcome (including
+	// which of a CA certificate's duplicate records becomes its primary record) doesn't depend on the order in which the
+	// workers finish. Each worker interns its own strings, so a value that repeats across shards is held once per shard.
+	body := records[1:]
+	shards := make([][]parsedCertificateRecord, min(runtime.GOMAXPROCS(0), max(len(body)/MIN_RECORDS_PER_SHARD, 1)))
+	shardSize := (len(body) + len(shards) - 1) / len(shards)
+	var wg sync.WaitGroup
+	for i := range shards {
+		wg.Go(func() {
+			si := make(stringInterner)
+			shard := body[min(i*shardSize, len(body)):min((i+1)*shardSize, len(body))]
+			shards[i] = make([]parsedCertificateRecord, 0, len(shard))
+			for _, record := range shard {
+				if pcr, ok := parseCertificateRecord(record, &csvIdx, si); ok {
+					shards[i] = append(shards[i], pcr)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	// Allocate the capabilities and records of every CA certificate up front.
+	s.caCapabilities = make([]CACertCapabilities, 0, len(body))
+	s.caRecords = make([]CertificateRecord, 0, len(body))
+	for _, shard := range shards {
+		for i := range shard {
+			s.addCertificateRecord(shard[i].sha256Fingerprint, &shard[i].cr, &shard[i].ccc, shard[i].line)
 		}
 	}
 
-	// Process CSV data.
-	for _, line := range records[1:] {
-		if len(line) <= greatestIdx {
-			logger.Warn("CSV data has a line that is missing one or more expected fields", zap.String("line", strings.Join(line, ",")))
-		}
+	// Retain the ALV results, if the report has any.
+	s.alvResultMap, s.alvAuditTypes = readALVResults(records, ccadbCsvPath)
 
-		// Populate the map of CA certificate capabilities indexed by SHA-256 fingerprint.
-		ccc := caCertCapabilities{
-			CertificateRecordType: line[csvIdx[IDX_CERTIFICATERECORDTYPE]],
-			TlsCapable:            line[csvIdx[IDX_TLSCAPABLE]] == "True",
-			TlsEvCapable:          line[csvIdx[IDX_TLSEVCAPABLE]] == "True",
-			SmimeCapable:          line[csvIdx[IDX_SMIMECAPABLE]] == "True",
-			CodeSigningCapable:    line[csvIdx[IDX_CODESIGNINGCAPABLE]] == "True",
-			HasVMCAudit:           line[csvIdx[IDX_VMCAUDITSTATEMENTDATE]] != "",
-		}
-		sha256Slice, err := hex.DecodeString(line[csvIdx[IDX_SHA256FINGERPRINT]])
-		if err != nil {
-			logger.Warn("CSV data contains an invalid hex string", zap.String("value", line[csvIdx[IDX_SHA256FINGERPRINT]]))
-			continue
+	return nil
+}
+
+// The minimum number of CSV records that readAllCertificateRecordsCSV gives each worker, below which the cost of
+// starting a worker outweighs the work.
+const MIN_RECORDS_PER_SHARD = 1000
+
+// A CA certificate's capabilities and record, as parsed from a line of the All Certificate Records report.
+type parsedCertificateRecord struct {
+	sha256Fingerprint [sha256.Size]byte
+	cr                CertificateRecord
+	ccc               CACertCapabilities
+	line              int
+}
+
+// parseCertificateRecord parses a record of the All Certificate Records report, whose fields are located by csvIdx. It
+// returns false if the record has no valid SHA-256 fingerprint.
+func parseCertificateRecord(record csvRecord, csvIdx *[MAX_IDX]int, si stringInterner) (parsedCertificateRecord, bool) {
+	line := record.fields
+
+	// Parse the CA certificate capabilities.
+	ccc := CACertCapabilities{
+		CertificateRecordType: ParseRecordType(line[csvIdx[IDX_CERTIFICATERECORDTYPE]]),
+		HasVMCAudit:           csvField(line, csvIdx[IDX_VMCAUDITSTATEMENTDATE]) != "",
+		TlsCapability:         parseTristateField(line, csvIdx[:], IDX_TLSCAPABLE, record.line),
+		TlsEvCapability:       parseTristateField(line, csvIdx[:], IDX_TLSEVCAPABLE, record.line),
+		SmimeCapability:       parseTristateField(line, csvIdx[:], IDX_SMIMECAPABLE, record.line),
+		CodeSigningCapability: parseTristateField(line, csvIdx[:], IDX_CODESIGNINGCAPABLE, record.line),
+	}
+	ccc.TlsCapable = ccc.TlsCapability.IsTrue()
+	ccc.TlsEvCapable = ccc.TlsEvCapability.IsTrue()
+	ccc.SmimeCapable = ccc.SmimeCapability.IsTrue()
+	ccc.CodeSigningCapable = ccc.CodeSigningCapability.IsTrue()
+	sha256Array, ok := HexFingerprintToArray(line[csvIdx[IDX_SHA256FINGERPRINT]])
+	if !ok {
+		logger.Warn("CSV data contains an invalid hex string", zap.String("value", line[csvIdx[IDX_SHA256FINGERPRINT]]))
+		return parsedCertificateRecord{}, false
+	}
+	if ccc.CertificateRecordType == RecordTypeUnknown {
+		logger.Warn("CSV data contains an unrecognized record type", zap.String("value", line[csvIdx[IDX_CERTIFICATERECORDTYPE]]), zap.Int("line", record.line))
+	}
+
+	// Parse the CA certificate record.
+	cr := CertificateRecord{
+		CAOwner:                si.intern(line[csvIdx[IDX_CAOWNER]]),
+		SubordinateCAOwner:     si.intern(line[csvIdx[IDX_SUBORDINATECAOWNER]]),
+		CertificateName:        si.intern(line[csvIdx[IDX_CERTIFICATENAME]]),
+		RevocationStatus:       ParseRevocationStatus(line[csvIdx[IDX_REVOCATIONSTATUS]]),
+		AuthorityKeyIdentifier: si.intern(line[csvIdx[IDX_AUTHORITYKEYIDENTIFIER]]),
+		SubjectKeyIdentifier:   si.intern(line[csvIdx[IDX_SUBJECTKEYIDENTIFIER]]),
+		AppleStatus:            si.intern(line[csvIdx[IDX_APPLESTATUS]]),
+		ChromeStatus:           si.intern(line[csvIdx[IDX_CHROMESTATUS]]),
+		MicrosoftStatus:        si.intern(line[csvIdx[IDX_MICROSOFTSTATUS]]),
+		MozillaStatus:          si.intern(line[csvIdx[IDX_MOZILLASTATUS]]),
+		AuditsSameAsParent:     parseTristateField(line, csvIdx[:], IDX_AUDITSSAMEASPARENT, record.line).IsTrue(),
+	}
+	var err error
+	if cr.RevocationStatus == RevocationStatusUnknown {
+		logger.Warn("CSV data contains an unrecognized revocation status", zap.String("value", line[csvIdx[IDX_REVOCATIONSTATUS]]), zap.Int("line", record.line))
+	}
+	if cr.ValidFrom, err = ParseCCADBDate(line[csvIdx[IDX_VALIDFROM]]); err != nil {
+		logger.Warn("CSV data contains an invalid date", zap.String("value", line[csvIdx[IDX_VALIDFROM]]))
+	}
+	if cr.ValidTo, err = ParseCCADBDate(line[csvIdx[IDX_VALIDTO]]); err != nil {
+		logger.Warn("CSV data contains an invalid date", zap.String("value", line[csvIdx[IDX_VALIDTO]]))
+	}
+	if auditPeriodEndDate := line[csvIdx[IDX_STANDARDAUDITPERIODENDDATE]]; auditPeriodEndDate != "" {
+		if cr.StandardAuditPeriodEndDate, err = ParseCCADBDate(auditPeriodEndDate); err != nil {
+			logger.Warn("CSV data contains an invalid date", zap.String("value", auditPeriodEndDate))
 		}
-		var sha256Array [sha256.Size]byte
-		copy(sha256Array[:], sha256Slice)
-		caCertCapabilitiesMap[sha256Array] = &ccc
-
-		// Populate/update the map of CA certificate capabilities indexed by key identifier.
-		keyIdentifier := line[csvIdx[IDX_SUBJECTKEYIDENTIFIER]]
-		if ic := issuerCapabilitiesMap[keyIdentifier]; ic != nil {
-			// Multiple CA certificates share this key identifier, so merge the capabilities.
-			if ccc.CertificateRecordType == CCADB_RECORD_ROOT {
-				ic.CertificateRecordType = CCADB_RECORD_ROOT
-			}
-			if ccc.TlsCapable {
-				ic.TlsCapable = true
-			}
-			if ccc.TlsEvCapable {
-				ic.TlsEvCapable = true
-			}
-			if ccc.SmimeCapable {
-				ic.SmimeCapable = true
-			}
-			if ccc.CodeSigningCapable {
-				ic.CodeSigningCapable = true
-			}
-			if ccc.HasVMCAudit {
-				ic.HasVMCAudit = true
-			}
+	}
+	if cr.FullCRLURLs, err = parseCRLURLs(csvField(line, csvIdx[IDX_FULLCRLURLS]), si); err != nil {
+		logger.Warn("CSV data contains an invalid JSON array of CRL URLs", zap.Error(err), zap.String("header", csvHeaders[IDX_FULLCRLURLS]), zap.Int("line", record.line))
+	}
+	if cr.PartitionedCRLURLs, err = parseCRLURLs(csvField(line, csvIdx[IDX_PARTITIONEDCRLURLS]), si); err != nil {
+		logger.Warn("CSV data contains an invalid JSON array of CRL URLs", zap.Error(err), zap.String("header", csvHeaders[IDX_PARTITIONEDCRLURLS]), zap.Int("line", record.line))
+	}
+	if parentSHA256 := line[csvIdx[IDX_PARENTSHA256FINGERPRINT]]; parentSHA256 != "" {
+		if parentSHA256Array, ok := HexFingerprintToArray(parentSHA256); !ok {
+			logger.Warn("CSV data contains an invalid hex string", zap.String("value", parentSHA256))
 		} else {
-			issuerCapabilitiesMap[line[csvIdx[IDX_SUBJECTKEYIDENTIFIER]]] = &issuerCapabilities{
-				caCertCapabilities: ccc,
-			}
+			cr.ParentSHA256Fingerprint = parentSHA256Array
+		}
+	}
+	return parsedCertificateRecord{sha256Fingerprint: sha256Array, cr: cr, ccc: ccc, line: record.line}, true
+}
+
+// parseTristateField parses one of the boolean columns of a CSV record, warning about values that are neither blank nor
+// recognized.
+func parseTristateField(line []string, csvIdx []int, idx int, lineNumber int) Tristate {
+	value := csvField(line, csvIdx[idx])
+	t := ParseTristate(value)
+	if t == TristateUnknown && strings.TrimSpace(value) != "" {
+		logger.Warn("CSV data contains an unrecognized boolean value", zap.String("value", value), zap.String("header", csvHeaders[idx]), zap.Int("line", lineNumber))
+	}
+	return t
+}
+
+// indexKeyIdentifier adds a CA certificate to the maps indexed by key identifier.
+func (s *Store) indexKeyIdentifier(keyIdentifier string, sha256Fingerprint [sha256.Size]byte, ccc CACertCapabilities) {
+	if keyIdentifier == "" {
+		return
+	}
+
+	// Populate the map of CA certificate SHA-256 fingerprints indexed by key identifier.
+	if !slices.Contains(s.sha256FingerprintsMap[keyIdentifier], sha256Fingerprint) {
+		s.sha256FingerprintsMap[keyIdentifier] = append(s.sha256FingerprintsMap[keyIdentifier], sha256Fingerprint)
+	}
+
+	// Populate/update the map of CA certificate capabilities indexed by key identifier.
+	if ic := s.issuerCapabilitiesMap[keyIdentifier]; ic != nil {
+		// Multiple CA certificates share this key identifier, so merge the capabilities.
+		ic.merge(&ccc)
+		ic.Sources.add(sha256Fingerprint, ccc)
+	} else {
+		ic = &IssuerCapabilities{
+			CACertCapabilities: ccc,
 		}
+		ic.Sources.add(sha256Fingerprint, ccc)
+		s.issuerCapabilitiesMap[keyIdentifier] = ic
 	}
 }
 
-func readSKIAndSHA256HashCSV(skiAndSHA256HashMap map[string][sha256.Size]byte, filePath string) {
+// add records the capabilities that a CA certificate contributes.
+func (cs *CapabilitySources) add(sha256Fingerprint [sha256.Size]byte, ccc CACertCapabilities) {
+	for _, source := range []struct {
+		contributes bool
+		list        *[][sha256.Size]byte
+	}{
+		{ccc.CertificateRecordType == RecordTypeRoot, &cs.Root},
+		{ccc.TlsCapable, &cs.TlsCapable},
+		{ccc.TlsEvCapable, &cs.TlsEvCapable},
+		{ccc.SmimeCapable, &cs.SmimeCapable},
+		{ccc.CodeSigningCapable, &cs.CodeSigningCapable},
+		{ccc.HasVMCAudit, &cs.HasVMCAudit},
+	} {
+		if i, found := slices.BinarySearchFunc(*source.list, sha256Fingerprint, compareSHA256Fingerprints); source.contributes && !found {
+			*source.list = slices.Insert(*source.list, i, sha256Fingerprint)
+		}
+	}
+}
+
+func (s *Store) readSKIAndSHA256HashCSV(skiAndSHA256HashMap map[string][sha256.Size]byte, filePath string) error {
 	// Read "SKI, SHA-256(Object)" CSV file.
-	skiAndSHA256HashCsvData, err := f.ReadFile(filePath)
+	skiAndSHA256HashCsvData, err := s.readDataFile(filePath)
 	if err != nil {
 		logger.Info("CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
-		return
+		return fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
 	}
 
 	// Parse CSV data.
-	reader := csv.NewReader(strings.NewReader(string(skiAndSHA256HashCsvData)))
-	reader.FieldsPerRecord = 2
-	reader.ReuseRecord = true
-	records, err := reader.ReadAll()
-	if err != nil {
-		logger.Error("CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
-		return
-	} else if len(records) == 0 {
+	records := readCSVRecords(skiAndSHA256HashCsvData, filePath, 2)
+	if len(records) == 0 {
 		logger.Error("CSV file is empty", zap.String("file_path", filePath))
-		return
+		return fmt.Errorf("%w: %s: CSV file is empty", ErrMalformedDataset, filePath)
 	}
 
 	// Process CSV data.
-	for _, line := range records[1:] {
+	for _, record := range records[1:] {
+		line := record.fields
+
 		// Decode Base64-encoded SHA-256 hashes.
 		decoded, err := base64.StdEncoding.DecodeString(line[1])
 		if err != nil {
@@ -241,59 +462,190 @@ func readSKIAndSHA256HashCSV(skiAndSHA256HashMap map[string][sha256.Size]byte, f
 		copy(sha256Hash[:], decoded)
 		skiAndSHA256HashMap[line[0]] = sha256Hash
 	}
+
+	return nil
 }
 
-var readAllCACertificatePEMsCSVOnce sync.Once
+// readDerivedSKICSV indexes the CA certificates that CCADB reports without a Subject Key Identifier by a key identifier
+// derived from the certificate itself, so that they can be found from the Authority Key Identifier of the certificates
+// that they issue. The derived key identifiers file is optional, since datasets fetched by older versions of this
+// package do not include it.
+func (s *Store) readDerivedSKICSV() error {
+	// Read "SHA-256 Fingerprint, SKI, SHA-256(SPKI)" CSV file.
+	derivedSKICsvData, err := s.readDataFile(DERIVED_SKI_PATH)
+	if errors.Is(err, fs.ErrNotExist) {
+		logger.Debug("CSV file does not exist", zap.String("file_path", DERIVED_SKI_PATH))
+		return nil
+	} else if err != nil {
+		logger.Info("CSV file could not be read", zap.Error(err), zap.String("file_path", DERIVED_SKI_PATH))
+		return fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
+	}
+
+	// Parse CSV data.
+	records := readCSVRecords(derivedSKICsvData, DERIVED_SKI_PATH, 3)
+	if len(records) == 0 {
+		logger.Error("CSV file is empty", zap.String("file_path", DERIVED_SKI_PATH))
+		return fmt.Errorf("%w: %s: CSV file is empty", ErrMalformedDataset, DERIVED_SKI_PATH)
+	}
+
+	// Process CSV data.
+	for _, record := range records[1:] {
+		line := record.fields
+
+		sha256Array, ok := HexFingerprintToArray(line[0])
+		if !ok {
+			logger.Warn("CSV data contains an invalid hex string", zap.String("value", line[0]))
+			continue
+		}
+
+		// Only use the derived key identifier when CCADB doesn't report one.
+		cr := s.certificateRecord(sha256Array)
+		if cr == nil || cr.SubjectKeyIdentifier != "" {
+			continue
+		}
+		s.indexKeyIdentifier(line[1], sha256Array, *s.caCertCapabilities(sha256Array))
+
+		if decoded, err := base64.StdEncoding.DecodeString(line[2]); err != nil || len(decoded) != sha256.Size {
+			logger.Warn("CSV data contains an invalid Base64 string", zap.String("value", line[2]))
+		} else if _, ok := s.issuerSPKISHA256Map[line[1]]; !ok {
+			var sha256Hash [sha256.Size]byte
+			copy(sha256Hash[:], decoded)
+			s.issuerSPKISHA256Map[line[1]] = sha256Hash
+		}
+	}
 
-func readAllCACertificatePEMsCSV() {
-	certificateDERMap = make(map[[sha256.Size]byte][]byte)
-	entries, err := pemFS.ReadDir("cmd/ski_spki/data")
+	return nil
+}
+
+func (s *Store) readAllCACertificatePEMsCSV() {
+	defer s.certificatesLoaded.Store(true)
+	s.certificateDERMap = make(map[[sha256.Size]byte][]byte)
+	s.certificateCache = newCertificateCache(PARSED_CERTIFICATE_CACHE_SIZE)
+	paths, err := s.pemCSVPaths()
 	if err != nil {
 		logger.Info("PEM data directory could not be read", zap.Error(err))
+		s.certificatesErr = fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
 		return
 	}
 
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
+	// Read each file concurrently. The results are merged in file order, so that the outcome doesn't depend on the
+	// order in which the workers finish. The certificates are only parsed when they are looked up (see
+	// parsedCertificate).
+	results := make([][]pemCertificate, len(paths))
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i, filePath := range paths {
+		wg.Go(func() {
+			workers <- struct{}{}
+			defer func() { <-workers }()
+			results[i] = s.readPEMCSVFile(filePath)
+		})
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		for _, pc := range result {
+			if _, exists := s.certificateDERMap[pc.sha256Fingerprint]; !exists {
+				s.certificateDERMap[pc.sha256Fingerprint] = pc.der
+			}
 		}
-		filePath := "cmd/ski_spki/data/" + entry.Name()
-		data, err := pemFS.ReadFile(filePath)
-		if err != nil {
-			logger.Warn("PEM CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
+	}
+
+	logger.Info("Loaded certificate DER data", zap.Int("count", len(s.certificateDERMap)))
+}
+
+// readPEMCSVFile reads the DER-encoded certificates from a PEM CSV file.
+func (s *Store) readPEMCSVFile(filePath string) []pemCertificate {
+	data, err := s.readDataFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		// There is no PEM report for a year in which no certificates were issued.
+		logger.Debug("PEM CSV file does not exist", zap.String("file_path", filePath))
+		return nil
+	} else if err != nil {
+		logger.Warn("PEM CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
+		return nil
+	}
+
+	records := readCSVRecords(data, filePath, 2)
+	if len(records) == 0 {
+		logger.Warn("PEM CSV file is empty", zap.String("file_path", filePath))
+		return nil
+	}
+
+	var pemCertificates []pemCertificate
+	for _, r := range records[1:] {
+		record := r.fields
+		var pc pemCertificate
+		var ok bool
+		if pc.sha256Fingerprint, ok = HexFingerprintToArray(record[0]); !ok {
 			continue
 		}
 
-		reader := csv.NewReader(strings.NewReader(string(data)))
-		reader.FieldsPerRecord = 2
-		reader.LazyQuotes = true
-		reader.TrimLeadingSpace = true
-		reader.ReuseRecord = true
-		records, err := reader.ReadAll()
-		if err != nil {
-			logger.Warn("PEM CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
+		block, _ := pem.Decode([]byte(record[1]))
+		if block == nil {
 			continue
 		}
+		pc.der = block.Bytes
+		pemCertificates = append(pemCertificates, pc)
+	}
 
-		for _, record := range records[1:] {
-			sha256Slice, err := hex.DecodeString(record[0])
-			if err != nil || len(sha256Slice) != sha256.Size {
-				continue
-			}
-			var sha256Array [sha256.Size]byte
-			copy(sha256Array[:], sha256Slice)
+	return pemCertificates
+}
 
-			if _, exists := certificateDERMap[sha256Array]; exists {
-				continue
-			}
+func (s *Store) readAllCertificateRecordsCSVRaw() {
+	defer s.rawRecordsLoaded.Store(true)
+	s.rawRecordMap = make(map[[sha256.Size]byte][]*RawRecord)
+	ccadbCsvPath := s.ccadbCSVPath()
+	ccadbCsvData, err := s.readDataFile(ccadbCsvPath)
+	if err != nil {
+		logger.Info("CSV file could not be read", zap.Error(err), zap.String("file_path", ccadbCsvPath))
+		return
+	}
 
-			block, _ := pem.Decode([]byte(record[1]))
-			if block == nil {
-				continue
-			}
-			certificateDERMap[sha256Array] = block.Bytes
+	// Parse CSV data.
+	records := readCSVRecords(ccadbCsvData, ccadbCsvPath, 0)
+	if len(records) == 0 {
+		logger.Error("CSV file is empty", zap.String("file_path", ccadbCsvPath))
+		return
+	}
+	header := records[0].fields
+	sha256Idx, recordTypeIdx := -1, -1
+	for i, v := range header {
+		switch v {
+		case "SHA-256 Fingerprint":
+			sha256Idx = i
+		case "Certificate Record Type":
+			recordTypeIdx = i
+		}
+	}
+	if sha256Idx == -1 {
+		logger.Error("CSV data is missing one or more expected headers", zap.String("file_path", ccadbCsvPath))
+		return
+	}
+
+	si := make(stringInterner)
+	for _, record := range records[1:] {
+		sha256Array, ok := HexFingerprintToArray(record.fields[sha256Idx])
+		if !ok {
+			continue
+		}
+		for i, field := range record.fields {
+			record.fields[i] = si.intern(field)
+		}
+		rr := &RawRecord{
+			LineNumber: record.line,
+			Header:     header,
+			Fields:     record.fields,
+		}
+
+		// Keep the primary record first, as described by addCertificateRecord.
+		rawRecords := s.rawRecordMap[sha256Array]
+		if len(rawRecords) > 0 && isPrimaryRecord(ParseRecordType(csvField(record.fields, recordTypeIdx)), ParseRecordType(csvField(rawRecords[0].Fields, recordTypeIdx))) {
+			s.rawRecordMap[sha256Array] = append([]*RawRecord{rr}, rawRecords...)
+		} else {
+			s.rawRecordMap[sha256Array] = append(rawRecords, rr)
 		}
 	}
 
-	logger.Info("Loaded certificate DER data", zap.Int("count", len(certificateDERMap)))
+	logger.Info("Loaded raw CSV records", zap.Int("count", len(s.rawRecordMap)))
 }
@@ -0,0 +1,157 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"os"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestMain(m *testing.M) {
+	// Malformed test data would otherwise be logged.
+	SetLogger(nil)
+	os.Exit(m.Run())
+}
+
+// TestShardedLoading checks that the All Certificate Records report is loaded identically however many workers parse
+// it.
+func TestShardedLoading(t *testing.T) {
+	load := func(procs int) *Store {
+		defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+		s, err := NewStore(EmbeddedFS())
+		if err != nil {
+			t.Fatalf("NewStore() returned %v", err)
+		} else if len(s.caRecords) < 2*MIN_RECORDS_PER_SHARD {
+			t.Fatal("Embedded dataset is too small to be sharded")
+		}
+		return s
+	}
+	sequential, sharded := load(1), load(4)
+	if !reflect.DeepEqual(sequential.caRecords, sharded.caRecords) || !reflect.DeepEqual(sequential.caCapabilities, sharded.caCapabilities) || !reflect.DeepEqual(sequential.caIndex, sharded.caIndex) {
+		t.Error("Records loaded by 4 workers differ from those loaded by 1")
+	}
+	if !reflect.DeepEqual(sequential.sha256FingerprintsMap, sharded.sha256FingerprintsMap) || !reflect.DeepEqual(sequential.issuerCapabilitiesMap, sharded.issuerCapabilitiesMap) {
+		t.Error("Key identifier indexes built by 4 workers differ from those built by 1")
+	}
+}
+
+func TestParseTristate(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want Tristate
+	}{
+		{"True", TristateTrue},
+		{" true ", TristateTrue},
+		{"YES", TristateTrue},
+		{"1", TristateTrue},
+		{"False", TristateFalse},
+		{"no", TristateFalse},
+		{"0", TristateFalse},
+		{"", TristateUnknown},
+		{"Unknown", TristateUnknown},
+		{"Maybe", TristateUnknown},
+	} {
+		if got := ParseTristate(tc.s); got != tc.want {
+			t.Errorf("ParseTristate(%q) = %v, want %v", tc.s, got, tc.want)
+		} else if got.IsTrue() != (tc.want == TristateTrue) {
+			t.Errorf("ParseTristate(%q).IsTrue() = %t", tc.s, got.IsTrue())
+		}
+	}
+
+	for _, want := range []Tristate{TristateUnknown, TristateFalse, TristateTrue} {
+		var got Tristate
+		if text, err := want.MarshalText(); err != nil {
+			t.Errorf("%v.MarshalText() returned %v", want, err)
+		} else if err := got.UnmarshalText(text); err != nil || got != want {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, got, err, want)
+		}
+	}
+	var got Tristate
+	if err := got.UnmarshalText([]byte("Maybe")); err == nil {
+		t.Error("UnmarshalText(\"Maybe\") didn't return an error")
+	}
+}
+
+// BenchmarkNewStore measures loading the embedded dataset, most of which is parsing the All Certificate Records report.
+// Compare -cpu 1 with -cpu 4 to measure the sharded parsing.
+func BenchmarkNewStore(b *testing.B) {
+	for b.Loop() {
+		if s, err := NewStore(EmbeddedFS()); s == nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// readFixture returns a file of the v5 ccadbtest fixture, for seeding the fuzz tests.
+func readFixture(f *testing.F, filePath string) []byte {
+	data, err := os.ReadFile("ccadbtest/fixtures/v5/" + filePath)
+	if err != nil {
+		f.Fatal(err)
+	}
+	return data
+}
+
+// newFuzzStore returns a Store whose dataset contains only the given file.
+func newFuzzStore(filePath string, data []byte) *Store {
+	return &Store{src: NewFSDataSource(fstest.MapFS{filePath: {Data: data}}), manifestResults: make(map[string]manifestResult)}
+}
+
+// FuzzAllCertificateRecordsCSV loads a Store from an All Certificate Records report, and then its raw records. The
+// checked-in corpus in testdata/fuzz is written by cmd/fuzz_corpus.
+func FuzzAllCertificateRecordsCSV(f *testing.F) {
+	f.Add(readFixture(f, CCADB_CSV_PATH))
+	f.Add([]byte(strings.Join(csvHeaders[:], ",") + "\n"))
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		s, err := NewStore(fstest.MapFS{
+			CCADB_CSV_PATH:      {Data: data},
+			SKI_SPKISHA256_PATH: {Data: []byte("Subject Key Identifier,SHA-256(Subject Public Key Info)\n")},
+		})
+		if s == nil {
+			if err == nil {
+				t.Fatal("NewStore returned neither a Store nor an error")
+			}
+			return
+		}
+		s.LoadRawRecords()
+	})
+}
+
+// FuzzSKISPKISHA256CSV reads a key identifiers CSV file, as written by cmd/ski_spki.
+func FuzzSKISPKISHA256CSV(f *testing.F) {
+	f.Add(readFixture(f, SKI_SPKISHA256_PATH))
+	f.Add([]byte("Subject Key Identifier,SHA-256(Subject Public Key Info)\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		newFuzzStore(SKI_SPKISHA256_PATH, data).readSKIAndSHA256HashCSV(make(map[string][sha256.Size]byte), SKI_SPKISHA256_PATH)
+	})
+}
+
+// FuzzDerivedSKICSV reads a derived key identifiers CSV file.
+func FuzzDerivedSKICSV(f *testing.F) {
+	f.Add(readFixture(f, DERIVED_SKI_PATH))
+	f.Add([]byte("SHA-256 Fingerprint,Subject Key Identifier,SHA-256(Subject Public Key Info)\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		newFuzzStore(DERIVED_SKI_PATH, data).readDerivedSKICSV()
+	})
+}
+
+// FuzzPEMCSV reads a PEM report. Every certificate that it returns must have been read from a PEM block.
+func FuzzPEMCSV(f *testing.F) {
+	const filePath = PEM_CSV_DIR + "/" + PEM_CSV_FILENAME_PREFIX + "2025"
+	f.Add(readFixture(f, PEM_CSV_DIR+"/"+PEM_CSV_FILENAME_PREFIX+"2015"))
+	f.Add([]byte("SHA-256 Fingerprint,X.509 Certificate (PEM)\n"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, pc := range newFuzzStore(filePath, data).readPEMCSVFile(filePath) {
+			if pc.der == nil {
+				t.Fatalf("readPEMCSVFile returned %X without a certificate", pc.sha256Fingerprint)
+			}
+		}
+	})
+}
@@ -0,0 +1,49 @@
+// Package ccadbtest provides small but realistic CCADB datasets, so that projects that depend on ccadb_data can
+// unit-test their CCADB-dependent logic deterministically.
+//
+// Each fixture contains the same CA certificate records, which were chosen to cover edge cases: ISRG Root X1 and some
+// of its intermediates, a cross-certificate, root certificates with a shared Subject Key Identifier, a root certificate
+// with no Subject Key Identifier, an intermediate whose parent is revoked, and S/MIME, Code Signing, and VMC capable CA
+// certificates.
+package ccadbtest
+
+import (
+	"embed"
+	"io/fs"
+	"testing"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+//go:embed fixtures
+var fixtures embed.FS
+
+const (
+	FIXTURE_V5 = "v5" // AllCertificateRecordsCSVFormatV5 report.
+	FIXTURE_V3 = "v3" // AllCertificateRecordsCSVFormatV3 report.
+)
+
+// FixtureFS returns the named fixture, laid out in the same way as the ccadb_data repository.
+func FixtureFS(name string) (fs.FS, error) {
+	return fs.Sub(fixtures, "fixtures/"+name)
+}
+
+// NewStoreFromFixture loads the named fixture into a new Store, with all optional data loaded. It fails the test if
+// the fixture cannot be loaded.
+func NewStoreFromFixture(tb testing.TB, name string) *ccadb_data.Store {
+	tb.Helper()
+	if _, err := fs.Stat(fixtures, "fixtures/"+name); err != nil {
+		tb.Fatalf("Unknown fixture %q: %v", name, err)
+	}
+	fsys, err := FixtureFS(name)
+	if err != nil {
+		tb.Fatalf("Fixture %q could not be opened: %v", name, err)
+	}
+	s, err := ccadb_data.NewStore(fsys)
+	if err != nil {
+		tb.Fatalf("Fixture %q could not be loaded: %v", name, err)
+	}
+	s.LoadAllCACertificates()
+	s.LoadRawRecords()
+	return s
+}
@@ -0,0 +1,81 @@
+package ccadbtest
+
+import (
+	"crypto/sha256"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+// The number of distinct CA certificates in each fixture.
+const TEST_FIXTURE_CERTIFICATES = 13
+
+// CA certificates in each fixture that cover edge cases.
+const (
+	TEST_ISRG_ROOT_X1_SHA256        = "96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6"
+	TEST_ISRG_ROOT_X1_CROSS_SHA256  = "6D99FB265EB1C5B3744765FCBC648F3CD8E1BFFAFDC4C2F99B9D47CF7FF1C24F"
+	TEST_SHARED_SKI                 = "Qj0rJKbBRc4="
+	TEST_MISSING_SKI_SHA256         = "D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624"
+	TEST_MISSING_SKI_DERIVED_SKI    = "jEPEy22YwaechGnr30oNYJY6w/s="
+	TEST_PARENT_CERT_REVOKED_SHA256 = "A85C84A0825AA019DC08FA9A02C4C39E3FD419347B2E92DF04633EE426D90077"
+)
+
+func TestMain(m *testing.M) {
+	// The fixtures' deliberately odd records would otherwise be logged.
+	ccadb_data.SetLogger(nil)
+	os.Exit(m.Run())
+}
+
+func mustFingerprint(t *testing.T, hexFingerprint string) [sha256.Size]byte {
+	t.Helper()
+	sha256Fingerprint, ok := ccadb_data.HexFingerprintToArray(hexFingerprint)
+	if !ok {
+		t.Fatalf("Invalid fingerprint %q", hexFingerprint)
+	}
+	return sha256Fingerprint
+}
+
+// TestNewStoreFromFixture checks that each fixture loads every CA certificate, and still covers its edge cases.
+func TestNewStoreFromFixture(t *testing.T) {
+	for _, name := range []string{FIXTURE_V5, FIXTURE_V3} {
+		t.Run(name, func(t *testing.T) {
+			s := NewStoreFromFixture(t, name)
+			if got := len(s.ListFingerprints(ccadb_data.CapabilityFilter{})); got != TEST_FIXTURE_CERTIFICATES {
+				t.Errorf("Fixture has %d CA certificates, want %d", got, TEST_FIXTURE_CERTIFICATES)
+			}
+
+			root := mustFingerprint(t, TEST_ISRG_ROOT_X1_SHA256)
+			if ccc := s.GetCACertCapabilitiesBySHA256(root); ccc == nil || ccc.CertificateRecordType != ccadb_data.RecordTypeRoot || !ccc.TlsCapable {
+				t.Errorf("ISRG Root X1 has capabilities %+v, want a TLS capable root", ccc)
+			}
+			if der, ok := s.GetCACertificateBySHA256(root); !ok || len(der) == 0 {
+				t.Error("ISRG Root X1's certificate was not loaded")
+			}
+			if s.GetRawRecordBySHA256(root) == nil {
+				t.Error("ISRG Root X1's raw record was not loaded")
+			}
+			cross := mustFingerprint(t, TEST_ISRG_ROOT_X1_CROSS_SHA256)
+			if got := s.GetSHA256FingerprintsByKeyIdentifier(s.GetCertificateRecordBySHA256(root).SubjectKeyIdentifier); !slices.Contains(got, root) || !slices.Contains(got, cross) {
+				t.Errorf("ISRG Root X1's key identifier finds %X, want both it and its cross-certificate", got)
+			}
+
+			if got := s.GetSHA256FingerprintsByKeyIdentifier(TEST_SHARED_SKI); len(got) != 2 {
+				t.Errorf("Shared key identifier finds %d CA certificates, want 2", len(got))
+			}
+
+			missingSKI := mustFingerprint(t, TEST_MISSING_SKI_SHA256)
+			if cr := s.GetCertificateRecordBySHA256(missingSKI); cr == nil || cr.SubjectKeyIdentifier != "" {
+				t.Errorf("CA certificate with no Subject Key Identifier has record %+v", cr)
+			}
+			if got := s.GetSHA256FingerprintsByKeyIdentifier(TEST_MISSING_SKI_DERIVED_SKI); !slices.Equal(got, [][sha256.Size]byte{missingSKI}) {
+				t.Errorf("Derived key identifier finds %X, want %X", got, missingSKI)
+			}
+
+			if cr := s.GetCertificateRecordBySHA256(mustFingerprint(t, TEST_PARENT_CERT_REVOKED_SHA256)); cr == nil || cr.RevocationStatus != ccadb_data.RevocationStatusParentRevoked {
+				t.Errorf("CA certificate whose parent is revoked has record %+v", cr)
+			}
+		})
+	}
+}
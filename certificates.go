@@ -0,0 +1,158 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+// The number of parsed CA certificates that a Store keeps, unless every certificate has been preloaded by
+// PreloadParsedCACertificates.
+const PARSED_CERTIFICATE_CACHE_SIZE = 4096
+
+// A least recently used cache of parsed CA certificates, indexed by SHA-256(Certificate). Certificates that could not be
+// parsed are cached as nil, so that they aren't parsed again.
+type certificateCache struct {
+	mu       sync.Mutex
+	capacity int
+	elements map[[sha256.Size]byte]*list.Element
+	// The cached certificates, most recently used first.
+	order *list.List
+}
+
+type certificateCacheEntry struct {
+	sha256Fingerprint [sha256.Size]byte
+	cert              *x509.Certificate
+}
+
+func newCertificateCache(capacity int) *certificateCache {
+	return &certificateCache{capacity: capacity, elements: make(map[[sha256.Size]byte]*list.Element), order: list.New()}
+}
+
+// get returns the cached certificate, and whether it was cached.
+func (cc *certificateCache) get(sha256Fingerprint [sha256.Size]byte) (*x509.Certificate, bool) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	element, ok := cc.elements[sha256Fingerprint]
+	if !ok {
+		return nil, false
+	}
+	cc.order.MoveToFront(element)
+	return element.Value.(*certificateCacheEntry).cert, true
+}
+
+// add caches a certificate, evicting the least recently used certificate if the cache is full.
+func (cc *certificateCache) add(sha256Fingerprint [sha256.Size]byte, cert *x509.Certificate) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if element, ok := cc.elements[sha256Fingerprint]; ok {
+		cc.order.MoveToFront(element)
+		return
+	}
+	cc.elements[sha256Fingerprint] = cc.order.PushFront(&certificateCacheEntry{sha256Fingerprint: sha256Fingerprint, cert: cert})
+	if cc.order.Len() > cc.capacity {
+		oldest := cc.order.Remove(cc.order.Back()).(*certificateCacheEntry)
+		delete(cc.elements, oldest.sha256Fingerprint)
+	}
+}
+
+// parseCertificate parses a DER-encoded CA certificate, returning nil if it could not be parsed.
+func parseCertificate(sha256Fingerprint [sha256.Size]byte, der []byte) *x509.Certificate {
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		logger.Debug("Certificate could not be parsed", zap.Error(err), zap.String("sha256", fmt.Sprintf("%X", sha256Fingerprint)))
+		return nil
+	}
+	return cert
+}
+
+// parsedCertificate returns the parsed CA certificate, parsing it on demand unless every certificate has been preloaded.
+// LoadAllCACertificates must have been called first.
+func (s *Store) parsedCertificate(sha256Fingerprint [sha256.Size]byte) *x509.Certificate {
+	if preloaded := s.preloadedCertificateMap.Load(); preloaded != nil {
+		return (*preloaded)[sha256Fingerprint]
+	}
+	der, ok := s.certificateDERMap[sha256Fingerprint]
+	if !ok {
+		return nil
+	} else if cert, ok := s.certificateCache.get(sha256Fingerprint); ok {
+		return cert
+	}
+	cert := parseCertificate(sha256Fingerprint, der)
+	s.certificateCache.add(sha256Fingerprint, cert)
+	return cert
+}
+
+// parseAllCertificates parses every loaded CA certificate concurrently, omitting those that could not be parsed.
+func (s *Store) parseAllCertificates() map[[sha256.Size]byte]*x509.Certificate {
+	if preloaded := s.preloadedCertificateMap.Load(); preloaded != nil {
+		return *preloaded
+	}
+
+	var mu sync.Mutex
+	certificateMap := make(map[[sha256.Size]byte]*x509.Certificate, len(s.certificateDERMap))
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for sha256Fingerprint, der := range s.certificateDERMap {
+		workers <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-workers }()
+			if cert := parseCertificate(sha256Fingerprint, der); cert != nil {
+				mu.Lock()
+				certificateMap[sha256Fingerprint] = cert
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return certificateMap
+}
+
+// preloadParsedCACertificates parses every loaded CA certificate, and keeps them all.
+func (s *Store) preloadParsedCACertificates() {
+	certificateMap := s.parseAllCertificates()
+	s.preloadedCertificateMap.Store(&certificateMap)
+	logger.Info("Preloaded parsed certificates", zap.Int("count", len(certificateMap)))
+}
+
+// indexCrossSigns indexes the CA certificates by the SHA-256 hash of their public key, keeping only the public keys
+// that have been certified by more than one issuer. The certificates are parsed for the index, but only kept if they
+// have been preloaded.
+func (s *Store) indexCrossSigns() {
+	s.crossSignsMap = make(map[[sha256.Size]byte][]*CrossSign)
+	for sha256Fingerprint, cert := range s.parseAllCertificates() {
+		spkiSHA256 := SPKIHashOf(cert)
+		s.crossSignsMap[spkiSHA256] = append(s.crossSignsMap[spkiSHA256], &CrossSign{
+			SHA256Fingerprint: sha256Fingerprint,
+			Subject:           cert.Subject.String(),
+			Issuer:            cert.Issuer.String(),
+			NotBefore:         cert.NotBefore,
+			NotAfter:          cert.NotAfter,
+		})
+	}
+
+	for spkiSHA256, crossSigns := range s.crossSignsMap {
+		issuers := make(map[string]struct{})
+		for _, cs := range crossSigns {
+			issuers[cs.Issuer] = struct{}{}
+		}
+		if len(issuers) < 2 {
+			delete(s.crossSignsMap, spkiSHA256)
+			continue
+		}
+		// The certificates were parsed in no particular order, so break ties by fingerprint.
+		slices.SortFunc(crossSigns, func(a, b *CrossSign) int {
+			if c := a.NotBefore.Compare(b.NotBefore); c != 0 {
+				return c
+			}
+			return bytes.Compare(a.SHA256Fingerprint[:], b.SHA256Fingerprint[:])
+		})
+	}
+}
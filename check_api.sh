@@ -0,0 +1,35 @@
+#!/bin/bash
+
+# Reports any incompatible changes to the supported API since BASE (by default, the latest release), and exits with
+# status 1 if there are any. Requires apidiff: go install golang.org/x/exp/cmd/apidiff@latest
+
+PACKAGES="github.com/crtsh/ccadb_data github.com/crtsh/ccadb_data/bucket github.com/crtsh/ccadb_data/ccadbtest github.com/crtsh/ccadb_data/client github.com/crtsh/ccadb_data/full github.com/crtsh/ccadb_data/roots"
+
+BASE=${1:-`git describe --tags --abbrev=0 --match 'v1.*' 2> /dev/null`}
+if [ -z "$BASE" ]; then
+  echo "No release found to compare with"
+  exit 2
+fi
+
+TMPDIR=`mktemp -d`
+trap "git worktree remove --force $TMPDIR/base 2> /dev/null; rm -rf $TMPDIR" EXIT
+git worktree add -q --detach $TMPDIR/base $BASE || exit 2
+
+INCOMPATIBLE=0
+for PACKAGE in $PACKAGES; do
+  # A package that is new since BASE has no API to break.
+  if ! (cd $TMPDIR/base && go list $PACKAGE > /dev/null 2>&1); then
+    continue
+  fi
+  (cd $TMPDIR/base && apidiff -w $TMPDIR/api $PACKAGE) || exit 2
+
+  # DatasetDate, RecordCount, and CertificateCount describe the embedded data, so they change with every data update.
+  CHANGES=`apidiff -incompatible $TMPDIR/api $PACKAGE | grep -v -E "(DatasetDate|RecordCount|CertificateCount): value changed"`
+  if [ -n "$CHANGES" ]; then
+    echo "Incompatible changes to $PACKAGE since $BASE:"
+    echo "$CHANGES"
+    INCOMPATIBLE=1
+  fi
+done
+
+exit $INCOMPATIBLE
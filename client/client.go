@@ -0,0 +1,78 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// The maximum size of an error message that is read from a response.
+const MAX_ERROR_SIZE = 4096
+
+// Client calls the HTTP API served by cmd/ccadb_server at a base URL.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client that calls the server at baseURL, e.g. "http://localhost:8080". A nil httpClient means
+// http.DefaultClient.
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{baseURL: strings.TrimSuffix(baseURL, "/"), httpClient: httpClient}
+}
+
+// Error is the error returned when the server responds with an HTTP status other than 200 OK, e.g. 404 Not Found when
+// no CA certificate has the requested SHA-256 fingerprint.
+type Error struct {
+	StatusCode int
+	// The error message in the response body.
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// do sends a request with the query parameters and, unless it is nil, the JSON encoding of body, and decodes the JSON
+// response into response.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, response any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	reqURL := c.baseURL + path
+	if len(query) > 0 {
+		reqURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, MAX_ERROR_SIZE))
+		return &Error{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
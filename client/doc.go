@@ -0,0 +1,11 @@
+// Package client calls the HTTP API served by cmd/ccadb_server, so that other services can look up CA certificates'
+// CCADB records and capabilities without loading the dataset themselves, or hand-writing request code:
+//
+//	c := client.NewClient("http://localhost:8080", nil)
+//	record, err := c.GetRecord(ctx, "96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6")
+//
+// The types and methods in api.go are generated by cmd/client_gen from the API's OpenAPI document, which the server
+// serves at /openapi.json. This package does not import the ccadb_data package, so it doesn't embed the dataset.
+package client
+
+//go:generate go run ../cmd/client_gen -output api.go
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/httpclient"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	ENV_LOGIN_URL     = "CCADB_API_LOGIN_URL"
+	ENV_CLIENT_ID     = "CCADB_API_CLIENT_ID"
+	ENV_CLIENT_SECRET = "CCADB_API_CLIENT_SECRET"
+)
+
+// A failed or missing ALV result, written as one JSON object per line.
+type finding struct {
+	CAOwner           string `json:"ca_owner"`
+	SHA256Fingerprint string `json:"sha256_fingerprint"`
+	CertificateName   string `json:"certificate_name"`
+	AuditType         string `json:"audit_type"`
+	// "Not Found", or "" or "Unknown" for a missing result.
+	Result string `json:"result"`
+}
+
+func main() {
+	format := flag.String("format", "text", "Output format: text, or json (one JSON object per finding per line)")
+	dataDir := flag.String("data", "", "Directory containing a CCADB dataset in the dataset layout read by ccadb_data.NewStore (defaults to the embedded data)")
+	alvFile := flag.String("alv", "", "CSV export of the CCADB's ALV results (defaults to the ALV columns of the All Certificate Records report)")
+	loginURL := flag.String("login-url", "", "URL of the CCADB's Salesforce instance or My Domain, to query the ALV results through the CCADB API (defaults to $"+ENV_LOGIN_URL+")")
+	clientID := flag.String("client-id", "", "CCADB API client ID (defaults to $"+ENV_CLIENT_ID+")")
+	clientSecret := flag.String("client-secret", "", "CCADB API client secret (defaults to $"+ENV_CLIENT_SECRET+")")
+	var alvConfig ccadb_data.ALVConfig
+	flag.StringVar(&alvConfig.Object, "object", ccadb_data.DEFAULT_ALV_OBJECT, "CCADB API object that holds the CA certificate records")
+	flag.StringVar(&alvConfig.FingerprintField, "fingerprint-field", ccadb_data.DEFAULT_ALV_FINGERPRINT_FIELD, "CCADB API field that holds each record's SHA-256 fingerprint")
+	flag.Func("field", "CCADB API field that holds an audit type's ALV result, as AUDIT_TYPE=FIELD (e.g. \"BR=BR_Audit_ALV_Found_Cert__c\"), or AUDIT_TYPE= to skip that audit type; may be repeated", func(value string) error {
+		auditType, field, ok := strings.Cut(value, "=")
+		if !ok || auditType == "" {
+			return fmt.Errorf("Expected AUDIT_TYPE=FIELD")
+		}
+		if alvConfig.ResultFields == nil {
+			alvConfig.ResultFields = make(map[string]string)
+		}
+		alvConfig.ResultFields[auditType] = field
+		return nil
+	})
+	httpFlags := httpclient.AddFlags(flag.CommandLine, httpclient.Config{Timeout: time.Duration(300) * time.Second})
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format text|json] [-data DIR] [-alv FILE | -login-url URL [-client-id ID] [-client-secret SECRET] [-object OBJECT] [-fingerprint-field FIELD] [-field AUDIT_TYPE=FIELD]... [-proxy URL] [-cafile FILE] [-insecure] [-timeout DURATION]] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	*loginURL = cmp.Or(*loginURL, os.Getenv(ENV_LOGIN_URL))
+	*clientID = cmp.Or(*clientID, os.Getenv(ENV_CLIENT_ID))
+	*clientSecret = cmp.Or(*clientSecret, os.Getenv(ENV_CLIENT_SECRET))
+	if flag.NArg() != 0 || (*format != "text" && *format != "json") || (*loginURL != "" && (*alvFile != "" || *clientID == "" || *clientSecret == "")) {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("alv_report")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	s := ccadb_data.GetDefaultStore()
+	if *dataDir != "" {
+		if s, err = ccadb_data.NewStore(os.DirFS(*dataDir)); err != nil {
+			logger.Fatal("Dataset could not be loaded", zap.Error(err), zap.String("dir", *dataDir))
+		}
+	}
+	if *alvFile != "" {
+		f, err := os.Open(*alvFile)
+		if err != nil {
+			logger.Fatal("ALV results could not be read", zap.Error(err), zap.String("file_path", *alvFile))
+		}
+		err = s.LoadALVResultsCSV(f)
+		f.Close()
+		if err != nil {
+			logger.Fatal("ALV results could not be read", zap.Error(err), zap.String("file_path", *alvFile))
+		}
+	} else if *loginURL != "" {
+		httpConfig, err := httpFlags.Config()
+		if err != nil {
+			logger.Fatal("HTTP client could not be configured", zap.Error(err))
+		}
+		httpClient, err := httpclient.New(httpConfig)
+		if err != nil {
+			logger.Fatal("HTTP client could not be configured", zap.Error(err))
+		}
+		c := ccadb_data.NewAPIClient(httpClient, ccadb_data.APIClientConfig{LoginURL: *loginURL, ClientID: *clientID, ClientSecret: *clientSecret})
+		if err = s.LoadALVResults(context.Background(), c, alvConfig); err != nil {
+			logger.Fatal("ALV results could not be loaded", zap.Error(err))
+		}
+	}
+
+	findings := s.ListALVFindings(time.Now().UTC())
+	if findings == nil {
+		logger.Fatal("The dataset has no ALV results; use -alv to read them from a CSV export, or -login-url to query the CCADB API")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	previousCAOwner := ""
+	for _, af := range findings {
+		f := &finding{
+			CAOwner:           af.CAOwner,
+			SHA256Fingerprint: fmt.Sprintf("%X", af.SHA256Fingerprint),
+			CertificateName:   af.CertificateName,
+			AuditType:         af.AuditType,
+			Result:            af.Result.String(),
+		}
+		if af.Result == ccadb_data.ALVResultNotFound {
+			tally.Add("failed", 1)
+		} else {
+			tally.Add("missing", 1)
+		}
+
+		if *format == "json" {
+			if err = encoder.Encode(f); err != nil {
+				logger.Fatal("Output could not be written", zap.Error(err))
+			}
+			continue
+		}
+		// Group the findings by CA Owner, which they are ordered by.
+		if f.CAOwner != previousCAOwner {
+			if previousCAOwner != "" {
+				fmt.Println()
+			}
+			fmt.Println(f.CAOwner)
+			previousCAOwner = f.CAOwner
+		}
+		fmt.Printf("  %s  %-15s  %-9s  %s\n", f.SHA256Fingerprint, f.AuditType, cmp.Or(f.Result, "Missing"), strings.ReplaceAll(f.CertificateName, "\n", " "))
+	}
+
+	tally.Exit(len(findings))
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+var logger *zap.Logger
+
+func main() {
+	dir := flag.String("dir", "", "Archive the dataset in this directory (e.g. one written by FetchReports) instead of the embedded full dataset")
+	verify := flag.Bool("verify", false, "Verify an existing archive and load it, instead of creating one")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console] [-dir DIRECTORY] <archive file>\n       %s [-log-level LEVEL] [-log-format json|console] -verify <archive file>\n", os.Args[0], os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 1 || (*verify && *dir != "") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("archive")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	if *verify {
+		verifyArchive(flag.Arg(0), tally)
+	} else {
+		createArchive(flag.Arg(0), *dir, tally)
+	}
+	tally.Exit(0)
+}
+
+// createArchive writes the dataset in dir, or else the embedded full dataset, to an archive file.
+func createArchive(filePath, dir string, tally *summary.Summary) {
+	fsys, datasetDate := full.FS(), ccadb_data.GetDatasetInfo().Date
+	if dir != "" {
+		// A downloaded dataset was fetched when its All Certificate Records report was written.
+		fsys, datasetDate = os.DirFS(dir), time.Time{}
+		for _, reportPath := range []string{ccadb_data.CCADB_CSV_PATH, ccadb_data.CCADB_CSV_V3_PATH, ccadb_data.SLIM_CSV_PATH} {
+			if fi, err := fs.Stat(fsys, reportPath); err == nil {
+				datasetDate = fi.ModTime()
+				break
+			}
+		}
+	}
+
+	file, err := os.Create(filePath)
+	if err != nil {
+		logger.Fatal("Archive could not be created", zap.Error(err), zap.String("file_path", filePath))
+	}
+	manifest, err := ccadb_data.WriteArchive(file, fsys, datasetDate)
+	if err == nil {
+		err = file.Close()
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(filePath)
+		logger.Fatal("Archive could not be written", zap.Error(err), zap.String("file_path", filePath))
+	}
+
+	var size int64
+	for _, f := range manifest.Files {
+		size += f.Size
+	}
+	tally.Add("files", len(manifest.Files))
+	logger.Info("Wrote archive", zap.String("file_path", filePath), zap.Int("file_count", len(manifest.Files)), zap.Int64("uncompressed_size", size), zap.Time("dataset_date", manifest.DatasetDate))
+}
+
+// verifyArchive verifies an archive file, and checks that a Store can be loaded from it.
+func verifyArchive(filePath string, tally *summary.Summary) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		logger.Fatal("Archive could not be read", zap.Error(err), zap.String("file_path", filePath))
+	}
+	defer file.Close()
+	fsys, manifest, err := ccadb_data.ReadArchive(file)
+	if err != nil {
+		logger.Fatal("Archive could not be verified", zap.Error(err), zap.String("file_path", filePath))
+	}
+	s, err := ccadb_data.NewStore(fsys)
+	if err != nil {
+		logger.Fatal("Archive could not be loaded", zap.Error(err), zap.String("file_path", filePath))
+	}
+	records := len(s.ListFingerprintsWhere(ccadb_data.Where()))
+	tally.Add("files", len(manifest.Files))
+	tally.Add("records", records)
+	logger.Info("Verified archive", zap.String("file_path", filePath), zap.Int("file_count", len(manifest.Files)), zap.Int("record_count", records), zap.Time("dataset_date", manifest.DatasetDate), zap.Time("created_at", manifest.CreatedAt))
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const DEFAULT_CSV_PATH = "full/data/AllCertificateRecordsCSVFormatV5"
+
+// Audit types that have an audit period in the CSV report, each with "<type> Audit Period Start Date" and
+// "<type> Audit Period End Date" columns.
+var auditTypes = []string{"Standard", "NetSec", "TLS BR", "TLS EVG", "Code Signing", "S/MIME BR", "VMC"}
+
+type period struct {
+	start time.Time
+	end   time.Time
+}
+
+// A compliance finding, written as one JSON object per line.
+type finding struct {
+	Type              string `json:"type"`
+	CAOwner           string `json:"ca_owner"`
+	AuditType         string `json:"audit_type"`
+	SHA256Fingerprint string `json:"sha256_fingerprint,omitempty"`
+	CertificateName   string `json:"certificate_name,omitempty"`
+	GapStart          string `json:"gap_start,omitempty"`
+	GapEnd            string `json:"gap_end,omitempty"`
+	PeriodEnd         string `json:"period_end,omitempty"`
+	Days              int    `json:"days"`
+}
+
+func main() {
+	gapDays := flag.Int("gap-days", 90, "Report gaps of more than this many days between consecutive audit periods")
+	staleDays := flag.Int("stale-days", 455, "Report CA certificates whose audit period ended more than this many days ago")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-gap-days N] [-stale-days N] [-log-level LEVEL] [-log-format json|console] [AllCertificateRecords CSV file]\n(Defaults to %s.)\n", os.Args[0], DEFAULT_CSV_PATH)
+	}
+	flag.Parse()
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("audit_gaps")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	filePath := DEFAULT_CSV_PATH
+	if flag.NArg() == 1 {
+		filePath = flag.Arg(0)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		logger.Fatal("CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
+	} else if len(records) == 0 {
+		logger.Fatal("CSV file is empty", zap.String("file_path", filePath))
+	}
+
+	// Examine the CSV header to find the fields that we need.
+	idx := make(map[string]int)
+	for i, name := range records[0] {
+		idx[name] = i
+	}
+	required := []string{"CA Owner", "Certificate Name", "SHA-256 Fingerprint", "Revocation Status", "Valid To (GMT)"}
+	for _, auditType := range auditTypes {
+		required = append(required, auditType+" Audit Period Start Date", auditType+" Audit Period End Date")
+	}
+	for _, name := range required {
+		if _, ok := idx[name]; !ok {
+			logger.Fatal("CSV data is missing one or more expected headers", zap.String("file_path", filePath), zap.String("header", name))
+		}
+	}
+
+	// Collect the audit periods of each CA owner, and report the CA certificates whose audit period ended long ago.
+	// Only unexpired CA certificates that CCADB doesn't consider to be revoked are examined.
+	now := time.Now().UTC()
+	var findings []finding
+	periods := make(map[[2]string][]period)
+	for _, record := range records[1:] {
+		if len(record) != len(records[0]) {
+			continue
+		} else if revocationStatus := record[idx["Revocation Status"]]; revocationStatus != "" && revocationStatus != "Not Revoked" {
+			continue
+		} else if validTo, err := ccadb_data.ParseCCADBDate(record[idx["Valid To (GMT)"]]); err != nil || now.After(validTo) {
+			continue
+		}
+
+		caOwner := record[idx["CA Owner"]]
+		for _, auditType := range auditTypes {
+			start, err := ccadb_data.ParseCCADBDate(record[idx[auditType+" Audit Period Start Date"]])
+			if err != nil || start.IsZero() {
+				continue
+			}
+			end, err := ccadb_data.ParseCCADBDate(record[idx[auditType+" Audit Period End Date"]])
+			if err != nil || end.IsZero() || end.Before(start) {
+				continue
+			}
+			key := [2]string{caOwner, auditType}
+			if p := (period{start, end}); !slices.Contains(periods[key], p) {
+				periods[key] = append(periods[key], p)
+			}
+
+			if days := int(now.Sub(end).Hours() / 24); days > *staleDays {
+				findings = append(findings, finding{
+					Type:              "stale",
+					CAOwner:           caOwner,
+					AuditType:         auditType,
+					SHA256Fingerprint: record[idx["SHA-256 Fingerprint"]],
+					CertificateName:   record[idx["Certificate Name"]],
+					PeriodEnd:         end.Format(time.DateOnly),
+					Days:              days,
+				})
+			}
+		}
+	}
+
+	// Report the gaps between consecutive audit periods of each CA owner, merging overlapping periods first.
+	for key, ps := range periods {
+		slices.SortFunc(ps, func(a, b period) int {
+			return cmp.Or(a.start.Compare(b.start), a.end.Compare(b.end))
+		})
+		covered := ps[0]
+		for _, p := range ps[1:] {
+			if days := int(p.start.Sub(covered.end).Hours()/24) - 1; days > *gapDays {
+				findings = append(findings, finding{
+					Type:      "gap",
+					CAOwner:   key[0],
+					AuditType: key[1],
+					GapStart:  covered.end.AddDate(0, 0, 1).Format(time.DateOnly),
+					GapEnd:    p.start.AddDate(0, 0, -1).Format(time.DateOnly),
+					Days:      days,
+				})
+			}
+			if p.end.After(covered.end) {
+				covered.end = p.end
+			}
+		}
+	}
+
+	slices.SortFunc(findings, func(a, b finding) int {
+		return cmp.Or(cmp.Compare(a.CAOwner, b.CAOwner), cmp.Compare(a.AuditType, b.AuditType), cmp.Compare(a.Type, b.Type), cmp.Compare(a.GapStart, b.GapStart), cmp.Compare(a.SHA256Fingerprint, b.SHA256Fingerprint))
+	})
+	encoder := json.NewEncoder(os.Stdout)
+	for _, f := range findings {
+		tally.Add(f.Type, 1)
+		if err = encoder.Encode(f); err != nil {
+			logger.Fatal("Output could not be written", zap.Error(err))
+		}
+	}
+
+	tally.Exit(len(findings))
+}
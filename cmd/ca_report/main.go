@@ -0,0 +1,211 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+// The dossier for one CA Owner.
+type report struct {
+	CAOwner       string          `json:"ca_owner"`
+	Roots         int             `json:"roots"`
+	Intermediates int             `json:"intermediates"`
+	Expired       int             `json:"expired"`
+	Revocation    revocationStats `json:"revocation"`
+	ExpiringSoon  []*expiringCert `json:"expiring_soon"`
+	FailingURLs   []*urlFailure   `json:"failing_urls,omitzero"`
+	AuditFindings []*auditFinding `json:"audit_findings,omitzero"`
+}
+
+// Number of intermediate certificates with each revocation status.
+type revocationStats struct {
+	NotRevoked    int `json:"not_revoked"`
+	Revoked       int `json:"revoked"`
+	ParentRevoked int `json:"parent_revoked"`
+}
+
+type expiringCert struct {
+	SHA256Fingerprint     string `json:"sha256_fingerprint"`
+	CertificateName       string `json:"certificate_name"`
+	CertificateRecordType string `json:"certificate_record_type"`
+	ValidTo               string `json:"valid_to"`
+}
+
+// A failure reported by url_check -format json.
+type urlFailure struct {
+	CAOwner      string `json:"ca_owner"`
+	Column       string `json:"column"`
+	URL          string `json:"url"`
+	Severity     string `json:"severity"`
+	FailureClass string `json:"failure_class"`
+	Error        string `json:"error"`
+}
+
+// A finding reported by audit_gaps.
+type auditFinding struct {
+	Type              string `json:"type"`
+	CAOwner           string `json:"ca_owner"`
+	AuditType         string `json:"audit_type"`
+	SHA256Fingerprint string `json:"sha256_fingerprint,omitempty"`
+	CertificateName   string `json:"certificate_name,omitempty"`
+	GapStart          string `json:"gap_start,omitempty"`
+	GapEnd            string `json:"gap_end,omitempty"`
+	PeriodEnd         string `json:"period_end,omitempty"`
+	Days              int    `json:"days"`
+}
+
+func main() {
+	format := flag.String("format", "markdown", "Output format: markdown, or json (one JSON object per CA Owner per line)")
+	expiringDays := flag.Int("expiring-days", 90, "Report unexpired, unrevoked CA certificates that expire within this many days")
+	urlCheckPath := flag.String("url-check", "", "Output of url_check -format json, from which to report failing URLs")
+	auditGapsPath := flag.String("audit-gaps", "", "Output of audit_gaps, from which to report audit findings")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format markdown|json] [-expiring-days N] [-url-check FILE] [-audit-gaps FILE] [-log-level LEVEL] [-log-format json|console] [CA Owner]...\n", os.Args[0])
+	}
+	flag.Parse()
+	if *format != "markdown" && *format != "json" {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("ca_report")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	reports := buildReports(flag.Args(), time.Now().UTC(), *expiringDays)
+	if *urlCheckPath != "" {
+		failures, err := readJSONLines[urlFailure](*urlCheckPath)
+		if err != nil {
+			logger.Fatal("File could not be read", zap.Error(err), zap.String("file_path", *urlCheckPath))
+		}
+		for _, r := range reports {
+			r.FailingURLs = []*urlFailure{}
+		}
+		for _, f := range failures {
+			if r := reports[f.CAOwner]; r != nil {
+				r.FailingURLs = append(r.FailingURLs, f)
+			}
+		}
+	}
+	if *auditGapsPath != "" {
+		findings, err := readJSONLines[auditFinding](*auditGapsPath)
+		if err != nil {
+			logger.Fatal("File could not be read", zap.Error(err), zap.String("file_path", *auditGapsPath))
+		}
+		for _, r := range reports {
+			r.AuditFindings = []*auditFinding{}
+		}
+		for _, f := range findings {
+			if r := reports[f.CAOwner]; r != nil {
+				r.AuditFindings = append(r.AuditFindings, f)
+			}
+		}
+	}
+
+	sorted := make([]*report, 0, len(reports))
+	for _, r := range reports {
+		sorted = append(sorted, r)
+	}
+	slices.SortFunc(sorted, func(a, b *report) int { return strings.Compare(a.CAOwner, b.CAOwner) })
+
+	w := bufio.NewWriter(os.Stdout)
+	if *format == "json" {
+		err = writeJSON(w, sorted)
+	} else {
+		writeMarkdown(w, sorted, *expiringDays)
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+	tally.Add("ca_owners", len(sorted))
+	tally.Exit(0)
+}
+
+// buildReports summarizes the CA certificates disclosed by each CA Owner, optionally only for the given CA Owners.
+func buildReports(caOwners []string, now time.Time, expiringDays int) map[string]*report {
+	reports := make(map[string]*report)
+	for _, sha256Fingerprint := range ccadb_data.ListFingerprints(ccadb_data.CapabilityFilter{}) {
+		cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+		if len(caOwners) > 0 && !slices.Contains(caOwners, cr.CAOwner) {
+			continue
+		}
+		r := reports[cr.CAOwner]
+		if r == nil {
+			r = &report{CAOwner: cr.CAOwner, ExpiringSoon: []*expiringCert{}}
+			reports[cr.CAOwner] = r
+		}
+
+		recordType := ccadb_data.GetCACertCapabilitiesBySHA256(sha256Fingerprint).CertificateRecordType
+		switch recordType {
+		case ccadb_data.RecordTypeRoot:
+			r.Roots++
+		case ccadb_data.RecordTypeIntermediate:
+			r.Intermediates++
+		}
+		switch cr.RevocationStatus {
+		case ccadb_data.RevocationStatusNotRevoked:
+			r.Revocation.NotRevoked++
+		case ccadb_data.RevocationStatusRevoked:
+			r.Revocation.Revoked++
+		case ccadb_data.RevocationStatusParentRevoked:
+			r.Revocation.ParentRevoked++
+		}
+
+		if !cr.ValidTo.After(now) {
+			r.Expired++
+		} else if cr.ValidTo.Before(now.AddDate(0, 0, expiringDays)) && cr.RevocationStatus != ccadb_data.RevocationStatusRevoked && cr.RevocationStatus != ccadb_data.RevocationStatusParentRevoked {
+			r.ExpiringSoon = append(r.ExpiringSoon, &expiringCert{
+				SHA256Fingerprint:     fmt.Sprintf("%X", sha256Fingerprint),
+				CertificateName:       cr.CertificateName,
+				CertificateRecordType: recordType.String(),
+				ValidTo:               cr.ValidTo.Format(time.DateOnly),
+			})
+		}
+	}
+
+	for _, r := range reports {
+		slices.SortFunc(r.ExpiringSoon, func(a, b *expiringCert) int {
+			return cmp.Or(strings.Compare(a.ValidTo, b.ValidTo), strings.Compare(a.CertificateName, b.CertificateName))
+		})
+	}
+	return reports
+}
+
+// readJSONLines reads a file of JSON objects, one per line.
+func readJSONLines[T any](filePath string) ([]*T, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var values []*T
+	decoder := json.NewDecoder(f)
+	for decoder.More() {
+		v := new(T)
+		if err = decoder.Decode(v); err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
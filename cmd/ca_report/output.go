@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+// writeJSON outputs one JSON object per CA Owner per line.
+func writeJSON(w io.Writer, reports []*report) error {
+	encoder := json.NewEncoder(w)
+	for _, r := range reports {
+		if err := encoder.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMarkdown outputs a Markdown document with a section per CA Owner. The failing URLs and audit findings sections
+// are only included when the corresponding results were provided.
+func writeMarkdown(w io.Writer, reports []*report, expiringDays int) {
+	fmt.Fprintf(w, "# CA Owner Report\n\nGenerated from the CCADB data fetched at %s.\n", ccadb_data.DatasetDate)
+	for _, r := range reports {
+		fmt.Fprintf(w, "\n## %s\n\n", markdownText(r.CAOwner))
+		fmt.Fprintf(w, "| Roots | Intermediates | Expired | Not Revoked | Revoked | Parent Cert Revoked |\n")
+		fmt.Fprintf(w, "|------:|--------------:|--------:|------------:|--------:|--------------------:|\n")
+		fmt.Fprintf(w, "| %d | %d | %d | %d | %d | %d |\n", r.Roots, r.Intermediates, r.Expired, r.Revocation.NotRevoked, r.Revocation.Revoked, r.Revocation.ParentRevoked)
+
+		fmt.Fprintf(w, "\n### Expiring within %d days\n\n", expiringDays)
+		if len(r.ExpiringSoon) == 0 {
+			fmt.Fprintf(w, "None.\n")
+		} else {
+			fmt.Fprintf(w, "| Valid To | Certificate Name | Type | SHA-256 Fingerprint |\n|---|---|---|---|\n")
+			for _, ec := range r.ExpiringSoon {
+				fmt.Fprintf(w, "| %s | %s | %s | `%s` |\n", ec.ValidTo, markdownText(ec.CertificateName), ec.CertificateRecordType, ec.SHA256Fingerprint)
+			}
+		}
+
+		if r.FailingURLs != nil {
+			fmt.Fprintf(w, "\n### Failing URLs\n\n")
+			if len(r.FailingURLs) == 0 {
+				fmt.Fprintf(w, "None.\n")
+			} else {
+				fmt.Fprintf(w, "| Severity | Column | URL | Failure Class | Error |\n|---|---|---|---|---|\n")
+				for _, f := range r.FailingURLs {
+					fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n", f.Severity, markdownText(f.Column), markdownText(f.URL), f.FailureClass, markdownText(f.Error))
+				}
+			}
+		}
+
+		if r.AuditFindings != nil {
+			fmt.Fprintf(w, "\n### Audit Findings\n\n")
+			if len(r.AuditFindings) == 0 {
+				fmt.Fprintf(w, "None.\n")
+			} else {
+				fmt.Fprintf(w, "| Finding | Audit Type | Certificate Name | Period | Days |\n|---|---|---|---|--:|\n")
+				for _, f := range r.AuditFindings {
+					period := f.PeriodEnd
+					if f.GapStart != "" {
+						period = f.GapStart + " to " + f.GapEnd
+					}
+					fmt.Fprintf(w, "| %s | %s | %s | %s | %d |\n", f.Type, f.AuditType, markdownText(f.CertificateName), period, f.Days)
+				}
+			}
+		}
+	}
+}
+
+// markdownText escapes text for use in a Markdown table cell or heading.
+func markdownText(s string) string {
+	return strings.NewReplacer("|", `\|`, "\r", " ", "\n", " ").Replace(s)
+}
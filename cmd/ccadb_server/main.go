@@ -0,0 +1,235 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/httpclient"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/openapi"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"github.com/graphql-go/graphql"
+	"go.uber.org/zap"
+)
+
+const MAX_REQUEST_SIZE = 1 << 20
+
+var logger *zap.Logger
+
+// A GraphQL request, as POSTed in a JSON body or passed as GET query parameters.
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func main() {
+	listen := flag.String("listen", ":8080", "Address on which to serve the GraphQL endpoint at /graphql, the JSON endpoints at /search, /record, and /issuer, their JSON Schema at /schema.json, and the OpenAPI document of the endpoints at /openapi.json")
+	reportProxyMode := flag.Bool("report-proxy", false, "Also serve a caching reverse proxy of the reports on the CCADB site at "+REPORT_PROXY_PATH+", e.g. "+REPORT_PROXY_PATH+"ccadb/"+ccadb_data.CCADB_CSV_REPORT)
+	reportMaxAge := flag.Duration("report-max-age", time.Hour, "How long the report proxy serves a report before revalidating it with CCADB")
+	cacheDir := flag.String("cache-dir", "", "Directory in which the report proxy caches the reports, to revalidate them with their ETags (including after a restart) and to serve them when CCADB is unavailable (required with -report-proxy)")
+	httpFlags := httpclient.AddFlags(flag.CommandLine, httpclient.Config{Timeout: time.Duration(300) * time.Second})
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-listen ADDRESS] [-report-proxy -cache-dir DIRECTORY [-report-max-age DURATION]] [-log-level LEVEL] [-log-format json|console] [-proxy URL] [-cafile FILE] [-insecure] [-timeout DURATION]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 || (*reportProxyMode && *cacheDir == "") || *reportMaxAge <= 0 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("ccadb_server")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+	httpConfig, err := httpFlags.Config()
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+	httpClient, err := httpclient.New(httpConfig)
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+
+	// The audits are only in the full CSV report.
+	ccadb_data.LoadRawRecords()
+	// The certificates are loaded so that /search also matches their subject names.
+	ccadb_data.LoadAllCACertificates()
+	schema, err := newSchema(newIndex())
+	if err != nil {
+		logger.Fatal("GraphQL schema could not be built", zap.Error(err))
+	}
+
+	http.Handle("/graphql", graphqlHandler(schema))
+	http.Handle("/search", http.HandlerFunc(searchHandler))
+	http.Handle("/record", http.HandlerFunc(recordHandler))
+	http.Handle("/issuer", http.HandlerFunc(issuerHandler))
+	http.Handle("/schema.json", documentHandler("application/schema+json", ccadb_data.JSONSchema()))
+	http.Handle("/openapi.json", documentHandler("application/json", openapi.Document()))
+	if *reportProxyMode {
+		http.Handle(REPORT_PROXY_PATH, newReportProxy(httpClient, *reportMaxAge, *cacheDir))
+	}
+	logger.Info("Serving GraphQL and JSON", zap.String("address", *listen))
+	if err = http.ListenAndServe(*listen, nil); err != nil {
+		logger.Fatal("GraphQL endpoint could not be served", zap.Error(err), zap.String("address", *listen))
+	}
+}
+
+// graphqlHandler serves GraphQL requests, as a GET with query, operationName, and (JSON-encoded) variables query
+// parameters, or as a POST with a JSON body. Errors in the query itself are reported in the response's errors field.
+func graphqlHandler(schema graphql.Schema) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		switch r.Method {
+		case http.MethodGet:
+			req.Query = r.URL.Query().Get("query")
+			req.OperationName = r.URL.Query().Get("operationName")
+			if variables := r.URL.Query().Get("variables"); variables != "" {
+				if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+					http.Error(w, "Invalid variables: "+err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+		case http.MethodPost:
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MAX_REQUEST_SIZE))
+			if err != nil {
+				http.Error(w, "Request body could not be read: "+err.Error(), http.StatusBadRequest)
+				return
+			} else if err = json.Unmarshal(body, &req); err != nil {
+				http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if req.Query == "" {
+			http.Error(w, "Missing query", http.StatusBadRequest)
+			return
+		}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  req.Query,
+			OperationName:  req.OperationName,
+			VariableValues: req.Variables,
+			Context:        r.Context(),
+		})
+		if result.HasErrors() {
+			logger.Debug("GraphQL query failed", zap.String("query", req.Query), zap.Any("errors", result.Errors))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			logger.Debug("GraphQL response could not be written", zap.Error(err))
+		}
+	})
+}
+
+// searchHandler serves searches for CA certificates by name, as a GET with q (a SearchRecords query) and limit query
+// parameters. The results are returned as a JSON array.
+func searchHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	limit := openapi.DEFAULT_SEARCH_LIMIT
+	if l := r.URL.Query().Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 1 || limit > openapi.MAX_SEARCH_LIMIT {
+			http.Error(w, fmt.Sprintf("Invalid limit: must be between 1 and %d", openapi.MAX_SEARCH_LIMIT), http.StatusBadRequest)
+			return
+		}
+	}
+	results, err := ccadb_data.SearchRecords(r.URL.Query().Get("q"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := make([]*ccadb_data.SearchResultJSON, 0, min(len(results), limit))
+	for _, result := range results[:min(len(results), limit)] {
+		response = append(response, ccadb_data.NewSearchResultJSON(result))
+	}
+	writeJSON(w, response)
+}
+
+// recordHandler serves the CA certificate whose SHA-256 fingerprint is the sha256 query parameter, as a RecordJSON.
+func recordHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	sha256Fingerprint, err := ccadb_data.ParseSHA256Fingerprint(r.URL.Query().Get("sha256"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record := ccadb_data.GetRecordJSONBySHA256(sha256Fingerprint)
+	if record == nil {
+		http.Error(w, "CA certificate not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, record)
+}
+
+// issuerHandler serves the CA certificates whose key identifier is the key_identifier query parameter, as an
+// IssuerJSON.
+func issuerHandler(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	keyIdentifier := r.URL.Query().Get("key_identifier")
+	if keyIdentifier == "" {
+		http.Error(w, "Missing key_identifier", http.StatusBadRequest)
+		return
+	}
+	issuer := ccadb_data.GetIssuerJSONByKeyIdentifier(keyIdentifier)
+	if issuer == nil {
+		http.Error(w, "Issuer not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, issuer)
+}
+
+// documentHandler serves a JSON document that describes the endpoints, such as their JSON Schema or OpenAPI document.
+func documentHandler(contentType string, document map[string]any) http.Handler {
+	body, err := json.Marshal(document)
+	if err != nil {
+		logger.Fatal("Document could not be encoded", zap.Error(err))
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowGet(w, r) {
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Write(body)
+	})
+}
+
+// allowGet responds with an error and returns false if the request is not a GET.
+func allowGet(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
+
+// writeJSON writes a JSON response.
+func writeJSON(w http.ResponseWriter, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.Debug("Response could not be written", zap.Error(err))
+	}
+}
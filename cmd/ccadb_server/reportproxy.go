@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"go.uber.org/zap"
+)
+
+// The path under which the reports on the CCADB site are proxied, e.g. /reports/ccadb/AllCertificateRecordsCSVFormatV5.
+const REPORT_PROXY_PATH = "/reports/"
+
+// The paths of the reports on the CCADB site, e.g. ccadb/AllCertificatePEMsCSVFormat.
+var reportPathRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+(/[A-Za-z0-9_]+)?$`)
+
+// A caching reverse proxy of the reports on the CCADB site, so that an organization's consumers share one copy of each
+// report instead of each downloading it from CCADB. A report is downloaded at most once per maxAge, and then only in full
+// if it has changed, since FetchReport revalidates the cached copy of the report with its ETag; until then, every
+// consumer is served the same copy, so consumers that download several reports get a consistent snapshot.
+type reportProxy struct {
+	client *http.Client
+	maxAge time.Duration
+	// The directory in which FetchReport caches the reports.
+	cacheDir string
+
+	mu      sync.Mutex
+	reports map[string]*proxiedReport
+}
+
+// A report served by the proxy, by its URL on the CCADB site.
+type proxiedReport struct {
+	// Held while the report is checked, so that concurrent requests for it wait for a single download.
+	mu       sync.Mutex
+	snapshot reportSnapshot
+}
+
+// A copy of a report, as served.
+type reportSnapshot struct {
+	data []byte
+	etag string
+	// When the report's content last changed, and when it was last downloaded or revalidated.
+	modifiedAt, checkedAt time.Time
+}
+
+func newReportProxy(client *http.Client, maxAge time.Duration, cacheDir string) *reportProxy {
+	return &reportProxy{client: client, maxAge: maxAge, cacheDir: cacheDir, reports: make(map[string]*proxiedReport)}
+}
+
+func (rp *reportProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	reportPath := strings.TrimPrefix(r.URL.Path, REPORT_PROXY_PATH)
+	if !reportPathRegexp.MatchString(reportPath) {
+		http.NotFound(w, r)
+		return
+	}
+	// The query parameters are encoded canonically, so that equivalent requests share a copy of the report.
+	url := ccadb_data.CCADB_SITE_URL + reportPath
+	if query := r.URL.Query(); len(query) > 0 {
+		url += "?" + query.Encode()
+	}
+
+	snapshot, err := rp.report(r.Context(), url)
+	if err != nil {
+		logger.Warn("Report could not be proxied", zap.Error(err), zap.String("url", url))
+		http.Error(w, "Report could not be downloaded from CCADB", http.StatusBadGateway)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("ETag", snapshot.etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(max(rp.maxAge-time.Since(snapshot.checkedAt), 0).Seconds())))
+	http.ServeContent(w, r, "", snapshot.modifiedAt, bytes.NewReader(snapshot.data))
+}
+
+// report returns the current copy of the report at url, first downloading or revalidating it if it was last checked
+// more than maxAge ago. If that fails, the previous copy is returned, if there is one.
+func (rp *reportProxy) report(ctx context.Context, url string) (reportSnapshot, error) {
+	rp.mu.Lock()
+	pr := rp.reports[url]
+	if pr == nil {
+		pr = &proxiedReport{}
+		rp.reports[url] = pr
+	}
+	rp.mu.Unlock()
+
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+	if pr.snapshot.data != nil && time.Since(pr.snapshot.checkedAt) < rp.maxAge {
+		return pr.snapshot, nil
+	}
+
+	// The download is shared by every request that is waiting for it, so it isn't cancelled if this request is.
+	data, err := ccadb_data.FetchReport(context.WithoutCancel(ctx), rp.client, url, ccadb_data.WithReportCacheDir(rp.cacheDir))
+	now := time.Now()
+	if err != nil {
+		if pr.snapshot.data == nil {
+			return reportSnapshot{}, err
+		}
+		logger.Warn("Report could not be downloaded, so the previous copy is served", zap.Error(err), zap.String("url", url), zap.Time("checked_at", pr.snapshot.checkedAt))
+		pr.snapshot.checkedAt = now
+		return pr.snapshot, nil
+	}
+	checksum := sha256.Sum256(data)
+	if etag := fmt.Sprintf(`"%x"`, checksum); etag != pr.snapshot.etag {
+		logger.Info("Report has changed", zap.String("url", url), zap.Int("size", len(data)))
+		pr.snapshot = reportSnapshot{data: data, etag: etag, modifiedAt: now}
+	}
+	pr.snapshot.checkedAt = now
+	return pr.snapshot, nil
+}
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	DEFAULT_RECORDS_LIMIT = 100
+	MAX_RECORDS_LIMIT     = 1000
+)
+
+// Audit types that have "<type> Audit URL", "<type> Audit Type", "<type> Audit Statement Date", "<type> Audit Period
+// Start Date", and "<type> Audit Period End Date" columns in the CSV report.
+var auditTypes = []string{"Standard", "NetSec", "TLS BR", "TLS EVG", "Code Signing", "S/MIME BR", "VMC"}
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+// The relations between CA certificates that the library doesn't index, built once at startup.
+type index struct {
+	// SHA-256 fingerprints of the CA certificates issued by each CA certificate, indexed by the issuer's fingerprint.
+	children map[[sha256.Size]byte][][sha256.Size]byte
+	// SHA-256 fingerprints of each CA Owner's CA certificates, indexed by CA Owner.
+	owners map[string][][sha256.Size]byte
+	// Every CA Owner, in alphabetical order.
+	ownerNames []string
+}
+
+func newIndex() *index {
+	idx := &index{children: make(map[[sha256.Size]byte][][sha256.Size]byte), owners: make(map[string][][sha256.Size]byte)}
+	for _, sha256Fingerprint := range ccadb_data.ListFingerprintsWhere(ccadb_data.Where()) {
+		cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+		if cr.ParentSHA256Fingerprint != ([sha256.Size]byte{}) && cr.ParentSHA256Fingerprint != sha256Fingerprint {
+			idx.children[cr.ParentSHA256Fingerprint] = append(idx.children[cr.ParentSHA256Fingerprint], sha256Fingerprint)
+		}
+		if _, ok := idx.owners[cr.CAOwner]; !ok {
+			idx.ownerNames = append(idx.ownerNames, cr.CAOwner)
+		}
+		idx.owners[cr.CAOwner] = append(idx.owners[cr.CAOwner], sha256Fingerprint)
+	}
+	slices.Sort(idx.ownerNames)
+	return idx
+}
+
+// An audit of a CA certificate, as reported in the CSV report.
+type audit struct {
+	Category        string `json:"category"`
+	URL             string `json:"url"`
+	Type            string `json:"type"`
+	StatementDate   string `json:"statementDate"`
+	PeriodStartDate string `json:"periodStartDate"`
+	PeriodEndDate   string `json:"periodEndDate"`
+}
+
+// A root program's status for a CA certificate.
+type rootProgramStatus struct {
+	RootProgram string `json:"rootProgram"`
+	Status      string `json:"status"`
+}
+
+// rawField returns the value of a column of the CA certificate's raw CSV record, or "" if either is missing.
+func rawField(sha256Fingerprint [sha256.Size]byte, column string) string {
+	rr := ccadb_data.GetRawRecordBySHA256(sha256Fingerprint)
+	if rr == nil {
+		return ""
+	}
+	if i := slices.Index(rr.Header, column); i >= 0 && i < len(rr.Fields) {
+		return rr.Fields[i]
+	}
+	return ""
+}
+
+// recordField defines a field of the Record type, which resolves a Record's SHA-256 fingerprint to its CCADB record.
+func recordField(t graphql.Output, description string, resolve func(sha256Fingerprint [sha256.Size]byte) any) *graphql.Field {
+	return &graphql.Field{
+		Type:        t,
+		Description: description,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return resolve(p.Source.([sha256.Size]byte)), nil
+		},
+	}
+}
+
+// existingRecord returns the fingerprint as a Record, or nil if it isn't disclosed in CCADB.
+func existingRecord(sha256Fingerprint [sha256.Size]byte) any {
+	if ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint) == nil {
+		return nil
+	}
+	return sha256Fingerprint
+}
+
+// newSchema builds the GraphQL schema. A Record is resolved from a CA certificate's SHA-256 fingerprint, an Owner from
+// a CA Owner's name, and an Issuer from a Base64 key identifier.
+func newSchema(idx *index) (graphql.Schema, error) {
+	capabilitiesType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Capabilities",
+		Description: "The capabilities of a CA certificate, or of every CA certificate with a key identifier.",
+		Fields: graphql.Fields{
+			"recordType":         &graphql.Field{Type: graphql.String},
+			"tlsCapable":         &graphql.Field{Type: graphql.Boolean},
+			"tlsEvCapable":       &graphql.Field{Type: graphql.Boolean},
+			"smimeCapable":       &graphql.Field{Type: graphql.Boolean},
+			"codeSigningCapable": &graphql.Field{Type: graphql.Boolean},
+			"hasVMCAudit":        &graphql.Field{Type: graphql.Boolean},
+		},
+	})
+	auditType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Audit",
+		Description: "An audit of a CA certificate, with dates as reported by CCADB.",
+		Fields: graphql.Fields{
+			"category":        &graphql.Field{Type: graphql.String, Description: "e.g. Standard, TLS BR, or S/MIME BR."},
+			"url":             &graphql.Field{Type: graphql.String},
+			"type":            &graphql.Field{Type: graphql.String},
+			"statementDate":   &graphql.Field{Type: graphql.String},
+			"periodStartDate": &graphql.Field{Type: graphql.String},
+			"periodEndDate":   &graphql.Field{Type: graphql.String},
+		},
+	})
+	rootProgramStatusType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "RootProgramStatus",
+		Fields: graphql.Fields{
+			"rootProgram": &graphql.Field{Type: graphql.String},
+			"status":      &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	var recordType, ownerType, issuerType *graphql.Object
+	recordType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Record",
+		Description: "A CA certificate disclosed in CCADB.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"sha256Fingerprint": recordField(graphql.String, "Upper-case hex.", func(fp [sha256.Size]byte) any { return fmt.Sprintf("%X", fp) }),
+				"certificateName": recordField(graphql.String, "", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).CertificateName
+				}),
+				"caOwner": recordField(graphql.String, "", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).CAOwner
+				}),
+				"subordinateCAOwner": recordField(graphql.String, "", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).SubordinateCAOwner
+				}),
+				"revocationStatus": recordField(graphql.String, "", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).RevocationStatus.String()
+				}),
+				"validFrom": recordField(graphql.String, "YYYY-MM-DD.", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).ValidFrom.Format(time.DateOnly)
+				}),
+				"validTo": recordField(graphql.String, "YYYY-MM-DD.", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).ValidTo.Format(time.DateOnly)
+				}),
+				"subjectKeyIdentifier": recordField(graphql.String, "Base64.", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).SubjectKeyIdentifier
+				}),
+				"authorityKeyIdentifier": recordField(graphql.String, "Base64.", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).AuthorityKeyIdentifier
+				}),
+				"capabilities": &graphql.Field{
+					Type: capabilitiesType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						ccc := ccadb_data.GetCACertCapabilitiesBySHA256(p.Source.([sha256.Size]byte))
+						if ccc == nil {
+							return nil, nil
+						}
+						return capabilitiesMap(ccc.CertificateRecordType, ccc.TlsCapable, ccc.TlsEvCapable, ccc.SmimeCapable, ccc.CodeSigningCapable, ccc.HasVMCAudit), nil
+					},
+				},
+				"rootProgramStatus": recordField(graphql.NewList(rootProgramStatusType), "", func(fp [sha256.Size]byte) any {
+					var statuses []*rootProgramStatus
+					for _, rootProgram := range rootPrograms {
+						statuses = append(statuses, &rootProgramStatus{RootProgram: rootProgram, Status: ccadb_data.GetRootProgramStatusBySHA256(fp, rootProgram)})
+					}
+					return statuses
+				}),
+				"fullCRLURLs": recordField(graphql.NewList(graphql.String), "", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).FullCRLURLs
+				}),
+				"partitionedCRLURLs": recordField(graphql.NewList(graphql.String), "", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).PartitionedCRLURLs
+				}),
+				"auditFirm": recordField(graphql.String, "", func(fp [sha256.Size]byte) any { return rawField(fp, "Audit Firm") }),
+				"audits": recordField(graphql.NewList(auditType), "The audits with a URL or an audit period.", func(fp [sha256.Size]byte) any {
+					var audits []*audit
+					for _, category := range auditTypes {
+						a := &audit{
+							Category:        category,
+							URL:             rawField(fp, category+" Audit URL"),
+							Type:            rawField(fp, category+" Audit Type"),
+							StatementDate:   rawField(fp, category+" Audit Statement Date"),
+							PeriodStartDate: rawField(fp, category+" Audit Period Start Date"),
+							PeriodEndDate:   rawField(fp, category+" Audit Period End Date"),
+						}
+						if a.URL != "" || a.PeriodStartDate != "" || a.PeriodEndDate != "" {
+							audits = append(audits, a)
+						}
+					}
+					return audits
+				}),
+				"parent": recordField(recordType, "The CA certificate that issued this one, if disclosed.", func(fp [sha256.Size]byte) any {
+					if parent := ccadb_data.GetCertificateRecordBySHA256(fp).ParentSHA256Fingerprint; parent != fp {
+						return existingRecord(parent)
+					}
+					return nil
+				}),
+				"children": recordField(graphql.NewList(recordType), "The CA certificates issued by this one.", func(fp [sha256.Size]byte) any {
+					return idx.children[fp]
+				}),
+				"owner": recordField(ownerType, "", func(fp [sha256.Size]byte) any {
+					return ccadb_data.GetCertificateRecordBySHA256(fp).CAOwner
+				}),
+				"issuer": recordField(issuerType, "The issuer identified by this CA certificate's Authority Key Identifier.", func(fp [sha256.Size]byte) any {
+					if aki := ccadb_data.GetCertificateRecordBySHA256(fp).AuthorityKeyIdentifier; aki != "" && ccadb_data.GetIssuerCapabilitiesByKeyIdentifier(aki) != nil {
+						return aki
+					}
+					return nil
+				}),
+			}
+		}),
+	})
+
+	ownerType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Owner",
+		Description: "A CA Owner.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (any, error) { return p.Source, nil }},
+				"records": &graphql.Field{
+					Type:        graphql.NewList(recordType),
+					Description: "The CA Owner's CA certificates, in ascending order of SHA-256 fingerprint.",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return idx.owners[p.Source.(string)], nil
+					},
+				},
+			}
+		}),
+	})
+
+	issuerType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Issuer",
+		Description: "The CA certificates that share a key identifier.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"keyIdentifier": &graphql.Field{Type: graphql.String, Description: "Base64.", Resolve: func(p graphql.ResolveParams) (any, error) { return p.Source, nil }},
+				"capabilities": &graphql.Field{
+					Type:        capabilitiesType,
+					Description: "The capabilities of every CA certificate with the key identifier, combined.",
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						ic := ccadb_data.GetIssuerCapabilitiesByKeyIdentifier(p.Source.(string))
+						if ic == nil {
+							return nil, nil
+						}
+						return capabilitiesMap(ic.CertificateRecordType, ic.TlsCapable, ic.TlsEvCapable, ic.SmimeCapable, ic.CodeSigningCapable, ic.HasVMCAudit), nil
+					},
+				},
+				"records": &graphql.Field{
+					Type: graphql.NewList(recordType),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return ccadb_data.GetSHA256FingerprintsByKeyIdentifier(p.Source.(string)), nil
+					},
+				},
+			}
+		}),
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"record": &graphql.Field{
+				Type:        recordType,
+				Description: "The CA certificate with a SHA-256 fingerprint.",
+				Args:        graphql.FieldConfigArgument{"sha256Fingerprint": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					sha256Fingerprint, err := ccadb_data.ParseSHA256Fingerprint(p.Args["sha256Fingerprint"].(string))
+					if err != nil {
+						return nil, err
+					}
+					return existingRecord(sha256Fingerprint), nil
+				},
+			},
+			"records": &graphql.Field{
+				Type:        graphql.NewList(recordType),
+				Description: "The CA certificates that match every argument given, in ascending order of SHA-256 fingerprint.",
+				Args: graphql.FieldConfigArgument{
+					"recordType":         &graphql.ArgumentConfig{Type: graphql.String, Description: "Root or Intermediate."},
+					"caOwner":            &graphql.ArgumentConfig{Type: graphql.String},
+					"includedIn":         &graphql.ArgumentConfig{Type: graphql.String, Description: "A root program: Apple, Chrome, Microsoft, or Mozilla."},
+					"tlsCapable":         &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"tlsEvCapable":       &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"smimeCapable":       &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"codeSigningCapable": &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"hasVMCAudit":        &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"notExpired":         &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"notRevoked":         &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"limit":              &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: DEFAULT_RECORDS_LIMIT, Description: fmt.Sprintf("At most %d.", MAX_RECORDS_LIMIT)},
+					"offset":             &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					q, err := recordsQuery(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					limit, offset := p.Args["limit"].(int), p.Args["offset"].(int)
+					if limit < 0 || limit > MAX_RECORDS_LIMIT || offset < 0 {
+						return nil, fmt.Errorf("limit must be between 0 and %d, and offset must not be negative", MAX_RECORDS_LIMIT)
+					}
+					sha256Fingerprints := ccadb_data.ListFingerprintsWhere(q)
+					sha256Fingerprints = sha256Fingerprints[min(offset, len(sha256Fingerprints)):]
+					return sha256Fingerprints[:min(limit, len(sha256Fingerprints))], nil
+				},
+			},
+			"owner": &graphql.Field{
+				Type: ownerType,
+				Args: graphql.FieldConfigArgument{"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if name := p.Args["name"].(string); idx.owners[name] != nil {
+						return name, nil
+					}
+					return nil, nil
+				},
+			},
+			"owners": &graphql.Field{
+				Type:        graphql.NewList(ownerType),
+				Description: "Every CA Owner, in alphabetical order.",
+				Resolve:     func(p graphql.ResolveParams) (any, error) { return idx.ownerNames, nil },
+			},
+			"issuer": &graphql.Field{
+				Type:        issuerType,
+				Description: "The issuer with a Base64 key identifier.",
+				Args:        graphql.FieldConfigArgument{"keyIdentifier": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if keyIdentifier := p.Args["keyIdentifier"].(string); ccadb_data.GetIssuerCapabilitiesByKeyIdentifier(keyIdentifier) != nil {
+						return keyIdentifier, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// capabilitiesMap returns the source of a Capabilities object.
+func capabilitiesMap(rt ccadb_data.RecordType, tls, tlsEv, smime, codeSigning, vmc bool) map[string]any {
+	return map[string]any{
+		"recordType":         rt.String(),
+		"tlsCapable":         tls,
+		"tlsEvCapable":       tlsEv,
+		"smimeCapable":       smime,
+		"codeSigningCapable": codeSigning,
+		"hasVMCAudit":        vmc,
+	}
+}
+
+// recordsQuery builds the query for the arguments of the records field. Boolean arguments only restrict the records
+// when they are true.
+func recordsQuery(args map[string]any) (*ccadb_data.Query, error) {
+	q := ccadb_data.Where()
+	if v, ok := args["recordType"].(string); ok {
+		rt := ccadb_data.ParseRecordType(v)
+		if rt == ccadb_data.RecordTypeUnknown {
+			return nil, errors.New("recordType must be Root or Intermediate")
+		}
+		q = q.RecordType(rt)
+	}
+	if v, ok := args["caOwner"].(string); ok {
+		q = q.CAOwner(v)
+	}
+	if v, ok := args["includedIn"].(string); ok {
+		if !slices.Contains(rootPrograms, v) {
+			return nil, errors.New("includedIn must be Apple, Chrome, Microsoft, or Mozilla")
+		}
+		q = q.IncludedIn(v)
+	}
+	for name, condition := range map[string]func(*ccadb_data.Query) *ccadb_data.Query{
+		"tlsCapable":         (*ccadb_data.Query).TLSCapable,
+		"tlsEvCapable":       (*ccadb_data.Query).TLSEVCapable,
+		"smimeCapable":       (*ccadb_data.Query).SMIMECapable,
+		"codeSigningCapable": (*ccadb_data.Query).CodeSigningCapable,
+		"hasVMCAudit":        (*ccadb_data.Query).HasVMCAudit,
+		"notExpired":         (*ccadb_data.Query).NotExpired,
+		"notRevoked":         (*ccadb_data.Query).NotRevoked,
+	} {
+		if v, ok := args[name].(bool); ok && v {
+			q = condition(q)
+		}
+	}
+	return q, nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+// A CA certificate record that was added, removed, or changed between two datasets.
+type changedRecord struct {
+	SHA256Fingerprint string `json:"sha256_fingerprint"`
+	CertificateName   string `json:"certificate_name"`
+	// For an added record, the certificate's subject, if its PEM could be loaded.
+	Subject               string   `json:"subject,omitempty"`
+	CAOwner               string   `json:"ca_owner"`
+	SubordinateCAOwner    string   `json:"subordinate_ca_owner,omitempty"`
+	CertificateRecordType string   `json:"certificate_record_type"`
+	Capabilities          []string `json:"capabilities"`
+	// For a changed record, the names of the fields that changed, e.g. "MozillaStatus" or "TlsCapable".
+	ChangedFields []string `json:"changed_fields,omitempty"`
+}
+
+// The differences between two datasets.
+type changes struct {
+	Added   []*changedRecord `json:"added"`
+	Removed []*changedRecord `json:"removed"`
+	Changed []*changedRecord `json:"changed"`
+}
+
+// Count returns the number of added, removed, and changed records.
+func (c *changes) Count() int {
+	return len(c.Added) + len(c.Removed) + len(c.Changed)
+}
+
+// describe summarizes a CA certificate record in s, including its subject if s's certificates have been loaded.
+func describe(s *ccadb_data.Store, sha256Fingerprint [sha256.Size]byte) *changedRecord {
+	cr := s.GetCertificateRecordBySHA256(sha256Fingerprint)
+	ccc := s.GetCACertCapabilitiesBySHA256(sha256Fingerprint)
+	r := &changedRecord{
+		SHA256Fingerprint:     fmt.Sprintf("%X", sha256Fingerprint),
+		CertificateName:       cr.CertificateName,
+		CAOwner:               cr.CAOwner,
+		SubordinateCAOwner:    cr.SubordinateCAOwner,
+		CertificateRecordType: ccc.CertificateRecordType.String(),
+		Capabilities:          []string{},
+	}
+	if cert := s.GetParsedCACertificateBySHA256(sha256Fingerprint); cert != nil {
+		r.Subject = cert.Subject.String()
+	}
+	for _, capability := range []struct {
+		name    string
+		capable bool
+	}{
+		{"TLS", ccc.TlsCapable},
+		{"TLS EV", ccc.TlsEvCapable},
+		{"S/MIME", ccc.SmimeCapable},
+		{"Code Signing", ccc.CodeSigningCapable},
+	} {
+		if capability.capable {
+			r.Capabilities = append(r.Capabilities, capability.name)
+		}
+	}
+	return r
+}
+
+// diff describes the records that were added, removed, or changed (in their CCADB record or their capabilities) between
+// the old and new datasets, in ascending order of SHA-256 fingerprint.
+func diff(old, new *ccadb_data.Store) *changes {
+	dc := ccadb_data.Compare(old, new)
+	c := &changes{Added: []*changedRecord{}, Removed: []*changedRecord{}, Changed: []*changedRecord{}}
+	for _, change := range dc.Changed {
+		r := describe(new, change.SHA256Fingerprint)
+		for _, field := range change.Fields {
+			r.ChangedFields = append(r.ChangedFields, field.Name)
+		}
+		c.Changed = append(c.Changed, r)
+	}
+	// Only the added records are described with their subjects, so the new dataset's certificates (which were
+	// downloaded into the same directory) are only loaded when there are any.
+	if len(dc.Added) > 0 {
+		new.LoadAllCACertificates()
+	}
+	for _, sha256Fingerprint := range dc.Added {
+		c.Added = append(c.Added, describe(new, sha256Fingerprint))
+	}
+	for _, sha256Fingerprint := range dc.Removed {
+		c.Removed = append(c.Removed, describe(old, sha256Fingerprint))
+	}
+	return c
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+const (
+	// The most added records that are described in one chat message.
+	MAX_CHAT_RECORDS = 20
+	CRTSH_URL        = "https://crt.sh/?sha256="
+)
+
+// A line of a chat message, rendered as Slack mrkdwn or Matrix HTML, or as plain text for Matrix clients that can't
+// render HTML.
+type chatLine struct {
+	label string
+	text  string
+	link  string
+}
+
+// chatMessage describes the Root and Intermediate records that were added to CCADB, as a heading and a block of lines
+// per record. There are no blocks if no such records were added.
+func chatMessage(c *changes) (string, [][]chatLine) {
+	var records []*changedRecord
+	for _, r := range c.Added {
+		if r.CertificateRecordType == ccadb_data.RecordTypeRoot.String() || r.CertificateRecordType == ccadb_data.RecordTypeIntermediate.String() {
+			records = append(records, r)
+		}
+	}
+	if len(records) == 0 {
+		return "", nil
+	}
+
+	heading := fmt.Sprintf("%d new CA certificate records in CCADB", len(records))
+	if len(records) == 1 {
+		heading = "1 new CA certificate record in CCADB"
+	}
+	var blocks [][]chatLine
+	for i, r := range records {
+		if i == MAX_CHAT_RECORDS {
+			blocks = append(blocks, []chatLine{{text: fmt.Sprintf("…and %d more.", len(records)-MAX_CHAT_RECORDS)}})
+			break
+		}
+		owner := r.CAOwner
+		if r.SubordinateCAOwner != "" {
+			owner += " (Subordinate CA Owner: " + r.SubordinateCAOwner + ")"
+		}
+		capabilities := strings.Join(r.Capabilities, ", ")
+		if capabilities == "" {
+			capabilities = "None"
+		}
+		blocks = append(blocks, []chatLine{
+			{label: r.CertificateRecordType, text: r.CertificateName, link: CRTSH_URL + r.SHA256Fingerprint},
+			{label: "Owner", text: owner},
+			{label: "Subject", text: r.Subject},
+			{label: "Capabilities", text: capabilities},
+		})
+	}
+	return heading, blocks
+}
+
+// A Slack incoming webhook.
+type slack struct {
+	client *http.Client
+	url    string
+}
+
+func (sl *slack) name() string {
+	return "slack"
+}
+
+// slackEscape escapes the characters that Slack treats as control characters in message text.
+var slackEscape = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func (sl *slack) notify(ctx context.Context, detectedAt time.Time, c *changes) error {
+	heading, blocks := chatMessage(c)
+	if blocks == nil {
+		return nil
+	}
+	var sb strings.Builder
+	sb.WriteString("*" + slackEscape.Replace(heading) + "*")
+	for _, block := range blocks {
+		sb.WriteString("\n")
+		for _, line := range block {
+			if line.text == "" {
+				continue
+			}
+			sb.WriteString("\n")
+			if line.label != "" {
+				sb.WriteString(slackEscape.Replace(line.label) + ": ")
+			}
+			if line.link != "" {
+				sb.WriteString("<" + line.link + "|" + slackEscape.Replace(line.text) + ">")
+			} else {
+				sb.WriteString(slackEscape.Replace(line.text))
+			}
+		}
+	}
+
+	body, err := json.Marshal(map[string]any{"text": sb.String(), "unfurl_links": false})
+	if err != nil {
+		return err
+	}
+	return sendJSON(ctx, sl.client, http.MethodPost, sl.url, nil, body)
+}
+
+// A Matrix room, which is sent an m.room.message event using the client-server API.
+type matrix struct {
+	client      *http.Client
+	homeserver  string
+	roomID      string
+	accessToken string
+}
+
+func (mx *matrix) name() string {
+	return "matrix"
+}
+
+func (mx *matrix) notify(ctx context.Context, detectedAt time.Time, c *changes) error {
+	heading, blocks := chatMessage(c)
+	if blocks == nil {
+		return nil
+	}
+	var plain, formatted strings.Builder
+	plain.WriteString(heading)
+	formatted.WriteString("<p><strong>" + html.EscapeString(heading) + "</strong></p>")
+	for _, block := range blocks {
+		plain.WriteString("\n")
+		formatted.WriteString("<p>")
+		for i, line := range block {
+			if line.text == "" {
+				continue
+			}
+			plain.WriteString("\n")
+			if i > 0 {
+				formatted.WriteString("<br>")
+			}
+			if line.label != "" {
+				plain.WriteString(line.label + ": ")
+				formatted.WriteString(html.EscapeString(line.label) + ": ")
+			}
+			plain.WriteString(line.text)
+			if line.link != "" {
+				plain.WriteString(" " + line.link)
+				formatted.WriteString(`<a href="` + html.EscapeString(line.link) + `">` + html.EscapeString(line.text) + "</a>")
+			} else {
+				formatted.WriteString(html.EscapeString(line.text))
+			}
+		}
+		formatted.WriteString("</p>")
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"msgtype":        "m.notice",
+		"body":           plain.String(),
+		"format":         "org.matrix.custom.html",
+		"formatted_body": formatted.String(),
+	})
+	if err != nil {
+		return err
+	}
+	// The transaction ID makes a retried request idempotent, so it identifies the set of changes.
+	txnID := "ccadb-" + strconv.FormatInt(detectedAt.UnixNano(), 10)
+	header := make(http.Header)
+	header.Set("Authorization", "Bearer "+mx.accessToken)
+	return sendJSON(ctx, mx.client, http.MethodPut, strings.TrimSuffix(mx.homeserver, "/")+"/_matrix/client/v3/rooms/"+url.PathEscape(mx.roomID)+"/send/m.room.message/"+txnID, header, body)
+}
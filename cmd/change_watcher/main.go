@@ -0,0 +1,131 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/httpclient"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+// Environment variables that hold secrets, when the corresponding flags aren't given.
+const (
+	ENV_WEBHOOK_SECRET      = "CCADB_WEBHOOK_SECRET"
+	ENV_SLACK_WEBHOOK_URL   = "CCADB_SLACK_WEBHOOK_URL"
+	ENV_MATRIX_ACCESS_TOKEN = "CCADB_MATRIX_ACCESS_TOKEN"
+)
+
+var logger *zap.Logger
+
+func main() {
+	dir := flag.String("dir", "", "Directory to download the CCADB CSV reports into (required)")
+	cacheDir := flag.String("cache-dir", "", "Directory in which to cache the downloaded reports, to reuse them when they haven't changed and when CCADB is unavailable")
+	ccadbProxyURL := flag.String("ccadb-proxy", "", "URL of a caching reverse proxy of the CCADB site to download the reports from, e.g. http://ccadb-server:8080/reports/ for ccadb_server -report-proxy")
+	force := flag.Bool("force", false, "Accept downloaded reports even if the number of records has shrunk by more than 10% since the previous download")
+	interval := flag.Duration("interval", time.Hour, "How often to check for changes")
+	once := flag.Bool("once", false, "Check for changes once, and exit with the number of changed records")
+	webhookURL := flag.String("webhook-url", "", "URL to POST a JSON description of each set of changes to")
+	webhookSecret := flag.String("webhook-secret", "", "Secret with which to sign each webhook's body (defaults to $"+ENV_WEBHOOK_SECRET+")")
+	slackWebhookURL := flag.String("slack-webhook-url", "", "Slack incoming webhook URL to post new Root and Intermediate records to (defaults to $"+ENV_SLACK_WEBHOOK_URL+")")
+	matrixHomeserver := flag.String("matrix-homeserver", "", "Matrix homeserver URL, e.g. https://matrix.org, to post new Root and Intermediate records with")
+	matrixRoom := flag.String("matrix-room", "", "Matrix room ID, e.g. !abcdef:matrix.org, to post new Root and Intermediate records to")
+	matrixAccessToken := flag.String("matrix-access-token", "", "Access token of the Matrix user that posts to -matrix-room (defaults to $"+ENV_MATRIX_ACCESS_TOKEN+")")
+	httpFlags := httpclient.AddFlags(flag.CommandLine, httpclient.Config{Timeout: time.Duration(300) * time.Second})
+	logFlags := logging.AddFlags(flag.CommandLine)
+	configPath := config.AddFlag(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-config FILE] [-log-level LEVEL] [-log-format json|console] -dir DIRECTORY [-cache-dir DIRECTORY] [-ccadb-proxy URL] [-force] [-interval DURATION] [-once] [-webhook-url URL [-webhook-secret SECRET]] [-slack-webhook-url URL] [-matrix-homeserver URL -matrix-room ID [-matrix-access-token TOKEN]] [-proxy URL] [-cafile FILE] [-insecure] [-timeout DURATION]\n", os.Args[0])
+	}
+	flag.Parse()
+	if err := config.Apply(flag.CommandLine, "change_watcher", *configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading configuration: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("change_watcher")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+	*slackWebhookURL = cmp.Or(*slackWebhookURL, os.Getenv(ENV_SLACK_WEBHOOK_URL))
+	*matrixAccessToken = cmp.Or(*matrixAccessToken, os.Getenv(ENV_MATRIX_ACCESS_TOKEN))
+	if flag.NArg() != 0 || *dir == "" || *interval <= 0 || (*matrixHomeserver == "") != (*matrixRoom == "") || (*matrixRoom != "" && *matrixAccessToken == "") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	httpConfig, err := httpFlags.Config()
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+	httpClient, err := httpclient.New(httpConfig)
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+
+	var notifiers []notifier
+	if *webhookURL != "" {
+		notifiers = append(notifiers, &webhook{client: httpClient, url: *webhookURL, secret: []byte(cmp.Or(*webhookSecret, os.Getenv(ENV_WEBHOOK_SECRET)))})
+	}
+	if *slackWebhookURL != "" {
+		notifiers = append(notifiers, &slack{client: httpClient, url: *slackWebhookURL})
+	}
+	if *matrixRoom != "" {
+		notifiers = append(notifiers, &matrix{client: httpClient, homeserver: *matrixHomeserver, roomID: *matrixRoom, accessToken: *matrixAccessToken})
+	}
+
+	// Changes are detected relative to the reports previously downloaded into dir, or else to the embedded data.
+	old, err := ccadb_data.NewStore(os.DirFS(*dir))
+	if err != nil {
+		logger.Info("Previously downloaded reports could not be loaded, so comparing with the embedded data", zap.Error(err), zap.String("dir", *dir))
+		old = ccadb_data.GetDefaultStore()
+	}
+
+	fetchOpts := []ccadb_data.FetchOption{ccadb_data.WithReportCacheDir(*cacheDir), ccadb_data.WithCCADBProxyURL(*ccadbProxyURL)}
+	if *force {
+		fetchOpts = append(fetchOpts, ccadb_data.WithMaxReportShrinkage(1))
+	}
+
+	ctx := context.Background()
+	for {
+		new, err := ccadb_data.FetchStore(ctx, httpClient, *dir, fetchOpts)
+		if err != nil {
+			if *once {
+				logger.Fatal("CCADB reports could not be fetched", zap.Error(err), zap.String("dir", *dir))
+			}
+			logger.Error("CCADB reports could not be fetched", zap.Error(err), zap.String("dir", *dir))
+		} else {
+			c := diff(old, new)
+			logger.Info("Checked for changes", zap.Int("added_count", len(c.Added)), zap.Int("removed_count", len(c.Removed)), zap.Int("changed_count", len(c.Changed)))
+			if c.Count() > 0 {
+				notifyAll(ctx, notifiers, time.Now().UTC(), c)
+			}
+			old = new
+
+			if *once {
+				tally.Add("added", len(c.Added))
+				tally.Add("removed", len(c.Removed))
+				tally.Add("changed", len(c.Changed))
+				tally.Exit(c.Count())
+			}
+		}
+		time.Sleep(*interval)
+	}
+}
+
+// notifyAll notifies every notifier of the changes. A notifier that fails is logged, and not retried.
+func notifyAll(ctx context.Context, notifiers []notifier, detectedAt time.Time, c *changes) {
+	for _, n := range notifiers {
+		if err := n.notify(ctx, detectedAt, c); err != nil {
+			logger.Error("Notification could not be delivered", zap.Error(err), zap.String("notifier", n.name()))
+		}
+	}
+}
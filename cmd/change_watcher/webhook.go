@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Header that carries the HMAC-SHA256 signature of a webhook's body, as "sha256=<hex>".
+const SIGNATURE_HEADER = "X-CCADB-Signature-256"
+
+// The body POSTed to a webhook.
+type webhookPayload struct {
+	DetectedAt time.Time `json:"detected_at"`
+	*changes
+}
+
+// A notifier tells a downstream system about the changes between two datasets.
+type notifier interface {
+	// name identifies the kind of notifier in log messages. Notifier URLs aren't logged, since they are often secret.
+	name() string
+	notify(ctx context.Context, detectedAt time.Time, c *changes) error
+}
+
+// A webhook that is POSTed a JSON description of the changes, signed with a shared secret if one is configured.
+type webhook struct {
+	client *http.Client
+	url    string
+	secret []byte
+}
+
+func (wh *webhook) name() string {
+	return "webhook"
+}
+
+func (wh *webhook) notify(ctx context.Context, detectedAt time.Time, c *changes) error {
+	body, err := json.Marshal(&webhookPayload{DetectedAt: detectedAt, changes: c})
+	if err != nil {
+		return err
+	}
+	header := make(http.Header)
+	if len(wh.secret) > 0 {
+		mac := hmac.New(sha256.New, wh.secret)
+		mac.Write(body)
+		header.Set(SIGNATURE_HEADER, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return sendJSON(ctx, wh.client, http.MethodPost, wh.url, header, body)
+}
+
+// sendJSON sends a JSON body, with any additional headers, and checks that the response status is 2xx.
+func sendJSON(ctx context.Context, client *http.Client, method, url string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if header != nil {
+		req.Header = header
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
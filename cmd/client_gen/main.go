@@ -0,0 +1,375 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/openapi"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	DEFAULT_OUTPUT_PATH = "client/api.go"
+	// The width to which generated comments are wrapped.
+	COMMENT_WIDTH = 120
+)
+
+// Words that are written in upper case (or in a fixed mixed case) in Go names.
+var initialisms = map[string]string{
+	"ca":      "CA",
+	"crl":     "CRL",
+	"ev":      "EV",
+	"id":      "ID",
+	"json":    "JSON",
+	"openapi": "OpenAPI",
+	"sha256":  "SHA256",
+	"smime":   "SMIME",
+	"spki":    "SPKI",
+	"tls":     "TLS",
+	"url":     "URL",
+	"urls":    "URLs",
+	"vmc":     "VMC",
+}
+
+// The parts of an OpenAPI document that the client is generated from.
+type document struct {
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type operation struct {
+	OperationID string       `json:"operationId"`
+	Summary     string       `json:"summary"`
+	Parameters  []*parameter `json:"parameters"`
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema *schema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema *schema `json:"schema"`
+		} `json:"content"`
+	} `json:"responses"`
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Description          string             `json:"description"`
+	Enum                 []any              `json:"enum"`
+	Items                *schema            `json:"items"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Required             []string           `json:"required"`
+}
+
+var logger *zap.Logger
+
+func main() {
+	output := flag.String("output", DEFAULT_OUTPUT_PATH, "Write the generated code to this file")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-output FILE] [-log-level LEVEL] [-log-format json|console]\n(Defaults to %s, when run from the repository root.)\n", os.Args[0], DEFAULT_OUTPUT_PATH)
+	}
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("client_gen")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+
+	// Round-trip the OpenAPI document through JSON, exactly as the server serves it.
+	data, err := json.Marshal(openapi.Document())
+	if err != nil {
+		logger.Fatal("OpenAPI document could not be encoded", zap.Error(err))
+	}
+	var doc document
+	if err = json.Unmarshal(data, &doc); err != nil {
+		logger.Fatal("OpenAPI document could not be decoded", zap.Error(err))
+	}
+
+	code, operations := generate(&doc)
+	if err = os.WriteFile(*output, code, 0644); err != nil {
+		logger.Fatal("Generated code could not be written", zap.Error(err), zap.String("file_path", *output))
+	}
+	tally.Add("types", len(doc.Components.Schemas))
+	tally.Add("operations", operations)
+	tally.Exit(0)
+}
+
+// generate returns the client's types and methods, and the number of methods.
+func generate(doc *document) ([]byte, int) {
+	var body bytes.Buffer
+	imports := map[string]bool{"context": true, "net/http": true}
+
+	// Generate a struct for each schema.
+	for _, name := range slices.Sorted(maps.Keys(doc.Components.Schemas)) {
+		s := doc.Components.Schemas[name]
+		if s.Type != "object" || s.Properties == nil {
+			logger.Fatal("Schema is not an object with properties", zap.String("schema", name))
+		}
+		fmt.Fprintf(&body, "\n%s", comment("", name+" is "+lowerFirst(s.Description)))
+		fmt.Fprintf(&body, "type %s struct {\n", name)
+		for _, jsonName := range slices.Sorted(maps.Keys(s.Properties)) {
+			property := s.Properties[jsonName]
+			required := slices.Contains(s.Required, jsonName)
+			description := property.Description
+			if len(property.Enum) > 0 {
+				description = strings.TrimSpace(description + " One of " + enumValues(property.Enum) + ".")
+			}
+			if description != "" {
+				fmt.Fprintf(&body, "%s", comment("\t", description))
+			}
+			tag := jsonName
+			if !required {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(&body, "\t%s %s `json:%q`\n", goName(jsonName), goType(property, required), tag)
+		}
+		fmt.Fprintf(&body, "}\n")
+	}
+
+	// Generate a method for each operation, ordered by name.
+	type pathOperation struct {
+		path, method string
+		op           *operation
+	}
+	var pathOperations []pathOperation
+	for path, methods := range doc.Paths {
+		for method, op := range methods {
+			pathOperations = append(pathOperations, pathOperation{path, strings.ToUpper(method), op})
+		}
+	}
+	slices.SortFunc(pathOperations, func(a, b pathOperation) int {
+		return strings.Compare(a.op.OperationID, b.op.OperationID)
+	})
+	for _, po := range pathOperations {
+		generateMethod(&body, imports, po.path, po.method, po.op)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by cmd/client_gen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package client\n\n")
+	fmt.Fprintf(&buf, "import (\n")
+	for _, path := range slices.Sorted(maps.Keys(imports)) {
+		fmt.Fprintf(&buf, "\t%q\n", path)
+	}
+	fmt.Fprintf(&buf, ")\n")
+	buf.Write(body.Bytes())
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		logger.Fatal("Generated code could not be formatted", zap.Error(err))
+	}
+	return formatted, len(pathOperations)
+}
+
+// generateMethod generates the method that calls an operation, which takes each of the operation's query parameters
+// and its request body, if it has one, as arguments, and returns its successful response.
+func generateMethod(body *bytes.Buffer, imports map[string]bool, path, method string, op *operation) {
+	name := goName(op.OperationID)
+	args := []string{"ctx context.Context"}
+	var setQuery strings.Builder
+	var optional []string
+	for _, p := range op.Parameters {
+		if p.In != "query" {
+			logger.Fatal("Parameter is not a query parameter", zap.String("operation", op.OperationID), zap.String("parameter", p.Name))
+		}
+		arg := goArgName(p.Name)
+		args = append(args, arg+" "+goType(p.Schema, true))
+		value := arg
+		switch p.Schema.Type {
+		case "string":
+		case "integer":
+			value = "strconv.Itoa(" + arg + ")"
+			imports["strconv"] = true
+		default:
+			logger.Fatal("Parameter type is not supported", zap.String("operation", op.OperationID), zap.String("parameter", p.Name))
+		}
+		if p.Required {
+			fmt.Fprintf(&setQuery, "\tquery.Set(%q, %s)\n", p.Name, value)
+		} else {
+			fmt.Fprintf(&setQuery, "\tif %s != %s {\n\t\tquery.Set(%q, %s)\n\t}\n", arg, zeroValue(p.Schema), p.Name, value)
+			optional = append(optional, arg)
+		}
+	}
+	requestBody := "nil"
+	if op.RequestBody != nil {
+		args = append(args, "request "+goType(op.RequestBody.Content["application/json"].Schema, false))
+		requestBody = "request"
+	}
+
+	response, ok := op.Responses["200"].Content["application/json"]
+	if !ok {
+		logger.Fatal("Operation has no JSON response", zap.String("operation", op.OperationID))
+	}
+	responseType := goType(response.Schema, true)
+	result := "response"
+	returnType := responseType
+	if response.Schema.Ref != "" {
+		result, returnType = "&response", "*"+responseType
+	}
+
+	description := name + " " + lowerFirst(op.Summary)
+	if len(optional) > 0 {
+		description += " " + strings.Join(optional, ", ") + " is omitted from the request if it is zero."
+	}
+	fmt.Fprintf(body, "\n%s", comment("", description))
+	fmt.Fprintf(body, "func (c *Client) %s(%s) (%s, error) {\n", name, strings.Join(args, ", "), returnType)
+	query := "nil"
+	if setQuery.Len() > 0 {
+		imports["net/url"] = true
+		fmt.Fprintf(body, "\tquery := url.Values{}\n%s", setQuery.String())
+		query = "query"
+	}
+	fmt.Fprintf(body, "\tvar response %s\n", responseType)
+	fmt.Fprintf(body, "\tif err := c.do(ctx, http.Method%s, %q, %s, %s, &response); err != nil {\n", methodName(method), path, query, requestBody)
+	fmt.Fprintf(body, "\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(body, "\treturn %s, nil\n}\n", result)
+}
+
+// goType returns the Go type of a schema. If the value isn't required, an object is referred to by a pointer, so that
+// it can be omitted.
+func goType(s *schema, required bool) string {
+	if s.Ref != "" {
+		name := s.Ref[strings.LastIndex(s.Ref, "/")+1:]
+		if !required {
+			return "*" + name
+		}
+		return name
+	}
+	switch s.Type {
+	case "string":
+		return "string"
+	case "boolean":
+		return "bool"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "array":
+		return "[]" + goType(s.Items, true)
+	case "object":
+		if s.Properties != nil {
+			logger.Fatal("Inline object schemas are not supported")
+		}
+		var additionalProperties schema
+		if json.Unmarshal(s.AdditionalProperties, &additionalProperties) == nil {
+			return "map[string]" + goType(&additionalProperties, true)
+		}
+		return "map[string]any"
+	case "":
+		return "any"
+	default:
+		logger.Fatal("Schema type is not supported", zap.String("type", s.Type))
+		return ""
+	}
+}
+
+// zeroValue returns the zero value of a parameter's type.
+func zeroValue(s *schema) string {
+	if s.Type == "integer" {
+		return "0"
+	}
+	return `""`
+}
+
+// goName returns the exported Go name of a JSON name in snake case or camel case, e.g. "sha256_fingerprint" becomes
+// "SHA256Fingerprint" and "operationName" becomes "OperationName".
+func goName(name string) string {
+	var sb strings.Builder
+	for _, word := range splitWords(name) {
+		if initialism, ok := initialisms[strings.ToLower(word)]; ok {
+			sb.WriteString(initialism)
+		} else {
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return sb.String()
+}
+
+// goArgName returns the unexported Go name of a JSON name, e.g. "key_identifier" becomes "keyIdentifier".
+func goArgName(name string) string {
+	words := splitWords(name)
+	return strings.ToLower(words[0]) + goName(strings.Join(words[1:], "_"))
+}
+
+// splitWords splits a name in snake case or camel case into its words.
+func splitWords(name string) []string {
+	var words []string
+	for _, part := range strings.Split(name, "_") {
+		start := 0
+		for i, r := range part {
+			if i > 0 && unicode.IsUpper(r) && !unicode.IsUpper(rune(part[i-1])) {
+				words = append(words, part[start:i])
+				start = i
+			}
+		}
+		if part != "" {
+			words = append(words, part[start:])
+		}
+	}
+	return words
+}
+
+// methodName returns the name of an HTTP method as used in net/http's constants, e.g. "Get" for GET.
+func methodName(method string) string {
+	return method[:1] + strings.ToLower(method[1:])
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// enumValues lists the values of an enum, e.g. `"A", "B", or "C"`.
+func enumValues(values []any) string {
+	var quoted []string
+	for _, value := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", value))
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+}
+
+// comment returns text as a Go comment with the given indentation, wrapped to COMMENT_WIDTH columns.
+func comment(indent, text string) string {
+	var sb strings.Builder
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > COMMENT_WIDTH && line != indent+"//" {
+			sb.WriteString(line + "\n")
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	sb.WriteString(line + "\n")
+	return sb.String()
+}
@@ -0,0 +1,51 @@
+package main
+
+import "strings"
+
+// CCADB's Country field is free text, so the same country is often spelled in several ways. Spellings that are known
+// to be used, or that are likely to be given as jurisdictions of interest, are mapped to one name, indexed by their
+// lower case form.
+var countryAliases = map[string]string{
+	"us":                       "United States of America",
+	"usa":                      "United States of America",
+	"united states":            "United States of America",
+	"united states of america": "United States of America",
+	"uk":                       "United Kingdom",
+	"gb":                       "United Kingdom",
+	"great britain":            "United Kingdom",
+	"united kingdom":           "United Kingdom",
+	"united kingdom of great britain and northern ireland": "United Kingdom",
+	"brasil":                          "Brazil",
+	"brazil":                          "Brazil",
+	"china":                           "China",
+	"people's republic of china":      "China",
+	"prc":                             "China",
+	"中国":                              "China",
+	"españa":                          "Spain",
+	"spain":                           "Spain",
+	"nl":                              "Netherlands",
+	"netherlands":                     "Netherlands",
+	"the netherlands":                 "Netherlands",
+	"poland":                          "Poland",
+	"polska":                          "Poland",
+	"korea":                           "Republic of Korea",
+	"south korea":                     "Republic of Korea",
+	"republic of korea":               "Republic of Korea",
+	"republic of korea (south korea)": "Republic of Korea",
+	"taiwan":                          "Taiwan",
+	"taiwan (republic of china)":      "Taiwan",
+	"taiwan, republic of china":       "Taiwan",
+	"turkey":                          "Türkiye",
+	"türkiye":                         "Türkiye",
+	"uae":                             "United Arab Emirates",
+	"united arab emirates":            "United Arab Emirates",
+}
+
+// normalizeCountry returns the name under which a Country value (or a jurisdiction of interest) is reported.
+func normalizeCountry(country string) string {
+	country = strings.Join(strings.Fields(country), " ")
+	if name, ok := countryAliases[strings.ToLower(country)]; ok {
+		return name
+	}
+	return country
+}
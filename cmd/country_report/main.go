@@ -0,0 +1,253 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+// The active, trusted CA certificates whose records give one country.
+type countryGroup struct {
+	Country string `json:"country"`
+	// Whether the country is one of the configured jurisdictions of interest.
+	Flagged bool `json:"flagged"`
+	// The Country values that were reported under this name, in alphabetical order.
+	ReportedAs    []string `json:"reported_as"`
+	CAOwners      []string `json:"ca_owners"`
+	Roots         int      `json:"roots"`
+	Intermediates int      `json:"intermediates"`
+	// Number of the CA certificates that each root program includes (or trusts).
+	TrustedBy map[string]int `json:"trusted_by"`
+}
+
+func main() {
+	format := flag.String("format", "markdown", "Output format: markdown, or json (one JSON object per country per line)")
+	program := flag.String("program", "", "Only count CA certificates trusted by this root program: Apple, Chrome, Microsoft, or Mozilla (default: every root program)")
+	jurisdictions := flag.String("jurisdictions", "", "Comma-separated countries to flag as jurisdictions of interest")
+	jurisdictionsPath := flag.String("jurisdictions-file", "", "File of countries to flag as jurisdictions of interest, one per line (# starts a comment)")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format markdown|json] [-program NAME] [-jurisdictions LIST] [-jurisdictions-file FILE] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	programs := rootPrograms
+	if *program != "" {
+		programs = []string{*program}
+	}
+	if flag.NArg() != 0 || (*program != "" && !slices.Contains(rootPrograms, *program)) || (*format != "markdown" && *format != "json") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("country_report")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	// Collect the jurisdictions of interest, under the names that they will be reported as.
+	flagged := make(map[string]bool)
+	for _, jurisdiction := range strings.Split(*jurisdictions, ",") {
+		if jurisdiction = normalizeCountry(jurisdiction); jurisdiction != "" {
+			flagged[jurisdiction] = true
+		}
+	}
+	if *jurisdictionsPath != "" {
+		if err = readJurisdictions(*jurisdictionsPath, flagged); err != nil {
+			logger.Fatal("Jurisdictions file could not be read", zap.Error(err), zap.String("file_path", *jurisdictionsPath))
+		}
+	}
+
+	// The Country field is only in the full report.
+	ccadb_data.LoadRawRecords()
+	groups := buildGroups(programs, flagged)
+	for jurisdiction := range flagged {
+		if !slices.ContainsFunc(groups, func(cg *countryGroup) bool { return cg.Country == jurisdiction }) {
+			logger.Info("Jurisdiction of interest has no active, trusted CA certificates", zap.String("country", jurisdiction))
+		}
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	if *format == "json" {
+		err = writeJSON(w, groups)
+	} else {
+		writeMarkdown(w, groups, programs)
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+
+	nFlagged := 0
+	for _, cg := range groups {
+		if cg.Flagged {
+			nFlagged++
+		}
+	}
+	tally.Add("countries", len(groups))
+	tally.Add("flagged", nFlagged)
+	tally.Exit(nFlagged)
+}
+
+// readJurisdictions adds the countries listed in a file, one per line, to flagged.
+func readJurisdictions(filePath string, flagged map[string]bool) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if jurisdiction := normalizeCountry(line); jurisdiction != "" {
+			flagged[jurisdiction] = true
+		}
+	}
+	return scanner.Err()
+}
+
+// buildGroups groups the CA certificates that are unexpired, that CCADB doesn't consider to be revoked, and that are
+// included in (or trusted by) at least one of the given root programs, by country. Flagged countries come first, then
+// the others in descending order of the number of CA certificates.
+func buildGroups(programs []string, flagged map[string]bool) []*countryGroup {
+	var included []*ccadb_data.Query
+	for _, rootProgram := range programs {
+		included = append(included, ccadb_data.Where().IncludedIn(rootProgram))
+	}
+	q := ccadb_data.Where().NotExpired().NotRevoked().Or(included...)
+
+	groups := make(map[string]*countryGroup)
+	for _, sha256Fingerprint := range ccadb_data.ListFingerprintsWhere(q) {
+		cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+		reportedAs := rawField(sha256Fingerprint, "Country")
+		country := normalizeCountry(reportedAs)
+		cg := groups[country]
+		if cg == nil {
+			cg = &countryGroup{Country: country, Flagged: flagged[country], ReportedAs: []string{}, CAOwners: []string{}, TrustedBy: make(map[string]int)}
+			groups[country] = cg
+		}
+		if reportedAs != "" && !slices.Contains(cg.ReportedAs, reportedAs) {
+			cg.ReportedAs = append(cg.ReportedAs, reportedAs)
+		}
+		if !slices.Contains(cg.CAOwners, cr.CAOwner) {
+			cg.CAOwners = append(cg.CAOwners, cr.CAOwner)
+		}
+		switch ccadb_data.GetCACertCapabilitiesBySHA256(sha256Fingerprint).CertificateRecordType {
+		case ccadb_data.RecordTypeRoot:
+			cg.Roots++
+		case ccadb_data.RecordTypeIntermediate:
+			cg.Intermediates++
+		}
+		for _, rootProgram := range programs {
+			if status := ccadb_data.GetRootProgramStatusBySHA256(sha256Fingerprint, rootProgram); status == ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED || status == ccadb_data.ROOT_PROGRAM_STATUS_TRUSTED {
+				cg.TrustedBy[rootProgram]++
+			}
+		}
+	}
+
+	sorted := make([]*countryGroup, 0, len(groups))
+	for _, cg := range groups {
+		slices.Sort(cg.ReportedAs)
+		slices.Sort(cg.CAOwners)
+		sorted = append(sorted, cg)
+	}
+	slices.SortFunc(sorted, func(a, b *countryGroup) int {
+		if a.Flagged != b.Flagged {
+			if a.Flagged {
+				return -1
+			}
+			return 1
+		} else if n, m := a.Roots+a.Intermediates, b.Roots+b.Intermediates; n != m {
+			return m - n
+		}
+		return strings.Compare(a.Country, b.Country)
+	})
+	return sorted
+}
+
+// rawField returns the value of a column of the CA certificate's raw CSV record, or "" if either is missing.
+func rawField(sha256Fingerprint [sha256.Size]byte, column string) string {
+	rr := ccadb_data.GetRawRecordBySHA256(sha256Fingerprint)
+	if rr == nil {
+		return ""
+	}
+	if i := slices.Index(rr.Header, column); i >= 0 && i < len(rr.Fields) {
+		return rr.Fields[i]
+	}
+	return ""
+}
+
+// writeJSON outputs one JSON object per country per line.
+func writeJSON(w io.Writer, groups []*countryGroup) error {
+	encoder := json.NewEncoder(w)
+	for _, cg := range groups {
+		if err := encoder.Encode(cg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeMarkdown outputs a Markdown document with a table of every country, followed by the CA Owners in each flagged
+// country.
+func writeMarkdown(w io.Writer, groups []*countryGroup, programs []string) {
+	fmt.Fprintf(w, "# Country Report\n\nActive, trusted CA certificates by country, generated from the CCADB data fetched at %s.\n\n", ccadb_data.DatasetDate)
+	fmt.Fprintf(w, "| Country | Flagged | CA Owners | Roots | Intermediates |")
+	for _, rootProgram := range programs {
+		fmt.Fprintf(w, " %s |", rootProgram)
+	}
+	fmt.Fprintf(w, "\n|---|---|--:|--:|--:|%s\n", strings.Repeat("--:|", len(programs)))
+	for _, cg := range groups {
+		country := cg.Country
+		if country == "" {
+			country = "(not disclosed)"
+		}
+		flaggedText := ""
+		if cg.Flagged {
+			flaggedText = "Yes"
+		}
+		fmt.Fprintf(w, "| %s | %s | %d | %d | %d |", markdownText(country), flaggedText, len(cg.CAOwners), cg.Roots, cg.Intermediates)
+		for _, rootProgram := range programs {
+			fmt.Fprintf(w, " %d |", cg.TrustedBy[rootProgram])
+		}
+		fmt.Fprintf(w, "\n")
+	}
+
+	fmt.Fprintf(w, "\n## Jurisdictions of Interest\n")
+	n := 0
+	for _, cg := range groups {
+		if !cg.Flagged {
+			continue
+		}
+		n++
+		fmt.Fprintf(w, "\n### %s\n\n", markdownText(cg.Country))
+		for _, caOwner := range cg.CAOwners {
+			fmt.Fprintf(w, "- %s\n", markdownText(caOwner))
+		}
+	}
+	if n == 0 {
+		fmt.Fprintf(w, "\nNone.\n")
+	}
+}
+
+// markdownText escapes text for use in a Markdown table cell or heading.
+func markdownText(s string) string {
+	return strings.NewReplacer("|", `\|`, "\r", " ", "\n", " ").Replace(s)
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/httpclient"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	DEFAULT_CSV_PATH  = "full/data/AllCertificateRecordsCSVFormatV5"
+	MAX_CONCURRENCY   = 16
+	MAX_DOCUMENT_SIZE = 64 << 20
+)
+
+// Columns that contain a CP/CPS URL, each with the column that contains the effective date recorded in CCADB.
+var documentColumns = []struct {
+	urlColumn  string
+	dateColumn string
+}{
+	{"Certificate Policy (CP) URL", "CP Effective Date"},
+	{"Certificate Practice Statement (CPS) URL", "CPS Effective Date"},
+	{"Certificate Practice & Policy Statement", "CP/CPS Effective Date"},
+	{"MD/AsciiDoc CP/CPS URL", "MD/AsciiDoc CP/CPS Effective Date"},
+}
+
+var (
+	// PDF Info dictionary dates, e.g. "/ModDate (D:20250915120000+02'00')".
+	pdfInfoDateRegex = regexp.MustCompile(`/(ModDate|CreationDate)\s*\(D:(\d{8})`)
+	// XMP metadata dates, e.g. "<xmp:ModifyDate>2025-09-15T12:00:00+02:00</xmp:ModifyDate>".
+	xmpDateRegex = regexp.MustCompile(`<xmp:(ModifyDate|CreateDate)>(\d{4}-\d{2}-\d{2})`)
+)
+
+var (
+	logger     *zap.Logger
+	httpClient *http.Client
+)
+
+// A CP/CPS document, and the CCADB records that refer to it.
+type document struct {
+	url          string
+	caOwners     []string
+	recordedDate time.Time
+}
+
+func main() {
+	maxAgeDays := flag.Int("max-age-days", 365, "Flag documents whose CCADB effective date is more than this many days ago")
+	toleranceDays := flag.Int("tolerance-days", 30, "Flag documents that were modified more than this many days after their CCADB effective date")
+	output := flag.String("output", "", "Write the output to this file instead of stdout")
+	concurrency := flag.Int("concurrency", MAX_CONCURRENCY, "Maximum number of documents to fetch at once")
+	excludes := config.AddExcludesFlag(flag.CommandLine)
+	httpFlags := httpclient.AddFlags(flag.CommandLine, httpclient.Config{Timeout: time.Duration(60) * time.Second})
+	logFlags := logging.AddFlags(flag.CommandLine)
+	configPath := config.AddFlag(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-config FILE] [-log-level LEVEL] [-log-format json|console] [-max-age-days N] [-tolerance-days N] [-output FILE] [-concurrency N] [-exclude PATTERN]... [-proxy URL] [-cafile FILE] [-insecure] [-timeout DURATION] [AllCertificateRecords CSV file] [CA Owner]\n(Defaults to %s.)\n", os.Args[0], DEFAULT_CSV_PATH)
+	}
+	flag.Parse()
+	if err := config.Apply(flag.CommandLine, "cps_check", *configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading configuration: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("cps_check")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	httpConfig, err := httpFlags.Config()
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	} else if httpClient, err = httpclient.New(httpConfig); err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+	if flag.NArg() > 2 || *concurrency < 1 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	filePath := DEFAULT_CSV_PATH
+	if flag.NArg() >= 1 {
+		filePath = flag.Arg(0)
+	}
+	caOwnerFilter := flag.Arg(1)
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		logger.Fatal("CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
+	} else if len(records) == 0 {
+		logger.Fatal("CSV file is empty", zap.String("file_path", filePath))
+	}
+
+	// Examine the CSV header to find the fields that we need.
+	idx := make(map[string]int)
+	for i, name := range records[0] {
+		idx[name] = i
+	}
+	required := []string{"CA Owner", "Revocation Status", "Valid To (GMT)"}
+	for _, dc := range documentColumns {
+		required = append(required, dc.urlColumn, dc.dateColumn)
+	}
+	for _, name := range required {
+		if _, ok := idx[name]; !ok {
+			logger.Fatal("CSV data is missing one or more expected headers", zap.String("file_path", filePath), zap.String("header", name))
+		}
+	}
+
+	// Collect the CP/CPS documents referred to by unexpired CA certificates that CCADB doesn't consider to be revoked.
+	// If the records disagree about a document's effective date, the latest one is used.
+	now := time.Now().UTC()
+	documents := make(map[string]*document)
+	for _, record := range records[1:] {
+		if len(record) != len(records[0]) {
+			continue
+		} else if revocationStatus := record[idx["Revocation Status"]]; revocationStatus != "" && revocationStatus != "Not Revoked" {
+			continue
+		} else if validTo, err := ccadb_data.ParseCCADBDate(record[idx["Valid To (GMT)"]]); err != nil || now.After(validTo) {
+			continue
+		}
+		caOwner := record[idx["CA Owner"]]
+		if caOwnerFilter != "" && caOwner != caOwnerFilter {
+			continue
+		}
+
+		for _, dc := range documentColumns {
+			url := record[idx[dc.urlColumn]]
+			recordedDate, err := ccadb_data.ParseCCADBDate(record[idx[dc.dateColumn]])
+			if url == "" || err != nil || recordedDate.IsZero() || excludes.Match(url) {
+				continue
+			}
+			d := documents[url]
+			if d == nil {
+				d = &document{url: url}
+				documents[url] = d
+			}
+			if !slices.Contains(d.caOwners, caOwner) {
+				d.caOwners = append(d.caOwners, caOwner)
+			}
+			if recordedDate.After(d.recordedDate) {
+				d.recordedDate = recordedDate
+			}
+		}
+	}
+
+	// Check each document concurrently.
+	var mu sync.Mutex
+	var results [][]string
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, *concurrency)
+	for _, d := range documents {
+		wg.Go(func() {
+			workers <- struct{}{}
+			defer func() { <-workers }()
+			if result := checkDocument(d, now, *maxAgeDays, *toleranceDays); result != nil {
+				mu.Lock()
+				results = append(results, result)
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	slices.SortFunc(results, func(a, b []string) int {
+		return cmp.Or(cmp.Compare(a[0], b[0]), cmp.Compare(a[1], b[1]))
+	})
+	out, err := config.CreateOutput(*output)
+	if err != nil {
+		logger.Fatal("Output file could not be created", zap.Error(err))
+	}
+	defer out.Close()
+	csvWriter := csv.NewWriter(out)
+	csvWriter.Write([]string{"CA Owner", "URL", "CCADB Effective Date", "Document Date", "Document Date Source", "Problem"})
+	csvWriter.WriteAll(results)
+	if err = csvWriter.Error(); err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+
+	tally.Add("documents", len(documents))
+	tally.Add("problems", len(results))
+	tally.Exit(len(results))
+}
+
+// checkDocument fetches a CP/CPS document and determines when it was last modified, preferring the PDF metadata to the
+// Last-Modified header. It returns a CSV result if there is a problem with the document, or nil otherwise.
+func checkDocument(d *document, now time.Time, maxAgeDays, toleranceDays int) []string {
+	var documentDate time.Time
+	var source, problem string
+	resp, err := httpClient.Get(d.url)
+	if err != nil {
+		problem = err.Error()
+	} else {
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			problem = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		} else if body, err := io.ReadAll(io.LimitReader(resp.Body, MAX_DOCUMENT_SIZE)); err != nil {
+			problem = err.Error()
+		} else if documentDate = pdfModifiedDate(body); !documentDate.IsZero() {
+			source = "PDF metadata"
+		} else if documentDate, err = http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+			source = "Last-Modified"
+		}
+	}
+
+	switch {
+	case problem != "":
+	case now.Sub(d.recordedDate) > time.Duration(maxAgeDays)*24*time.Hour:
+		problem = "Effective date is more than " + strconv.Itoa(maxAgeDays) + " days ago"
+	case !documentDate.IsZero() && documentDate.Sub(d.recordedDate) > time.Duration(toleranceDays+1)*24*time.Hour:
+		problem = "Document was modified after its effective date"
+	default:
+		return nil
+	}
+
+	result := []string{d.caOwners[0], d.url, d.recordedDate.Format(time.DateOnly), "", source, problem}
+	if !documentDate.IsZero() {
+		result[3] = documentDate.UTC().Format(time.DateOnly)
+	}
+	for _, caOwner := range d.caOwners[1:] {
+		result[0] += "; " + caOwner
+	}
+	return result
+}
+
+// pdfModifiedDate returns the latest modification or creation date in a PDF document's metadata, or the zero time if
+// the document is not a PDF or has no dates. Dates are only found if the metadata is not compressed.
+func pdfModifiedDate(body []byte) time.Time {
+	if !bytes.HasPrefix(body, []byte("%PDF")) {
+		return time.Time{}
+	}
+
+	var latest time.Time
+	for _, m := range pdfInfoDateRegex.FindAllSubmatch(body, -1) {
+		if t, err := time.Parse("20060102", string(m[2])); err == nil && t.After(latest) {
+			latest = t
+		}
+	}
+	for _, m := range xmpDateRegex.FindAllSubmatch(body, -1) {
+		if t, err := ccadb_data.ParseCCADBDate(string(m[2])); err == nil && t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
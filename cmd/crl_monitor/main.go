@@ -0,0 +1,276 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/httpclient"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/progress"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const (
+	MAX_CONCURRENCY = 16
+	MAX_CRL_SIZE    = 256 << 20
+)
+
+var (
+	logger      *zap.Logger
+	httpClient  *http.Client
+	concurrency int
+	// How often checkAll logs its progress.
+	progressInterval *time.Duration
+)
+
+// A disclosed CRL, and the CA certificates that disclose it.
+type crl struct {
+	url                string
+	caOwner            string
+	sha256Fingerprints [][sha256.Size]byte
+}
+
+// The outcome of checking a CRL.
+type result struct {
+	URL            string    `json:"url"`
+	CAOwner        string    `json:"ca_owner"`
+	Size           int       `json:"size"`
+	ThisUpdate     time.Time `json:"this_update,omitzero"`
+	NextUpdate     time.Time `json:"next_update,omitzero"`
+	SignatureValid bool      `json:"signature_valid"`
+	Error          string    `json:"error,omitempty"`
+	FetchDuration  float64   `json:"fetch_duration_seconds"`
+	CheckedAt      time.Time `json:"checked_at"`
+}
+
+var (
+	labels           = []string{"url", "ca_owner"}
+	fetchSuccess     = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_crl_fetch_success", Help: "Whether the CRL was fetched and parsed successfully."}, labels)
+	fetchDuration    = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_crl_fetch_duration_seconds", Help: "How long the CRL took to fetch."}, labels)
+	sizeBytes        = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_crl_size_bytes", Help: "Size of the CRL."}, labels)
+	thisUpdate       = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_crl_this_update_timestamp_seconds", Help: "The CRL's thisUpdate time."}, labels)
+	nextUpdate       = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_crl_next_update_timestamp_seconds", Help: "The CRL's nextUpdate time."}, labels)
+	signatureValid   = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_crl_signature_valid", Help: "Whether the CRL's signature was verified with the public key of a CA certificate that discloses it."}, labels)
+	lastCheck        = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_crl_last_check_timestamp_seconds", Help: "When the CRL was last checked."}, labels)
+	crlsMonitored    = prometheus.NewGauge(prometheus.GaugeOpts{Name: "ccadb_crl_monitored", Help: "Number of disclosed CRLs being monitored."})
+	lastPassDuration = prometheus.NewGauge(prometheus.GaugeOpts{Name: "ccadb_crl_last_pass_duration_seconds", Help: "How long the last pass over every CRL took."})
+)
+
+func main() {
+	interval := flag.Duration("interval", time.Hour, "How often to check every CRL")
+	listen := flag.String("listen", ":9100", "Address on which to serve Prometheus metrics at /metrics")
+	once := flag.Bool("once", false, "Check every CRL once, print the results as JSON (one object per line), and exit")
+	output := flag.String("output", "", "With -once, write the output to this file instead of stdout")
+	flag.IntVar(&concurrency, "concurrency", MAX_CONCURRENCY, "Maximum number of CRLs to fetch at once")
+	excludes := config.AddExcludesFlag(flag.CommandLine)
+	progressInterval = progress.AddFlag(flag.CommandLine)
+	httpFlags := httpclient.AddFlags(flag.CommandLine, httpclient.Config{Timeout: time.Duration(60) * time.Second})
+	logFlags := logging.AddFlags(flag.CommandLine)
+	configPath := config.AddFlag(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-config FILE] [-log-level LEVEL] [-log-format json|console] [-interval DURATION] [-listen ADDRESS] [-once [-output FILE]] [-concurrency N] [-exclude PATTERN]... [-progress-interval DURATION] [-proxy URL] [-cafile FILE] [-insecure] [-timeout DURATION] [CA Owner]\n", os.Args[0])
+	}
+	flag.Parse()
+	if err := config.Apply(flag.CommandLine, "crl_monitor", *configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading configuration: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("crl_monitor")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+	if flag.NArg() > 1 || concurrency < 1 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	httpConfig, err := httpFlags.Config()
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	} else if httpClient, err = httpclient.New(httpConfig); err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+
+	// The issuers' public keys are needed to verify CRL signatures.
+	ccadb_data.PreloadParsedCACertificates()
+	crls := disclosedCRLs(flag.Arg(0), excludes)
+	crlsMonitored.Set(float64(len(crls)))
+
+	if *once {
+		out, err := config.CreateOutput(*output)
+		if err != nil {
+			logger.Fatal("Output file could not be created", zap.Error(err))
+		}
+		defer out.Close()
+		encoder := json.NewEncoder(out)
+		failed := 0
+		for _, r := range checkAll(crls) {
+			if r.Error != "" || !r.SignatureValid {
+				failed++
+			}
+			if err = encoder.Encode(r); err != nil {
+				logger.Fatal("Output could not be written", zap.Error(err))
+			}
+		}
+		tally.Add("crls", len(crls))
+		tally.Add("failed", failed)
+		tally.Exit(failed)
+	}
+
+	prometheus.MustRegister(fetchSuccess, fetchDuration, sizeBytes, thisUpdate, nextUpdate, signatureValid, lastCheck, crlsMonitored, lastPassDuration)
+	go func() {
+		for {
+			start := time.Now()
+			results := checkAll(crls)
+			lastPassDuration.Set(time.Since(start).Seconds())
+			failed := 0
+			for _, r := range results {
+				if r.Error != "" || !r.SignatureValid {
+					failed++
+				}
+			}
+			logger.Info("Checked CRLs", zap.Int("count", len(results)), zap.Int("failed_count", failed), zap.Duration("duration", time.Since(start)))
+			time.Sleep(*interval)
+		}
+	}()
+
+	http.Handle("/metrics", promhttp.Handler())
+	if err = http.ListenAndServe(*listen, nil); err != nil {
+		logger.Fatal("Metrics could not be served", zap.Error(err), zap.String("address", *listen))
+	}
+}
+
+// disclosedCRLs returns the CRLs disclosed for unexpired CA certificates that CCADB doesn't consider to be revoked,
+// optionally only for one CA Owner or Subordinate CA Owner, except for excluded URLs.
+func disclosedCRLs(caOwnerFilter string, excludes *config.Excludes) []*crl {
+	crls := make(map[string]*crl)
+	for _, sha256Fingerprint := range ccadb_data.ListFingerprints(ccadb_data.CapabilityFilter{ValidAt: time.Now(), ExcludeRevoked: true}) {
+		cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+		if caOwnerFilter != "" && cr.CAOwner != caOwnerFilter && cr.SubordinateCAOwner != caOwnerFilter {
+			continue
+		}
+		for _, url := range slices.Concat(cr.FullCRLURLs, cr.PartitionedCRLURLs) {
+			if excludes.Match(url) {
+				continue
+			}
+			c := crls[url]
+			if c == nil {
+				c = &crl{url: url, caOwner: cr.CAOwner}
+				crls[url] = c
+			}
+			c.sha256Fingerprints = append(c.sha256Fingerprints, sha256Fingerprint)
+		}
+	}
+	return slices.Collect(maps.Values(crls))
+}
+
+// checkAll checks every CRL, updates the metrics, and returns the results sorted by URL.
+func checkAll(crls []*crl) []*result {
+	var mu sync.Mutex
+	var results []*result
+	tracker := progress.Start(logger, "Checking CRLs", len(crls), *progressInterval)
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for _, c := range crls {
+		semaphore <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+			r := check(c)
+			updateMetrics(r)
+			tracker.Add(r.Error != "" || !r.SignatureValid)
+			mu.Lock()
+			results = append(results, r)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+	tracker.Stop()
+
+	slices.SortFunc(results, func(a, b *result) int { return strings.Compare(a.URL, b.URL) })
+	return results
+}
+
+// check fetches and parses a CRL, and verifies its signature.
+func check(c *crl) *result {
+	r := &result{URL: c.url, CAOwner: c.caOwner, CheckedAt: time.Now().UTC()}
+	start := time.Now()
+	resp, err := httpClient.Get(c.url)
+	r.FetchDuration = time.Since(start).Seconds()
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		r.Error = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		return r
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MAX_CRL_SIZE+1))
+	r.FetchDuration = time.Since(start).Seconds()
+	r.Size = len(data)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	} else if len(data) > MAX_CRL_SIZE {
+		r.Error = fmt.Sprintf("CRL is larger than %d bytes", MAX_CRL_SIZE)
+		return r
+	}
+
+	revocationList, err := x509.ParseRevocationList(data)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	r.ThisUpdate, r.NextUpdate = revocationList.ThisUpdate, revocationList.NextUpdate
+
+	// The CRL may have been signed by any of the CA certificates that disclose it, e.g. when a key has been cross-signed.
+	for _, sha256Fingerprint := range c.sha256Fingerprints {
+		if issuer := ccadb_data.GetParsedCACertificateBySHA256(sha256Fingerprint); issuer != nil && revocationList.CheckSignatureFrom(issuer) == nil {
+			r.SignatureValid = true
+			break
+		}
+	}
+	return r
+}
+
+// updateMetrics records the outcome of checking a CRL.
+func updateMetrics(r *result) {
+	lv := []string{r.URL, r.CAOwner}
+	fetchSuccess.WithLabelValues(lv...).Set(boolToFloat(r.Error == ""))
+	fetchDuration.WithLabelValues(lv...).Set(r.FetchDuration)
+	sizeBytes.WithLabelValues(lv...).Set(float64(r.Size))
+	signatureValid.WithLabelValues(lv...).Set(boolToFloat(r.SignatureValid))
+	lastCheck.WithLabelValues(lv...).Set(float64(r.CheckedAt.Unix()))
+	if r.Error == "" {
+		thisUpdate.WithLabelValues(lv...).Set(float64(r.ThisUpdate.Unix()))
+		if !r.NextUpdate.IsZero() {
+			nextUpdate.WithLabelValues(lv...).Set(float64(r.NextUpdate.Unix()))
+		}
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
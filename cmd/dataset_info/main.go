@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	OUTPUT_PATH      = "dataset_info.go"
+	MANIFEST_PATH    = ccadb_data.MANIFEST_PATH
+	FULL_DIR         = "full/"
+	CCADB_CSV_PATH   = FULL_DIR + "data/AllCertificateRecordsCSVFormatV5"
+	SLIM_CSV_PATH    = "data/AllCertificateRecordsSlim.csv"
+	SKI_SPKI_PATH    = "data/ski_spkisha256.csv"
+	DERIVED_SKI_PATH = "data/derived_ski.csv"
+	PEM_CSV_PATTERN  = FULL_DIR + "cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_*"
+)
+
+// Data files that are only present once they have been fetched by fetch_csv_reports.sh.
+var optionalPaths = []string{ccadb_data.MOZILLA_INCLUDED_CA_REPORT_PATH, ccadb_data.CHROME_ROOT_STORE_PATH, ccadb_data.CT_LOG_LIST_PATH}
+
+var datasetDateRegex = regexp.MustCompile(`DatasetDate\s*=\s*"([^"]+)"`)
+
+var logger *zap.Logger
+
+func main() {
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console]\n(Run from the repository root, after fetching the CCADB CSV reports.)\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("dataset_info")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+
+	pemPaths, err := filepath.Glob(PEM_CSV_PATTERN)
+	if err != nil {
+		logger.Fatal("PEM CSV files could not be found", zap.Error(err), zap.String("pattern", PEM_CSV_PATTERN))
+	}
+	slices.Sort(pemPaths)
+	dataPaths := append([]string{CCADB_CSV_PATH, SLIM_CSV_PATH, SKI_SPKI_PATH, DERIVED_SKI_PATH}, pemPaths...)
+	for _, filePath := range optionalPaths {
+		if _, err = os.Stat(filePath); err == nil {
+			dataPaths = append(dataPaths, filePath)
+		}
+	}
+
+	// Calculate the checksum of each data file, and count the records.
+	checksums := make(map[string]string)
+	var recordCount, certificateCount int
+	for _, filePath := range dataPaths {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			logger.Fatal("Data file could not be read", zap.Error(err), zap.String("file_path", filePath))
+		}
+		sha256Hash := sha256.Sum256(data)
+		// Index the checksums by the path within the dataset layout read by NewStore, which the full subpackage shares.
+		checksums[strings.TrimPrefix(filepath.ToSlash(filePath), FULL_DIR)] = hex.EncodeToString(sha256Hash[:])
+
+		switch {
+		case filePath == CCADB_CSV_PATH:
+			recordCount = countRecords(filePath, data)
+		case strings.HasPrefix(filePath, filepath.Dir(PEM_CSV_PATTERN)):
+			certificateCount += countRecords(filePath, data)
+		}
+	}
+
+	// Keep the existing dataset date if none of the data files have changed, so that this file only changes when the
+	// data does.
+	tally.Add("files", len(dataPaths))
+	tally.Add("records", recordCount)
+	tally.Add("certificates", certificateCount)
+	datasetDate := time.Now().UTC().Format(time.RFC3339)
+	existing, _ := os.ReadFile(OUTPUT_PATH)
+	if m := datasetDateRegex.FindSubmatch(existing); m != nil && bytes.Equal(generate(string(m[1]), recordCount, certificateCount, checksums), existing) {
+		datasetDate = string(m[1])
+	} else if err = os.WriteFile(OUTPUT_PATH, generate(datasetDate, recordCount, certificateCount, checksums), 0644); err != nil {
+		logger.Fatal("Generated code could not be written", zap.Error(err), zap.String("file_path", OUTPUT_PATH))
+	}
+
+	// The manifest lists the same checksums and dataset date, so that datasets loaded from this repository's layout
+	// (including the embedded data) can be verified when they are read, and their age is known.
+	datasetTime, _ := time.Parse(time.RFC3339, datasetDate)
+	if err = os.WriteFile(MANIFEST_PATH, ccadb_data.FormatManifest(datasetTime, checksums), 0644); err != nil {
+		logger.Fatal("Manifest could not be written", zap.Error(err), zap.String("file_path", MANIFEST_PATH))
+	}
+	tally.Exit(0)
+}
+
+func countRecords(filePath string, data []byte) int {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		logger.Fatal("CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
+	} else if len(records) == 0 {
+		return 0
+	}
+	return len(records) - 1
+}
+
+func generate(datasetDate string, recordCount, certificateCount int, checksums map[string]string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by cmd/dataset_info; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package ccadb_data\n\n")
+	fmt.Fprintf(&buf, "const (\n")
+	fmt.Fprintf(&buf, "\t// When the embedded CCADB data was fetched (RFC 3339).\n")
+	fmt.Fprintf(&buf, "\tDatasetDate = %q\n", datasetDate)
+	fmt.Fprintf(&buf, "\t// Number of records in the embedded AllCertificateRecordsCSVFormatV5 report.\n")
+	fmt.Fprintf(&buf, "\tRecordCount = %d\n", recordCount)
+	fmt.Fprintf(&buf, "\t// Number of certificates in the embedded AllCertificatePEMsCSVFormat reports.\n")
+	fmt.Fprintf(&buf, "\tCertificateCount = %d\n", certificateCount)
+	fmt.Fprintf(&buf, ")\n\n")
+	fmt.Fprintf(&buf, "// SHA-256 checksums of the embedded data files, indexed by their paths in the dataset layout read by NewStore, as in\n")
+	fmt.Fprintf(&buf, "// the manifest. The files under full/ in this repository are embedded by the full subpackage.\n")
+	fmt.Fprintf(&buf, "var DatasetChecksums = map[string]string{\n")
+	for _, filePath := range slices.Sorted(maps.Keys(checksums)) {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", filePath, checksums[filePath])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		logger.Fatal("Generated code could not be formatted", zap.Error(err))
+	}
+	return formatted
+}
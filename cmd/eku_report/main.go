@@ -0,0 +1,168 @@
+package main
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+// Why an intermediate certificate's extended key usage is unrestricted.
+const (
+	// The certificate has no Extended Key Usage extension, so it may issue certificates for any purpose.
+	EKU_ABSENT = "absent"
+	// The certificate's Extended Key Usage extension includes anyExtendedKeyUsage.
+	EKU_ANY = "anyExtendedKeyUsage"
+)
+
+// A TLS capable intermediate certificate without EKU restrictions, written as one JSON object per line.
+type certificate struct {
+	SHA256Fingerprint  string   `json:"sha256_fingerprint"`
+	CertificateName    string   `json:"certificate_name"`
+	CAOwner            string   `json:"ca_owner"`
+	SubordinateCAOwner string   `json:"subordinate_ca_owner,omitempty"`
+	ValidTo            string   `json:"valid_to"`
+	EKU                string   `json:"eku"`
+	Capabilities       []string `json:"capabilities"`
+	TrustedBy          []string `json:"trusted_by"`
+}
+
+func main() {
+	format := flag.String("format", "text", "Output format: text, or json (one JSON object per CA certificate per line)")
+	program := flag.String("program", "", "Only report CA certificates trusted by this root program: Apple, Chrome, Microsoft, or Mozilla (default: every TLS capable CA certificate)")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format text|json] [-program NAME] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 || (*program != "" && !slices.Contains(rootPrograms, *program)) || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("eku_report")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	q := ccadb_data.Where().RecordType(ccadb_data.RecordTypeIntermediate).TLSCapable().NotExpired().NotRevoked()
+	if *program != "" {
+		q = q.IncludedIn(*program)
+	}
+	sha256Fingerprints := ccadb_data.ListFingerprintsWhere(q)
+	// Every candidate's certificate is examined, so parse them all up front.
+	ccadb_data.PreloadParsedCACertificates()
+
+	var certificates []*certificate
+	unparsed := 0
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		cert := ccadb_data.GetParsedCACertificateBySHA256(sha256Fingerprint)
+		if cert == nil {
+			logger.Warn("CA certificate could not be parsed", zap.String("sha256_fingerprint", fmt.Sprintf("%X", sha256Fingerprint)))
+			unparsed++
+			continue
+		}
+		eku := unrestrictedEKU(cert)
+		if eku == "" {
+			continue
+		}
+		certificates = append(certificates, newCertificate(sha256Fingerprint, eku))
+	}
+	slices.SortFunc(certificates, func(a, b *certificate) int {
+		return cmp.Or(cmp.Compare(a.CAOwner, b.CAOwner), cmp.Compare(a.CertificateName, b.CertificateName), cmp.Compare(a.SHA256Fingerprint, b.SHA256Fingerprint))
+	})
+
+	encoder := json.NewEncoder(os.Stdout)
+	for i, c := range certificates {
+		tally.Add(c.EKU, 1)
+		if *format == "json" {
+			if err = encoder.Encode(c); err != nil {
+				logger.Fatal("Output could not be written", zap.Error(err))
+			}
+			continue
+		}
+		// Group the certificates by CA Owner, which they are ordered by.
+		if i == 0 || c.CAOwner != certificates[i-1].CAOwner {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Println(c.CAOwner)
+		}
+		fmt.Printf("  %s  %s  %s\n", c.ValidTo, c.SHA256Fingerprint, strings.ReplaceAll(c.CertificateName, "\n", " "))
+		details := []string{"EKU: " + c.EKU}
+		if c.SubordinateCAOwner != "" {
+			details = append(details, "Subordinate CA Owner: "+c.SubordinateCAOwner)
+		}
+		details = append(details, "Capabilities: "+strings.Join(c.Capabilities, ", "))
+		if len(c.TrustedBy) > 0 {
+			details = append(details, "Trusted by: "+strings.Join(c.TrustedBy, ", "))
+		}
+		fmt.Printf("    %s\n", strings.Join(details, "; "))
+	}
+
+	tally.Add("intermediates", len(sha256Fingerprints))
+	tally.Add("unparsed", unparsed)
+	tally.Exit(len(certificates))
+}
+
+// unrestrictedEKU returns why the certificate's extended key usage is unrestricted, or "" if it is restricted.
+func unrestrictedEKU(cert *x509.Certificate) string {
+	switch {
+	case len(cert.ExtKeyUsage) == 0 && len(cert.UnknownExtKeyUsage) == 0:
+		return EKU_ABSENT
+	case slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageAny):
+		return EKU_ANY
+	default:
+		return ""
+	}
+}
+
+func newCertificate(sha256Fingerprint [sha256.Size]byte, eku string) *certificate {
+	cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+	ccc := ccadb_data.GetCACertCapabilitiesBySHA256(sha256Fingerprint)
+	c := &certificate{
+		SHA256Fingerprint:  fmt.Sprintf("%X", sha256Fingerprint),
+		CertificateName:    cr.CertificateName,
+		CAOwner:            cr.CAOwner,
+		SubordinateCAOwner: cr.SubordinateCAOwner,
+		ValidTo:            cr.ValidTo.Format(time.DateOnly),
+		EKU:                eku,
+		Capabilities:       []string{},
+		TrustedBy:          []string{},
+	}
+	for _, capability := range []struct {
+		name    string
+		capable bool
+	}{
+		{"TLS", ccc.TlsCapable},
+		{"TLS EV", ccc.TlsEvCapable},
+		{"S/MIME", ccc.SmimeCapable},
+		{"Code Signing", ccc.CodeSigningCapable},
+	} {
+		if capability.capable {
+			c.Capabilities = append(c.Capabilities, capability.name)
+		}
+	}
+	for _, rootProgram := range rootPrograms {
+		if status := ccadb_data.GetRootProgramStatusBySHA256(sha256Fingerprint, rootProgram); status == ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED || status == ccadb_data.ROOT_PROGRAM_STATUS_TRUSTED {
+			c.TrustedBy = append(c.TrustedBy, rootProgram)
+		}
+	}
+	return c
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+// The expiring CA certificates of one CA Owner, written as one JSON object per line.
+type owner struct {
+	CAOwner      string         `json:"ca_owner"`
+	Certificates []*certificate `json:"certificates"`
+}
+
+type certificate struct {
+	SHA256Fingerprint     string   `json:"sha256_fingerprint"`
+	CertificateName       string   `json:"certificate_name"`
+	CertificateRecordType string   `json:"certificate_record_type"`
+	ValidTo               string   `json:"valid_to"`
+	DaysLeft              int      `json:"days_left"`
+	Capabilities          []string `json:"capabilities"`
+	TrustedBy             []string `json:"trusted_by"`
+	HasReplacement        bool     `json:"has_replacement"`
+}
+
+func main() {
+	days := flag.Int("days", 90, "Report CA certificates that expire within this many days")
+	format := flag.String("format", "text", "Output format: text, or json (one JSON object per CA Owner per line)")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-days N] [-format text|json] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 || *days < 0 || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("expiring")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	now := time.Now().UTC()
+	encoder := json.NewEncoder(os.Stdout)
+	withoutReplacement := 0
+	for i, eco := range ccadb_data.ListExpiringCACertificates(now, time.Duration(*days)*24*time.Hour) {
+		o := &owner{CAOwner: eco.CAOwner}
+		for _, ecc := range eco.Certificates {
+			c := &certificate{
+				SHA256Fingerprint:     fmt.Sprintf("%X", ecc.SHA256Fingerprint),
+				CertificateName:       ecc.CertificateName,
+				CertificateRecordType: ecc.CertificateRecordType.String(),
+				ValidTo:               ecc.ValidTo.Format(time.DateOnly),
+				DaysLeft:              int(ecc.ValidTo.Sub(now).Hours() / 24),
+				Capabilities:          []string{},
+				TrustedBy:             []string{},
+				HasReplacement:        ecc.HasReplacement,
+			}
+			for _, capability := range []struct {
+				name    string
+				capable bool
+			}{
+				{"TLS", ecc.Capabilities.TlsCapable},
+				{"TLS EV", ecc.Capabilities.TlsEvCapable},
+				{"S/MIME", ecc.Capabilities.SmimeCapable},
+				{"Code Signing", ecc.Capabilities.CodeSigningCapable},
+			} {
+				if capability.capable {
+					c.Capabilities = append(c.Capabilities, capability.name)
+				}
+			}
+			c.TrustedBy = append(c.TrustedBy, ecc.TrustedBy...)
+			if !c.HasReplacement {
+				withoutReplacement++
+			}
+			o.Certificates = append(o.Certificates, c)
+			tally.Add("certificates", 1)
+		}
+
+		if *format == "json" {
+			if err = encoder.Encode(o); err != nil {
+				logger.Fatal("Output could not be written", zap.Error(err))
+			}
+			continue
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Println(o.CAOwner)
+		for _, c := range o.Certificates {
+			fmt.Printf("  %s (%d days left)  %s  %s  %s\n", c.ValidTo, c.DaysLeft, c.SHA256Fingerprint, c.CertificateRecordType, strings.ReplaceAll(c.CertificateName, "\n", " "))
+			var details []string
+			if len(c.Capabilities) > 0 {
+				details = append(details, "Capabilities: "+strings.Join(c.Capabilities, ", "))
+			}
+			if len(c.TrustedBy) > 0 {
+				details = append(details, "Trusted by: "+strings.Join(c.TrustedBy, ", "))
+			}
+			if !c.HasReplacement {
+				details = append(details, "NO REPLACEMENT")
+			}
+			fmt.Printf("    %s\n", strings.Join(details, "; "))
+		}
+	}
+
+	// Trust paths through a CA certificate's key break when it expires without a replacement.
+	tally.Add("without_replacement", withoutReplacement)
+	tally.Exit(withoutReplacement)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/crtsh/ccadb_data/internal/export"
+)
+
+// A field of a BigQuery table schema, as accepted by "bq load" and "bq mk".
+type bigQueryField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Mode        string `json:"mode"`
+	Description string `json:"description"`
+}
+
+// writeBigQuerySchema writes the BigQuery table schema that the records written by writeJSONL match. Each field's
+// description is the CSV header of its column.
+func writeBigQuerySchema(w io.Writer, columns []export.Column, records []*export.Record) error {
+	fields := make([]*bigQueryField, len(columns))
+	for i, c := range columns {
+		fields[i] = &bigQueryField{Name: c.Name, Type: "STRING", Mode: "NULLABLE", Description: c.Header}
+		switch c.Type {
+		case export.COLUMN_DATE:
+			fields[i].Type = "DATE"
+		case export.COLUMN_BOOLEAN:
+			fields[i].Type = "BOOL"
+		case export.COLUMN_STRING_LIST:
+			fields[i].Mode = "REPEATED"
+		}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(fields)
+}
+
+// writeJSONL writes each record as one JSON object per line, with dates written as YYYY-MM-DD, as BigQuery loads
+// them.
+func writeJSONL(w io.Writer, columns []export.Column, records []*export.Record) error {
+	bw := bufio.NewWriter(w)
+	encoder := json.NewEncoder(bw)
+	encoder.SetEscapeHTML(false)
+	for _, record := range records {
+		row := make(map[string]any, len(columns))
+		for i, c := range columns {
+			if t, ok := record.Values[i].(time.Time); ok {
+				row[c.Name] = t.Format(time.DateOnly)
+			} else {
+				row[c.Name] = record.Values[i]
+			}
+		}
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
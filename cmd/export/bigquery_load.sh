@@ -0,0 +1,17 @@
+#!/bin/bash
+# Loads an AllCertificateRecords CSV report into a BigQuery table, replacing its contents. Requires the bq tool from the
+# Google Cloud SDK, authenticated with access to the dataset.
+
+if [ -z "$1" ]; then
+  echo "Usage: $0 <dataset.table> [AllCertificateRecords CSV file]" >&2
+  exit 2
+fi
+
+set -e
+TMPDIR=`mktemp -d`
+trap "rm -rf $TMPDIR" EXIT
+cd `dirname $0`/../..
+
+go run ./cmd/export -format bigquery-schema -output $TMPDIR/schema.json $2
+go run ./cmd/export -format jsonl -output $TMPDIR/records.jsonl $2
+bq load --replace --source_format=NEWLINE_DELIMITED_JSON "$1" $TMPDIR/records.jsonl $TMPDIR/schema.json
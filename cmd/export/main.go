@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/export"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const DEFAULT_CSV_PATH = "full/data/AllCertificateRecordsCSVFormatV5"
+
+// Writers for each supported output format, indexed by the -format flag value.
+var writers = map[string]func(w io.Writer, columns []export.Column, records []*export.Record) error{
+	"parquet":         writeParquet,
+	"jsonl":           writeJSONL,
+	"bigquery-schema": writeBigQuerySchema,
+}
+
+func main() {
+	format := flag.String("format", "parquet", "Output format: parquet, jsonl (one JSON object per line), or bigquery-schema (the BigQuery table schema of the jsonl output)")
+	output := flag.String("output", "", "Write the output to this file instead of stdout")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format parquet|jsonl|bigquery-schema] [-output FILE] [-log-level LEVEL] [-log-format json|console] [AllCertificateRecords CSV file]\n(Defaults to %s.)\n", os.Args[0], DEFAULT_CSV_PATH)
+	}
+	flag.Parse()
+	write := writers[*format]
+	if flag.NArg() > 1 || write == nil {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("export")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	filePath := DEFAULT_CSV_PATH
+	if flag.NArg() == 1 {
+		filePath = flag.Arg(0)
+	}
+
+	columns, records, err := export.ReadReport(filePath)
+	if err != nil {
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
+	}
+
+	out, err := config.CreateOutput(*output)
+	if err != nil {
+		logger.Fatal("Output file could not be created", zap.Error(err))
+	}
+	if err = write(out, columns, records); err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	} else if err = out.Close(); err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+
+	logger.Info("Exported records", zap.Int("count", len(records)), zap.String("format", *format))
+	tally.Add("records", len(records))
+	tally.Exit(0)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"io"
+
+	"github.com/crtsh/ccadb_data/internal/export"
+	"github.com/parquet-go/parquet-go"
+)
+
+// Number of records per Parquet row group.
+const PARQUET_ROW_GROUP_SIZE = 4096
+
+// parquetNode returns the Parquet type of a column. Every column except a list is optional, since CCADB leaves many
+// fields empty.
+func parquetNode(c *export.Column) parquet.Node {
+	switch c.Type {
+	case export.COLUMN_DATE:
+		return parquet.Optional(parquet.Date())
+	case export.COLUMN_BOOLEAN:
+		return parquet.Optional(parquet.Leaf(parquet.BooleanType))
+	case export.COLUMN_STRING_LIST:
+		return parquet.List(parquet.String())
+	default:
+		return parquet.Optional(parquet.String())
+	}
+}
+
+// writeParquet writes the records as a Parquet file, with one column per CSV column.
+func writeParquet(w io.Writer, columns []export.Column, records []*export.Record) error {
+	group := make(parquet.Group, len(columns))
+	for i := range columns {
+		group[columns[i].Name] = parquetNode(&columns[i])
+	}
+	writer := parquet.NewGenericWriter[map[string]any](w, parquet.NewSchema("ccadb", group), parquet.Compression(&parquet.Zstd), parquet.PageBufferSize(1<<20))
+
+	rows := make([]map[string]any, 0, PARQUET_ROW_GROUP_SIZE)
+	for _, record := range records {
+		row := make(map[string]any, len(columns))
+		for i := range columns {
+			row[columns[i].Name] = record.Values[i]
+		}
+		if rows = append(rows, row); len(rows) == cap(rows) {
+			if _, err := writer.Write(rows); err != nil {
+				return err
+			} else if err = writer.Flush(); err != nil {
+				return err
+			}
+			rows = rows[:0]
+		}
+	}
+	if _, err := writer.Write(rows); err != nil {
+		return err
+	}
+	return writer.Close()
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+// An externally operated TLS capable intermediate certificate, written as one JSON object per line.
+type certificate struct {
+	SHA256Fingerprint  string   `json:"sha256_fingerprint"`
+	CertificateName    string   `json:"certificate_name"`
+	CAOwner            string   `json:"ca_owner"`
+	SubordinateCAOwner string   `json:"subordinate_ca_owner"`
+	UltimateRootOwner  string   `json:"ultimate_root_owner,omitempty"`
+	ValidTo            string   `json:"valid_to"`
+	Capabilities       []string `json:"capabilities"`
+	TrustedBy          []string `json:"trusted_by"`
+}
+
+func main() {
+	format := flag.String("format", "text", "Output format: text, or json (one JSON object per CA certificate per line)")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format text|json] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("externally_operated")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	encoder := json.NewEncoder(os.Stdout)
+	subordinateCAOwners := make(map[string]bool)
+	previousSubordinateCAOwner := ""
+	for _, eocc := range ccadb_data.ListExternallyOperatedCACertificates(time.Now().UTC()) {
+		c := &certificate{
+			SHA256Fingerprint:  fmt.Sprintf("%X", eocc.SHA256Fingerprint),
+			CertificateName:    eocc.CertificateName,
+			CAOwner:            eocc.CAOwner,
+			SubordinateCAOwner: eocc.SubordinateCAOwner,
+			UltimateRootOwner:  eocc.UltimateRootOwner,
+			ValidTo:            eocc.ValidTo.Format(time.DateOnly),
+			Capabilities:       []string{},
+			TrustedBy:          []string{},
+		}
+		for _, capability := range []struct {
+			name    string
+			capable bool
+		}{
+			{"TLS", eocc.Capabilities.TlsCapable},
+			{"TLS EV", eocc.Capabilities.TlsEvCapable},
+			{"S/MIME", eocc.Capabilities.SmimeCapable},
+			{"Code Signing", eocc.Capabilities.CodeSigningCapable},
+		} {
+			if capability.capable {
+				c.Capabilities = append(c.Capabilities, capability.name)
+			}
+		}
+		c.TrustedBy = append(c.TrustedBy, eocc.TrustedBy...)
+		subordinateCAOwners[c.SubordinateCAOwner] = true
+		tally.Add("certificates", 1)
+
+		if *format == "json" {
+			if err = encoder.Encode(c); err != nil {
+				logger.Fatal("Output could not be written", zap.Error(err))
+			}
+			continue
+		}
+		// Group the certificates by Subordinate CA Owner, which they are ordered by.
+		if c.SubordinateCAOwner != previousSubordinateCAOwner {
+			if previousSubordinateCAOwner != "" {
+				fmt.Println()
+			}
+			fmt.Println(c.SubordinateCAOwner)
+			previousSubordinateCAOwner = c.SubordinateCAOwner
+		}
+		fmt.Printf("  %s  %s  %s\n", c.ValidTo, c.SHA256Fingerprint, strings.ReplaceAll(c.CertificateName, "\n", " "))
+		details := []string{"CA Owner: " + c.CAOwner}
+		if c.UltimateRootOwner != "" && !strings.EqualFold(c.UltimateRootOwner, c.CAOwner) {
+			details = append(details, "Ultimate Root Owner: "+c.UltimateRootOwner)
+		}
+		details = append(details, "Capabilities: "+strings.Join(c.Capabilities, ", "))
+		if len(c.TrustedBy) > 0 {
+			details = append(details, "Trusted by: "+strings.Join(c.TrustedBy, ", "))
+		}
+		fmt.Printf("    %s\n", strings.Join(details, "; "))
+	}
+
+	tally.Add("subordinate_ca_owners", len(subordinateCAOwners))
+	tally.Exit(0)
+}
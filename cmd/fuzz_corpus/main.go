@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+// The PEM report that the FuzzPEMCSV seeds are taken from.
+const PEM_CSV_PATH = ccadb_data.PEM_CSV_DIR + "/AllCertificatePEMsCSVFormat_NotBeforeYear_2020"
+
+// The columns of the All Certificate Records report whose values seed FuzzParseCRLURLs.
+var crlColumns = []string{"JSON Array of All Full CRL URLs", "JSON Array of Partitioned CRLs"}
+
+// The fuzz tests whose argument is a string rather than a []byte.
+var stringHarnesses = []string{"FuzzParseCCADBDate", "FuzzParseCRLURLs"}
+
+var logger *zap.Logger
+
+func main() {
+	dir := flag.String("dir", "testdata/fuzz", "Write each fuzz test's seed corpus to DIR/<fuzz test>, which is where go test reads it from when DIR is testdata/fuzz")
+	seeds := flag.Int("seeds", 10, "The maximum number of seeds to write for each fuzz test")
+	records := flag.Int("records", 3, "The number of records in each CSV seed, after its header")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-dir DIR] [-seeds N] [-records N] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 || *seeds < 1 || *records < 1 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("fuzz_corpus")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	// The seeds are taken from the full dataset, as embedded by the full subpackage.
+	fsys := full.FS()
+	ccadbRecords := readCSV(fsys, ccadb_data.CCADB_CSV_PATH)
+	corpora := map[string][][]byte{
+		"FuzzAllCertificateRecordsCSV": csvSeeds(ccadbRecords, *records, *seeds),
+		"FuzzReadCSVRecords":           csvSeeds(ccadbRecords, *records, *seeds),
+		"FuzzSKISPKISHA256CSV":         csvSeeds(readCSV(fsys, ccadb_data.SKI_SPKISHA256_PATH), *records, *seeds),
+		"FuzzDerivedSKICSV":            csvSeeds(readCSV(fsys, ccadb_data.DERIVED_SKI_PATH), *records, *seeds),
+		"FuzzPEMCSV":                   csvSeeds(readCSV(fsys, PEM_CSV_PATH), *records, *seeds),
+		"FuzzParseCCADBDate":           columnSeeds(ccadbRecords, func(name string) bool { return strings.Contains(name, "Date") || strings.HasPrefix(name, "Valid ") }, *seeds),
+		"FuzzParseCRLURLs":             columnSeeds(ccadbRecords, func(name string) bool { return slices.Contains(crlColumns, name) }, *seeds),
+	}
+
+	for harness, corpus := range corpora {
+		corpusDir := filepath.Join(*dir, harness)
+		if err = os.MkdirAll(corpusDir, 0755); err != nil {
+			logger.Fatal("Corpus directory could not be created", zap.Error(err), zap.String("dir", corpusDir))
+		}
+		// Like go test, name each input by its SHA-256 hash, so that rerunning this tool doesn't duplicate inputs.
+		for _, seed := range corpus {
+			data := corpusFile(seed, slices.Contains(stringHarnesses, harness))
+			sha256Hash := sha256.Sum256(data)
+			filePath := filepath.Join(corpusDir, fmt.Sprintf("%x", sha256Hash[:8]))
+			if err = os.WriteFile(filePath, data, 0644); err != nil {
+				logger.Fatal("Seed could not be written", zap.Error(err), zap.String("file_path", filePath))
+			}
+		}
+		tally.Add(harness, len(corpus))
+	}
+	tally.Exit(0)
+}
+
+// corpusFile encodes a seed in the corpus file format of go test, as the argument of a fuzz test that takes a []byte or
+// a string.
+func corpusFile(seed []byte, isString bool) []byte {
+	if isString {
+		return fmt.Appendf(nil, "go test fuzz v1\nstring(%q)\n", seed)
+	}
+	return fmt.Appendf(nil, "go test fuzz v1\n[]byte(%q)\n", seed)
+}
+
+// readCSV reads every record of a CSV file in the dataset, including its header.
+func readCSV(fsys fs.FS, filePath string) [][]string {
+	data, err := fs.ReadFile(fsys, filePath)
+	if err != nil {
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		logger.Fatal("CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
+	} else if len(records) < 2 {
+		logger.Fatal("CSV file has no records", zap.String("file_path", filePath))
+	}
+	return records
+}
+
+// csvSeeds returns up to maxSeeds CSV files, each of which has the header and recordsPerSeed consecutive records,
+// taken from throughout the file.
+func csvSeeds(records [][]string, recordsPerSeed, maxSeeds int) [][]byte {
+	header, body := records[0], records[1:]
+	step := max(len(body)/maxSeeds, recordsPerSeed)
+	var seeds [][]byte
+	for start := 0; start < len(body) && len(seeds) < maxSeeds; start += step {
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		writer.Write(header)
+		writer.WriteAll(body[start:min(start+recordsPerSeed, len(body))])
+		seeds = append(seeds, buf.Bytes())
+	}
+	return seeds
+}
+
+// columnSeeds returns up to maxSeeds distinct, non-empty values of the columns whose names match.
+func columnSeeds(records [][]string, matches func(name string) bool, maxSeeds int) [][]byte {
+	var columns []int
+	for i, name := range records[0] {
+		if matches(name) {
+			columns = append(columns, i)
+		}
+	}
+	seen := make(map[string]bool)
+	var seeds [][]byte
+	for _, record := range records[1:] {
+		for _, i := range columns {
+			if i >= len(record) || record[i] == "" || seen[record[i]] {
+				continue
+			} else if len(seeds) == maxSeeds {
+				return seeds
+			}
+			seen[record[i]] = true
+			seeds = append(seeds, []byte(record[i]))
+		}
+	}
+	return seeds
+}
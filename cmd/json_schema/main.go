@@ -0,0 +1,52 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+func main() {
+	output := flag.String("output", "", "Write the schema to this file instead of stdout")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-output FILE] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("json_schema")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	// Print the JSON Schema of the JSON representations of CA certificates, issuers, and search results, from which
+	// clients of the JSON endpoints can be generated.
+	schema := ccadb_data.JSONSchema()
+	out, err := config.CreateOutput(*output)
+	if err != nil {
+		logger.Fatal("Output file could not be created", zap.Error(err))
+	}
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(schema); err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	} else if err = out.Close(); err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+
+	tally.Add("definitions", len(schema["$defs"].(map[string]any)))
+	tally.Exit(0)
+}
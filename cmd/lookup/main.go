@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+type result struct {
+	Input                 string            `json:"input"`
+	SHA256Fingerprint     string            `json:"sha256_fingerprint"`
+	CertificateName       string            `json:"certificate_name"`
+	CAOwner               string            `json:"ca_owner"`
+	SubordinateCAOwner    string            `json:"subordinate_ca_owner,omitempty"`
+	UltimateRootOwner     string            `json:"ultimate_root_owner,omitempty"`
+	CertificateRecordType string            `json:"certificate_record_type"`
+	RevocationStatus      string            `json:"revocation_status,omitempty"`
+	ValidFrom             string            `json:"valid_from"`
+	ValidTo               string            `json:"valid_to"`
+	SubjectKeyIdentifier  string            `json:"subject_key_identifier,omitempty"`
+	RootProgramStatus     map[string]string `json:"root_program_status"`
+	Capabilities          []string          `json:"capabilities"`
+	ParentChain           []chainEntry      `json:"parent_chain"`
+	Record                map[string]string `json:"record"`
+	recordHeaders         []string
+}
+
+type chainEntry struct {
+	SHA256Fingerprint string `json:"sha256_fingerprint"`
+	CertificateName   string `json:"certificate_name"`
+	RevocationStatus  string `json:"revocation_status,omitempty"`
+}
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+var logger *zap.Logger
+
+func main() {
+	format := flag.String("format", "text", "Output format: text, csv, or json (one JSON object per line)")
+	search := flag.String("search", "", "Instead of identifiers, look up the CA certificates whose Certificate Name, CA Owner, or subject CN or O contains this case-insensitive text (or matches it, if it is a /regular expression/)")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format text|csv|json] [-log-level LEVEL] [-log-format json|console] <Certificate file | SHA-256 Fingerprint | Base64 Subject Key Identifier>...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [-format text|csv|json] [-log-level LEVEL] [-log-format json|console] - < identifiers.txt\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s [-format text|csv|json] [-log-level LEVEL] [-log-format json|console] -search QUERY\n", os.Args[0])
+	}
+	flag.Parse()
+	if (flag.NArg() == 0) == (*search == "") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("lookup")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	var w writer
+	switch *format {
+	case "text":
+		w = &textWriter{}
+	case "csv":
+		w = newCSVWriter(os.Stdout)
+	case "json":
+		w = &jsonWriter{encoder: json.NewEncoder(os.Stdout)}
+	default:
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+
+	ccadb_data.LoadRawRecords()
+	if *search != "" {
+		failed := searchRecords(*search, w, tally)
+		tally.Add("failed", failed)
+		tally.Exit(failed)
+	}
+
+	// Read newline-delimited identifiers from stdin if requested, otherwise use the command-line arguments.
+	inputs := flag.Args()
+	if len(inputs) == 1 && inputs[0] == "-" {
+		inputs = nil
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if input := strings.TrimSpace(scanner.Text()); input != "" {
+				inputs = append(inputs, input)
+			}
+		}
+		if err = scanner.Err(); err != nil {
+			logger.Fatal("Identifiers could not be read from stdin", zap.Error(err))
+		}
+	}
+
+	// Look up each identifier.
+	failed := 0
+	for _, input := range inputs {
+		sha256Fingerprints, err := identify(input)
+		if err == nil && len(sha256Fingerprints) == 0 {
+			err = fmt.Errorf("Not found in CCADB")
+		}
+		if err != nil {
+			failed++
+			if err = w.writeError(input, err); err != nil {
+				logger.Fatal("Output could not be written", zap.Error(err))
+			}
+			continue
+		}
+		for _, sha256Fingerprint := range sha256Fingerprints {
+			if r := lookup(sha256Fingerprint); r != nil {
+				tally.Add("found", 1)
+				r.Input = input
+				if err = w.write(r); err != nil {
+					logger.Fatal("Output could not be written", zap.Error(err))
+				}
+			}
+		}
+	}
+
+	if err = w.flush(); err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+	tally.Add("inputs", len(inputs))
+	tally.Add("failed", failed)
+	tally.Exit(failed)
+}
+
+// searchRecords looks up the CA certificates that match a search query, returning 1 if there are none (or the query is
+// invalid) and 0 otherwise.
+func searchRecords(query string, w writer, tally *summary.Summary) int {
+	// Load the certificates so that their subject names are searched too.
+	ccadb_data.LoadAllCACertificates()
+	results, err := ccadb_data.SearchRecords(query)
+	if err == nil && len(results) == 0 {
+		err = fmt.Errorf("Not found in CCADB")
+	}
+	failed := 0
+	if err != nil {
+		failed = 1
+		err = w.writeError(query, err)
+	}
+	for _, sr := range results {
+		if err != nil {
+			break
+		}
+		if r := lookup(sr.SHA256Fingerprint); r != nil {
+			tally.Add("found", 1)
+			r.Input = query
+			err = w.write(r)
+		}
+	}
+	if err == nil {
+		err = w.flush()
+	}
+	if err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+	return failed
+}
+
+// identify determines the SHA-256 fingerprint(s) of the CA certificate(s) identified by a command-line argument.
+func identify(arg string) ([][sha256.Size]byte, error) {
+	// Certificate file (PEM or DER).
+	if data, err := os.ReadFile(arg); err == nil {
+		if block, _ := pem.Decode(data); block != nil {
+			data = block.Bytes
+		}
+		sha256Fingerprint := sha256.Sum256(data)
+		if ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint) == nil {
+			return nil, nil
+		}
+		return [][sha256.Size]byte{sha256Fingerprint}, nil
+	}
+
+	// Hex-encoded SHA-256 fingerprint, in either case and optionally colon-separated.
+	if sha256Fingerprint, err := ccadb_data.ParseSHA256Fingerprint(arg); err == nil {
+		if ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint) == nil {
+			return nil, nil
+		}
+		return [][sha256.Size]byte{sha256Fingerprint}, nil
+	}
+
+	// Base64-encoded Subject Key Identifier.
+	if _, err := base64.StdEncoding.DecodeString(arg); err == nil {
+		return ccadb_data.GetSHA256FingerprintsByKeyIdentifier(arg), nil
+	}
+
+	return nil, fmt.Errorf("Not a certificate file, SHA-256 fingerprint, or Base64 Subject Key Identifier")
+}
+
+func lookup(sha256Fingerprint [sha256.Size]byte) *result {
+	cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+	if cr == nil {
+		return nil
+	}
+
+	r := &result{
+		SHA256Fingerprint:    strings.ToUpper(hex.EncodeToString(sha256Fingerprint[:])),
+		CertificateName:      cr.CertificateName,
+		CAOwner:              cr.CAOwner,
+		SubordinateCAOwner:   cr.SubordinateCAOwner,
+		RevocationStatus:     cr.RevocationStatus.String(),
+		ValidFrom:            cr.ValidFrom.Format(time.DateOnly),
+		ValidTo:              cr.ValidTo.Format(time.DateOnly),
+		SubjectKeyIdentifier: cr.SubjectKeyIdentifier,
+		RootProgramStatus:    make(map[string]string),
+		Capabilities:         []string{},
+		ParentChain:          []chainEntry{},
+		Record:               make(map[string]string),
+	}
+
+	r.UltimateRootOwner, _ = ccadb_data.GetUltimateRootOwner(sha256Fingerprint)
+	for _, rootProgram := range rootPrograms {
+		r.RootProgramStatus[rootProgram] = ccadb_data.GetRootProgramStatusBySHA256(sha256Fingerprint, rootProgram)
+	}
+
+	if ccc := ccadb_data.GetCACertCapabilitiesBySHA256(sha256Fingerprint); ccc != nil {
+		r.CertificateRecordType = ccc.CertificateRecordType.String()
+		for _, capability := range []struct {
+			name    string
+			capable bool
+		}{
+			{"TLS", ccc.TlsCapable},
+			{"TLS EV", ccc.TlsEvCapable},
+			{"S/MIME", ccc.SmimeCapable},
+			{"Code Signing", ccc.CodeSigningCapable},
+			{"VMC Audit", ccc.HasVMCAudit},
+		} {
+			if capability.capable {
+				r.Capabilities = append(r.Capabilities, capability.name)
+			}
+		}
+	}
+
+	// Walk the disclosed parent links up to the root.
+	seen := map[[sha256.Size]byte]bool{sha256Fingerprint: true}
+	for parent := cr.ParentSHA256Fingerprint; parent != [sha256.Size]byte{} && !seen[parent]; {
+		seen[parent] = true
+		entry := chainEntry{SHA256Fingerprint: strings.ToUpper(hex.EncodeToString(parent[:]))}
+		parentRecord := ccadb_data.GetCertificateRecordBySHA256(parent)
+		if parentRecord == nil {
+			r.ParentChain = append(r.ParentChain, entry)
+			break
+		}
+		entry.CertificateName = parentRecord.CertificateName
+		entry.RevocationStatus = parentRecord.RevocationStatus.String()
+		r.ParentChain = append(r.ParentChain, entry)
+		parent = parentRecord.ParentSHA256Fingerprint
+	}
+
+	if raw := ccadb_data.GetRawRecordBySHA256(sha256Fingerprint); raw != nil {
+		for i, v := range raw.Fields {
+			if v != "" && i < len(raw.Header) {
+				r.Record[raw.Header[i]] = v
+				r.recordHeaders = append(r.recordHeaders, raw.Header[i])
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// writer outputs lookup results in a particular format.
+type writer interface {
+	write(r *result) error
+	writeError(input string, err error) error
+	flush() error
+}
+
+// textWriter outputs human-readable results.
+type textWriter struct {
+	count int
+}
+
+func (w *textWriter) write(r *result) error {
+	if w.count++; w.count > 1 {
+		fmt.Println()
+	}
+	fmt.Printf("SHA-256 Fingerprint:     %s\n", r.SHA256Fingerprint)
+	fmt.Printf("Certificate Name:        %s\n", r.CertificateName)
+	fmt.Printf("CA Owner:                %s\n", r.CAOwner)
+	if r.SubordinateCAOwner != "" {
+		fmt.Printf("Subordinate CA Owner:    %s\n", r.SubordinateCAOwner)
+	}
+	if r.UltimateRootOwner != "" {
+		fmt.Printf("Ultimate Root Owner:     %s\n", r.UltimateRootOwner)
+	}
+	fmt.Printf("Certificate Record Type: %s\n", r.CertificateRecordType)
+	if r.RevocationStatus != "" {
+		fmt.Printf("Revocation Status:       %s\n", r.RevocationStatus)
+	}
+	fmt.Printf("Validity:                %s to %s\n", r.ValidFrom, r.ValidTo)
+	if r.SubjectKeyIdentifier != "" {
+		fmt.Printf("Subject Key Identifier:  %s\n", r.SubjectKeyIdentifier)
+	}
+	fmt.Printf("Capabilities:            %s\n", strings.Join(r.Capabilities, ", "))
+	fmt.Printf("Root Program Status:\n")
+	for _, rootProgram := range rootPrograms {
+		fmt.Printf("  %-10s %s\n", rootProgram+":", r.RootProgramStatus[rootProgram])
+	}
+	if len(r.ParentChain) > 0 {
+		fmt.Printf("Parent Chain:\n")
+		for _, entry := range r.ParentChain {
+			fmt.Printf("  %s %s", entry.SHA256Fingerprint, entry.CertificateName)
+			if entry.RevocationStatus != "" {
+				fmt.Printf(" (%s)", entry.RevocationStatus)
+			}
+			fmt.Println()
+		}
+	}
+	fmt.Printf("CCADB Record:\n")
+	for _, header := range r.recordHeaders {
+		fmt.Printf("  %s: %s\n", header, strings.ReplaceAll(r.Record[header], "\n", " "))
+	}
+	return nil
+}
+
+func (w *textWriter) writeError(input string, err error) error {
+	logger.Warn("Identifier could not be looked up", zap.Error(err), zap.String("input", input))
+	return nil
+}
+
+func (w *textWriter) flush() error {
+	return nil
+}
+
+// csvWriter outputs one CSV row per result.
+type csvWriter struct {
+	csvWriter *csv.Writer
+}
+
+func newCSVWriter(out io.Writer) *csvWriter {
+	w := &csvWriter{csvWriter: csv.NewWriter(out)}
+	header := []string{"Input", "SHA-256 Fingerprint", "Certificate Name", "CA Owner", "Subordinate CA Owner", "Certificate Record Type", "Revocation Status", "Valid From (GMT)", "Valid To (GMT)", "Subject Key Identifier"}
+	for _, rootProgram := range rootPrograms {
+		header = append(header, rootProgram+" Status")
+	}
+	w.csvWriter.Write(append(header, "Capabilities", "Parent SHA-256 Fingerprint", "Error"))
+	return w
+}
+
+func (w *csvWriter) write(r *result) error {
+	row := []string{r.Input, r.SHA256Fingerprint, r.CertificateName, r.CAOwner, r.SubordinateCAOwner, r.CertificateRecordType, r.RevocationStatus, r.ValidFrom, r.ValidTo, r.SubjectKeyIdentifier}
+	for _, rootProgram := range rootPrograms {
+		row = append(row, r.RootProgramStatus[rootProgram])
+	}
+	var parentSHA256 string
+	if len(r.ParentChain) > 0 {
+		parentSHA256 = r.ParentChain[0].SHA256Fingerprint
+	}
+	w.csvWriter.Write(append(row, strings.Join(r.Capabilities, ";"), parentSHA256, ""))
+	return w.csvWriter.Error()
+}
+
+func (w *csvWriter) writeError(input string, err error) error {
+	row := make([]string, 10+len(rootPrograms)+3)
+	row[0] = input
+	row[len(row)-1] = err.Error()
+	w.csvWriter.Write(row)
+	return w.csvWriter.Error()
+}
+
+func (w *csvWriter) flush() error {
+	w.csvWriter.Flush()
+	return w.csvWriter.Error()
+}
+
+// jsonWriter outputs one JSON object per line.
+type jsonWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonWriter) write(r *result) error {
+	return w.encoder.Encode(r)
+}
+
+func (w *jsonWriter) writeError(input string, err error) error {
+	return w.encoder.Encode(struct {
+		Input string `json:"input"`
+		Error string `json:"error"`
+	}{input, err.Error()})
+}
+
+func (w *jsonWriter) flush() error {
+	return nil
+}
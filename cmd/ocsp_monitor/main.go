@@ -0,0 +1,420 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/httpclient"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	MAX_CONCURRENCY        = 16
+	MAX_OCSP_RESPONSE_SIZE = 1 << 20
+	TEST_WEBSITE_COLUMN    = "Test Website URL - Valid"
+	SOURCE_CONFIGURED      = "configured"
+)
+
+var (
+	logger      *zap.Logger
+	httpClient  *http.Client
+	concurrency int
+	// The leaf certificates are verified against the issuers disclosed in CCADB rather than a local trust store, so the
+	// test websites are fetched without verifying their certificate chains.
+	testWebsiteClient *http.Client
+)
+
+// A certificate to query an OCSP responder about.
+type target struct {
+	ocspURL           string
+	caOwner           string
+	source            string
+	issuerFingerprint [sha256.Size]byte
+	serialNumber      *big.Int
+}
+
+// The outcome of querying an OCSP responder.
+type result struct {
+	URL               string    `json:"url"`
+	CAOwner           string    `json:"ca_owner"`
+	Source            string    `json:"source"`
+	IssuerFingerprint string    `json:"issuer_sha256_fingerprint,omitempty"`
+	SerialNumber      string    `json:"serial_number,omitempty"`
+	Status            string    `json:"status,omitempty"`
+	ProducedAt        time.Time `json:"produced_at,omitzero"`
+	ThisUpdate        time.Time `json:"this_update,omitzero"`
+	NextUpdate        time.Time `json:"next_update,omitzero"`
+	SignatureValid    bool      `json:"signature_valid"`
+	Error             string    `json:"error,omitempty"`
+	Latency           float64   `json:"latency_seconds"`
+	CheckedAt         time.Time `json:"checked_at"`
+	status            int
+}
+
+var (
+	labels           = []string{"url", "ca_owner", "serial_number"}
+	querySuccess     = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_query_success", Help: "Whether the OCSP responder returned a successful response that could be parsed."}, labels)
+	latency          = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_latency_seconds", Help: "How long the OCSP responder took to respond."}, labels)
+	certStatus       = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_cert_status", Help: "The certificate status in the OCSP response (0 = good, 1 = revoked, 2 = unknown)."}, labels)
+	producedAt       = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_produced_at_timestamp_seconds", Help: "The OCSP response's producedAt time."}, labels)
+	thisUpdate       = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_this_update_timestamp_seconds", Help: "The OCSP response's thisUpdate time."}, labels)
+	nextUpdate       = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_next_update_timestamp_seconds", Help: "The OCSP response's nextUpdate time."}, labels)
+	signatureValid   = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_signature_valid", Help: "Whether the OCSP response's signature was verified with the issuer's public key, directly or via a delegated responder."}, labels)
+	lastCheck        = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "ccadb_ocsp_last_check_timestamp_seconds", Help: "When the OCSP responder was last queried."}, labels)
+	targetsMonitored = prometheus.NewGauge(prometheus.GaugeOpts{Name: "ccadb_ocsp_monitored", Help: "Number of certificates whose OCSP responders are being queried."})
+	lastPassDuration = prometheus.NewGauge(prometheus.GaugeOpts{Name: "ccadb_ocsp_last_pass_duration_seconds", Help: "How long the last pass over every OCSP responder took."})
+)
+
+func main() {
+	interval := flag.Duration("interval", 15*time.Minute, "How often to query every OCSP responder")
+	listen := flag.String("listen", ":9101", "Address on which to serve Prometheus metrics at /metrics")
+	once := flag.Bool("once", false, "Query every OCSP responder once, print the results as JSON (one object per line), and exit")
+	targetsPath := flag.String("targets", "", "CSV file of OCSP URL, issuer SHA-256 fingerprint, and hex serial number to query, instead of the test websites")
+	output := flag.String("output", "", "With -once, write the output to this file instead of stdout")
+	flag.IntVar(&concurrency, "concurrency", MAX_CONCURRENCY, "Maximum number of OCSP responders to query at once")
+	excludes := config.AddExcludesFlag(flag.CommandLine)
+	httpFlags := httpclient.AddFlags(flag.CommandLine, httpclient.Config{Timeout: time.Duration(30) * time.Second})
+	logFlags := logging.AddFlags(flag.CommandLine)
+	configPath := config.AddFlag(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-config FILE] [-log-level LEVEL] [-log-format json|console] [-interval DURATION] [-listen ADDRESS] [-once [-output FILE]] [-targets FILE] [-concurrency N] [-exclude PATTERN]... [-proxy URL] [-cafile FILE] [-insecure] [-timeout DURATION] [CA Owner]\n", os.Args[0])
+	}
+	flag.Parse()
+	if err := config.Apply(flag.CommandLine, "ocsp_monitor", *configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading configuration: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("ocsp_monitor")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+	if flag.NArg() > 1 || concurrency < 1 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	httpConfig, err := httpFlags.Config()
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	} else if httpClient, err = httpclient.New(httpConfig); err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+	httpConfig.Insecure = true
+	if testWebsiteClient, err = httpclient.New(httpConfig); err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+	testWebsiteClient.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+
+	// The issuers' public keys are needed to build OCSP requests and verify OCSP responses.
+	ccadb_data.PreloadParsedCACertificates()
+	var configured []*target
+	if *targetsPath != "" {
+		if configured, err = readTargets(*targetsPath); err != nil {
+			logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", *targetsPath))
+		}
+	} else {
+		ccadb_data.LoadRawRecords()
+	}
+
+	// The test websites' certificates are refetched on every pass, because they're replaced as they expire.
+	targets := func() []*target {
+		if *targetsPath != "" {
+			return configured
+		}
+		return testWebsiteTargets(flag.Arg(0), excludes)
+	}
+
+	if *once {
+		out, err := config.CreateOutput(*output)
+		if err != nil {
+			logger.Fatal("Output file could not be created", zap.Error(err))
+		}
+		defer out.Close()
+		encoder := json.NewEncoder(out)
+		results := checkAll(targets())
+		failed := 0
+		for _, r := range results {
+			if r.Error != "" || !r.SignatureValid {
+				failed++
+			}
+			if err = encoder.Encode(r); err != nil {
+				logger.Fatal("Output could not be written", zap.Error(err))
+			}
+		}
+		tally.Add("responders", len(results))
+		tally.Add("failed", failed)
+		tally.Exit(failed)
+	}
+
+	prometheus.MustRegister(querySuccess, latency, certStatus, producedAt, thisUpdate, nextUpdate, signatureValid, lastCheck, targetsMonitored, lastPassDuration)
+	go func() {
+		for {
+			start := time.Now()
+			results := checkAll(targets())
+			lastPassDuration.Set(time.Since(start).Seconds())
+			failed := 0
+			for _, r := range results {
+				if r.Error != "" || !r.SignatureValid {
+					failed++
+				}
+			}
+			logger.Info("Queried OCSP responders", zap.Int("count", len(results)), zap.Int("failed_count", failed), zap.Duration("duration", time.Since(start)))
+			time.Sleep(*interval)
+		}
+	}()
+
+	http.Handle("/metrics", promhttp.Handler())
+	if err = http.ListenAndServe(*listen, nil); err != nil {
+		logger.Fatal("Metrics could not be served", zap.Error(err), zap.String("address", *listen))
+	}
+}
+
+// readTargets reads a CSV file of OCSP URL, issuer SHA-256 fingerprint, and hex-encoded serial number. Lines that start
+// with "#" are ignored.
+func readTargets(filePath string) ([]*target, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = 3
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []*target
+	for i, record := range records {
+		t := &target{ocspURL: record[0], source: SOURCE_CONFIGURED, serialNumber: new(big.Int)}
+		if t.issuerFingerprint, err = ccadb_data.ParseSHA256Fingerprint(record[1]); err != nil {
+			return nil, fmt.Errorf("Line %d: %w", i+1, err)
+		}
+		if _, ok := t.serialNumber.SetString(strings.ReplaceAll(record[2], ":", ""), 16); !ok {
+			return nil, fmt.Errorf("Line %d: Invalid serial number %q", i+1, record[2])
+		}
+		cr := ccadb_data.GetCertificateRecordBySHA256(t.issuerFingerprint)
+		if cr == nil {
+			return nil, fmt.Errorf("Line %d: Issuer %X is not disclosed in CCADB", i+1, t.issuerFingerprint)
+		}
+		t.caOwner = cr.CAOwner
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+// testWebsiteTargets fetches the certificate served by each valid test website disclosed for an unexpired CA certificate
+// that CCADB doesn't consider to be revoked, optionally only for one CA Owner or Subordinate CA Owner. Certificates that
+// have no OCSP URL are skipped, as are excluded test websites and OCSP URLs.
+func testWebsiteTargets(caOwnerFilter string, excludes *config.Excludes) []*target {
+	testWebsites := make(map[string]bool)
+	for _, sha256Fingerprint := range ccadb_data.ListFingerprints(ccadb_data.CapabilityFilter{ValidAt: time.Now(), ExcludeRevoked: true}) {
+		cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+		if caOwnerFilter != "" && cr.CAOwner != caOwnerFilter && cr.SubordinateCAOwner != caOwnerFilter {
+			continue
+		}
+		if raw := ccadb_data.GetRawRecordBySHA256(sha256Fingerprint); raw != nil {
+			for _, u := range ccadb_data.ExtractURLs(raw.Header, raw.Fields) {
+				if u.Column == TEST_WEBSITE_COLUMN && !excludes.Match(u.URL) {
+					testWebsites[u.URL] = true
+				}
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	var targets []*target
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for testWebsite := range testWebsites {
+		semaphore <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+			t, err := testWebsiteTarget(testWebsite)
+			if err != nil {
+				logger.Warn("OCSP target could not be determined from test website", zap.Error(err), zap.String("url", testWebsite))
+				return
+			} else if t != nil && !excludes.Match(t.ocspURL) {
+				mu.Lock()
+				targets = append(targets, t)
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return targets
+}
+
+// testWebsiteTarget fetches the certificate served by a test website, and finds its issuer in CCADB.
+func testWebsiteTarget(testWebsite string) (*target, error) {
+	resp, err := testWebsiteClient.Head(testWebsite)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return nil, fmt.Errorf("No certificate was served")
+	}
+	leaf := resp.TLS.PeerCertificates[0]
+	if len(leaf.OCSPServer) == 0 {
+		return nil, nil
+	}
+
+	for _, sha256Fingerprint := range ccadb_data.GetSHA256FingerprintsByKeyIdentifierBytes(leaf.AuthorityKeyId) {
+		if issuer := ccadb_data.GetParsedCACertificateBySHA256(sha256Fingerprint); issuer != nil && leaf.CheckSignatureFrom(issuer) == nil {
+			return &target{
+				ocspURL:           leaf.OCSPServer[0],
+				caOwner:           ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint).CAOwner,
+				source:            testWebsite,
+				issuerFingerprint: sha256Fingerprint,
+				serialNumber:      leaf.SerialNumber,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("Issuer of the served certificate is not disclosed in CCADB")
+}
+
+// checkAll queries every OCSP responder, updates the metrics, and returns the results sorted by URL and serial number.
+func checkAll(targets []*target) []*result {
+	targetsMonitored.Set(float64(len(targets)))
+	var mu sync.Mutex
+	var results []*result
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for _, t := range targets {
+		semaphore <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+			r := check(t)
+			updateMetrics(r)
+			mu.Lock()
+			results = append(results, r)
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	slices.SortFunc(results, func(a, b *result) int {
+		return cmp.Or(strings.Compare(a.URL, b.URL), strings.Compare(a.SerialNumber, b.SerialNumber))
+	})
+	return results
+}
+
+// check queries an OCSP responder about a certificate, and verifies the response's signature.
+func check(t *target) *result {
+	r := &result{URL: t.ocspURL, CAOwner: t.caOwner, Source: t.source, IssuerFingerprint: fmt.Sprintf("%X", t.issuerFingerprint), SerialNumber: fmt.Sprintf("%X", t.serialNumber), CheckedAt: time.Now().UTC()}
+	issuer := ccadb_data.GetParsedCACertificateBySHA256(t.issuerFingerprint)
+	if issuer == nil {
+		r.Error = "Issuer certificate is not available"
+		return r
+	}
+	// Only the serial number of the certificate is needed to build the request.
+	cert := &x509.Certificate{SerialNumber: t.serialNumber}
+	request, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	start := time.Now()
+	resp, err := httpClient.Post(t.ocspURL, "application/ocsp-request", bytes.NewReader(request))
+	r.Latency = time.Since(start).Seconds()
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		r.Error = fmt.Sprintf("HTTP %d", resp.StatusCode)
+		return r
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MAX_OCSP_RESPONSE_SIZE+1))
+	r.Latency = time.Since(start).Seconds()
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	} else if len(data) > MAX_OCSP_RESPONSE_SIZE {
+		r.Error = fmt.Sprintf("OCSP response is larger than %d bytes", MAX_OCSP_RESPONSE_SIZE)
+		return r
+	}
+
+	// Parse the response without checking its signature first, so that an invalid signature can be told apart from a
+	// malformed or unsuccessful response.
+	response, err := ocsp.ParseResponseForCert(data, cert, nil)
+	if err != nil {
+		var responseError ocsp.ResponseError
+		if errors.As(err, &responseError) {
+			r.Error = fmt.Sprintf("OCSP response status: %s", responseError.Status)
+		} else {
+			r.Error = err.Error()
+		}
+		return r
+	}
+	r.status = response.Status
+	r.Status = statusString(response.Status)
+	r.ProducedAt, r.ThisUpdate, r.NextUpdate = response.ProducedAt, response.ThisUpdate, response.NextUpdate
+	_, err = ocsp.ParseResponseForCert(data, cert, issuer)
+	r.SignatureValid = err == nil
+	return r
+}
+
+func statusString(status int) string {
+	switch status {
+	case ocsp.Good:
+		return "good"
+	case ocsp.Revoked:
+		return "revoked"
+	default:
+		return "unknown"
+	}
+}
+
+// updateMetrics records the outcome of querying an OCSP responder.
+func updateMetrics(r *result) {
+	lv := []string{r.URL, r.CAOwner, r.SerialNumber}
+	querySuccess.WithLabelValues(lv...).Set(boolToFloat(r.Error == ""))
+	latency.WithLabelValues(lv...).Set(r.Latency)
+	signatureValid.WithLabelValues(lv...).Set(boolToFloat(r.SignatureValid))
+	lastCheck.WithLabelValues(lv...).Set(float64(r.CheckedAt.Unix()))
+	if r.Error == "" {
+		certStatus.WithLabelValues(lv...).Set(float64(r.status))
+		producedAt.WithLabelValues(lv...).Set(float64(r.ProducedAt.Unix()))
+		thisUpdate.WithLabelValues(lv...).Set(float64(r.ThisUpdate.Unix()))
+		if !r.NextUpdate.IsZero() {
+			nextUpdate.WithLabelValues(lv...).Set(float64(r.NextUpdate.Unix()))
+		}
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	OUTPUT_PATH    = "roots/roots.go"
+	CCADB_CSV_PATH = "full/data/AllCertificateRecordsCSVFormatV5"
+)
+
+// A group of root certificates to generate a variable for.
+type group struct {
+	name        string
+	description string
+	statusCol   string
+	capableCol  string
+}
+
+var groups = []group{
+	{"Apple", "included in the Apple root store", "Apple Status", ""},
+	{"AppleTLS", "included in the Apple root store and capable of issuing TLS certificates", "Apple Status", "TLS Capable"},
+	{"AppleSMIME", "included in the Apple root store and capable of issuing S/MIME certificates", "Apple Status", "S/MIME Capable"},
+	{"Chrome", "included in the Chrome Root Store", "Chrome Status", ""},
+	{"Microsoft", "included in the Microsoft root store", "Microsoft Status", ""},
+	{"MicrosoftTLS", "included in the Microsoft root store and capable of issuing TLS certificates", "Microsoft Status", "TLS Capable"},
+	{"MicrosoftSMIME", "included in the Microsoft root store and capable of issuing S/MIME certificates", "Microsoft Status", "S/MIME Capable"},
+	{"Mozilla", "included in the Mozilla root store", "Mozilla Status", ""},
+	{"MozillaTLS", "included in the Mozilla root store and capable of issuing TLS certificates", "Mozilla Status", "TLS Capable"},
+	{"MozillaSMIME", "included in the Mozilla root store and capable of issuing S/MIME certificates", "Mozilla Status", "S/MIME Capable"},
+}
+
+type root struct {
+	name              string
+	sha256Fingerprint []byte
+}
+
+var logger *zap.Logger
+
+func main() {
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console]\n(Run from the repository root, after fetching the CCADB CSV reports.)\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("roots_gen")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+
+	data, err := os.ReadFile(CCADB_CSV_PATH)
+	if err != nil {
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", CCADB_CSV_PATH))
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		logger.Fatal("CSV file could not be parsed", zap.Error(err), zap.String("file_path", CCADB_CSV_PATH))
+	} else if len(records) == 0 {
+		logger.Fatal("CSV file is empty", zap.String("file_path", CCADB_CSV_PATH))
+	}
+
+	// Examine the CSV header to find the fields that we need.
+	idx := make(map[string]int)
+	for i, name := range records[0] {
+		idx[name] = i
+	}
+	for _, name := range []string{"Certificate Name", "Certificate Record Type", "SHA-256 Fingerprint"} {
+		if _, ok := idx[name]; !ok {
+			logger.Fatal("CSV data is missing one or more expected headers", zap.String("file_path", CCADB_CSV_PATH), zap.String("header", name))
+		}
+	}
+	for _, g := range groups {
+		for _, name := range []string{g.statusCol, g.capableCol} {
+			if _, ok := idx[name]; !ok && name != "" {
+				logger.Fatal("CSV data is missing one or more expected headers", zap.String("file_path", CCADB_CSV_PATH), zap.String("header", name))
+			}
+		}
+	}
+
+	// Find the included root certificates in each group.
+	roots := make(map[string][]root)
+	for _, record := range records[1:] {
+		if len(record) != len(records[0]) || record[idx["Certificate Record Type"]] != "Root Certificate" {
+			continue
+		}
+		sha256Fingerprint, err := hex.DecodeString(record[idx["SHA-256 Fingerprint"]])
+		if err != nil || len(sha256Fingerprint) != 32 {
+			continue
+		}
+		for _, g := range groups {
+			if record[idx[g.statusCol]] != "Included" || (g.capableCol != "" && record[idx[g.capableCol]] != "True") {
+				continue
+			}
+			r := root{name: record[idx["Certificate Name"]], sha256Fingerprint: sha256Fingerprint}
+			if !slices.ContainsFunc(roots[g.name], func(other root) bool { return bytes.Equal(other.sha256Fingerprint, r.sha256Fingerprint) }) {
+				roots[g.name] = append(roots[g.name], r)
+			}
+		}
+	}
+
+	if err = os.WriteFile(OUTPUT_PATH, generate(roots), 0644); err != nil {
+		logger.Fatal("Generated code could not be written", zap.Error(err), zap.String("file_path", OUTPUT_PATH))
+	}
+	for _, g := range groups {
+		tally.Add(g.name, len(roots[g.name]))
+	}
+	tally.Exit(0)
+}
+
+func generate(roots map[string][]root) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by cmd/roots_gen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package roots\n\n")
+	fmt.Fprintf(&buf, "import \"crypto/sha256\"\n")
+	for _, g := range groups {
+		slices.SortFunc(roots[g.name], func(a, b root) int {
+			return cmp.Or(strings.Compare(a.name, b.name), bytes.Compare(a.sha256Fingerprint, b.sha256Fingerprint))
+		})
+		fmt.Fprintf(&buf, "\n// SHA-256 fingerprints of the root certificates that are %s.\n", g.description)
+		fmt.Fprintf(&buf, "var %s = [][sha256.Size]byte{\n", g.name)
+		for _, r := range roots[g.name] {
+			fmt.Fprintf(&buf, "\t{")
+			for i, b := range r.sha256Fingerprint {
+				if i > 0 {
+					fmt.Fprintf(&buf, ", ")
+				}
+				fmt.Fprintf(&buf, "0x%02X", b)
+			}
+			fmt.Fprintf(&buf, "}, // %s\n", strings.ReplaceAll(r.name, "\n", " "))
+		}
+		fmt.Fprintf(&buf, "}\n")
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		logger.Fatal("Generated code could not be formatted", zap.Error(err))
+	}
+	return formatted
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const DEFAULT_CSV_PATH = "full/data/AllCertificateRecordsCSVFormatV5"
+
+var logger *zap.Logger
+
+func main() {
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console] [AllCertificateRecords CSV file]\n(Defaults to %s.)\n", os.Args[0], DEFAULT_CSV_PATH)
+	}
+	flag.Parse()
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("schema")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+	filePath := DEFAULT_CSV_PATH
+	if flag.NArg() == 1 {
+		filePath = flag.Arg(0)
+	}
+
+	// Read the CSV header.
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", filePath))
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})))
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if err != nil {
+		logger.Fatal("CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
+	}
+
+	// Print each column, marking the ones that are parsed and flagging the ones that are unknown.
+	schema := ccadb_data.DescribeCSVHeader(header)
+	fmt.Printf("Format version: %d\n\n", schema.FormatVersion)
+	changes := 0
+	for _, column := range schema.Columns {
+		switch {
+		case column.Parsed:
+			fmt.Printf("  PARSED   %s\n", column.Name)
+			tally.Add("parsed", 1)
+		case column.Known:
+			fmt.Printf("           %s\n", column.Name)
+			tally.Add("known", 1)
+		default:
+			fmt.Printf("  NEW      %s\n", column.Name)
+			tally.Add("new", 1)
+			changes++
+		}
+	}
+	for _, name := range schema.Missing {
+		fmt.Printf("  MISSING  %s\n", name)
+		tally.Add("missing", 1)
+		changes++
+	}
+
+	// Exit with a distinct status when the columns have changed, so that this can be automated.
+	if changes > 0 {
+		fmt.Printf("\nThe CSV columns have changed since this package was last updated.\n")
+	}
+	tally.Exit(changes)
+}
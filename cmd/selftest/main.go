@@ -0,0 +1,161 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	// The minimum fraction of the records in the embedded report that must be loaded.
+	MIN_RECORD_FRACTION = 0.99
+	// The minimum fraction of the loaded records that must be found by looking up their Subject Key Identifier.
+	MIN_SKI_COVERAGE = 0.99
+)
+
+// Root certificates that are expected to be in CCADB for the foreseeable future. If any of these is missing, then the
+// data is almost certainly truncated or misparsed.
+var anchors = []struct {
+	name              string
+	sha256Fingerprint string
+}{
+	{"ISRG Root X1", "96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6"},
+	{"ISRG Root X2", "69729B8E15A86EFC177A57AFB7171DFC64ADD28C2FCA8CF1507E34453CCB1470"},
+	{"DigiCert Global Root G2", "CB3CCBB76031E5E0138F8DD39A23F9DE47FFC35E43C1144CEA27D46A5AB1CB5F"},
+	{"GTS Root R1", "D947432ABDE7B7FA90FC2E6B59101B1280E0E1C7E4E40FA3C6887FFF57A7F4CF"},
+}
+
+// A root certificate that Mozilla and Chrome distrust for TLS certificates issued after a date in the past, which is
+// only known from their constraints reports.
+const ENTRUST_ROOT_CA_G2_SHA256 = "43DF5774B03E7FEF5FE40D931A7BEDF1BB2E6B42738C4E6D3841103D3AA7F339"
+
+// A CT log that is expected to stay in the CT log list, which keeps retired logs.
+const GOOGLE_ICARUS_LOG_ID = "KTxRllTIOWW6qlD8WAfUt2+/WHopctykwwz05UVH9Hg="
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+// Number of checks that passed and failed.
+var passed, failed int
+
+func main() {
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("selftest")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	// Load the embedded data in the same way as the library, with the full subpackage imported.
+	s := ccadb_data.GetDefaultStore()
+	check("Dataset loads without error", s.Err() == nil, "%v", s.Err())
+
+	// The embedded files must match the checksums that were recorded by cmd/dataset_info.
+	matched := 0
+	for _, filePath := range slices.Sorted(maps.Keys(ccadb_data.DatasetChecksums)) {
+		if data, err := fs.ReadFile(full.FS(), filePath); err != nil {
+			check("Checksum of "+filePath, false, "%v", err)
+		} else if sha256Hash := sha256.Sum256(data); hex.EncodeToString(sha256Hash[:]) != ccadb_data.DatasetChecksums[filePath] {
+			check("Checksum of "+filePath, false, "%x", sha256Hash)
+		} else {
+			matched++
+		}
+	}
+	check("Embedded file checksums", matched == len(ccadb_data.DatasetChecksums), "%d of %d match", matched, len(ccadb_data.DatasetChecksums))
+
+	// The files that the Store has read must have been verified against the embedded manifest.
+	mc := s.GetManifestCheck()
+	check("Dataset manifest", mc.OK(), "%d verified, %d mismatched, %d unlisted", len(mc.Verified), len(mc.Mismatched), len(mc.Unlisted))
+
+	// Nearly every record in the report must have been loaded.
+	sha256Fingerprints := s.ListFingerprints(ccadb_data.CapabilityFilter{})
+	check("Records loaded", float64(len(sha256Fingerprints)) >= MIN_RECORD_FRACTION*ccadb_data.RecordCount, "%d of %d", len(sha256Fingerprints), ccadb_data.RecordCount)
+
+	// Each root program must include some root certificates.
+	for _, rootProgram := range rootPrograms {
+		roots := s.ListFingerprints(ccadb_data.CapabilityFilter{RecordType: ccadb_data.RecordTypeRoot, RootProgram: rootProgram})
+		check(rootProgram+" root certificates", len(roots) > 0, "%d", len(roots))
+	}
+
+	// Well-known root certificates must be present.
+	for _, anchor := range anchors {
+		sha256Fingerprint, _ := ccadb_data.HexFingerprintToArray(anchor.sha256Fingerprint)
+		ccc := s.GetCACertCapabilitiesBySHA256(sha256Fingerprint)
+		check(anchor.name+" present", ccc != nil && ccc.CertificateRecordType == ccadb_data.RecordTypeRoot, "%s", anchor.sha256Fingerprint)
+	}
+
+	// The root program constraints reports must be present, and must distrust a root certificate that they are known to.
+	for _, filePath := range []string{ccadb_data.MOZILLA_INCLUDED_CA_REPORT_PATH, ccadb_data.CHROME_ROOT_STORE_PATH} {
+		_, err = fs.Stat(full.FS(), filePath)
+		check("Constraints report "+filePath, err == nil, "%v", err)
+	}
+	entrustRootCAG2, _ := ccadb_data.HexFingerprintToArray(ENTRUST_ROOT_CA_G2_SHA256)
+	entrustConstraints := s.GetRootStoreConstraintsBySHA256(entrustRootCAG2)
+	check("Entrust Root Certification Authority - G2 distrusted for TLS", s.IsDistrustedForTLSAfter(entrustRootCAG2, time.Now()), "%d sets of constraints", len(entrustConstraints))
+
+	// The CT log list must be present, and must describe a well-known log.
+	_, err = fs.Stat(full.FS(), ccadb_data.CT_LOG_LIST_PATH)
+	check("CT log list "+ccadb_data.CT_LOG_LIST_PATH, err == nil, "%v", err)
+	icarusLogID, _ := base64.StdEncoding.DecodeString(GOOGLE_ICARUS_LOG_ID)
+	check("Google 'Icarus' log present", len(icarusLogID) == sha256.Size && s.GetCTLogByID([sha256.Size]byte(icarusLogID)) != nil, "%s", GOOGLE_ICARUS_LOG_ID)
+
+	// Nearly every record must be found by looking up its Subject Key Identifier.
+	found := 0
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		if cr := s.GetCertificateRecordBySHA256(sha256Fingerprint); cr != nil && cr.SubjectKeyIdentifier != "" && slices.Contains(s.GetSHA256FingerprintsByKeyIdentifier(cr.SubjectKeyIdentifier), sha256Fingerprint) {
+			found++
+		}
+	}
+	check("Subject Key Identifier coverage", len(sha256Fingerprints) > 0 && float64(found) >= MIN_SKI_COVERAGE*float64(len(sha256Fingerprints)), "%d of %d", found, len(sha256Fingerprints))
+
+	// The slim report must load the same records as the full report.
+	slim, err := ccadb_data.NewStore(ccadb_data.EmbeddedFS())
+	if err != nil {
+		check("Slim dataset loads without error", false, "%v", err)
+	} else {
+		slimFingerprints := slim.ListFingerprints(ccadb_data.CapabilityFilter{})
+		check("Slim dataset matches full dataset", slices.Equal(slimFingerprints, sha256Fingerprints), "%d and %d records", len(slimFingerprints), len(sha256Fingerprints))
+	}
+
+	// Exit with a distinct status when an invariant is violated, so that a release can be blocked.
+	if failed > 0 {
+		fmt.Printf("\nOne or more self-test checks failed.\n")
+	}
+	tally.Add("passed", passed)
+	tally.Add("failed", failed)
+	tally.Exit(failed)
+}
+
+// check prints the outcome of a self-test check.
+func check(name string, ok bool, format string, args ...any) {
+	status := "PASS"
+	if ok {
+		passed++
+	} else {
+		status = "FAIL"
+		failed++
+	}
+	fmt.Printf("  %s  %s (%s)\n", status, name, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	SIGNING_KEY_ENV = "DATASET_SIGNING_KEY"
+	SIG_SUFFIX      = ".sig"
+)
+
+func main() {
+	genKey := flag.Bool("genkey", false, "Generate a new key pair instead of signing")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console] <Data file>...\n       %s -genkey\n(The Base64 Ed25519 private key seed is read from $%s. Each signature is written to <Data file>%s.)\n", os.Args[0], os.Args[0], SIGNING_KEY_ENV, SIG_SUFFIX)
+	}
+	flag.Parse()
+	if *genKey != (flag.NArg() == 0) {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("sign_dataset")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+
+	if *genKey {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			logger.Fatal("Key could not be generated", zap.Error(err))
+		}
+		fmt.Printf("%s (repository secret): %s\n", SIGNING_KEY_ENV, base64.StdEncoding.EncodeToString(privateKey.Seed()))
+		fmt.Printf("DATASET_SIGNING_PUBLIC_KEY (signature.go): %s\n", base64.StdEncoding.EncodeToString(publicKey))
+		tally.Exit(0)
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(os.Getenv(SIGNING_KEY_ENV))
+	if err != nil || len(seed) != ed25519.SeedSize {
+		logger.Fatal("Environment variable is not a Base64 Ed25519 private key seed", zap.String("name", SIGNING_KEY_ENV))
+	}
+	privateKey := ed25519.NewKeyFromSeed(seed)
+
+	for _, filePath := range flag.Args() {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			logger.Fatal("Data file could not be read", zap.Error(err), zap.String("file_path", filePath))
+		}
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, data)) + "\n"
+		if err = os.WriteFile(filePath+SIG_SUFFIX, []byte(signature), 0644); err != nil {
+			logger.Fatal("Signature could not be written", zap.Error(err), zap.String("file_path", filePath+SIG_SUFFIX))
+		}
+		tally.Add("signed", 1)
+	}
+	tally.Exit(0)
+}
@@ -2,3 +2,6 @@
 
 echo "Subject Key Identifier,SHA-256(Subject Public Key Info)" > ../../data/ski_spkisha256.csv
 go run main.go spki | sort | uniq >> ../../data/ski_spkisha256.csv
+
+echo "SHA-256 Fingerprint,Subject Key Identifier,SHA-256(Subject Public Key Info)" > ../../data/derived_ski.csv
+go run main.go derived | sort | uniq >> ../../data/derived_ski.csv
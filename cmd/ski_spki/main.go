@@ -1,33 +1,53 @@
 package main
 
 import (
+	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/x509"
-	"embed"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/base64"
 	"encoding/csv"
 	"encoding/pem"
+	"flag"
 	"fmt"
+	"io/fs"
 	"os"
 	"strings"
+
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
 )
 
-//go:embed data/*
-var files embed.FS
+// The PEM CSV reports, relative to this directory.
+const PEM_CSV_DIR = "../../full/cmd/ski_spki/data"
 
 func main() {
-	if len(os.Args) != 2 || os.Args[1] != "spki" {
-		fmt.Fprintf(os.Stderr, "Usage: %s <spki>\n", os.Args[0])
-		os.Exit(1)
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console] <spki | derived>\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 1 || (flag.Arg(0) != "spki" && flag.Arg(0) != "derived") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("ski_spki")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
 	}
 
-	if dirEntry, err := files.ReadDir("data"); err != nil {
-		panic(err)
+	files := os.DirFS(PEM_CSV_DIR)
+	if dirEntry, err := fs.ReadDir(files, "."); err != nil {
+		logger.Fatal("PEM data directory could not be read", zap.Error(err), zap.String("file_path", PEM_CSV_DIR))
 	} else {
 		for _, entry := range dirEntry {
 			var data []byte
-			if data, err = files.ReadFile("data/" + entry.Name()); err != nil {
-				panic(err)
+			if data, err = fs.ReadFile(files, entry.Name()); err != nil {
+				logger.Fatal("PEM CSV file could not be read", zap.Error(err), zap.String("file_path", entry.Name()))
 			}
 
 			reader := csv.NewReader(strings.NewReader(string(data)))
@@ -37,22 +57,45 @@ func main() {
 			reader.ReuseRecord = true
 			records, err := reader.ReadAll()
 			if err != nil {
-				panic(err)
+				logger.Fatal("PEM CSV file could not be parsed", zap.Error(err), zap.String("file_path", entry.Name()))
 			}
 
 			for _, record := range records[1:] {
 				var cert *x509.Certificate
 				if block, _ := pem.Decode([]byte(record[1])); block == nil {
-					panic(fmt.Errorf("Failed to decode PEM block from Certificate"))
-				} else if cert, err = x509.ParseCertificate(block.Bytes); err == nil && cert.SubjectKeyId != nil {
-					var sha256Hash [32]byte
-					switch os.Args[1] {
-					case "spki":
+					logger.Fatal("PEM CSV file contains an invalid PEM block", zap.String("file_path", entry.Name()), zap.String("sha256", record[0]))
+				} else if cert, err = x509.ParseCertificate(block.Bytes); err != nil {
+					tally.Add("unparsable", 1)
+					continue
+				}
+
+				var sha256Hash [32]byte
+				switch flag.Arg(0) {
+				case "spki":
+					if cert.SubjectKeyId != nil {
+						sha256Hash = sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+						fmt.Printf("%s,%s\n", base64.StdEncoding.EncodeToString(cert.SubjectKeyId), base64.StdEncoding.EncodeToString(sha256Hash[:]))
+						tally.Add("written", 1)
+					}
+				case "derived":
+					// Derive a key identifier for certificates that have no Subject Key Identifier, using method 1 of
+					// RFC 5280 section 4.2.1.2.
+					if cert.SubjectKeyId == nil {
+						var spki struct {
+							Algorithm        pkix.AlgorithmIdentifier
+							SubjectPublicKey asn1.BitString
+						}
+						if _, err = asn1.Unmarshal(cert.RawSubjectPublicKeyInfo, &spki); err != nil {
+							continue
+						}
+						keyIdentifier := sha1.Sum(spki.SubjectPublicKey.Bytes)
 						sha256Hash = sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+						fmt.Printf("%X,%s,%s\n", sha256.Sum256(cert.Raw), base64.StdEncoding.EncodeToString(keyIdentifier[:]), base64.StdEncoding.EncodeToString(sha256Hash[:]))
+						tally.Add("written", 1)
 					}
-					fmt.Printf("%s,%s\n", base64.StdEncoding.EncodeToString(cert.SubjectKeyId), base64.StdEncoding.EncodeToString(sha256Hash[:]))
 				}
 			}
 		}
 	}
+	tally.Exit(0)
 }
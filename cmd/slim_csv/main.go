@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	OUTPUT_PATH    = "data/AllCertificateRecordsSlim.csv"
+	CCADB_CSV_PATH = "full/data/AllCertificateRecordsCSVFormatV5"
+)
+
+func main() {
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console]\n(Run from the repository root, after fetching the CCADB CSV reports.)\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("slim_csv")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	data, err := os.ReadFile(CCADB_CSV_PATH)
+	if err != nil {
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", CCADB_CSV_PATH))
+	}
+	if data, err = ccadb_data.GenerateSlimCSV(data); err != nil {
+		logger.Fatal("Slim CSV file could not be generated", zap.Error(err), zap.String("file_path", OUTPUT_PATH))
+	} else if err = os.WriteFile(OUTPUT_PATH, data, 0644); err != nil {
+		logger.Fatal("Slim CSV file could not be written", zap.Error(err), zap.String("file_path", OUTPUT_PATH))
+	}
+	tally.Add("bytes", len(data))
+	tally.Exit(0)
+}
@@ -0,0 +1,29 @@
+package main
+
+// The header of the AllCertificateRecordsCSVFormatV5 report, in the order in which CCADB writes its columns.
+var v5Header = []string{
+	"CA Owner", "Salesforce Record ID", "Certificate Name", "Parent Salesforce Record ID", "Parent Certificate Name",
+	"Certificate Record Type", "Subordinate CA Owner", "Apple Status", "Chrome Status", "Microsoft Status",
+	"Mozilla Status", "Status of Root Cert", "Revocation Status", "SHA-256 Fingerprint", "Parent SHA-256 Fingerprint",
+	"Valid From (GMT)", "Valid To (GMT)", "Authority Key Identifier", "Subject Key Identifier",
+	"Technically Constrained", "Trust Bits for Root Cert", "EV OIDs for Root Cert", "Derived Trust Bits",
+	"JSON Array of All Full CRL URLs", "JSON Array of Partitioned CRLs", "DV ACME Directory URL(s)",
+	"OV ACME Directory URL(s)", "EV ACME Directory URL(s)", "IV ACME Directory URL(s)", "Audit Firm",
+	"Audit Firm Location", "Audits Same as Parent", "Standard Audit URL", "Standard Audit Type",
+	"Standard Audit Statement Date", "Standard Audit Period Start Date", "Standard Audit Period End Date",
+	"NetSec Audit URL", "NetSec Audit Type", "NetSec Audit Statement Date", "NetSec Audit Period Start Date",
+	"NetSec Audit Period End Date", "TLS BR Audit URL", "TLS BR Audit Type", "TLS BR Audit Statement Date",
+	"TLS BR Audit Period Start Date", "TLS BR Audit Period End Date", "TLS EVG Audit URL", "TLS EVG Audit Type",
+	"TLS EVG Audit Statement Date", "TLS EVG Audit Period Start Date", "TLS EVG Audit Period End Date",
+	"Code Signing Audit URL", "Code Signing Audit Type", "Code Signing Audit Statement Date",
+	"Code Signing Audit Period Start Date", "Code Signing Audit Period End Date", "S/MIME BR Audit URL",
+	"S/MIME BR Audit Type", "S/MIME BR Audit Statement Date", "S/MIME BR Audit Period Start Date",
+	"S/MIME BR Audit Period End Date", "VMC Audit URL", "VMC Audit Type", "VMC Audit Statement Date",
+	"VMC Audit Period Start Date", "VMC Audit Period End Date", "Policy Documentation", "CA Document Repository",
+	"CP Same as Parent", "Certificate Policy (CP) URL", "CP Effective Date", "CPS Same as Parent",
+	"Certificate Practice Statement (CPS) URL", "CPS Effective Date", "CP/CPS Same as Parent",
+	"Certificate Practice & Policy Statement", "CP/CPS Effective Date", "MD/AsciiDoc CP/CPS Same as Parent",
+	"MD/AsciiDoc CP/CPS URL", "MD/AsciiDoc CP/CPS Effective Date", "Test Website URL - Valid",
+	"Test Website URL - Expired", "Test Website URL - Revoked", "TLS Capable", "TLS EV Capable", "Code Signing Capable",
+	"S/MIME Capable", "Country",
+}
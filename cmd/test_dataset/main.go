@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+const (
+	TEST_CA_OWNER             = "CCADB Test CA Owner"
+	TEST_SUBORDINATE_CA_OWNER = "CCADB Test Subordinate CA Owner"
+	// CRL URLs use the .test TLD, which is reserved for testing (RFC 6761), so they never resolve.
+	TEST_CRL_URL_PREFIX = "http://crl.ccadb.test/"
+)
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+// A fake CA certificate in the test dataset.
+type testCA struct {
+	name string
+	// The index of the issuing root in testCAs, or -1 for a root.
+	parent int
+	// Each root program's status, for roots. Intermediates are "Trusted" by the root programs that include their root,
+	// unless they are revoked or expired.
+	statuses           map[string]string
+	tls, tlsEV, smime  bool
+	codeSigning        bool
+	revoked, expired   bool
+	constrained        bool
+	subordinateCAOwner string
+
+	key  *ecdsa.PrivateKey
+	cert *x509.Certificate
+}
+
+// The CA certificates in the test dataset, which cover the capabilities, root program statuses, and revocation and
+// expiry states that the lookup API distinguishes between. Roots come before the intermediates that they issue.
+var testCAs = []*testCA{
+	{name: "CCADB Test Root CA", parent: -1, statuses: allPrograms(ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED), tls: true, tlsEV: true, smime: true},
+	{name: "CCADB Test TLS Issuing CA", parent: 0, tls: true},
+	{name: "CCADB Test EV TLS Issuing CA", parent: 0, tls: true, tlsEV: true},
+	{name: "CCADB Test S/MIME Issuing CA", parent: 0, smime: true},
+	{name: "CCADB Test Constrained TLS CA", parent: 0, tls: true, constrained: true, subordinateCAOwner: TEST_SUBORDINATE_CA_OWNER},
+	{name: "CCADB Test Revoked TLS CA", parent: 0, tls: true, revoked: true},
+	{name: "CCADB Test Expired TLS CA", parent: 0, tls: true, expired: true},
+	{name: "CCADB Test Code Signing Root CA", parent: -1, statuses: map[string]string{
+		ccadb_data.ROOT_PROGRAM_APPLE:     ccadb_data.ROOT_PROGRAM_STATUS_NOT_INCLUDED,
+		ccadb_data.ROOT_PROGRAM_CHROME:    ccadb_data.ROOT_PROGRAM_STATUS_NOT_INCLUDED,
+		ccadb_data.ROOT_PROGRAM_MICROSOFT: ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED,
+		ccadb_data.ROOT_PROGRAM_MOZILLA:   ccadb_data.ROOT_PROGRAM_STATUS_NOT_INCLUDED,
+	}, codeSigning: true},
+	{name: "CCADB Test Removed Root CA", parent: -1, statuses: map[string]string{
+		ccadb_data.ROOT_PROGRAM_APPLE:     ccadb_data.ROOT_PROGRAM_STATUS_NOT_INCLUDED,
+		ccadb_data.ROOT_PROGRAM_CHROME:    ccadb_data.ROOT_PROGRAM_STATUS_NOT_INCLUDED,
+		ccadb_data.ROOT_PROGRAM_MICROSOFT: ccadb_data.ROOT_PROGRAM_STATUS_DISABLED,
+		ccadb_data.ROOT_PROGRAM_MOZILLA:   ccadb_data.ROOT_PROGRAM_STATUS_REMOVED,
+	}, tls: true},
+}
+
+func allPrograms(status string) map[string]string {
+	statuses := make(map[string]string)
+	for _, rootProgram := range rootPrograms {
+		statuses[rootProgram] = status
+	}
+	return statuses
+}
+
+var logger *zap.Logger
+
+func main() {
+	dir := flag.String("dir", "", "Directory to write the test dataset into, using the dataset layout read by ccadb_data.NewStore (required)")
+	keysDir := flag.String("keys-dir", "", "Directory to write each test CA certificate's private key into, e.g. to issue test certificates")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-log-level LEVEL] [-log-format json|console] -dir DIRECTORY [-keys-dir DIRECTORY]\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 0 || *dir == "" {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("test_dataset")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	// Validity periods are relative to today, so that the dataset's expired and unexpired CA certificates stay so.
+	now := time.Now().UTC().Truncate(24 * time.Hour)
+	for i, ca := range testCAs {
+		if err = ca.issue(now, int64(i+1)); err != nil {
+			logger.Fatal("Test CA certificate could not be generated", zap.Error(err), zap.String("certificate_name", ca.name))
+		}
+	}
+
+	files := make(map[string][]byte)
+	if files[ccadb_data.CCADB_CSV_PATH], err = certificateRecordsCSV(now); err != nil {
+		logger.Fatal("CSV file could not be generated", zap.Error(err), zap.String("file_path", ccadb_data.CCADB_CSV_PATH))
+	} else if files[ccadb_data.SLIM_CSV_PATH], err = ccadb_data.GenerateSlimCSV(files[ccadb_data.CCADB_CSV_PATH]); err != nil {
+		logger.Fatal("Slim CSV file could not be generated", zap.Error(err), zap.String("file_path", ccadb_data.SLIM_CSV_PATH))
+	}
+	for year, data := range pemCSVs() {
+		files[ccadb_data.PEM_CSV_DIR+"/"+ccadb_data.PEM_CSV_FILENAME_PREFIX+strconv.Itoa(year)] = data
+	}
+	files[ccadb_data.SKI_SPKISHA256_PATH] = skiAndSPKISHA256CSV()
+	// Every test CA certificate has a Subject Key Identifier extension, so none needs a derived key identifier.
+	files[ccadb_data.DERIVED_SKI_PATH] = []byte("SHA-256 Fingerprint,Subject Key Identifier,SHA-256(Subject Public Key Info)\n")
+	files[ccadb_data.MOZILLA_INCLUDED_CA_REPORT_PATH] = mozillaIncludedCACertificateReport()
+	files[ccadb_data.CHROME_ROOT_STORE_PATH] = chromeRootStore()
+	if files[ccadb_data.CT_LOG_LIST_PATH], err = ctLogList(now); err != nil {
+		logger.Fatal("CT log list could not be generated", zap.Error(err), zap.String("file_path", ccadb_data.CT_LOG_LIST_PATH))
+	}
+	for filePath, data := range files {
+		writeFile(filepath.Join(*dir, filepath.FromSlash(filePath)), data)
+	}
+	manifest, err := ccadb_data.GenerateManifest(os.DirFS(*dir), now)
+	if err != nil {
+		logger.Fatal("Manifest could not be generated", zap.Error(err), zap.String("dir", *dir))
+	}
+	writeFile(filepath.Join(*dir, filepath.FromSlash(ccadb_data.MANIFEST_PATH)), manifest)
+
+	if *keysDir != "" {
+		for _, ca := range testCAs {
+			der, err := x509.MarshalPKCS8PrivateKey(ca.key)
+			if err != nil {
+				logger.Fatal("Private key could not be encoded", zap.Error(err), zap.String("certificate_name", ca.name))
+			}
+			writeFile(filepath.Join(*keysDir, fmt.Sprintf("%X.key", sha256.Sum256(ca.cert.Raw))), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+		}
+	}
+
+	// Check that the dataset loads, and list its CA certificates.
+	s, err := ccadb_data.NewStore(os.DirFS(*dir))
+	if err != nil {
+		logger.Fatal("Test dataset could not be loaded", zap.Error(err), zap.String("dir", *dir))
+	}
+	for _, ca := range testCAs {
+		sha256Fingerprint := sha256.Sum256(ca.cert.Raw)
+		if s.GetCertificateRecordBySHA256(sha256Fingerprint) == nil {
+			logger.Fatal("Test dataset is missing a CA certificate", zap.String("certificate_name", ca.name), zap.String("sha256_fingerprint", fmt.Sprintf("%X", sha256Fingerprint)))
+		}
+		fmt.Printf("%X  %s\n", sha256Fingerprint, ca.name)
+	}
+
+	tally.Add("certificates", len(testCAs))
+	tally.Add("files", len(files)+1)
+	tally.Exit(0)
+}
+
+// issue generates the CA certificate's key pair, and issues its certificate (self-signed, for a root).
+func (ca *testCA) issue(now time.Time, serialNumber int64) error {
+	var err error
+	if ca.key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+		return err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serialNumber),
+		Subject:               pkix.Name{Country: []string{"US"}, Organization: []string{cmp.Or(ca.subordinateCAOwner, TEST_CA_OWNER)}, CommonName: ca.name},
+		NotBefore:             now.AddDate(-1, 0, 0),
+		NotAfter:              now.AddDate(5, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	issuer, issuerKey := template, ca.key
+	if ca.parent == -1 {
+		template.NotAfter = now.AddDate(20, 0, 0)
+	} else {
+		issuer, issuerKey = testCAs[ca.parent].cert, testCAs[ca.parent].key
+		template.MaxPathLenZero = true
+		template.CRLDistributionPoints = []string{ca.crlURL()}
+		if ca.tls {
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth)
+		}
+		if ca.smime {
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageEmailProtection)
+		}
+		if ca.codeSigning {
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageCodeSigning)
+		}
+		if ca.constrained {
+			template.PermittedDNSDomainsCritical = true
+			template.PermittedDNSDomains = []string{"test"}
+		}
+		if ca.expired {
+			template.NotBefore, template.NotAfter = now.AddDate(-3, 0, 0), now.AddDate(-1, 0, 0)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, ca.key.Public(), issuerKey)
+	if err != nil {
+		return err
+	}
+	ca.cert, err = x509.ParseCertificate(der)
+	return err
+}
+
+// crlURL returns the (unresolvable) URL of the CA certificate's CRL.
+func (ca *testCA) crlURL() string {
+	return TEST_CRL_URL_PREFIX + strings.ReplaceAll(strings.ToLower(strings.ReplaceAll(ca.name, "/", "")), " ", "-") + ".crl"
+}
+
+// status returns the CA certificate's status in a root program.
+func (ca *testCA) status(rootProgram string) string {
+	if ca.parent == -1 {
+		return ca.statuses[rootProgram]
+	} else if !ca.revoked && !ca.expired && testCAs[ca.parent].statuses[rootProgram] == ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED {
+		return ccadb_data.ROOT_PROGRAM_STATUS_TRUSTED
+	}
+	return ccadb_data.ROOT_PROGRAM_STATUS_NOT_TRUSTED
+}
+
+// trustBits returns the CA certificate's trust bits, as CCADB writes them.
+func (ca *testCA) trustBits() string {
+	var trustBits []string
+	if ca.tls {
+		trustBits = append(trustBits, "Server Authentication", "Client Authentication")
+	}
+	if ca.smime {
+		trustBits = append(trustBits, "Secure Email")
+	}
+	if ca.codeSigning {
+		trustBits = append(trustBits, "Code Signing")
+	}
+	return strings.Join(trustBits, ";")
+}
+
+// certificateRecordsCSV produces the AllCertificateRecordsCSVFormatV5 report.
+func certificateRecordsCSV(now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(v5Header)
+	for i, ca := range testCAs {
+		root := ca
+		if ca.parent != -1 {
+			root = testCAs[ca.parent]
+		}
+		auditPeriodEnd := now.AddDate(0, -2, 0)
+		fields := map[string]string{
+			"CA Owner":                          TEST_CA_OWNER,
+			"Salesforce Record ID":              salesforceRecordID(i),
+			"Certificate Name":                  ca.name,
+			"Subordinate CA Owner":              ca.subordinateCAOwner,
+			"Apple Status":                      ca.status(ccadb_data.ROOT_PROGRAM_APPLE),
+			"Chrome Status":                     ca.status(ccadb_data.ROOT_PROGRAM_CHROME),
+			"Microsoft Status":                  ca.status(ccadb_data.ROOT_PROGRAM_MICROSOFT),
+			"Mozilla Status":                    ca.status(ccadb_data.ROOT_PROGRAM_MOZILLA),
+			"Status of Root Cert":               fmt.Sprintf("Apple: %s; Google Chrome: %s; Microsoft: %s; Mozilla: %s", root.statuses[ccadb_data.ROOT_PROGRAM_APPLE], root.statuses[ccadb_data.ROOT_PROGRAM_CHROME], root.statuses[ccadb_data.ROOT_PROGRAM_MICROSOFT], root.statuses[ccadb_data.ROOT_PROGRAM_MOZILLA]),
+			"SHA-256 Fingerprint":               fmt.Sprintf("%X", sha256.Sum256(ca.cert.Raw)),
+			"Valid From (GMT)":                  ca.cert.NotBefore.Format(time.DateOnly),
+			"Valid To (GMT)":                    ca.cert.NotAfter.Format(time.DateOnly),
+			"Subject Key Identifier":            ccadb_data.KeyIdentifierToBase64(ca.cert.SubjectKeyId),
+			"Technically Constrained":           capitalizedBool(ca.constrained),
+			"Audit Firm":                        "CCADB Test Auditor",
+			"Audits Same as Parent":             capitalizedBool(ca.parent != -1),
+			"Standard Audit Type":               "WebTrust",
+			"Standard Audit Statement Date":     auditPeriodEnd.AddDate(0, 1, 0).Format(time.DateOnly),
+			"Standard Audit Period Start Date":  auditPeriodEnd.AddDate(-1, 0, 1).Format(time.DateOnly),
+			"Standard Audit Period End Date":    auditPeriodEnd.Format(time.DateOnly),
+			"CP Same as Parent":                 "False",
+			"CPS Same as Parent":                "False",
+			"CP/CPS Same as Parent":             "False",
+			"MD/AsciiDoc CP/CPS Same as Parent": "False",
+			"TLS Capable":                       capitalizedBool(ca.tls),
+			"TLS EV Capable":                    capitalizedBool(ca.tlsEV),
+			"Code Signing Capable":              capitalizedBool(ca.codeSigning),
+			"S/MIME Capable":                    capitalizedBool(ca.smime),
+			"Country":                           "United States of America",
+		}
+		if ca.parent == -1 {
+			fields["Certificate Record Type"] = ccadb_data.CCADB_RECORD_ROOT
+			fields["Parent Certificate Name"] = TEST_CA_OWNER
+			fields["Trust Bits for Root Cert"] = ca.trustBits()
+		} else {
+			fields["Certificate Record Type"] = ccadb_data.CCADB_RECORD_INTERMEDIATE
+			fields["Parent Salesforce Record ID"] = salesforceRecordID(ca.parent)
+			fields["Parent Certificate Name"] = root.name
+			fields["Parent SHA-256 Fingerprint"] = fmt.Sprintf("%X", sha256.Sum256(root.cert.Raw))
+			fields["Authority Key Identifier"] = ccadb_data.KeyIdentifierToBase64(ca.cert.AuthorityKeyId)
+			fields["Derived Trust Bits"] = ca.trustBits()
+			fields["JSON Array of All Full CRL URLs"] = `["` + ca.crlURL() + `"]`
+			fields["Revocation Status"] = ccadb_data.CCADB_NOT_REVOKED
+			if ca.revoked {
+				fields["Revocation Status"] = ccadb_data.CCADB_REVOKED
+			}
+		}
+
+		record := make([]string, len(v5Header))
+		for j, name := range v5Header {
+			record[j] = fields[name]
+		}
+		w.Write(record)
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// pemCSVs produces the AllCertificatePEMsCSVFormat reports, indexed by the year of the certificates' NotBefore dates.
+func pemCSVs() map[int][]byte {
+	buffers := make(map[int]*bytes.Buffer)
+	writers := make(map[int]*csv.Writer)
+	for _, ca := range testCAs {
+		year := ca.cert.NotBefore.Year()
+		if writers[year] == nil {
+			buffers[year] = &bytes.Buffer{}
+			writers[year] = csv.NewWriter(buffers[year])
+			writers[year].Write([]string{"SHA-256 Fingerprint", "X.509 Certificate (PEM)"})
+		}
+		writers[year].Write([]string{fmt.Sprintf("%X", sha256.Sum256(ca.cert.Raw)), string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}))})
+	}
+
+	pemCSVs := make(map[int][]byte)
+	for year, w := range writers {
+		w.Flush()
+		pemCSVs[year] = buffers[year].Bytes()
+	}
+	return pemCSVs
+}
+
+// skiAndSPKISHA256CSV produces the SKI to SHA-256(SubjectPublicKeyInfo) CSV.
+func skiAndSPKISHA256CSV() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("Subject Key Identifier,SHA-256(Subject Public Key Info)\n")
+	for _, ca := range testCAs {
+		spkiSHA256 := ccadb_data.SPKIHashOf(ca.cert)
+		fmt.Fprintf(&buf, "%s,%s\n", ccadb_data.KeyIdentifierToBase64(ca.cert.SubjectKeyId), base64.StdEncoding.EncodeToString(spkiSHA256[:]))
+	}
+	return buf.Bytes()
+}
+
+// mozillaIncludedCACertificateReport produces Mozilla's Included CA Certificate Report, which lists the roots included
+// by Mozilla. None of them is constrained.
+func mozillaIncludedCACertificateReport() []byte {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"Owner", "Common Name or Certificate Name", "SHA-256 Fingerprint", "Distrust for TLS After Date", "Distrust for S/MIME After Date", "Mozilla Applied Constraints"})
+	for _, ca := range testCAs {
+		if ca.parent == -1 && ca.statuses[ccadb_data.ROOT_PROGRAM_MOZILLA] == ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED {
+			w.Write([]string{TEST_CA_OWNER, ca.name, fmt.Sprintf("%X", sha256.Sum256(ca.cert.Raw)), "", "", ""})
+		}
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+// chromeRootStore produces the Chrome Root Store's root_store.textproto, which lists the roots included by Chrome.
+// None of them is constrained.
+func chromeRootStore() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("# proto-file: chrome_root_store.proto\n# proto-message: RootStore\n\nversion_major: 1\n")
+	for _, ca := range testCAs {
+		if ca.parent == -1 && ca.statuses[ccadb_data.ROOT_PROGRAM_CHROME] == ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED {
+			fmt.Fprintf(&buf, "\n# %s\ntrust_anchors {\n  sha256_hex: \"%x\"\n}\n", ca.name, sha256.Sum256(ca.cert.Raw))
+		}
+	}
+	return buf.Bytes()
+}
+
+// ctLogList produces a v3 CT log list, which lists one usable test log with a newly generated key.
+func ctLogList(now time.Time) ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	spki, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	logID := sha256.Sum256(spki)
+	return fmt.Appendf(nil, `{
+  "version": "1.0",
+  "log_list_timestamp": %q,
+  "operators": [
+    {
+      "name": "CCADB Test CT Log Operator",
+      "email": [],
+      "logs": [
+        {
+          "description": "CCADB Test CT Log",
+          "log_id": %q,
+          "key": %q,
+          "url": "https://ct.ccadb.test/",
+          "mmd": 86400,
+          "state": {"usable": {"timestamp": %q}}
+        }
+      ]
+    }
+  ]
+}
+`, now.Format(time.RFC3339), base64.StdEncoding.EncodeToString(logID[:]), base64.StdEncoding.EncodeToString(spki), now.AddDate(-1, 0, 0).Format(time.RFC3339)), nil
+}
+
+// salesforceRecordID returns a fake, but well-formed, 18-character Salesforce Account record ID.
+func salesforceRecordID(i int) string {
+	return fmt.Sprintf("001TEST%011d", i+1)
+}
+
+// capitalizedBool formats a boolean as CCADB does.
+func capitalizedBool(b bool) string {
+	if b {
+		return "True"
+	}
+	return "False"
+}
+
+// writeFile writes a file of the test dataset, creating its directory if need be.
+func writeFile(filePath string, data []byte) {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		logger.Fatal("Directory could not be created", zap.Error(err), zap.String("file_path", filePath))
+	} else if err = os.WriteFile(filePath, data, 0644); err != nil {
+		logger.Fatal("File could not be written", zap.Error(err), zap.String("file_path", filePath))
+	}
+}
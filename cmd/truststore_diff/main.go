@@ -0,0 +1,152 @@
+package main
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+// Problems that can be reported for a certificate that the local trust store trusts.
+const (
+	PROBLEM_UNDISCLOSED  = "undisclosed"
+	PROBLEM_REVOKED      = "revoked"
+	PROBLEM_REMOVED      = "removed"
+	PROBLEM_NOT_INCLUDED = "not_included"
+	PROBLEM_DISTRUSTED   = "distrusted"
+)
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+// A locally trusted certificate with at least one problem, written as one JSON object per line.
+type difference struct {
+	SHA256Fingerprint string            `json:"sha256_fingerprint"`
+	Label             string            `json:"label"`
+	Subject           string            `json:"subject,omitempty"`
+	CertificateName   string            `json:"certificate_name,omitempty"`
+	CAOwner           string            `json:"ca_owner,omitempty"`
+	RootProgramStatus map[string]string `json:"root_program_status,omitempty"`
+	Problems          []string          `json:"problems"`
+}
+
+func main() {
+	storeFormat := flag.String("store-format", "pem", "Trust store format: pem (a PEM bundle, or a directory of PEM or DER files), nss (an NSS certdata.txt file), apple (Apple's published list of trusted root certificates, saved as HTML), or microsoft (a Windows serialized certificate store, e.g. from certutil -generateSSTFromWU)")
+	program := flag.String("program", "", "Only compare against this root program's status: Apple, Chrome, Microsoft, or Mozilla (default: every root program)")
+	format := flag.String("format", "text", "Output format: text, or json (one JSON object per line)")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-store-format pem|nss|apple|microsoft] [-program NAME] [-format text|json] [-log-level LEVEL] [-log-format json|console] <trust store path>\n", os.Args[0])
+	}
+	flag.Parse()
+	readStore := storeReaders[*storeFormat]
+	programs := rootPrograms
+	if *program != "" {
+		programs = []string{*program}
+	}
+	if flag.NArg() != 1 || readStore == nil || (*program != "" && !slices.Contains(rootPrograms, *program)) || (*format != "text" && *format != "json") {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("truststore_diff")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	tcs, err := readStore(flag.Arg(0))
+	if err != nil {
+		logger.Fatal("Trust store could not be read", zap.Error(err), zap.String("file_path", flag.Arg(0)))
+	}
+
+	now := time.Now()
+	encoder := json.NewEncoder(os.Stdout)
+	seen := make(map[[sha256.Size]byte]bool)
+	differences := 0
+	for _, tc := range tcs {
+		sha256Fingerprint := tc.sha256Fingerprint()
+		if seen[sha256Fingerprint] {
+			continue
+		}
+		seen[sha256Fingerprint] = true
+		tally.Add("certificates", 1)
+
+		d := compare(sha256Fingerprint, tc, programs, now)
+		if len(d.Problems) == 0 {
+			continue
+		}
+		differences++
+		for _, problem := range d.Problems {
+			tally.Add(problem, 1)
+		}
+
+		if *format == "json" {
+			err = encoder.Encode(d)
+		} else {
+			_, err = fmt.Printf("%s  %s: %s\n", d.SHA256Fingerprint, strings.ReplaceAll(cmp.Or(d.CertificateName, d.Subject, d.Label), "\n", " "), strings.Join(d.Problems, ", "))
+		}
+		if err != nil {
+			logger.Fatal("Output could not be written", zap.Error(err))
+		}
+	}
+
+	tally.Add("differences", differences)
+	tally.Exit(differences)
+}
+
+// compare compares a locally trusted certificate with its CCADB record, if any. A certificate is removed if any of the
+// given root programs has removed, blocked, or disabled it, and not included if none of them include or trust it.
+func compare(sha256Fingerprint [sha256.Size]byte, tc *trustedCertificate, programs []string, now time.Time) *difference {
+	d := &difference{SHA256Fingerprint: fmt.Sprintf("%X", sha256Fingerprint), Label: tc.Label, Problems: []string{}}
+	if cert, err := x509.ParseCertificate(tc.DER); err == nil {
+		d.Subject = cert.Subject.String()
+	}
+
+	cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+	if cr == nil {
+		d.Problems = append(d.Problems, PROBLEM_UNDISCLOSED)
+		return d
+	}
+	d.CertificateName = cr.CertificateName
+	d.CAOwner = cr.CAOwner
+
+	if cr.RevocationStatus == ccadb_data.RevocationStatusRevoked || cr.RevocationStatus == ccadb_data.RevocationStatusParentRevoked {
+		d.Problems = append(d.Problems, PROBLEM_REVOKED)
+	}
+
+	d.RootProgramStatus = make(map[string]string)
+	removed, included := false, false
+	for _, rootProgram := range programs {
+		status := ccadb_data.GetRootProgramStatusBySHA256(sha256Fingerprint, rootProgram)
+		d.RootProgramStatus[rootProgram] = status
+		switch status {
+		case ccadb_data.ROOT_PROGRAM_STATUS_REMOVED, ccadb_data.ROOT_PROGRAM_STATUS_BLOCKED, ccadb_data.ROOT_PROGRAM_STATUS_DISABLED:
+			removed = true
+		case ccadb_data.ROOT_PROGRAM_STATUS_INCLUDED, ccadb_data.ROOT_PROGRAM_STATUS_TRUSTED:
+			included = true
+		}
+	}
+	if removed {
+		d.Problems = append(d.Problems, PROBLEM_REMOVED)
+	} else if !included {
+		d.Problems = append(d.Problems, PROBLEM_NOT_INCLUDED)
+	}
+
+	// Certificates issued today would be distrusted, e.g. because a "distrust after" date has passed.
+	if ccadb_data.IsDistrustedForTLSAfter(sha256Fingerprint, now) || ccadb_data.IsDistrustedForSMIMEAfter(sha256Fingerprint, now) {
+		d.Problems = append(d.Problems, PROBLEM_DISTRUSTED)
+	}
+	return d
+}
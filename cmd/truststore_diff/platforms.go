@@ -0,0 +1,124 @@
+package main
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+)
+
+var (
+	// The headings and table rows of an HTML page, in document order.
+	htmlHeadingOrRowRegexp = regexp.MustCompile(`(?is)<(h[1-6]|tr)\b[^>]*>(.*?)</(?:h[1-6]|tr)\s*>`)
+	htmlCellRegexp         = regexp.MustCompile(`(?is)<t[dh]\b[^>]*>(.*?)</t[dh]\s*>`)
+	htmlTagRegexp          = regexp.MustCompile(`(?s)<[^>]*>`)
+	// The separators between the hex digits of a fingerprint.
+	fingerprintSeparatorRegexp = regexp.MustCompile(`[\s:]+`)
+)
+
+// readAppleRootList reads Apple's published list of the root certificates that are available in an Apple OS release
+// (e.g. "List of available trusted root certificates in iOS 17, iPadOS 17, macOS 14, tvOS 17, and watchOS 10"), saved
+// as an HTML page. Only the certificates in its "Trusted certificates" section are trusted; those in its "Always Ask"
+// and "Blocked" sections are skipped. The list identifies each certificate by its SHA-256 fingerprint.
+func readAppleRootList(path string) ([]*trustedCertificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tcs []*trustedCertificate
+	trustedSection := false
+	for _, match := range htmlHeadingOrRowRegexp.FindAllStringSubmatch(string(data), -1) {
+		if !strings.EqualFold(match[1], "tr") {
+			trustedSection = strings.HasPrefix(strings.ToLower(htmlText(match[2])), "trusted")
+			continue
+		} else if !trustedSection {
+			continue
+		}
+
+		// The first cell is the certificate's name, and one of the others is its SHA-256 fingerprint.
+		cells := htmlCellRegexp.FindAllStringSubmatch(match[2], -1)
+		for _, cell := range cells {
+			if fingerprint, err := hex.DecodeString(fingerprintSeparatorRegexp.ReplaceAllString(htmlText(cell[1]), "")); err == nil && len(fingerprint) == sha256.Size {
+				tcs = append(tcs, &trustedCertificate{Label: htmlText(cells[0][1]), SHA256Fingerprint: [sha256.Size]byte(fingerprint)})
+				break
+			}
+		}
+	}
+	if len(tcs) == 0 {
+		return nil, errors.New("No trusted certificates found in Apple root list")
+	}
+	return tcs, nil
+}
+
+// htmlText returns the text of an HTML fragment, with its whitespace collapsed.
+func htmlText(fragment string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(htmlTagRegexp.ReplaceAllString(fragment, " "))), " ")
+}
+
+// The Windows serialized certificate store (.sst) format.
+const (
+	// A serialized store starts with a zero version, and then this magic.
+	SST_MAGIC = "CERT"
+	// The IDs of the elements that a serialized store consists of. A certificate's properties precede it, and an element
+	// with ID 0 ends the store.
+	SST_END_ID                = 0
+	SST_FRIENDLY_NAME_PROP_ID = 11
+	SST_CERTIFICATE_ID        = 32
+)
+
+// readMicrosoftSST reads a Windows serialized certificate store, such as the list of every root certificate in the
+// Microsoft Trusted Root Program that Windows Update distributes (written by certutil -generateSSTFromWU roots.sst), or
+// a certificate store exported from a Windows machine (e.g. by Get-ChildItem Cert:\LocalMachine\Root |
+// Export-Certificate -Type SST -FilePath roots.sst in PowerShell). Every certificate is assumed to be trusted, and is
+// labelled with its friendly name, if it has one.
+func readMicrosoftSST(path string) ([]*trustedCertificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	} else if len(data) < 8 || binary.LittleEndian.Uint32(data) != 0 || string(data[4:8]) != SST_MAGIC {
+		return nil, errors.New("Not a serialized certificate store")
+	}
+
+	var tcs []*trustedCertificate
+	friendlyName := ""
+	for rest := data[8:]; len(rest) >= 12; {
+		id, length := binary.LittleEndian.Uint32(rest), binary.LittleEndian.Uint32(rest[8:])
+		if id == SST_END_ID {
+			break
+		} else if uint64(length) > uint64(len(rest)-12) {
+			return nil, fmt.Errorf("Truncated element %d in serialized certificate store", id)
+		}
+		value := rest[12 : 12+length]
+		rest = rest[12+length:]
+
+		switch id {
+		case SST_FRIENDLY_NAME_PROP_ID:
+			friendlyName = utf16LEString(value)
+		case SST_CERTIFICATE_ID:
+			tcs = append(tcs, &trustedCertificate{Label: cmp.Or(friendlyName, filepath.Base(path)), DER: value})
+			friendlyName = ""
+		}
+	}
+	if len(tcs) == 0 {
+		return nil, errors.New("No certificates found in serialized certificate store")
+	}
+	return tcs, nil
+}
+
+// utf16LEString decodes a NUL-terminated UTF-16LE string.
+func utf16LEString(b []byte) string {
+	units := make([]uint16, len(b)/2)
+	for i := range units {
+		units[i] = binary.LittleEndian.Uint16(b[2*i:])
+	}
+	return strings.TrimRight(string(utf16.Decode(units)), "\x00")
+}
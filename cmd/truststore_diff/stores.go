@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// A certificate that a local trust store trusts.
+type trustedCertificate struct {
+	// Where the certificate was found: its file name, or its label in the trust store.
+	Label string
+	// Nil if the trust store only lists the certificate's SHA-256 fingerprint, in which case SHA256Fingerprint is set.
+	DER               []byte
+	SHA256Fingerprint [sha256.Size]byte
+}
+
+// Readers for each supported trust store format, indexed by the -store-format flag value.
+var storeReaders = map[string]func(path string) ([]*trustedCertificate, error){
+	"pem":       readPEMStore,
+	"nss":       readNSSCertdata,
+	"apple":     readAppleRootList,
+	"microsoft": readMicrosoftSST,
+}
+
+// sha256Fingerprint returns the SHA-256 fingerprint of the certificate.
+func (tc *trustedCertificate) sha256Fingerprint() [sha256.Size]byte {
+	if tc.DER == nil {
+		return tc.SHA256Fingerprint
+	}
+	return sha256.Sum256(tc.DER)
+}
+
+// readPEMStore reads a PEM bundle, or a directory of PEM or DER certificate files (e.g. /etc/ssl/certs). Every
+// certificate is assumed to be trusted. Files in a directory that don't contain certificates are skipped.
+func readPEMStore(path string) ([]*trustedCertificate, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return readCertificateFile(data, filepath.Base(path)), nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var tcs []*trustedCertificate
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		tcs = append(tcs, readCertificateFile(data, entry.Name())...)
+	}
+	return tcs, nil
+}
+
+// readCertificateFile returns the certificates in a PEM file, or the certificate in a DER file.
+func readCertificateFile(data []byte, name string) []*trustedCertificate {
+	var tcs []*trustedCertificate
+	for rest := data; ; {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		} else if block.Type == "CERTIFICATE" || block.Type == "TRUSTED CERTIFICATE" {
+			tcs = append(tcs, &trustedCertificate{Label: name, DER: block.Bytes})
+		}
+	}
+	// A DER certificate is an ASN.1 SEQUENCE.
+	if len(tcs) == 0 && len(data) > 0 && data[0] == 0x30 {
+		tcs = append(tcs, &trustedCertificate{Label: name, DER: data})
+	}
+	return tcs
+}
+
+// An object in an NSS certdata.txt file: its attributes, indexed by name. MULTILINE_OCTAL values are decoded.
+type nssObject map[string]string
+
+// NSS trust values that make a certificate a trust anchor.
+const NSS_TRUSTED_DELEGATOR = "CKT_NSS_TRUSTED_DELEGATOR"
+
+// readNSSCertdata reads an NSS certdata.txt file, such as the one that NSS builds its built-in root store from. A
+// certificate is trusted if its trust object makes it a trust anchor for server authentication or for email
+// protection.
+func readNSSCertdata(path string) ([]*trustedCertificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := parseNSSObjects(data)
+	if err != nil {
+		return nil, err
+	}
+
+	trusted := make(map[[sha1.Size]byte]bool)
+	for _, object := range objects {
+		if object["CKA_CLASS"] == "CKO_NSS_TRUST" && len(object["CKA_CERT_SHA1_HASH"]) == sha1.Size &&
+			(object["CKA_TRUST_SERVER_AUTH"] == NSS_TRUSTED_DELEGATOR || object["CKA_TRUST_EMAIL_PROTECTION"] == NSS_TRUSTED_DELEGATOR) {
+			trusted[[sha1.Size]byte([]byte(object["CKA_CERT_SHA1_HASH"]))] = true
+		}
+	}
+
+	var tcs []*trustedCertificate
+	for _, object := range objects {
+		if object["CKA_CLASS"] == "CKO_CERTIFICATE" && trusted[sha1.Sum([]byte(object["CKA_VALUE"]))] {
+			tcs = append(tcs, &trustedCertificate{Label: object["CKA_LABEL"], DER: []byte(object["CKA_VALUE"])})
+		}
+	}
+	return tcs, nil
+}
+
+// parseNSSObjects parses the objects in an NSS certdata.txt file. Each object starts with a CKA_CLASS attribute.
+func parseNSSObjects(data []byte) ([]nssObject, error) {
+	var objects []nssObject
+	var object nssObject
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || line == "BEGINDATA" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		name, attributeType := fields[0], fields[1]
+		if name == "CKA_CLASS" {
+			object = make(nssObject)
+			objects = append(objects, object)
+		} else if object == nil {
+			continue
+		}
+
+		switch attributeType {
+		case "MULTILINE_OCTAL":
+			var value []byte
+			for {
+				if !scanner.Scan() {
+					return nil, fmt.Errorf("Unterminated MULTILINE_OCTAL value for %s at line %d", name, lineNumber)
+				}
+				lineNumber++
+				octal := strings.TrimSpace(scanner.Text())
+				if octal == "END" {
+					break
+				}
+				for _, digits := range strings.Split(octal, `\`)[1:] {
+					b, err := strconv.ParseUint(digits, 8, 8)
+					if err != nil {
+						return nil, fmt.Errorf("Invalid octal value for %s at line %d: %w", name, lineNumber, err)
+					}
+					value = append(value, byte(b))
+				}
+			}
+			object[name] = string(value)
+		case "UTF8":
+			value, err := strconv.Unquote(strings.TrimSpace(strings.TrimPrefix(line, name+" "+attributeType)))
+			if err != nil {
+				return nil, fmt.Errorf("Invalid UTF8 value for %s at line %d: %w", name, lineNumber, err)
+			}
+			object[name] = value
+		default:
+			if len(fields) > 2 {
+				object[name] = fields[2]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	} else if len(objects) == 0 {
+		return nil, errors.New("No objects found in certdata.txt file")
+	}
+	return objects, nil
+}
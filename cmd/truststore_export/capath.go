@@ -0,0 +1,159 @@
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+)
+
+// ASN.1 string tags that encoding/asn1 doesn't define.
+const (
+	TAG_VISIBLE_STRING   = 26
+	TAG_UNIVERSAL_STRING = 28
+)
+
+// The ASN.1 string types whose values OpenSSL canonicalizes when hashing a name, by tag.
+var canonicalStringTags = map[int]bool{
+	asn1.TagUTF8String:      true,
+	asn1.TagPrintableString: true,
+	asn1.TagT61String:       true,
+	asn1.TagIA5String:       true,
+	TAG_VISIBLE_STRING:      true,
+	TAG_UNIVERSAL_STRING:    true,
+	asn1.TagBMPString:       true,
+}
+
+type attributeTypeAndValue struct {
+	Type  asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+// writeCApath writes an OpenSSL CApath directory, as c_rehash or openssl rehash would: each root certificate is written
+// to a PEM file named by its SHA-256 fingerprint, and linked to by a symlink named by the hash of its subject (e.g.
+// 5ad8a5d6.0), which is how OpenSSL finds a certificate's issuer in the directory. The directory is created if it
+// doesn't exist, and must be empty, so that it can't contain stale links.
+func writeCApath(dir string, roots []*root) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	} else if entries, err := os.ReadDir(dir); err != nil {
+		return err
+	} else if len(entries) > 0 {
+		return errors.New("Output directory is not empty")
+	}
+
+	links := make(map[uint32]int)
+	for _, r := range roots {
+		subjectHash, err := opensslNameHash(r.Cert.RawSubject)
+		if err != nil {
+			return fmt.Errorf("Subject of %q could not be hashed: %w", r.CertificateName, err)
+		}
+		fileName := fmt.Sprintf("%X.pem", r.SHA256Fingerprint)
+		data := fmt.Appendf(nil, "# %s\n", r.CertificateName)
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: r.Cert.Raw})...)
+		if err = os.WriteFile(filepath.Join(dir, fileName), data, 0644); err != nil {
+			return err
+		}
+		// Root certificates with the same subject are told apart by the link's suffix.
+		if err = os.Symlink(fileName, filepath.Join(dir, fmt.Sprintf("%08x.%d", subjectHash, links[subjectHash]))); err != nil {
+			return err
+		}
+		links[subjectHash]++
+	}
+	return nil
+}
+
+// opensslNameHash returns the hash of a DER-encoded name that OpenSSL uses to find certificates by subject in a CApath
+// directory (X509_NAME_hash): the first four bytes, little-endian, of the SHA-1 hash of the name's canonical encoding.
+// In the canonical encoding, the outer SEQUENCE is omitted, and string values are converted to UTF8String, with leading
+// and trailing whitespace removed, other runs of whitespace replaced by a single space, and ASCII letters lowercased.
+func opensslNameHash(rawName []byte) (uint32, error) {
+	var rdns []asn1.RawValue
+	if rest, err := asn1.Unmarshal(rawName, &rdns); err != nil {
+		return 0, err
+	} else if len(rest) > 0 {
+		return 0, errors.New("Trailing data after name")
+	}
+
+	var canonical []byte
+	for _, rdn := range rdns {
+		var atvs []attributeTypeAndValue
+		if _, err := asn1.UnmarshalWithParams(rdn.FullBytes, &atvs, "set"); err != nil {
+			return 0, err
+		}
+		for i, atv := range atvs {
+			if atv.Value.Class != asn1.ClassUniversal || !canonicalStringTags[atv.Value.Tag] {
+				continue
+			}
+			value, err := decodeString(atv.Value)
+			if err != nil {
+				return 0, err
+			}
+			atvs[i].Value = asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagUTF8String, Bytes: []byte(canonicalString(value))}
+		}
+		encoded, err := asn1.MarshalWithParams(atvs, "set")
+		if err != nil {
+			return 0, err
+		}
+		canonical = append(canonical, encoded...)
+	}
+	hash := sha1.Sum(canonical)
+	return binary.LittleEndian.Uint32(hash[:4]), nil
+}
+
+// decodeString returns the UTF-8 encoding of an ASN.1 string value. Like OpenSSL, T61String values are treated as
+// ISO-8859-1.
+func decodeString(value asn1.RawValue) (string, error) {
+	switch value.Tag {
+	case asn1.TagT61String:
+		runes := make([]rune, len(value.Bytes))
+		for i, b := range value.Bytes {
+			runes[i] = rune(b)
+		}
+		return string(runes), nil
+	case asn1.TagBMPString:
+		if len(value.Bytes)%2 != 0 {
+			return "", errors.New("Invalid BMPString")
+		}
+		units := make([]uint16, len(value.Bytes)/2)
+		for i := range units {
+			units[i] = binary.BigEndian.Uint16(value.Bytes[2*i:])
+		}
+		return string(utf16.Decode(units)), nil
+	case TAG_UNIVERSAL_STRING:
+		if len(value.Bytes)%4 != 0 {
+			return "", errors.New("Invalid UniversalString")
+		}
+		runes := make([]rune, len(value.Bytes)/4)
+		for i := range runes {
+			runes[i] = rune(binary.BigEndian.Uint32(value.Bytes[4*i:]))
+		}
+		return string(runes), nil
+	default:
+		return string(value.Bytes), nil
+	}
+}
+
+// canonicalString canonicalizes a string value as OpenSSL does. Only ASCII whitespace and letters are affected.
+func canonicalString(s string) string {
+	const whitespace = " \t\n\v\f\r"
+	var b strings.Builder
+	for i, field := range strings.FieldsFunc(strings.Trim(s, whitespace), func(r rune) bool { return strings.ContainsRune(whitespace, r) }) {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		for _, c := range []byte(field) {
+			if 'A' <= c && c <= 'Z' {
+				c += 'a' - 'A'
+			}
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
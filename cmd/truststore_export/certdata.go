@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+// NSS trust values.
+const (
+	NSS_TRUSTED_DELEGATOR = "CKT_NSS_TRUSTED_DELEGATOR"
+	NSS_MUST_VERIFY_TRUST = "CKT_NSS_MUST_VERIFY_TRUST"
+)
+
+// The format of the dates in certdata.txt comments, as written by NSS's certdata tooling.
+const CERTDATA_COMMENT_DATE_FORMAT = "Mon Jan 02 15:04:05 2006"
+
+// writeCertdata writes an NSS certdata.txt file, in the format that NSS builds its built-in root store from: a
+// certificate object and a trust object for each root certificate. A root certificate is a trust anchor for server
+// authentication, email protection, and code signing if it is capable of issuing TLS, S/MIME, and code signing
+// certificates respectively, and the root program's "distrust after" dates are written as NSS's server and email
+// distrust after attributes.
+func writeCertdata(w io.Writer, opts *exportOptions, roots []*root) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "#\n# The root certificates that are included in the %s root store, as reported by CCADB.\n", opts.RootProgram)
+	fmt.Fprintf(bw, "#\n# Generated by cmd/truststore_export from github.com/crtsh/ccadb_data.\n#\n")
+	fmt.Fprintf(bw, "BEGINDATA\n\n")
+	fmt.Fprintf(bw, "# Builtin root list\n")
+	writeCommonAttributes(bw, "CKO_NSS_BUILTIN_ROOT_LIST", opts.RootProgram+" Builtin Roots")
+
+	for _, r := range roots {
+		serialNumber, err := asn1.Marshal(r.Cert.SerialNumber)
+		if err != nil {
+			return fmt.Errorf("Serial number of %q could not be encoded: %w", r.CertificateName, err)
+		}
+
+		fmt.Fprintf(bw, "\n#\n# Certificate %s\n#\n", strconv.Quote(r.CertificateName))
+		writeCertificateComments(bw, r)
+		writeCommonAttributes(bw, "CKO_CERTIFICATE", r.CertificateName)
+		fmt.Fprintf(bw, "CKA_CERTIFICATE_TYPE CK_CERTIFICATE_TYPE CKC_X_509\n")
+		writeOctal(bw, "CKA_SUBJECT", r.Cert.RawSubject)
+		fmt.Fprintf(bw, "CKA_ID UTF8 \"0\"\n")
+		writeOctal(bw, "CKA_ISSUER", r.Cert.RawIssuer)
+		writeOctal(bw, "CKA_SERIAL_NUMBER", serialNumber)
+		writeOctal(bw, "CKA_VALUE", r.Cert.Raw)
+		writeBool(bw, "CKA_NSS_MOZILLA_CA_POLICY", opts.RootProgram == ccadb_data.ROOT_PROGRAM_MOZILLA)
+		writeDistrustAfter(bw, "CKA_NSS_SERVER_DISTRUST_AFTER", "Server", r.DistrustForTLSAfter)
+		writeDistrustAfter(bw, "CKA_NSS_EMAIL_DISTRUST_AFTER", "Email", r.DistrustForSMIMEAfter)
+
+		sha1Hash := sha1.Sum(r.Cert.Raw)
+		md5Hash := md5.Sum(r.Cert.Raw)
+		fmt.Fprintf(bw, "\n# Trust for %s\n", strconv.Quote(r.CertificateName))
+		writeCertificateComments(bw, r)
+		writeCommonAttributes(bw, "CKO_NSS_TRUST", r.CertificateName)
+		writeOctal(bw, "CKA_CERT_SHA1_HASH", sha1Hash[:])
+		writeOctal(bw, "CKA_CERT_MD5_HASH", md5Hash[:])
+		writeOctal(bw, "CKA_ISSUER", r.Cert.RawIssuer)
+		writeOctal(bw, "CKA_SERIAL_NUMBER", serialNumber)
+		writeTrust(bw, "CKA_TRUST_SERVER_AUTH", r.TLSCapable)
+		writeTrust(bw, "CKA_TRUST_EMAIL_PROTECTION", r.SMIMECapable)
+		writeTrust(bw, "CKA_TRUST_CODE_SIGNING", r.CodeSigningCapable)
+		writeBool(bw, "CKA_TRUST_STEP_UP_APPROVED", false)
+	}
+	return bw.Flush()
+}
+
+// writeCertificateComments writes the comments that describe a certificate, as NSS's certdata tooling does.
+func writeCertificateComments(bw *bufio.Writer, r *root) {
+	sha1Hash := sha1.Sum(r.Cert.Raw)
+	fmt.Fprintf(bw, "# Issuer: %s\n", r.Cert.Issuer)
+	fmt.Fprintf(bw, "# Serial Number: %s\n", colonHex(r.Cert.SerialNumber.Bytes()))
+	fmt.Fprintf(bw, "# Subject: %s\n", r.Cert.Subject)
+	fmt.Fprintf(bw, "# Not Valid Before: %s\n", r.Cert.NotBefore.UTC().Format(CERTDATA_COMMENT_DATE_FORMAT))
+	fmt.Fprintf(bw, "# Not Valid After : %s\n", r.Cert.NotAfter.UTC().Format(CERTDATA_COMMENT_DATE_FORMAT))
+	fmt.Fprintf(bw, "# Fingerprint (SHA-256): %s\n", colonHex(r.SHA256Fingerprint[:]))
+	fmt.Fprintf(bw, "# Fingerprint (SHA1): %s\n", colonHex(sha1Hash[:]))
+}
+
+// writeCommonAttributes writes the attributes that start every object: its class, and those of a read-only token object.
+func writeCommonAttributes(bw *bufio.Writer, class, label string) {
+	fmt.Fprintf(bw, "CKA_CLASS CK_OBJECT_CLASS %s\n", class)
+	writeBool(bw, "CKA_TOKEN", true)
+	writeBool(bw, "CKA_PRIVATE", false)
+	writeBool(bw, "CKA_MODIFIABLE", false)
+	fmt.Fprintf(bw, "CKA_LABEL UTF8 %s\n", strconv.Quote(label))
+}
+
+func writeBool(bw *bufio.Writer, name string, value bool) {
+	if value {
+		fmt.Fprintf(bw, "%s CK_BBOOL CK_TRUE\n", name)
+	} else {
+		fmt.Fprintf(bw, "%s CK_BBOOL CK_FALSE\n", name)
+	}
+}
+
+func writeTrust(bw *bufio.Writer, name string, trusted bool) {
+	if trusted {
+		fmt.Fprintf(bw, "%s CK_TRUST %s\n", name, NSS_TRUSTED_DELEGATOR)
+	} else {
+		fmt.Fprintf(bw, "%s CK_TRUST %s\n", name, NSS_MUST_VERIFY_TRUST)
+	}
+}
+
+// writeDistrustAfter writes a distrust after attribute, which NSS encodes as the UTCTime at the end of the date, or as
+// false if date is zero.
+func writeDistrustAfter(bw *bufio.Writer, name, purpose string, date time.Time) {
+	if date.IsZero() {
+		writeBool(bw, name, false)
+		return
+	}
+	distrustAfter := date.UTC().AddDate(0, 0, 1).Add(-time.Second)
+	fmt.Fprintf(bw, "# For %s Distrust After: %s\n", purpose, distrustAfter.Format(CERTDATA_COMMENT_DATE_FORMAT))
+	writeOctal(bw, name, []byte(distrustAfter.Format("060102150405Z")))
+}
+
+// writeOctal writes a MULTILINE_OCTAL attribute, with 16 bytes per line.
+func writeOctal(bw *bufio.Writer, name string, value []byte) {
+	fmt.Fprintf(bw, "%s MULTILINE_OCTAL\n", name)
+	for i, b := range value {
+		fmt.Fprintf(bw, "\\%03o", b)
+		if i%16 == 15 || i == len(value)-1 {
+			fmt.Fprintf(bw, "\n")
+		}
+	}
+	fmt.Fprintf(bw, "END\n")
+}
+
+func colonHex(b []byte) string {
+	hexBytes := make([]string, len(b))
+	for i := range b {
+		hexBytes[i] = fmt.Sprintf("%02X", b[i])
+	}
+	return strings.Join(hexBytes, ":")
+}
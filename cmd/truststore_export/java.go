@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// The JKS file format, as read by Java's KeyStore implementation.
+const (
+	JKS_MAGIC                     = 0xFEEDFEED
+	JKS_VERSION                   = 2
+	JKS_TRUSTED_CERTIFICATE_ENTRY = 2
+	// Appended to the password when computing the file's integrity digest.
+	JKS_DIGEST_WHITENER = "Mighty Aphrodite"
+)
+
+// writePKCS12 writes a PKCS#12 trust store, in which every root certificate is marked as trusted for Java (which reads
+// PKCS#12 trust stores since Java 8). Its certificates are encrypted with AES-256 and its integrity is protected with
+// HMAC-SHA-256, both keyed by the password, which Java 11.0.12 and later and OpenSSL 1.1.1 and later support.
+func writePKCS12(w io.Writer, opts *exportOptions, roots []*root) error {
+	entries := make([]pkcs12.TrustStoreEntry, len(roots))
+	for i, alias := range javaAliases(roots) {
+		entries[i] = pkcs12.TrustStoreEntry{Cert: roots[i].Cert, FriendlyName: alias}
+	}
+	pfxData, err := pkcs12.Modern.EncodeTrustStoreEntries(entries, opts.Password)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(pfxData)
+	return err
+}
+
+// writeJKS writes a JKS trust store, the format of Java's original KeyStore implementation, for Java versions and
+// tools that don't read PKCS#12 trust stores. Every root certificate is a trusted certificate entry.
+func writeJKS(w io.Writer, opts *exportOptions, roots []*root) error {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, []uint32{JKS_MAGIC, JKS_VERSION, uint32(len(roots))})
+	for i, alias := range javaAliases(roots) {
+		binary.Write(&buf, binary.BigEndian, uint32(JKS_TRUSTED_CERTIFICATE_ENTRY))
+		if err := writeJavaUTF(&buf, alias); err != nil {
+			return err
+		}
+		binary.Write(&buf, binary.BigEndian, opts.DatasetDate.UnixMilli())
+		if err := writeJavaUTF(&buf, "X.509"); err != nil {
+			return err
+		}
+		binary.Write(&buf, binary.BigEndian, uint32(len(roots[i].Cert.Raw)))
+		buf.Write(roots[i].Cert.Raw)
+	}
+
+	// The integrity digest is computed over the password's UTF-16 code units, big-endian, then the whitener, then the
+	// data.
+	digest := sha1.New()
+	for _, unit := range utf16.Encode([]rune(opts.Password + JKS_DIGEST_WHITENER)) {
+		digest.Write([]byte{byte(unit >> 8), byte(unit)})
+	}
+	digest.Write(buf.Bytes())
+	buf.Write(digest.Sum(nil))
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// javaAliases returns each root certificate's alias in a Java trust store, which is its lowercased Certificate Name
+// (since Java treats aliases case-insensitively), followed by its SHA-256 fingerprint if another root certificate has
+// the same name.
+func javaAliases(roots []*root) []string {
+	count := make(map[string]int)
+	for _, r := range roots {
+		count[strings.ToLower(r.CertificateName)]++
+	}
+	aliases := make([]string, len(roots))
+	for i, r := range roots {
+		if aliases[i] = strings.ToLower(r.CertificateName); count[aliases[i]] > 1 {
+			aliases[i] += fmt.Sprintf(" (%x)", r.SHA256Fingerprint)
+		}
+	}
+	return aliases
+}
+
+// writeJavaUTF writes a string as Java's DataOutput.writeUTF does: its length, then its characters in modified UTF-8,
+// which encodes NUL as two bytes and characters outside the BMP as surrogate pairs.
+func writeJavaUTF(buf *bytes.Buffer, s string) error {
+	var encoded []byte
+	for _, unit := range utf16.Encode([]rune(s)) {
+		switch {
+		case unit != 0 && unit < 0x80:
+			encoded = append(encoded, byte(unit))
+		case unit < 0x800:
+			encoded = append(encoded, 0xC0|byte(unit>>6), 0x80|byte(unit&0x3F))
+		default:
+			encoded = append(encoded, 0xE0|byte(unit>>12), 0x80|byte(unit>>6&0x3F), 0x80|byte(unit&0x3F))
+		}
+	}
+	if len(encoded) > 0xFFFF {
+		return fmt.Errorf("Alias %q is too long", s)
+	}
+	binary.Write(buf, binary.BigEndian, uint16(len(encoded)))
+	buf.Write(encoded)
+	return nil
+}
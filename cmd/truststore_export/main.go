@@ -0,0 +1,151 @@
+package main
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data"
+	_ "github.com/crtsh/ccadb_data/full"
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
+)
+
+var rootPrograms = []string{ccadb_data.ROOT_PROGRAM_APPLE, ccadb_data.ROOT_PROGRAM_CHROME, ccadb_data.ROOT_PROGRAM_MICROSOFT, ccadb_data.ROOT_PROGRAM_MOZILLA}
+
+// Writers for each supported output format, indexed by the -format flag value.
+var writers = map[string]func(w io.Writer, opts *exportOptions, roots []*root) error{
+	"certdata": writeCertdata,
+	"pkcs12":   writePKCS12,
+	"jks":      writeJKS,
+}
+
+// Writers for each supported output format that is a directory rather than a file, indexed by the -format flag value.
+var directoryWriters = map[string]func(dir string, roots []*root) error{
+	"capath": writeCApath,
+}
+
+// How the root certificates are written.
+type exportOptions struct {
+	RootProgram string
+	// The password of a PKCS#12 or JKS trust store.
+	Password string
+	// When the CCADB data was fetched, which is written as the creation date of each JKS entry, so that the output only
+	// changes when the data does.
+	DatasetDate time.Time
+}
+
+// Queries for each supported capability, indexed by the -capability flag value.
+var capabilities = map[string]func(q *ccadb_data.Query) *ccadb_data.Query{
+	"":      func(q *ccadb_data.Query) *ccadb_data.Query { return q },
+	"tls":   (*ccadb_data.Query).TLSCapable,
+	"smime": (*ccadb_data.Query).SMIMECapable,
+}
+
+// A root certificate that is included in the root program, and what the root program trusts it for.
+type root struct {
+	SHA256Fingerprint  [sha256.Size]byte
+	CertificateName    string
+	Cert               *x509.Certificate
+	TLSCapable         bool
+	SMIMECapable       bool
+	CodeSigningCapable bool
+	// Certificates issued after these dates (which cover the whole day, UTC) are distrusted by the root program, unless
+	// they are zero.
+	DistrustForTLSAfter   time.Time
+	DistrustForSMIMEAfter time.Time
+}
+
+func main() {
+	format := flag.String("format", "certdata", "Output format: certdata (an NSS certdata.txt file), pkcs12 or jks (a Java trust store), or capath (a directory of PEM files with OpenSSL's hashed symlinks, which -output must name)")
+	program := flag.String("program", ccadb_data.ROOT_PROGRAM_MOZILLA, "Export the root certificates that are included in this root program: Apple, Chrome, Microsoft, or Mozilla")
+	capability := flag.String("capability", "", "Only export the root certificates that are capable of issuing tls or smime certificates (default: every included root certificate)")
+	output := flag.String("output", "", "Write the output to this file (or, for capath, directory) instead of stdout")
+	password := flag.String("password", "changeit", "The password of a pkcs12 or jks trust store")
+	logFlags := logging.AddFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-format certdata|pkcs12|jks|capath] [-program NAME] [-capability tls|smime] [-output FILE|DIR] [-password PASSWORD] [-log-level LEVEL] [-log-format json|console]\n", os.Args[0])
+	}
+	flag.Parse()
+	write, writeDirectory := writers[*format], directoryWriters[*format]
+	capable := capabilities[*capability]
+	if flag.NArg() != 0 || (write == nil && (writeDirectory == nil || *output == "")) || capable == nil || !slices.Contains(rootPrograms, *program) {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	tally := summary.New("truststore_export")
+	logger, err := logFlags.Logger(zap.WithFatalHook(tally))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	ccadb_data.SetLogger(logger)
+
+	sha256Fingerprints := ccadb_data.ListFingerprintsWhere(capable(ccadb_data.Where().RecordType(ccadb_data.RecordTypeRoot).IncludedIn(*program)))
+	ccadb_data.LoadAllCACertificates()
+	var roots []*root
+	unparsed := 0
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		cert := ccadb_data.GetParsedCACertificateBySHA256(sha256Fingerprint)
+		if cert == nil {
+			logger.Warn("Root certificate could not be parsed", zap.String("sha256_fingerprint", fmt.Sprintf("%X", sha256Fingerprint)))
+			unparsed++
+			continue
+		}
+		roots = append(roots, newRoot(sha256Fingerprint, cert, *program))
+	}
+	slices.SortFunc(roots, func(a, b *root) int {
+		return cmp.Or(strings.Compare(a.CertificateName, b.CertificateName), slices.Compare(a.SHA256Fingerprint[:], b.SHA256Fingerprint[:]))
+	})
+
+	if writeDirectory != nil {
+		if err = writeDirectory(*output, roots); err != nil {
+			logger.Fatal("Output could not be written", zap.Error(err), zap.String("dir", *output))
+		}
+	} else {
+		out, err := config.CreateOutput(*output)
+		if err != nil {
+			logger.Fatal("Output file could not be created", zap.Error(err))
+		}
+		opts := &exportOptions{RootProgram: *program, Password: *password, DatasetDate: ccadb_data.GetDatasetInfo().Date}
+		if err = write(out, opts, roots); err != nil {
+			logger.Fatal("Output could not be written", zap.Error(err))
+		} else if err = out.Close(); err != nil {
+			logger.Fatal("Output could not be written", zap.Error(err))
+		}
+	}
+
+	logger.Info("Exported root certificates", zap.Int("count", len(roots)), zap.String("format", *format), zap.String("root_program", *program))
+	tally.Add("roots", len(roots))
+	tally.Add("unparsed", unparsed)
+	tally.Exit(0)
+}
+
+func newRoot(sha256Fingerprint [sha256.Size]byte, cert *x509.Certificate, rootProgram string) *root {
+	cr := ccadb_data.GetCertificateRecordBySHA256(sha256Fingerprint)
+	ccc := ccadb_data.GetCACertCapabilitiesBySHA256(sha256Fingerprint)
+	r := &root{
+		SHA256Fingerprint:  sha256Fingerprint,
+		CertificateName:    strings.ReplaceAll(cr.CertificateName, "\n", " "),
+		Cert:               cert,
+		TLSCapable:         ccc.TlsCapable,
+		SMIMECapable:       ccc.SmimeCapable,
+		CodeSigningCapable: ccc.CodeSigningCapable,
+	}
+	for _, rsc := range ccadb_data.GetRootStoreConstraintsBySHA256(sha256Fingerprint) {
+		if rsc.RootProgram == rootProgram {
+			r.DistrustForTLSAfter = rsc.DistrustForTLSAfter
+			r.DistrustForSMIMEAfter = rsc.DistrustForSMIMEAfter
+		}
+	}
+	return r
+}
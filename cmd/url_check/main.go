@@ -1,44 +1,151 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	_ "embed"
 	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
+	"net/http/httptrace"
 	"os"
+	"slices"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
-	"github.com/hueristiq/hq-go-url/extractor"
+	"github.com/crtsh/ccadb_data"
+	"github.com/crtsh/ccadb_data/internal/config"
+	"github.com/crtsh/ccadb_data/internal/httpclient"
+	"github.com/crtsh/ccadb_data/internal/logging"
+	"github.com/crtsh/ccadb_data/internal/progress"
+	"github.com/crtsh/ccadb_data/internal/summary"
+	"go.uber.org/zap"
 )
 
-var httpClient *http.Client
+// Failure classes, so that failures can be triaged without parsing the error text.
+const (
+	FAILURE_DNS_NXDOMAIN       = "dns_nxdomain"
+	FAILURE_DNS_SERVFAIL       = "dns_servfail"
+	FAILURE_DNS_OTHER          = "dns_error"
+	FAILURE_CONNECTION_REFUSED = "connection_refused"
+	FAILURE_TLS_HANDSHAKE      = "tls_handshake"
+	FAILURE_TIMEOUT            = "timeout"
+	FAILURE_HTTP_STATUS        = "http_status"
+	FAILURE_REDIRECT_LOOP      = "redirect_loop"
+	FAILURE_TOO_MANY_REDIRECTS = "too_many_redirects"
+	FAILURE_REDIRECT_DOWNGRADE = "redirect_downgrade"
+	FAILURE_REDIRECT_UPGRADE   = "redirect_upgrade"
+	FAILURE_OTHER              = "other"
+)
+
+const (
+	SEVERITY_ERROR   = "error"
+	SEVERITY_WARNING = "warning"
+
+	MAX_CONCURRENCY = 64
+	MAX_REDIRECTS   = 10
+)
+
+var (
+	logger           *zap.Logger
+	httpClient       *http.Client
+	warnHTTPSUpgrade bool
+)
+
+// Where a URL was found in the CSV data.
+type attribution struct {
+	caOwner    string
+	subCAOwner string
+	column     string
+	// The first line of the CSV data that the URL was found in, for this CA Owner, Subordinate CA Owner, and column.
+	line int
+}
+
+// sameAs reports whether two attributions have the same CA Owner, Subordinate CA Owner, and column.
+func (a attribution) sameAs(other attribution) bool {
+	return a.caOwner == other.caOwner && a.subCAOwner == other.subCAOwner && a.column == other.column
+}
 
 func main() {
-	httpClient = &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true,
-			},
-		},
-		Timeout: time.Duration(30) * time.Second,
+	httpFlags := httpclient.AddFlags(flag.CommandLine, httpclient.Config{Timeout: time.Duration(30) * time.Second})
+	format := flag.String("format", "csv", "Output format: csv, json (one JSON object per line), or sarif")
+	output := flag.String("output", "", "Write the output to this file instead of stdout")
+	concurrency := flag.Int("concurrency", MAX_CONCURRENCY, "Maximum number of URLs to check at once")
+	excludes := config.AddExcludesFlag(flag.CommandLine)
+	flag.BoolVar(&warnHTTPSUpgrade, "warn-https-upgrade", false, "Report redirects from http to https as warnings")
+	progressInterval := progress.AddFlag(flag.CommandLine)
+	logFlags := logging.AddFlags(flag.CommandLine)
+	configPath := config.AddFlag(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-config FILE] [-log-level LEVEL] [-log-format json|console] [-format csv|json|sarif] [-output FILE] [-concurrency N] [-exclude PATTERN]... [-proxy URL] [-cafile FILE] [-insecure] [-timeout DURATION] [-warn-https-upgrade] [-progress-interval DURATION] <AllCertificateRecordsCSVFormatV5> [CA Owner]\n", os.Args[0])
+	}
+	flag.Parse()
+	if err := config.Apply(flag.CommandLine, "url_check", *configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading configuration: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
+	}
+	var err error
+	tally := summary.New("url_check")
+	if logger, err = logFlags.Logger(zap.WithFatalHook(tally)); err != nil {
+		fmt.Fprintf(os.Stderr, "Error configuring logger: %v\n", err)
+		os.Exit(summary.EXIT_ERROR)
 	}
+	ccadb_data.SetLogger(logger)
 
 	// Validate the command-line arguments.
-	switch len(os.Args) {
-	case 2, 3:
+	switch flag.NArg() {
+	case 1, 2:
+	default:
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+	if *concurrency < 1 {
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+
+	out, err := config.CreateOutput(*output)
+	if err != nil {
+		logger.Fatal("Output file could not be created", zap.Error(err))
+	}
+	defer out.Close()
+	var w writer
+	switch *format {
+	case "csv":
+		w = &csvWriter{csvWriter: csv.NewWriter(out)}
+	case "json":
+		w = &jsonWriter{encoder: json.NewEncoder(out)}
+	case "sarif":
+		w = &sarifWriter{out: out, csvPath: flag.Arg(0)}
 	default:
-		fmt.Fprintf(os.Stderr, "Usage: %s <AllCertificateRecordsCSVFormatV5> [CA Owner]\n", os.Args[0])
-		os.Exit(1)
+		flag.Usage()
+		os.Exit(summary.EXIT_ERROR)
+	}
+
+	// Configure the HTTP client. Flags take precedence over environment variables.
+	httpConfig, err := httpFlags.Config()
+	if err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+	if httpClient, err = httpclient.New(httpConfig); err != nil {
+		logger.Fatal("HTTP client could not be configured", zap.Error(err))
+	}
+	httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+		// checkURL follows redirects itself.
+		return http.ErrUseLastResponse
 	}
 
 	// Read the CSV file.
-	csvReport, err := os.ReadFile(os.Args[1])
+	csvReport, err := os.ReadFile(flag.Arg(0))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading CSV file: %v\n", err)
-		os.Exit(1)
+		logger.Fatal("CSV file could not be read", zap.Error(err), zap.String("file_path", flag.Arg(0)))
 	}
 
 	// Parse the CSV file.
@@ -46,14 +153,21 @@ func main() {
 	reader.FieldsPerRecord = -1
 	reader.LazyQuotes = true
 	reader.TrimLeadingSpace = true
-	reader.ReuseRecord = true
-	records, err := reader.ReadAll()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing CSV file: %v\n", err)
-		os.Exit(1)
-	} else if len(records) == 0 {
-		fmt.Fprintf(os.Stderr, "CSV file is empty\n")
-		os.Exit(1)
+	var records [][]string
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			logger.Fatal("CSV file could not be parsed", zap.Error(err), zap.String("file_path", flag.Arg(0)))
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, record)
+		lines = append(lines, line)
+	}
+	if len(records) == 0 {
+		logger.Fatal("CSV file is empty", zap.String("file_path", flag.Arg(0)))
 	}
 
 	// Determine the indexes of the required fields.
@@ -74,71 +188,243 @@ func main() {
 		}
 	}
 	if caOwnerIdx == -1 || subCAOwnerIdx == -1 || revocationStatusIdx == -1 || validToIdx == -1 {
-		fmt.Fprintf(os.Stderr, "An expected field was not found in the CSV header\n")
-		os.Exit(1)
+		logger.Fatal("CSV data is missing one or more expected headers", zap.String("file_path", flag.Arg(0)))
 	}
 
-	// Parse the CSV data.
-	results := make(map[string][]string)
-	e := extractor.New(extractor.WithScheme())
-	regex := e.CompileRegex()
-	for _, record := range records[1:] {
+	// Parse the CSV data, collecting every (CA Owner, Subordinate CA Owner, column) that each URL is found in.
+	urls := make(map[string][]attribution)
+	skipped := 0
+	for n, record := range records[1:] {
+		// Skip malformed records, rather than abandoning the whole run.
+		if len(record) != len(records[0]) {
+			logger.Warn("CSV data has a line that does not have the expected number of fields", zap.String("file_path", flag.Arg(0)), zap.Int("line", lines[n+1]), zap.Int("fields", len(record)), zap.Int("expected_fields", len(records[0])))
+			skipped++
+			continue
+		}
 		// Skip revoked certificates.
 		switch record[revocationStatusIdx] {
 		case "Revoked", "Parent Cert Revoked":
 			continue
 		}
-		// Skip expired certificates.
-		notAfter, err := time.Parse(time.DateOnly, record[validToIdx])
+		// Skip expired certificates, and those whose expiry is unknown.
+		notAfter, err := ccadb_data.ParseCCADBDate(record[validToIdx])
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error parsing Valid To date: %v\n", err)
-			os.Exit(1)
+			logger.Warn("CSV data contains an invalid date", zap.Error(err), zap.String("file_path", flag.Arg(0)), zap.Int("line", lines[n+1]))
+			skipped++
+			continue
 		} else if time.Now().After(notAfter) {
 			continue
 		}
 		// If required, filter by CA Owner.
-		if len(os.Args) < 3 || record[caOwnerIdx] == os.Args[2] || record[subCAOwnerIdx] == os.Args[2] {
+		if caOwner := flag.Arg(1); caOwner == "" || record[caOwnerIdx] == caOwner || record[subCAOwnerIdx] == caOwner {
 			// Add all encountered URLs to a map.
-			for _, field := range record {
-				for _, url := range regex.FindAllString(field, -1) {
-					results[url] = []string{record[caOwnerIdx], record[subCAOwnerIdx]}
+			for _, ru := range ccadb_data.ExtractURLs(records[0], record) {
+				if excludes.Match(ru.URL) {
+					continue
+				}
+				a := attribution{caOwner: record[caOwnerIdx], subCAOwner: record[subCAOwnerIdx], column: ru.Column, line: lines[n+1]}
+				if !slices.ContainsFunc(urls[ru.URL], a.sameAs) {
+					urls[ru.URL] = append(urls[ru.URL], a)
 				}
 			}
 		}
 	}
 
-	// Wait for all URL checks to complete.
+	// Check each URL once. A single goroutine reports the failures as the checks complete, so that a long run's output
+	// isn't lost if it is interrupted.
+	checked := make(chan checkedURL)
+	done := make(chan error)
+	go func() {
+		done <- report(w, urls, checked, tally)
+	}()
+	tracker := progress.Start(logger, "Checking URLs", len(urls), *progressInterval)
 	var wg sync.WaitGroup
-	for url, result := range results {
-		wg.Go(func() { checkURL(append(result, url)) })
+	semaphore := make(chan struct{}, *concurrency)
+	for url := range urls {
+		semaphore <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+			c := checkedURL{url: url}
+			defer func() {
+				// A bug triggered by one URL mustn't abort the whole run.
+				if r := recover(); r != nil {
+					c.result = &checkResult{severity: SEVERITY_ERROR, failureClass: FAILURE_OTHER, err: fmt.Sprintf("URL check panicked: %v", r)}
+				}
+				tracker.Add(c.result != nil)
+				checked <- c
+			}()
+			c.result = checkURL(url)
+		})
 	}
 	wg.Wait()
+	tracker.Stop()
+	close(checked)
+	if err = <-done; err != nil {
+		logger.Fatal("Output could not be written", zap.Error(err))
+	}
+	tally.Add("urls", len(urls))
+	tally.Add("skipped_records", skipped)
+	tally.Exit(tracker.Failed())
 }
 
-func checkURL(result []string) {
-	req, err := http.NewRequest("HEAD", result[2], nil)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "%v", err)
-		os.Exit(1)
+// A URL, and the result of checking it (nil if the check succeeded).
+type checkedURL struct {
+	url    string
+	result *checkResult
+}
+
+// report writes each failure once for every CA Owner, Subordinate CA Owner, and column that the URL was found in, as the
+// checks complete, and counts the failures by severity. After a write error, the remaining checks are still received
+// (so that they can complete) but not written, and the first write error is returned.
+func report(w writer, urls map[string][]attribution, checked <-chan checkedURL, tally *summary.Summary) error {
+	var err error
+	failed := 0
+	for c := range checked {
+		if c.result == nil {
+			continue
+		}
+		failed++
+		tally.Add(c.result.severity, 1)
+		for _, a := range urls[c.url] {
+			if err != nil {
+				break
+			}
+			err = w.write(&failure{CAOwner: a.caOwner, SubordinateCAOwner: a.subCAOwner, Column: a.column, URL: c.url, Severity: c.result.severity, FailureClass: c.result.failureClass, Error: c.result.err, ResolvedIPs: c.result.resolvedIPs, FinalURL: c.result.finalURL, RedirectHops: c.result.redirectHops, line: a.line})
+		}
 	}
-	resp, err := httpClient.Do(req)
-	if err != nil {
-		result = append(result, err.Error())
-	} else if resp.StatusCode != 200 {
-		result = append(result, fmt.Sprintf("HTTP %d", resp.StatusCode))
-	} else {
-		return
-	}
-
-	csvWriter := csv.NewWriter(os.Stdout)
-	csvWriter.Write(result)
-	if err = csvWriter.Error(); err != nil {
-		fmt.Fprintf(os.Stderr, "%v", err)
-		os.Exit(1)
-	}
-	csvWriter.Flush()
-	if err = csvWriter.Error(); err != nil {
-		fmt.Fprintf(os.Stderr, "%v", err)
-		os.Exit(1)
+	if err == nil {
+		err = w.flush()
+	}
+	logger.Info("Checked URLs", zap.Int("count", len(urls)), zap.Int("failed_count", failed))
+	return err
+}
+
+// The result of a failed (or, for warnings, questionable) URL check.
+type checkResult struct {
+	severity     string
+	failureClass string
+	err          string
+	resolvedIPs  []string
+	finalURL     string
+	redirectHops int
+}
+
+// checkURL checks that url responds with HTTP 200, following any redirects, and returns a description of the failure
+// if it doesn't.
+func checkURL(url string) *checkResult {
+	result := &checkResult{severity: SEVERITY_ERROR}
+
+	// Record the IP addresses that each hostname resolved to, and that were connected to. When a proxy is used, these
+	// are the proxy's IP addresses.
+	var mu sync.Mutex
+	addIP := func(ip string) {
+		mu.Lock()
+		if !slices.Contains(result.resolvedIPs, ip) {
+			result.resolvedIPs = append(result.resolvedIPs, ip)
+		}
+		mu.Unlock()
+	}
+	trace := &httptrace.ClientTrace{
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			for _, addr := range info.Addrs {
+				addIP(addr.IP.String())
+			}
+		},
+		ConnectStart: func(network, addr string) {
+			if host, _, err := net.SplitHostPort(addr); err == nil {
+				addIP(host)
+			}
+		},
+	}
+
+	// Follow the redirect chain one hop at a time, so that loops and scheme changes can be detected.
+	visited := []string{url}
+	var downgraded, upgraded bool
+	for {
+		req, err := http.NewRequestWithContext(httptrace.WithClientTrace(context.Background(), trace), "HEAD", url, nil)
+		if err != nil {
+			result.failureClass, result.err = FAILURE_OTHER, err.Error()
+			return result
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			result.failureClass, result.err = classifyError(err), err.Error()
+			return result
+		}
+		resp.Body.Close()
+
+		location, err := resp.Location()
+		if err != nil || !isRedirect(resp.StatusCode) {
+			if resp.StatusCode != 200 {
+				result.failureClass, result.err = FAILURE_HTTP_STATUS, fmt.Sprintf("HTTP %d", resp.StatusCode)
+				return result
+			}
+			break
+		}
+
+		// Follow the redirect.
+		result.redirectHops++
+		result.finalURL = location.String()
+		if slices.Contains(visited, result.finalURL) {
+			result.failureClass, result.err = FAILURE_REDIRECT_LOOP, fmt.Sprintf("Redirect loop after %d hops", result.redirectHops)
+			return result
+		} else if result.redirectHops > MAX_REDIRECTS {
+			result.failureClass, result.err = FAILURE_TOO_MANY_REDIRECTS, fmt.Sprintf("More than %d redirects", MAX_REDIRECTS)
+			return result
+		} else if resp.Request.URL.Scheme == "https" && location.Scheme == "http" {
+			downgraded = true
+		} else if resp.Request.URL.Scheme == "http" && location.Scheme == "https" {
+			upgraded = true
+		}
+		visited = append(visited, result.finalURL)
+		url = result.finalURL
+	}
+
+	// The URL is reachable, but redirects between schemes are worth a warning.
+	result.severity = SEVERITY_WARNING
+	if downgraded {
+		result.failureClass, result.err = FAILURE_REDIRECT_DOWNGRADE, "Redirected from https to http"
+		return result
+	} else if upgraded && warnHTTPSUpgrade {
+		result.failureClass, result.err = FAILURE_REDIRECT_UPGRADE, "Redirected from http to https"
+		return result
+	}
+	return nil
+}
+
+// isRedirect reports whether statusCode is an HTTP redirect that has a Location.
+func isRedirect(statusCode int) bool {
+	switch statusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
+
+// classifyError determines the class of failure that caused an HTTP request to fail.
+func classifyError(err error) string {
+	var dnsErr *net.DNSError
+	var certVerificationErr *tls.CertificateVerificationError
+	var alertErr tls.AlertError
+	var recordHeaderErr tls.RecordHeaderError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &dnsErr):
+		if dnsErr.IsNotFound {
+			return FAILURE_DNS_NXDOMAIN
+		} else if strings.Contains(dnsErr.Err, "server misbehaving") {
+			// The Go resolver reports SERVFAIL (and other server failures) as "server misbehaving".
+			return FAILURE_DNS_SERVFAIL
+		}
+		return FAILURE_DNS_OTHER
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return FAILURE_CONNECTION_REFUSED
+	case errors.As(err, &certVerificationErr), errors.As(err, &alertErr), errors.As(err, &recordHeaderErr):
+		return FAILURE_TLS_HANDSHAKE
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return FAILURE_TIMEOUT
+	default:
+		return FAILURE_OTHER
 	}
 }
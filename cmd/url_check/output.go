@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	SARIF_SCHEMA          = "https://json.schemastore.org/sarif-2.1.0.json"
+	SARIF_VERSION         = "2.1.0"
+	SARIF_RULE_ID_ERROR   = "unreachable-url"
+	SARIF_RULE_ID_WARNING = "redirected-url"
+)
+
+// A URL check failure, attributed to one CA Owner, Subordinate CA Owner, and column.
+type failure struct {
+	CAOwner            string   `json:"ca_owner"`
+	SubordinateCAOwner string   `json:"subordinate_ca_owner,omitempty"`
+	Column             string   `json:"column"`
+	URL                string   `json:"url"`
+	Severity           string   `json:"severity"`
+	FailureClass       string   `json:"failure_class"`
+	Error              string   `json:"error"`
+	ResolvedIPs        []string `json:"resolved_ips,omitempty"`
+	FinalURL           string   `json:"final_url,omitempty"`
+	RedirectHops       int      `json:"redirect_hops,omitempty"`
+	line               int
+}
+
+// writer outputs URL check failures in a particular format.
+type writer interface {
+	write(f *failure) error
+	flush() error
+}
+
+// csvWriter outputs one CSV row per failure, flushing each one so that it is written as soon as it is found.
+type csvWriter struct {
+	csvWriter *csv.Writer
+}
+
+func (w *csvWriter) write(f *failure) error {
+	var redirectHops string
+	if f.RedirectHops > 0 {
+		redirectHops = strconv.Itoa(f.RedirectHops)
+	}
+	w.csvWriter.Write([]string{f.CAOwner, f.SubordinateCAOwner, f.Column, f.URL, f.Severity, f.FailureClass, f.Error, strings.Join(f.ResolvedIPs, " "), f.FinalURL, redirectHops})
+	w.csvWriter.Flush()
+	return w.csvWriter.Error()
+}
+
+func (w *csvWriter) flush() error {
+	w.csvWriter.Flush()
+	return w.csvWriter.Error()
+}
+
+// jsonWriter outputs one JSON object per line.
+type jsonWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonWriter) write(f *failure) error {
+	return w.encoder.Encode(f)
+}
+
+func (w *jsonWriter) flush() error {
+	return nil
+}
+
+// sarifWriter outputs a SARIF log, so that failures can be surfaced as code scanning annotations on the CSV file.
+type sarifWriter struct {
+	out     io.Writer
+	csvPath string
+	results []sarifResult
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver struct {
+		Name           string      `json:"name"`
+		InformationURI string      `json:"informationUri"`
+		Rules          []sarifRule `json:"rules"`
+	} `json:"driver"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation struct {
+		ArtifactLocation struct {
+			URI string `json:"uri"`
+		} `json:"artifactLocation"`
+		Region struct {
+			StartLine int `json:"startLine"`
+		} `json:"region"`
+	} `json:"physicalLocation"`
+}
+
+func (w *sarifWriter) write(f *failure) error {
+	text := fmt.Sprintf("%s: %s [%s] (CA Owner: %s", f.URL, f.Error, f.FailureClass, f.CAOwner)
+	if f.SubordinateCAOwner != "" {
+		text += ", Subordinate CA Owner: " + f.SubordinateCAOwner
+	}
+	text += ", column: " + f.Column + ")"
+	if f.RedirectHops > 0 {
+		text += fmt.Sprintf(", after %d redirect(s) to %s", f.RedirectHops, f.FinalURL)
+	}
+	ruleID := SARIF_RULE_ID_ERROR
+	if f.Severity == SEVERITY_WARNING {
+		ruleID = SARIF_RULE_ID_WARNING
+	}
+
+	var location sarifLocation
+	location.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(w.csvPath)
+	location.PhysicalLocation.Region.StartLine = f.line
+	w.results = append(w.results, sarifResult{
+		RuleID:    ruleID,
+		Level:     f.Severity,
+		Message:   sarifMessage{Text: text},
+		Locations: []sarifLocation{location},
+	})
+	return nil
+}
+
+func (w *sarifWriter) flush() error {
+	run := sarifRun{Results: w.results}
+	if run.Results == nil {
+		run.Results = []sarifResult{}
+	}
+	run.Tool.Driver.Name = "url_check"
+	run.Tool.Driver.InformationURI = "https://github.com/crtsh/ccadb_data"
+	run.Tool.Driver.Rules = []sarifRule{
+		{ID: SARIF_RULE_ID_ERROR, ShortDescription: sarifMessage{Text: "A URL disclosed in CCADB could not be fetched"}},
+		{ID: SARIF_RULE_ID_WARNING, ShortDescription: sarifMessage{Text: "A URL disclosed in CCADB redirects to a different scheme"}},
+	}
+
+	encoder := json.NewEncoder(w.out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{Schema: SARIF_SCHEMA, Version: SARIF_VERSION, Runs: []sarifRun{run}})
+}
@@ -0,0 +1,148 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"iter"
+	"math/bits"
+	"slices"
+
+	"go.uber.org/zap"
+)
+
+// A compact index of the CA certificates by SHA-256 fingerprint (see WithCompactIndex): their SHA-256 fingerprints in
+// ascending order, and a parallel slice of their leading 8 bytes. The position of a CA certificate's fingerprint is also
+// its position in the Store's caCapabilities and caRecords. Since SHA-256 fingerprints are uniformly distributed, the
+// entries are partitioned into buckets by their leading bits, with about 4 entries in each bucket, so that both
+// building the index and looking up a fingerprint only need to examine a few entries. Each entry occupies about 42
+// bytes, whereas a map entry also carries the map's per-entry overhead.
+type compactCAIndex struct {
+	// The number of bits by which a prefix is shifted right to give its bucket.
+	shift uint
+	// The index of the first entry in each bucket, followed by the number of entries.
+	buckets []uint32
+	// The first 8 bytes of each SHA-256 fingerprint as a big-endian integer, which are compared before the full
+	// fingerprint.
+	prefixes           []uint64
+	sha256Fingerprints [][sha256.Size]byte
+}
+
+// newCompactCAIndex builds a compact index of the SHA-256 fingerprints in m. It also returns, for each entry of the
+// index, the value of its SHA-256 fingerprint in m.
+func newCompactCAIndex(m map[[sha256.Size]byte]uint32) (*compactCAIndex, []uint32) {
+	bucketBits := max(bits.Len(uint(len(m)))-2, 1)
+	idx := &compactCAIndex{
+		shift:              uint(64 - bucketBits),
+		buckets:            make([]uint32, 1<<bucketBits+1),
+		prefixes:           make([]uint64, len(m)),
+		sha256Fingerprints: make([][sha256.Size]byte, len(m)),
+	}
+	values := make([]uint32, len(m))
+
+	// Count the entries in each bucket, then place each entry in its bucket.
+	for sha256Fingerprint := range m {
+		idx.buckets[idx.bucket(sha256Fingerprint)+1]++
+	}
+	for b := 1; b < len(idx.buckets); b++ {
+		idx.buckets[b] += idx.buckets[b-1]
+	}
+	next := slices.Clone(idx.buckets[:len(idx.buckets)-1])
+	for sha256Fingerprint, value := range m {
+		b := idx.bucket(sha256Fingerprint)
+		i := next[b]
+		next[b]++
+		idx.prefixes[i] = binary.BigEndian.Uint64(sha256Fingerprint[:8])
+		idx.sha256Fingerprints[i] = sha256Fingerprint
+		values[i] = value
+	}
+
+	// Insertion sort each bucket.
+	for b := range len(idx.buckets) - 1 {
+		for i := idx.buckets[b] + 1; i < idx.buckets[b+1]; i++ {
+			for j := i; j > idx.buckets[b] && bytes.Compare(idx.sha256Fingerprints[j][:], idx.sha256Fingerprints[j-1][:]) < 0; j-- {
+				idx.prefixes[j], idx.prefixes[j-1] = idx.prefixes[j-1], idx.prefixes[j]
+				idx.sha256Fingerprints[j], idx.sha256Fingerprints[j-1] = idx.sha256Fingerprints[j-1], idx.sha256Fingerprints[j]
+				values[j], values[j-1] = values[j-1], values[j]
+			}
+		}
+	}
+	return idx, values
+}
+
+// bucket returns the bucket of a SHA-256 fingerprint.
+func (idx *compactCAIndex) bucket(sha256Fingerprint [sha256.Size]byte) uint64 {
+	return binary.BigEndian.Uint64(sha256Fingerprint[:8]) >> idx.shift
+}
+
+// get returns the position of the CA certificate in the index, and whether it is in the index.
+func (idx *compactCAIndex) get(sha256Fingerprint [sha256.Size]byte) (uint32, bool) {
+	prefix := binary.BigEndian.Uint64(sha256Fingerprint[:8])
+	b := prefix >> idx.shift
+	for i := idx.buckets[b]; i < idx.buckets[b+1]; i++ {
+		if idx.prefixes[i] == prefix && idx.sha256Fingerprints[i] == sha256Fingerprint {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// compactIndex replaces the map that indexes the CA certificates while loading with a compact index, reordering their
+// capabilities and records to match it.
+func (s *Store) compactIndex() {
+	idx, order := newCompactCAIndex(s.caIndex)
+	caCapabilities := make([]CACertCapabilities, len(order))
+	caRecords := make([]CertificateRecord, len(order))
+	for i, j := range order {
+		caCapabilities[i] = s.caCapabilities[j]
+		caRecords[i] = s.caRecords[j]
+	}
+	s.caCapabilities, s.caRecords = caCapabilities, caRecords
+	s.compactCAIndex = idx
+	s.caIndex = nil
+	logger.Debug("Built compact CA certificate index", zap.Int("count", len(idx.sha256Fingerprints)))
+}
+
+// caPosition returns the position of the CA certificate in caCapabilities and caRecords, and whether it is in CCADB.
+func (s *Store) caPosition(sha256Fingerprint [sha256.Size]byte) (uint32, bool) {
+	if s.compactCAIndex != nil {
+		return s.compactCAIndex.get(sha256Fingerprint)
+	}
+	i, ok := s.caIndex[sha256Fingerprint]
+	return i, ok
+}
+
+// caCertCapabilities returns the capabilities of the CA certificate, or nil if it is not in CCADB.
+func (s *Store) caCertCapabilities(sha256Fingerprint [sha256.Size]byte) *CACertCapabilities {
+	if i, ok := s.caPosition(sha256Fingerprint); ok {
+		return &s.caCapabilities[i]
+	}
+	return nil
+}
+
+// certificateRecord returns the record of the CA certificate, or nil if it is not in CCADB.
+func (s *Store) certificateRecord(sha256Fingerprint [sha256.Size]byte) *CertificateRecord {
+	if i, ok := s.caPosition(sha256Fingerprint); ok {
+		return &s.caRecords[i]
+	}
+	return nil
+}
+
+// certificateRecords iterates over the CA certificates' SHA-256 fingerprints and records, in no particular order.
+func (s *Store) certificateRecords() iter.Seq2[[sha256.Size]byte, *CertificateRecord] {
+	return func(yield func([sha256.Size]byte, *CertificateRecord) bool) {
+		if s.compactCAIndex != nil {
+			for i, sha256Fingerprint := range s.compactCAIndex.sha256Fingerprints {
+				if !yield(sha256Fingerprint, &s.caRecords[i]) {
+					return
+				}
+			}
+			return
+		}
+		for sha256Fingerprint, i := range s.caIndex {
+			if !yield(sha256Fingerprint, &s.caRecords[i]) {
+				return
+			}
+		}
+	}
+}
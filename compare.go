@@ -0,0 +1,81 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"reflect"
+	"slices"
+)
+
+// DatasetComparison is the differences between two datasets, as returned by Compare.
+type DatasetComparison struct {
+	// The SHA-256 fingerprints of the CA certificates that are only in the new dataset, in ascending order.
+	Added [][sha256.Size]byte
+	// The SHA-256 fingerprints of the CA certificates that are only in the old dataset, in ascending order.
+	Removed [][sha256.Size]byte
+	// The CA certificates whose records or capabilities differ, in ascending order of SHA-256 fingerprint.
+	Changed []*RecordChange
+}
+
+// Count returns the number of CA certificates that were added, removed, or changed.
+func (dc *DatasetComparison) Count() int {
+	return len(dc.Added) + len(dc.Removed) + len(dc.Changed)
+}
+
+// RecordChange is the differences between two datasets' records of one CA certificate, as held in DatasetComparison.
+type RecordChange struct {
+	SHA256Fingerprint [sha256.Size]byte
+	// The fields that differ, in the order in which they are declared in CertificateRecord and then CACertCapabilities.
+	Fields []*FieldChange
+}
+
+// FieldChange is a field of a CA certificate record that differs between two datasets, as held in RecordChange.
+type FieldChange struct {
+	// The name of the field, e.g. "MozillaStatus" or "TlsCapable".
+	Name string
+	Old  any
+	New  any
+}
+
+// Compare compares the CA certificate records and capabilities in two Stores, e.g. the embedded dataset and a freshly
+// fetched one, and returns the CA certificates that were added to, removed from, or changed in the new one.
+func Compare(old, new *Store) *DatasetComparison {
+	dc := &DatasetComparison{}
+	for sha256Fingerprint, newRecord := range new.certificateRecords() {
+		oldRecord := old.certificateRecord(sha256Fingerprint)
+		if oldRecord == nil {
+			dc.Added = append(dc.Added, sha256Fingerprint)
+			continue
+		}
+		fields := compareFields(oldRecord, newRecord)
+		if oldCapabilities, newCapabilities := old.caCertCapabilities(sha256Fingerprint), new.caCertCapabilities(sha256Fingerprint); oldCapabilities != nil && newCapabilities != nil {
+			fields = append(fields, compareFields(oldCapabilities, newCapabilities)...)
+		}
+		if len(fields) > 0 {
+			dc.Changed = append(dc.Changed, &RecordChange{SHA256Fingerprint: sha256Fingerprint, Fields: fields})
+		}
+	}
+	for sha256Fingerprint := range old.certificateRecords() {
+		if new.certificateRecord(sha256Fingerprint) == nil {
+			dc.Removed = append(dc.Removed, sha256Fingerprint)
+		}
+	}
+
+	slices.SortFunc(dc.Added, compareSHA256Fingerprints)
+	slices.SortFunc(dc.Removed, compareSHA256Fingerprints)
+	slices.SortFunc(dc.Changed, func(a, b *RecordChange) int {
+		return compareSHA256Fingerprints(a.SHA256Fingerprint, b.SHA256Fingerprint)
+	})
+	return dc
+}
+
+// compareFields returns the fields that differ between two structs of the same type.
+func compareFields[T any](old, new *T) []*FieldChange {
+	oldValue, newValue := reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem()
+	var fields []*FieldChange
+	for i := range oldValue.NumField() {
+		if o, n := oldValue.Field(i).Interface(), newValue.Field(i).Interface(); !reflect.DeepEqual(o, n) {
+			fields = append(fields, &FieldChange{Name: oldValue.Type().Field(i).Name, Old: o, New: n})
+		}
+	}
+	return fields
+}
@@ -0,0 +1,335 @@
+package ccadb_data
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RootStoreConstraints is a set of constraints applied by a root program to a CA certificate that it includes, beyond
+// its inclusion status, as returned by GetRootStoreConstraintsBySHA256. A root program may apply several alternative
+// sets of constraints to the same CA certificate, in which case a certificate only needs to satisfy one of them.
+type RootStoreConstraints struct {
+	RootProgram string
+	// Certificates issued after these dates (which cover the whole day, UTC) are distrusted.
+	DistrustForTLSAfter   time.Time
+	DistrustForSMIMEAfter time.Time
+	// TLS certificates with an SCT after SCTNotAfter are distrusted, as are those that don't have all of their SCTs
+	// after SCTAllAfter.
+	SCTNotAfter time.Time
+	SCTAllAfter time.Time
+	// TLS certificates are only trusted for these DNS names, if any are listed.
+	PermittedDNSNames []string
+	// Any other constraints, as described by the root program.
+	AppliedConstraints string
+}
+
+// A report that describes the constraints applied by a root program to the root certificates that it includes.
+type constraintsReport struct {
+	rootProgram string
+	url         string
+	filePath    string
+	// Converts the downloaded report into the form stored at filePath, if they differ.
+	decode func(data []byte) ([]byte, error)
+	parse  func(data []byte, filePath string) (map[[sha256.Size]byte][]*RootStoreConstraints, error)
+}
+
+// The paths of the root program constraints reports in a dataset.
+const (
+	MOZILLA_INCLUDED_CA_REPORT_PATH = "data/IncludedCACertificateReportPEMCSV"
+	CHROME_ROOT_STORE_PATH          = "data/root_store.textproto"
+)
+
+// The root program constraints reports that are fetched alongside the CCADB reports. Apple's constraints are derived
+// from the CCADB reports instead (see deriveAppleConstraints).
+var constraintsReports = []*constraintsReport{
+	{
+		rootProgram: ROOT_PROGRAM_MOZILLA,
+		url:         CCADB_SITE_URL + "mozilla/IncludedCACertificateReportPEMCSV",
+		filePath:    MOZILLA_INCLUDED_CA_REPORT_PATH,
+		parse:       parseMozillaIncludedCACertificateReport,
+	},
+	{
+		rootProgram: ROOT_PROGRAM_CHROME,
+		url:         "https://chromium.googlesource.com/chromium/src/+/main/net/data/ssl/chrome_root_store/root_store.textproto?format=TEXT",
+		filePath:    CHROME_ROOT_STORE_PATH,
+		// Gitiles serves file contents Base64-encoded.
+		decode: func(data []byte) ([]byte, error) {
+			return base64.StdEncoding.AppendDecode(nil, bytes.TrimSpace(data))
+		},
+		parse: parseChromeRootStore,
+	},
+}
+
+// readConstraintsReports reads the root program constraints reports. A missing report (e.g. in the embedded data, or in
+// a dataset fetched by an older version of this package) means that no constraints are known for that root program.
+func (s *Store) readConstraintsReports() error {
+	for _, report := range constraintsReports {
+		data, err := s.readDataFile(report.filePath)
+		if errors.Is(err, fs.ErrNotExist) {
+			logger.Info("Root program constraints file does not exist, so no constraints are known", zap.String("file_path", report.filePath))
+			continue
+		} else if err != nil {
+			logger.Info("Root program constraints file could not be read", zap.Error(err), zap.String("file_path", report.filePath))
+			return fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
+		}
+
+		constraintsMap, err := report.parse(data, report.filePath)
+		if err != nil {
+			return err
+		}
+		for sha256Fingerprint, constraints := range constraintsMap {
+			for _, rsc := range constraints {
+				rsc.RootProgram = report.rootProgram
+			}
+			s.rootStoreConstraintsMap[sha256Fingerprint] = append(s.rootStoreConstraintsMap[sha256Fingerprint], constraints...)
+		}
+	}
+
+	s.deriveAppleConstraints()
+	return nil
+}
+
+// deriveAppleConstraints adds Apple's constraints, which Apple publishes through the Apple Status that it maintains in
+// CCADB rather than in a report of its own. Apple distrusts every certificate issued by a CA certificate that it has
+// blocked, which is recorded as distrust of the TLS and S/MIME certificates issued after the day before the CA
+// certificate became valid, so that IsDistrustedForTLSAfter and IsDistrustedForSMIMEAfter also apply it to the
+// certificates issued by its subordinate CAs.
+func (s *Store) deriveAppleConstraints() {
+	for sha256Fingerprint, cr := range s.certificateRecords() {
+		if cr.AppleStatus != ROOT_PROGRAM_STATUS_BLOCKED || cr.ValidFrom.IsZero() {
+			continue
+		}
+		distrustAfter := cr.ValidFrom.Truncate(24*time.Hour).AddDate(0, 0, -1)
+		s.rootStoreConstraintsMap[sha256Fingerprint] = append(s.rootStoreConstraintsMap[sha256Fingerprint], &RootStoreConstraints{
+			RootProgram:           ROOT_PROGRAM_APPLE,
+			DistrustForTLSAfter:   distrustAfter,
+			DistrustForSMIMEAfter: distrustAfter,
+			AppliedConstraints:    "Blocked",
+		})
+	}
+}
+
+// parseMozillaIncludedCACertificateReport parses Mozilla's Included CA Certificate Report CSV.
+func parseMozillaIncludedCACertificateReport(data []byte, filePath string) (map[[sha256.Size]byte][]*RootStoreConstraints, error) {
+	// Parse CSV data.
+	records := readCSVRecords(data, filePath, 0)
+	if len(records) == 0 {
+		logger.Error("CSV file is empty", zap.String("file_path", filePath))
+		return nil, fmt.Errorf("%w: %s: CSV file is empty", ErrMalformedDataset, filePath)
+	}
+
+	// Examine the CSV header to find the fields that we need. Only the SHA-256 fingerprint is required.
+	header := records[0].fields
+	sha256Idx := headerIndexOf(header, "SHA-256 Fingerprint")
+	if sha256Idx == -1 {
+		logger.Error("CSV data is missing one or more expected headers", zap.String("file_path", filePath), zap.String("header", "SHA-256 Fingerprint"))
+		return nil, fmt.Errorf("%w: %s: CSV data is missing the %q header", ErrMalformedDataset, filePath, "SHA-256 Fingerprint")
+	}
+	distrustForTLSAfterIdx := headerIndexOf(header, "Distrust for TLS After Date")
+	distrustForSMIMEAfterIdx := headerIndexOf(header, "Distrust for S/MIME After Date")
+	appliedConstraintsIdx := headerIndexOf(header, "Mozilla Applied Constraints")
+
+	// Process CSV data.
+	constraintsMap := make(map[[sha256.Size]byte][]*RootStoreConstraints)
+	for _, record := range records[1:] {
+		line := record.fields
+
+		sha256Array, ok := parseConstraintsFingerprint(line[sha256Idx], filePath)
+		if !ok {
+			continue
+		}
+
+		rsc := RootStoreConstraints{AppliedConstraints: csvField(line, appliedConstraintsIdx)}
+		var err error
+		if rsc.DistrustForTLSAfter, err = ParseCCADBDate(csvField(line, distrustForTLSAfterIdx)); err != nil {
+			logger.Warn("CSV data contains an invalid date", zap.Error(err), zap.String("file_path", filePath))
+		}
+		if rsc.DistrustForSMIMEAfter, err = ParseCCADBDate(csvField(line, distrustForSMIMEAfterIdx)); err != nil {
+			logger.Warn("CSV data contains an invalid date", zap.Error(err), zap.String("file_path", filePath))
+		}
+		if rsc.DistrustForTLSAfter.IsZero() && rsc.DistrustForSMIMEAfter.IsZero() && rsc.AppliedConstraints == "" {
+			continue
+		}
+		constraintsMap[sha256Array] = append(constraintsMap[sha256Array], &rsc)
+	}
+
+	return constraintsMap, nil
+}
+
+// parseChromeRootStore parses the trust anchors in the Chrome Root Store's root_store.textproto. Only the subset of
+// the protobuf text format that is used by that file is supported.
+func parseChromeRootStore(data []byte, filePath string) (map[[sha256.Size]byte][]*RootStoreConstraints, error) {
+	constraintsMap := make(map[[sha256.Size]byte][]*RootStoreConstraints)
+	var path []string
+	var sha256Array [sha256.Size]byte
+	var haveSHA256 bool
+	var constraints []*RootStoreConstraints
+	var rsc *RootStoreConstraints
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for line != "" {
+			switch {
+			case line[0] == '}':
+				// End of a message.
+				if len(path) == 0 {
+					return nil, fmt.Errorf("%w: %s:%d: Unbalanced braces", ErrMalformedDataset, filePath, lineNumber)
+				}
+				switch strings.Join(path, ".") {
+				case "trust_anchors":
+					if haveSHA256 && len(constraints) > 0 {
+						constraintsMap[sha256Array] = append(constraintsMap[sha256Array], constraints...)
+					}
+					haveSHA256, constraints = false, nil
+				case "trust_anchors.constraints":
+					constraints = append(constraints, rsc)
+					rsc = nil
+				}
+				path = path[:len(path)-1]
+				line = strings.TrimSpace(line[1:])
+			case isTextprotoMessageStart(line):
+				// Start of a message: "name {" or "name: {".
+				name, rest, _ := strings.Cut(line, "{")
+				path = append(path, strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(name), ":")))
+				if strings.Join(path, ".") == "trust_anchors.constraints" {
+					rsc = &RootStoreConstraints{}
+				}
+				line = strings.TrimSpace(rest)
+			default:
+				// A scalar field: "name: value".
+				name, rest, ok := strings.Cut(line, ":")
+				if !ok {
+					return nil, fmt.Errorf("%w: %s:%d: Unexpected content", ErrMalformedDataset, filePath, lineNumber)
+				}
+				value, remainder := cutTextprotoValue(strings.TrimSpace(rest))
+				line = strings.TrimSpace(remainder)
+				switch strings.Join(append(path, strings.TrimSpace(name)), ".") {
+				case "trust_anchors.sha256_hex":
+					sha256Array, haveSHA256 = parseConstraintsFingerprint(value, filePath)
+				case "trust_anchors.constraints.sct_not_after_sec":
+					rsc.SCTNotAfter = parseTextprotoUnixTime(value, filePath)
+				case "trust_anchors.constraints.sct_all_after_sec":
+					rsc.SCTAllAfter = parseTextprotoUnixTime(value, filePath)
+				case "trust_anchors.constraints.permitted_dns_names":
+					rsc.PermittedDNSNames = append(rsc.PermittedDNSNames, value)
+				case "trust_anchors.constraints.min_version":
+					rsc.AppliedConstraints = strings.TrimSpace(rsc.AppliedConstraints + " Chrome version >= " + value)
+				case "trust_anchors.constraints.max_version_exclusive":
+					rsc.AppliedConstraints = strings.TrimSpace(rsc.AppliedConstraints + " Chrome version < " + value)
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrMalformedDataset, filePath, err)
+	} else if len(path) != 0 {
+		return nil, fmt.Errorf("%w: %s: Unbalanced braces", ErrMalformedDataset, filePath)
+	}
+
+	return constraintsMap, nil
+}
+
+// isTextprotoMessageStart reports whether line starts with the name of a message field, followed by "{".
+func isTextprotoMessageStart(line string) bool {
+	name, _, found := strings.Cut(line, "{")
+	name = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(name), ":"))
+	return found && name != "" && !strings.ContainsAny(name, " \t:\"")
+}
+
+// cutTextprotoValue returns the scalar value at the start of s (without quotes, if quoted), and the rest of s.
+func cutTextprotoValue(s string) (value, rest string) {
+	if strings.HasPrefix(s, `"`) {
+		if end := strings.Index(s[1:], `"`); end != -1 {
+			return s[1 : end+1], s[end+2:]
+		}
+		return strings.Trim(s, `"`), ""
+	}
+	if end := strings.IndexAny(s, " \t}"); end != -1 {
+		return s[:end], s[end:]
+	}
+	return s, ""
+}
+
+// parseTextprotoUnixTime parses a number of seconds since the Unix epoch.
+func parseTextprotoUnixTime(value, filePath string) time.Time {
+	sec, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		logger.Warn("Root program constraints file contains an invalid time", zap.String("value", value), zap.String("file_path", filePath))
+		return time.Time{}
+	}
+	return time.Unix(sec, 0).UTC()
+}
+
+// parseConstraintsFingerprint parses a hex SHA-256 fingerprint, which is sometimes colon-separated.
+func parseConstraintsFingerprint(value, filePath string) ([sha256.Size]byte, bool) {
+	sha256Array, err := ParseSHA256Fingerprint(value)
+	if err != nil {
+		logger.Warn("Root program constraints file contains an invalid hex string", zap.String("value", value), zap.String("file_path", filePath))
+		return sha256Array, false
+	}
+	return sha256Array, true
+}
+
+// headerIndexOf returns the index of the named header, or -1 if the header is not present.
+func headerIndexOf(header []string, name string) int {
+	for i, h := range header {
+		if strings.EqualFold(strings.TrimSpace(h), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// distrustsForTLS reports whether these constraints distrust a TLS certificate issued at issuanceDate. An SCT is
+// assumed to have been issued at the same time as the certificate.
+func (rsc *RootStoreConstraints) distrustsForTLS(issuanceDate time.Time) bool {
+	return (!rsc.DistrustForTLSAfter.IsZero() && !issuanceDate.Before(rsc.DistrustForTLSAfter.AddDate(0, 0, 1))) ||
+		(!rsc.SCTNotAfter.IsZero() && issuanceDate.After(rsc.SCTNotAfter)) ||
+		(!rsc.SCTAllAfter.IsZero() && !issuanceDate.After(rsc.SCTAllAfter))
+}
+
+// distrustsForSMIME reports whether these constraints distrust an S/MIME certificate issued at issuanceDate.
+func (rsc *RootStoreConstraints) distrustsForSMIME(issuanceDate time.Time) bool {
+	return !rsc.DistrustForSMIMEAfter.IsZero() && !issuanceDate.Before(rsc.DistrustForSMIMEAfter.AddDate(0, 0, 1))
+}
+
+// isDistrustedAfter reports whether a certificate issued at issuanceDate by the CA certificate (or a certificate
+// issued by one of its disclosed parents) is distrusted by any root program. A root program distrusts the certificate
+// if every set of constraints that it applies to a CA certificate in the chain distrusts it.
+func (s *Store) isDistrustedAfter(sha256Fingerprint [sha256.Size]byte, issuanceDate time.Time, distrusts func(*RootStoreConstraints, time.Time) bool) bool {
+	seen := make(map[[sha256.Size]byte]bool)
+	for current := sha256Fingerprint; current != [sha256.Size]byte{} && !seen[current]; {
+		seen[current] = true
+		distrustedBy := make(map[string]bool)
+		for _, rsc := range s.rootStoreConstraintsMap[current] {
+			if distrusted, ok := distrustedBy[rsc.RootProgram]; !ok || distrusted {
+				distrustedBy[rsc.RootProgram] = distrusts(rsc, issuanceDate)
+			}
+		}
+		for _, distrusted := range distrustedBy {
+			if distrusted {
+				return true
+			}
+		}
+		if cr := s.certificateRecord(current); cr != nil {
+			current = cr.ParentSHA256Fingerprint
+		} else {
+			break
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"encoding/csv"
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+const (
+	TEST_ISRG_ROOT_X1_SHA256        = "96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6"
+	TEST_R10_SHA256                 = "9D7C3F1AA6AD2B2EC0D5CF1E246F8D9AE6CBC9FD0755AD37BB974B1F2FB603F3"
+	TEST_PARENT_CERT_REVOKED_SHA256 = "A85C84A0825AA019DC08FA9A02C4C39E3FD419347B2E92DF04633EE426D90077"
+)
+
+func TestParseMozillaIncludedCACertificateReport(t *testing.T) {
+	data := `"SHA-256 Fingerprint","Distrust for TLS After Date","Distrust for S/MIME After Date","Mozilla Applied Constraints"
+"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6","2024.11.30","2025.03.31",""
+"9D:7C:3F:1A:A6:AD:2B:2E:C0:D5:CF:1E:24:6F:8D:9A:E6:CB:C9:FD:07:55:AD:37:BB:97:4B:1F:2F:B6:03:F3","","","*.gov"
+"591E9CE6C863D3A079E9FABE1478C7339A26B21269DDE795211361024AE31A44","","",""
+"Not a fingerprint","2024.11.30","",""
+`
+	constraintsMap, err := parseMozillaIncludedCACertificateReport([]byte(data), "test.csv")
+	if err != nil {
+		t.Fatalf("parseMozillaIncludedCACertificateReport() returned %v", err)
+	} else if len(constraintsMap) != 2 {
+		t.Fatalf("parseMozillaIncludedCACertificateReport() returned constraints for %d root certificates, want 2", len(constraintsMap))
+	}
+
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	if got := constraintsMap[isrgRootX1]; len(got) != 1 || !got[0].DistrustForTLSAfter.Equal(time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC)) || !got[0].DistrustForSMIMEAfter.Equal(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Constraints for ISRG Root X1 = %+v", got)
+	}
+	r10, _ := HexFingerprintToArray(TEST_R10_SHA256)
+	if got := constraintsMap[r10]; len(got) != 1 || got[0].AppliedConstraints != "*.gov" || !got[0].DistrustForTLSAfter.IsZero() {
+		t.Errorf("Constraints for R10 = %+v", got)
+	}
+
+	if _, err := parseMozillaIncludedCACertificateReport([]byte("\"Owner\"\n\"ISRG\"\n"), "test.csv"); err == nil {
+		t.Error("parseMozillaIncludedCACertificateReport() returned no error without a SHA-256 Fingerprint header")
+	}
+}
+
+func TestParseChromeRootStore(t *testing.T) {
+	data := `# proto-file: chrome_root_store.proto
+version_major: 1
+
+trust_anchors {
+  sha256_hex: "96bcec06264976f37460779acf28c5a7cfe8a3c0aae11a8ffcee05c0bddf08c6"
+}
+
+trust_anchors {
+  sha256_hex: "9d7c3f1aa6ad2b2ec0d5cf1e246f8d9ae6cbc9fd0755ad37bb974b1f2fb603f3"
+  constraints {
+    sct_not_after_sec: 1730419199
+    min_version: "127"
+  }
+  constraints: { permitted_dns_names: "example.gov" permitted_dns_names: "example.mil" }
+}
+`
+	constraintsMap, err := parseChromeRootStore([]byte(data), "root_store.textproto")
+	if err != nil {
+		t.Fatalf("parseChromeRootStore() returned %v", err)
+	} else if len(constraintsMap) != 1 {
+		t.Fatalf("parseChromeRootStore() returned constraints for %d root certificates, want 1", len(constraintsMap))
+	}
+
+	r10, _ := HexFingerprintToArray(TEST_R10_SHA256)
+	got := constraintsMap[r10]
+	if len(got) != 2 {
+		t.Fatalf("parseChromeRootStore() returned %d sets of constraints for R10, want 2", len(got))
+	}
+	if !got[0].SCTNotAfter.Equal(time.Unix(1730419199, 0)) || got[0].AppliedConstraints != "Chrome version >= 127" {
+		t.Errorf("First constraints for R10 = %+v", got[0])
+	}
+	if !slices.Equal(got[1].PermittedDNSNames, []string{"example.gov", "example.mil"}) {
+		t.Errorf("Second constraints for R10 = %+v", got[1])
+	}
+
+	for _, malformed := range []string{"trust_anchors {\n", "}\n", "trust_anchors {\n  sha256_hex\n}\n"} {
+		if _, err := parseChromeRootStore([]byte(malformed), "root_store.textproto"); err == nil {
+			t.Errorf("parseChromeRootStore(%q) returned no error", malformed)
+		}
+	}
+}
+
+func TestIsDistrustedForTLSAfter(t *testing.T) {
+	fsys := fixtureMapFS(t, "v5")
+	fsys[MOZILLA_INCLUDED_CA_REPORT_PATH] = &fstest.MapFile{Data: []byte(`"SHA-256 Fingerprint","Distrust for TLS After Date","Distrust for S/MIME After Date"
+"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6","2024.11.30",""
+`)}
+	s, err := NewStore(fsys, WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	r10, _ := HexFingerprintToArray(TEST_R10_SHA256)
+	for _, tc := range []struct {
+		sha256Fingerprint [32]byte
+		issuanceDate      time.Time
+		want              bool
+	}{
+		{isrgRootX1, time.Date(2024, 11, 30, 23, 59, 59, 0, time.UTC), false},
+		{isrgRootX1, time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC), true},
+		// The distrust date of a parent applies to the certificates issued by its subordinate CAs.
+		{r10, time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC), false},
+		{r10, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), true},
+	} {
+		if got := s.IsDistrustedForTLSAfter(tc.sha256Fingerprint, tc.issuanceDate); got != tc.want {
+			t.Errorf("IsDistrustedForTLSAfter(%X, %s) = %t, want %t", tc.sha256Fingerprint, tc.issuanceDate, got, tc.want)
+		}
+	}
+	if s.IsDistrustedForSMIMEAfter(isrgRootX1, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsDistrustedForSMIMEAfter() = true without an S/MIME distrust date")
+	}
+}
+
+func TestIsDistrustedForTLSAfterSCTConstraints(t *testing.T) {
+	// Chrome distrusts ISRG Root X1's certificates with an SCT after 2024-11-11 23:59:59, and Certum CA's certificates
+	// unless all of their SCTs are after 2025-01-01 00:00:00.
+	fsys := fixtureMapFS(t, "v5")
+	fsys[CHROME_ROOT_STORE_PATH] = &fstest.MapFile{Data: []byte(`trust_anchors {
+  sha256_hex: "96bcec06264976f37460779acf28c5a7cfe8a3c0aae11a8ffcee05c0bddf08c6"
+  constraints { sct_not_after_sec: 1731369599 }
+}
+trust_anchors {
+  sha256_hex: "d8e0febc1db2e38d00940f37d27d41344d993e734b99d5656d9778d4d8143624"
+  constraints { sct_all_after_sec: 1735689600 }
+}
+`)}
+	s, err := NewStore(fsys, WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	certumCA, _ := HexFingerprintToArray("D8E0FEBC1DB2E38D00940F37D27D41344D993E734B99D5656D9778D4D8143624")
+	sctNotAfter, sctAllAfter := time.Unix(1731369599, 0).UTC(), time.Unix(1735689600, 0).UTC()
+	for _, tc := range []struct {
+		name              string
+		sha256Fingerprint [32]byte
+		issuanceDate      time.Time
+		want              bool
+	}{
+		{"Before sct_not_after_sec", isrgRootX1, sctNotAfter.Add(-time.Second), false},
+		{"At sct_not_after_sec", isrgRootX1, sctNotAfter, false},
+		{"After sct_not_after_sec", isrgRootX1, sctNotAfter.Add(time.Second), true},
+		{"Before sct_all_after_sec", certumCA, sctAllAfter.Add(-time.Second), true},
+		{"At sct_all_after_sec", certumCA, sctAllAfter, true},
+		{"After sct_all_after_sec", certumCA, sctAllAfter.Add(time.Second), false},
+	} {
+		if got := s.IsDistrustedForTLSAfter(tc.sha256Fingerprint, tc.issuanceDate); got != tc.want {
+			t.Errorf("%s: IsDistrustedForTLSAfter(%X, %s) = %t, want %t", tc.name, tc.sha256Fingerprint, tc.issuanceDate, got, tc.want)
+		}
+	}
+}
+
+func TestAppleConstraints(t *testing.T) {
+	// Block ISRG Root X1 in Apple's root program.
+	fsys := fixtureMapFS(t, "v5")
+	reader := csv.NewReader(bytes.NewReader(fsys[CCADB_CSV_PATH].Data))
+	reader.LazyQuotes = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sha256Idx, appleStatusIdx := slices.Index(records[0], "SHA-256 Fingerprint"), slices.Index(records[0], "Apple Status")
+	for _, record := range records[1:] {
+		if record[sha256Idx] == TEST_ISRG_ROOT_X1_SHA256 {
+			record[appleStatusIdx] = ROOT_PROGRAM_STATUS_BLOCKED
+		}
+	}
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.WriteAll(records)
+	fsys[CCADB_CSV_PATH] = &fstest.MapFile{Data: buf.Bytes()}
+	s, err := NewStore(fsys, WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	r10, _ := HexFingerprintToArray(TEST_R10_SHA256)
+	var appleConstraints []*RootStoreConstraints
+	for _, rsc := range s.GetRootStoreConstraintsBySHA256(isrgRootX1) {
+		if rsc.RootProgram == ROOT_PROGRAM_APPLE {
+			appleConstraints = append(appleConstraints, rsc)
+		}
+	}
+	if len(appleConstraints) != 1 || appleConstraints[0].AppliedConstraints != "Blocked" {
+		t.Fatalf("Apple's constraints for ISRG Root X1 = %+v", appleConstraints)
+	}
+	validFrom := s.GetCertificateRecordBySHA256(isrgRootX1).ValidFrom
+	for _, sha256Fingerprint := range [][32]byte{isrgRootX1, r10} {
+		if !s.IsDistrustedForTLSAfter(sha256Fingerprint, validFrom) || !s.IsDistrustedForSMIMEAfter(sha256Fingerprint, validFrom.AddDate(5, 0, 0)) {
+			t.Errorf("Certificates issued under %X are not distrusted", sha256Fingerprint)
+		}
+	}
+	if s.IsDistrustedForTLSAfter(isrgRootX1, validFrom.AddDate(0, 0, -2)) {
+		t.Error("Certificates issued before ISRG Root X1 became valid are distrusted")
+	}
+
+	// Without the block, Apple has no constraints.
+	if s, err = NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1)); err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	for _, rsc := range s.GetRootStoreConstraintsBySHA256(isrgRootX1) {
+		if rsc.RootProgram == ROOT_PROGRAM_APPLE {
+			t.Errorf("Apple's constraints for ISRG Root X1 = %+v, want none", rsc)
+		}
+	}
+}
+
+func TestMissingChromeRootStore(t *testing.T) {
+	// Without the Chrome Root Store, Mozilla's constraints still apply, and no Chrome constraints are known.
+	fsys := fixtureMapFS(t, "v5", CHROME_ROOT_STORE_PATH)
+	fsys[MOZILLA_INCLUDED_CA_REPORT_PATH] = &fstest.MapFile{Data: []byte(`"SHA-256 Fingerprint","Distrust for TLS After Date","Distrust for S/MIME After Date"
+"96BCEC06264976F37460779ACF28C5A7CFE8A3C0AAE11A8FFCEE05C0BDDF08C6","2024.11.30",""
+`)}
+	s, err := NewStore(fsys, WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	rscs := s.GetRootStoreConstraintsBySHA256(isrgRootX1)
+	if len(rscs) != 1 || rscs[0].RootProgram != ROOT_PROGRAM_MOZILLA {
+		t.Errorf("GetRootStoreConstraintsBySHA256(ISRG Root X1) = %+v, want only Mozilla's constraints", rscs)
+	}
+	if !s.IsDistrustedForTLSAfter(isrgRootX1, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsDistrustedForTLSAfter() = false after Mozilla's distrust date")
+	}
+}
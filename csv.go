@@ -0,0 +1,145 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// A single CSV record, and the line number on which it starts.
+type csvRecord struct {
+	line   int
+	fields []string
+}
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// A field that is wrapped in smart quotes instead of ASCII double quotes.
+var smartQuotedField = regexp.MustCompile(`(?m)(^|,)“([^"\n“”]*)”(,|$)`)
+
+// sanitizeCSV repairs problems seen in CCADB CSV exports that would otherwise cause encoding/csv to fail.
+func sanitizeCSV(data []byte) []byte {
+	// Strip a leading UTF-8 BOM, which would otherwise become part of the first header name.
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	// Normalize line endings, so that bare CRs are not mistaken for field content.
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	data = bytes.ReplaceAll(data, []byte("\r"), []byte("\n"))
+
+	// Replace smart quotes that have been used as delimiters for a field that contains a comma. Smart quotes around a
+	// field that doesn't contain a comma are harmless, so they are retained. Only the lines that contain a smart quote
+	// are examined, since running the regular expression over the whole file dominates the load time.
+	if bytes.ContainsRune(data, '“') {
+		sanitized := make([]byte, 0, len(data))
+		for line := range bytes.Lines(data) {
+			if bytes.ContainsRune(line, '“') {
+				line = smartQuotedField.ReplaceAllFunc(line, func(match []byte) []byte {
+					if !bytes.Contains(smartQuotedField.FindSubmatch(match)[2], []byte(",")) {
+						return match
+					}
+					return smartQuotedField.ReplaceAll(match, []byte(`$1"$2"$3`))
+				})
+			}
+			sanitized = append(sanitized, line...)
+		}
+		data = sanitized
+	}
+
+	return data
+}
+
+// readCSVRecords parses CSV data one record at a time, so that a malformed record only causes that record to be
+// skipped. The header is returned as the first record. If fieldsPerRecord is 0, the number of fields in the header is
+// expected in every record.
+func readCSVRecords(data []byte, filePath string, fieldsPerRecord int) []csvRecord {
+	reader := csv.NewReader(bytes.NewReader(sanitizeCSV(data)))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	var records []csvRecord
+	var pending *csvRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				logger.Warn("CSV data has a line that could not be parsed", zap.Error(err), zap.String("file_path", filePath), zap.Int("line", parseErr.StartLine))
+				pending = nil
+				continue
+			}
+			logger.Error("CSV file could not be parsed", zap.Error(err), zap.String("file_path", filePath))
+			return records
+		}
+		line, _ := reader.FieldPos(0)
+
+		if len(records) == 0 {
+			// This is the header.
+			records = append(records, csvRecord{line: line, fields: fields})
+			if fieldsPerRecord == 0 {
+				fieldsPerRecord = len(fields)
+			}
+			continue
+		}
+
+		// An unquoted field that contains a newline splits a record across multiple lines. Rejoin the pieces when
+		// doing so produces the expected number of fields.
+		if pending != nil {
+			joined := append(pending.fields[:len(pending.fields)-1:len(pending.fields)-1], pending.fields[len(pending.fields)-1]+"\n"+fields[0])
+			joined = append(joined, fields[1:]...)
+			if len(joined) <= fieldsPerRecord {
+				pending.fields = joined
+				if len(joined) == fieldsPerRecord {
+					records = append(records, *pending)
+					pending = nil
+				}
+				continue
+			}
+			logger.Warn("CSV data has a line that is missing one or more expected fields", zap.String("file_path", filePath), zap.Int("line", pending.line))
+			pending = nil
+		}
+
+		switch {
+		case len(fields) == fieldsPerRecord:
+			records = append(records, csvRecord{line: line, fields: fields})
+		case len(fields) < fieldsPerRecord:
+			pending = &csvRecord{line: line, fields: fields}
+		default:
+			logger.Warn("CSV data has a line that has too many fields", zap.String("file_path", filePath), zap.Int("line", line))
+		}
+	}
+
+	if pending != nil {
+		logger.Warn("CSV data has a line that is missing one or more expected fields", zap.String("file_path", filePath), zap.Int("line", pending.line))
+	}
+
+	return records
+}
+
+// A stringInterner returns a canonical copy of each string, so that repeated values (such as CA Owners, record types,
+// and statuses) share memory, and so that a value does not keep the rest of its CSV record alive.
+type stringInterner map[string]string
+
+func (si stringInterner) intern(s string) string {
+	if interned, ok := si[s]; ok {
+		return interned
+	}
+	s = strings.Clone(s)
+	si[s] = s
+	return s
+}
+
+// csvField returns the field at the given index, or an empty string if the field is absent (index -1).
+func csvField(fields []string, idx int) string {
+	if idx < 0 || idx >= len(fields) {
+		return ""
+	}
+	return fields[idx]
+}
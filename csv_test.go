@@ -0,0 +1,106 @@
+package ccadb_data
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReadCSVRecords(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data string
+		want [][]string
+	}{
+		{
+			name: "Well-formed",
+			data: "A,B,C\n1,2,3\n4,5,6\n",
+			want: [][]string{{"A", "B", "C"}, {"1", "2", "3"}, {"4", "5", "6"}},
+		},
+		{
+			name: "BOM and CRLF",
+			data: "\xEF\xBB\xBFA,B,C\r\n1,2,3\r\n4,5,6\r\n",
+			want: [][]string{{"A", "B", "C"}, {"1", "2", "3"}, {"4", "5", "6"}},
+		},
+		{
+			name: "Bare CR",
+			data: "A,B,C\r1,2,3\r",
+			want: [][]string{{"A", "B", "C"}, {"1", "2", "3"}},
+		},
+		{
+			name: "Smart quotes around a field with a comma",
+			data: "A,B,C\n1,“Example, Inc.”,3\n",
+			want: [][]string{{"A", "B", "C"}, {"1", "Example, Inc.", "3"}},
+		},
+		{
+			name: "Smart quotes around a field without a comma",
+			data: "A,B,C\n1,“Example”,3\n",
+			want: [][]string{{"A", "B", "C"}, {"1", "“Example”", "3"}},
+		},
+		{
+			name: "Newline in an unquoted field",
+			data: "A,B,C\n1,Example\nInc.,3\n4,5,6\n",
+			want: [][]string{{"A", "B", "C"}, {"1", "Example\nInc.", "3"}, {"4", "5", "6"}},
+		},
+		{
+			name: "Too many fields",
+			data: "A,B,C\n1,2,3,4\n4,5,6\n",
+			want: [][]string{{"A", "B", "C"}, {"4", "5", "6"}},
+		},
+		{
+			name: "Too few fields",
+			data: "A,B,C\n1,2\n4,5,6,7\n8,9,10\n",
+			want: [][]string{{"A", "B", "C"}, {"8", "9", "10"}},
+		},
+		{
+			name: "Stray quote",
+			data: "A,B,C\n1,2 \"inch\",3\n",
+			want: [][]string{{"A", "B", "C"}, {"1", "2 \"inch\"", "3"}},
+		},
+		{
+			name: "Empty",
+			data: "",
+			want: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got [][]string
+			for _, record := range readCSVRecords([]byte(tc.data), "test.csv", 0) {
+				got = append(got, record.fields)
+			}
+			if !slices.EqualFunc(got, tc.want, slices.Equal) {
+				t.Errorf("readCSVRecords(%q) = %q, want %q", tc.data, got, tc.want)
+			}
+		})
+	}
+}
+
+func FuzzReadCSVRecords(f *testing.F) {
+	for _, seed := range []string{
+		"SHA-256 Fingerprint,Certificate Name,Valid From (GMT)\nAA,Example Root,2020-01-01\n",
+		"\xEF\xBB\xBFSHA-256 Fingerprint,Certificate Name\r\nAA,Example Root\r\nBB,Example Intermediate\r\n",
+		"A,B,C\n1,“Example, Inc.”,3\n4,“Example”,6\n",
+		"A,B,C\n1,Example\nInc.,3\n",
+		"A,B,C\n1,2,3,4\n5,6\n7,8,9\n",
+		"A,B,C\n1,\"unterminated,3\n",
+		"A,B,C\n1,2 \"inch\",3\n\"4\"5,6,7\n",
+		"A\n\n\n",
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		records := readCSVRecords(data, "fuzz.csv", 0)
+		if len(records) == 0 {
+			return
+		}
+		// Every record after the header must have as many fields as the header, and start after it.
+		header := records[0]
+		for _, record := range records[1:] {
+			if len(record.fields) != len(header.fields) {
+				t.Fatalf("Record on line %d has %d fields, but the header has %d", record.line, len(record.fields), len(header.fields))
+			} else if record.line <= header.line {
+				t.Fatalf("Record on line %d does not follow the header on line %d", record.line, header.line)
+			}
+		}
+	})
+}
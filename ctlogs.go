@@ -0,0 +1,132 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	CT_LOG_LIST_URL  = "https://www.gstatic.com/ct/log_list/v3/log_list.json"
+	CT_LOG_LIST_PATH = "data/log_list.json"
+)
+
+// CTLog is a Certificate Transparency log, as described by a CT log list and returned by GetCTLogByID.
+type CTLog struct {
+	LogID       [sha256.Size]byte
+	Description string
+	Operator    string
+	// The log's public key, as a DER-encoded SubjectPublicKeyInfo.
+	Key []byte
+	// The submission URL. For a static CT API (tiled) log, MonitoringURL is also set.
+	URL           string
+	MonitoringURL string
+	// Maximum Merge Delay.
+	MMD time.Duration
+	// The log's current state, and when it entered that state.
+	State          CTLogState
+	StateTimestamp time.Time
+	// The log only accepts certificates that expire within this interval, if it is set. End is exclusive.
+	TemporalIntervalStart time.Time
+	TemporalIntervalEnd   time.Time
+}
+
+// The parts of a v3 CT log list that are used.
+type ctLogListJSON struct {
+	Operators []struct {
+		Name      string      `json:"name"`
+		Logs      []ctLogJSON `json:"logs"`
+		TiledLogs []ctLogJSON `json:"tiled_logs"`
+	} `json:"operators"`
+}
+
+// A log in a v3 CT log list. Static CT API (tiled) logs have a submission URL and a monitoring URL instead of a URL.
+type ctLogJSON struct {
+	Description   string `json:"description"`
+	LogID         string `json:"log_id"`
+	Key           string `json:"key"`
+	URL           string `json:"url"`
+	SubmissionURL string `json:"submission_url"`
+	MonitoringURL string `json:"monitoring_url"`
+	MMD           int    `json:"mmd"`
+	// Exactly one state is expected, keyed by its name.
+	State map[string]struct {
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"state"`
+	TemporalInterval *struct {
+		StartInclusive time.Time `json:"start_inclusive"`
+		EndExclusive   time.Time `json:"end_exclusive"`
+	} `json:"temporal_interval"`
+}
+
+// readCTLogList reads the CT log list. A missing log list (e.g. in the embedded data, or in a dataset fetched by an older
+// version of this package) means that no CT logs are known.
+func (s *Store) readCTLogList() error {
+	data, err := s.readDataFile(CT_LOG_LIST_PATH)
+	if errors.Is(err, fs.ErrNotExist) {
+		logger.Info("CT log list does not exist, so no CT logs are known", zap.String("file_path", CT_LOG_LIST_PATH))
+		return nil
+	} else if err != nil {
+		logger.Info("CT log list could not be read", zap.Error(err), zap.String("file_path", CT_LOG_LIST_PATH))
+		return fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
+	}
+
+	if s.ctLogMap, err = parseCTLogList(data); err != nil {
+		logger.Error("CT log list could not be parsed", zap.Error(err), zap.String("file_path", CT_LOG_LIST_PATH))
+		return fmt.Errorf("%w: %s: %w", ErrMalformedDataset, CT_LOG_LIST_PATH, err)
+	}
+	return nil
+}
+
+// parseCTLogList parses a v3 CT log list, including any static CT API (tiled) logs.
+func parseCTLogList(data []byte) (map[[sha256.Size]byte]*CTLog, error) {
+	var list ctLogListJSON
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	ctLogMap := make(map[[sha256.Size]byte]*CTLog)
+	for _, operator := range list.Operators {
+		for _, l := range slices.Concat(operator.Logs, operator.TiledLogs) {
+			logID, err := base64.StdEncoding.DecodeString(l.LogID)
+			if err != nil || len(logID) != sha256.Size {
+				logger.Debug("Invalid CT log ID", zap.String("log_id", l.LogID))
+				continue
+			}
+			key, err := base64.StdEncoding.DecodeString(l.Key)
+			if err != nil {
+				logger.Debug("Invalid CT log key", zap.String("log_id", l.LogID))
+				continue
+			}
+
+			cl := &CTLog{
+				LogID:         [sha256.Size]byte(logID),
+				Description:   l.Description,
+				Operator:      operator.Name,
+				Key:           key,
+				URL:           l.URL,
+				MonitoringURL: l.MonitoringURL,
+				MMD:           time.Duration(l.MMD) * time.Second,
+			}
+			if cl.URL == "" {
+				cl.URL = l.SubmissionURL
+			}
+			for name, state := range l.State {
+				cl.State, cl.StateTimestamp = ParseCTLogState(name), state.Timestamp
+			}
+			if l.TemporalInterval != nil {
+				cl.TemporalIntervalStart, cl.TemporalIntervalEnd = l.TemporalInterval.StartInclusive, l.TemporalInterval.EndExclusive
+			}
+			ctLogMap[cl.LogID] = cl
+		}
+	}
+
+	return ctLogMap, nil
+}
@@ -0,0 +1,134 @@
+package ccadb_data
+
+import (
+	"encoding/base64"
+	"errors"
+	"io/fs"
+	"slices"
+	"testing"
+	"time"
+)
+
+const (
+	TEST_AVIATOR_LOG_ID   = "aPaY+B9kgr46jO65KB1M/HFRXWeT1ETRCmesu09P+8Q="
+	TEST_ICARUS_LOG_ID    = "KTxRllTIOWW6qlD8WAfUt2+/WHopctykwwz05UVH9Hg="
+	TEST_ARGON2020_LOG_ID = "sh4FzIuizYogTodm+Su5iiUgZ2va+nDnsklTLe+LkF4="
+	// The static CT API (tiled) log in the v5 fixture's CT log list.
+	TEST_TILED_LOG_ID = "GsphDXiGNEnaPm3Z4je2x7iIRB5V3e+DtfLsH+aqhos="
+	// The SHA-256 hash of ISRG Root X1's SubjectPublicKeyInfo.
+	TEST_ISRG_ROOT_X1_SPKI_SHA256 = "C5+lpZ7tcVwmwQIMcRtPbsQtWLABXhQzejna0wHFr8M="
+)
+
+// testBase64SHA256 decodes a Base64-encoded SHA-256 hash.
+func testBase64SHA256(t testing.TB, b64 string) [32]byte {
+	t.Helper()
+	b, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil || len(b) != 32 {
+		t.Fatalf("Invalid Base64-encoded SHA-256 hash %q", b64)
+	}
+	return [32]byte(b)
+}
+
+func TestParseCTLogList(t *testing.T) {
+	data := `{"operators": [{
+  "name": "Example",
+  "logs": [
+    {"description": "Retired", "log_id": "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=", "key": "AAAA", "url": "https://ct.example/", "mmd": 86400,
+     "state": {"retired": {"timestamp": "2024-01-01T00:00:00Z"}},
+     "temporal_interval": {"start_inclusive": "2024-01-01T00:00:00Z", "end_exclusive": "2024-07-01T00:00:00Z"}},
+    {"description": "Invalid log ID", "log_id": "AAEC", "key": "AAAA", "url": "https://invalid.example/", "mmd": 86400}
+  ],
+  "tiled_logs": [
+    {"description": "Tiled", "log_id": "HyAhIiMkJSYnKCkqKywtLi8wMTIzNDU2Nzg5Ojs8PT4=", "key": "AAAA", "submission_url": "https://submit.example/", "monitoring_url": "https://monitor.example/", "mmd": 60,
+     "state": {"usable": {"timestamp": "2025-01-01T00:00:00Z"}}}
+  ]
+}]}`
+	ctLogMap, err := parseCTLogList([]byte(data))
+	if err != nil {
+		t.Fatalf("parseCTLogList() returned %v", err)
+	} else if len(ctLogMap) != 2 {
+		t.Fatalf("parseCTLogList() returned %d logs, want 2", len(ctLogMap))
+	}
+
+	retired := ctLogMap[testBase64SHA256(t, "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")]
+	if retired == nil || retired.Operator != "Example" || retired.State != CTLogStateRetired || retired.MMD != 24*time.Hour || !retired.TemporalIntervalEnd.Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Retired log = %+v", retired)
+	} else if !retired.WasAcceptableAt(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)) || retired.WasAcceptableAt(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Retired log is not only acceptable before it was retired")
+	}
+	tiled := ctLogMap[testBase64SHA256(t, "HyAhIiMkJSYnKCkqKywtLi8wMTIzNDU2Nzg5Ojs8PT4=")]
+	if tiled == nil || tiled.URL != "https://submit.example/" || tiled.MonitoringURL != "https://monitor.example/" || tiled.State != CTLogStateUsable {
+		t.Errorf("Tiled log = %+v", tiled)
+	}
+
+	if _, err = parseCTLogList([]byte(`{"operators": [`)); err == nil {
+		t.Error("parseCTLogList() returned no error for truncated JSON")
+	}
+}
+
+func TestGetCTLogByID(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	if cl := s.GetCTLogByID(testBase64SHA256(t, TEST_ICARUS_LOG_ID)); cl == nil || cl.Description != "Google 'Icarus' log" || cl.Operator != "Google" || cl.State != CTLogStateUsable {
+		t.Errorf("GetCTLogByID(Icarus) = %+v", cl)
+	}
+	if cl := s.GetCTLogByID([32]byte{}); cl != nil {
+		t.Errorf("GetCTLogByID() of an unknown log = %+v, want nil", cl)
+	}
+
+	// A tiled log is listed under "tiled_logs", and has a submission URL instead of a URL.
+	if cl := s.GetCTLogByID(testBase64SHA256(t, TEST_TILED_LOG_ID)); cl == nil || cl.Operator != "CCADB Test CT Log Operator" || cl.URL != "https://ct.ccadb.test/tiled/" || cl.MonitoringURL != "https://ct-monitor.ccadb.test/tiled/" || cl.MMD != time.Minute || cl.State != CTLogStateUsable {
+		t.Errorf("GetCTLogByID(tiled log) = %+v", cl)
+	}
+}
+
+// TestEmbeddedCTLogList checks that the embedded dataset loads whether or not it has a CT log list, and that a log list
+// that it has describes Google's Icarus log.
+func TestEmbeddedCTLogList(t *testing.T) {
+	s, err := NewStore(EmbeddedFS())
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	} else if _, err = fs.Stat(EmbeddedFS(), CT_LOG_LIST_PATH); errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("Embedded dataset has no %s", CT_LOG_LIST_PATH)
+	}
+	if cl := s.GetCTLogByID(testBase64SHA256(t, TEST_ICARUS_LOG_ID)); cl == nil || cl.Operator != "Google" {
+		t.Errorf("GetCTLogByID(Icarus) = %+v, want a log operated by Google", cl)
+	}
+}
+
+func TestCheckEmbeddedSCTs(t *testing.T) {
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	timestamp := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	sc := s.CheckEmbeddedSCTs(testBase64SHA256(t, TEST_ISRG_ROOT_X1_SPKI_SHA256), []EmbeddedSCT{
+		{LogID: testBase64SHA256(t, TEST_ICARUS_LOG_ID), Timestamp: timestamp},
+		{LogID: testBase64SHA256(t, TEST_AVIATOR_LOG_ID), Timestamp: timestamp},
+	})
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	if !sc.IssuerDisclosed || !slices.Contains(sc.IssuerSHA256Fingerprints, isrgRootX1) {
+		t.Errorf("CheckEmbeddedSCTs() returned issuers %X, want ISRG Root X1", sc.IssuerSHA256Fingerprints)
+	}
+	if len(sc.SCTs) != 2 || sc.SCTs[0].Log == nil || sc.SCTs[1].Log == nil || !sc.AllSCTsAcceptable() {
+		t.Errorf("CheckEmbeddedSCTs() returned SCTs %+v, want 2 acceptable SCTs", sc.SCTs)
+	}
+
+	// An SCT from an unknown log, or from a log that is no longer acceptable, is not acceptable.
+	sc = s.CheckEmbeddedSCTs([32]byte{}, []EmbeddedSCT{
+		{LogID: testBase64SHA256(t, TEST_ICARUS_LOG_ID), Timestamp: timestamp},
+		{LogID: [32]byte{1}, Timestamp: timestamp},
+	})
+	if sc.IssuerDisclosed || len(sc.IssuerSHA256Fingerprints) != 0 {
+		t.Errorf("CheckEmbeddedSCTs() returned issuers %X for an unknown key", sc.IssuerSHA256Fingerprints)
+	}
+	if len(sc.SCTs) != 2 || !sc.SCTs[0].Acceptable || sc.SCTs[1].Log != nil || sc.SCTs[1].Acceptable || sc.AllSCTsAcceptable() {
+		t.Errorf("CheckEmbeddedSCTs() returned SCTs %+v", sc.SCTs)
+	}
+	if s.CheckEmbeddedSCTs([32]byte{}, nil).AllSCTsAcceptable() {
+		t.Error("AllSCTsAcceptable() = true without any SCTs")
+	}
+}
@@ -0,0 +1,30 @@
+package ccadb_data
+
+import "time"
+
+//go:generate go run ./cmd/dataset_info
+//go:generate go run ./cmd/roots_gen
+
+// Information about the embedded CCADB data, as generated by cmd/dataset_info when the data was fetched.
+type DatasetInfo struct {
+	Date             time.Time
+	RecordCount      int
+	CertificateCount int
+	Checksums        map[string]string
+}
+
+func GetDatasetInfo() DatasetInfo {
+	datasetTime, _ := getDatasetTime()
+	return DatasetInfo{
+		Date:             datasetTime,
+		RecordCount:      RecordCount,
+		CertificateCount: CertificateCount,
+		Checksums:        DatasetChecksums,
+	}
+}
+
+// getDatasetTime determines when the embedded CCADB data was fetched.
+func getDatasetTime() (time.Time, bool) {
+	datasetTime, err := time.Parse(time.RFC3339, DatasetDate)
+	return datasetTime, err == nil
+}
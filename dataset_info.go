@@ -0,0 +1,54 @@
+// Code generated by cmd/dataset_info; DO NOT EDIT.
+
+package ccadb_data
+
+const (
+	// When the embedded CCADB data was fetched (RFC 3339).
+	DatasetDate = "2026-10-17T01:18:38Z"
+	// Number of records in the embedded AllCertificateRecordsCSVFormatV5 report.
+	RecordCount = 10142
+	// Number of certificates in the embedded AllCertificatePEMsCSVFormat reports.
+	CertificateCount = 10127
+)
+
+// SHA-256 checksums of the embedded data files, indexed by their paths in the dataset layout read by NewStore, as in
+// the manifest. The files under full/ in this repository are embedded by the full subpackage.
+var DatasetChecksums = map[string]string{
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_1994": "33d8fd41e44b6df927a0b08b9a74b7d46073cc9918bd90c862a459714123251e",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_1995": "70528e0abe6844ad6bbece4d8b22d16dec67d5dffc28cadab1c8eac39d188c7d",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_1996": "f6bfcd92e621726d5fb0ebcfc572a75e3886d5dac0455c98f6e5dff220087b29",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_1997": "765c22e7c183688af586083767d96aaf4d44f9dd04c4591d2dd1fc401b8ce2e4",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_1998": "b5f08134408acecf44e3702d2939b4ea394c27e0378d92cb36bb81239d046e45",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_1999": "9f440737b206768e8bda6186f807a9da93af232dd67c7170f44a00265d03939f",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2000": "734a9921a0deb4b23f29fb1b2976bccf89b7a0cd901636d78bd428df541cad4b",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2001": "c8df41322d640c2446d529ed1bffa11d60dcfdf3953d025d06897100b9196928",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2002": "6e6a9c34532041cb14aa8342485c1f21ad4c6e1c4ee7d5412e0d894481cb9897",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2003": "264308b980f79b3df2f20596204f5c31170d078768df85a135a1bc32bcb70fae",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2004": "b63d04bb88b4b3e8c9c7ebc33b11b0e5485be4118d11e33b6509ab1de116e52b",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2005": "e9129d66c3ce693ada293d3ec43765eb8c918e9aa7357d5ae228ec3c261a16f5",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2006": "9cb2d1cf4f100e1dd98a0978a9bffe9c6dc1b2c69bc18c228ab6403790508f4f",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2007": "cba561d20279675bb2dbe4fad81b5c3cbf247bdf2a7e71ac2e9c9fdc362537fd",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2008": "203f84d24e9eea98e8a159760bf7dd53c9975874d6258973a7e713261620d1f0",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2009": "0629b842c1b5e6b230aaf654d4d6b6bf6852f11a99d32677776c4ec2ad0ff324",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2010": "a0c10dea817d8ac54211d0df22cb201a147b710d559b909866d83183b7d50bb6",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2011": "e69a8965d502b395e4ff1e60df2d5d3b89339711c291dcbbe0f03b0320ec304a",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2012": "801e006e19201bbabc5d606b83a41bc92fada805cd09e90c238aceb143341908",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2013": "9d25ff5e7fe11154b316887a7826ee51f360905fa824499c5c2e80010c80bb65",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2014": "d7a5c85fe16c58063aa288f407c62b02c8b6424e1a99bf1fa6b6f337a918ac6b",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2015": "4bbac4ef16970fdc4ea04a6178872e8cdbfb1f7fdf12bdc22cf7c92ac566c071",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2016": "022dd0bbcdc5121d7afdf33fcac49f1102a39878876c01ef20f99d0f0a5fa236",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2017": "0863c537ca9a903bad5097de85c1830fb6a1843b38f4ee9957baada111dd7307",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2018": "6fb349967e7c79bbbecef1513d64ecd16e96a7dc1b6c43500be0983d2d17ba4f",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2019": "7327339ebf5db0ec5d47ac1eb28e22c2d44db52ca36edfae7d0447224aab771c",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2020": "a30626425d9d4f601482124b8c4ce996a825237a7303cae2ad02d36459258b01",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2021": "1a4f9b5fe3d1e5bd057de82b17eebd18416fe5ad25a4e381b3f17fd865ce7a48",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2022": "784294da76f0aca0c908978ee173008f35add3db178b826795b2b443f223f482",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2023": "70a4ea42d68c247a5b9a46607349f6f807890272575076b99a16b6b2221a80f9",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2024": "0109071b845e984e5e72f65be084a32fe55016ac5a2e4266d18c3b1490eb855e",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2025": "eb3719818e88f34a036e4ac032864ed9e4dad03f088b251064b981eb85b495c1",
+	"cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2026": "0d1cf35c0fe3c16b560f45570142274c180264864b37f87a2ba350c972389e4e",
+	"data/AllCertificateRecordsCSVFormatV5":                            "da26e78dbde701c6fc72e56fc2c9159a3b1ab05bef7ffd6f2690d97b8cbc68d4",
+	"data/AllCertificateRecordsSlim.csv":                               "10cd4ae7d1a1a55a525f29266defd495d2487fce2a95f29e1ac9442ac2606b61",
+	"data/derived_ski.csv":                                             "38e7dc0713d7f4e1126446a46773b1c975770e08f5b019e7e31a4ee3c2dc1fdf",
+	"data/ski_spkisha256.csv":                                          "e9e6fe4a3f4bee0afb75ddb324a0e026a5aa61099e8a243ac4dc6bd3ed941922",
+}
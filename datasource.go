@@ -0,0 +1,175 @@
+package ccadb_data
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// DataSource provides the data files that a Store is loaded from, by their paths in the dataset layout read by NewStore
+// (e.g. "data/AllCertificateRecordsSlim.csv"). Implementations are provided for file systems (including the embedded
+// data), directories, HTTP servers, and offline archives, and other storage (e.g. a cloud storage bucket) can be
+// supported by implementing this interface.
+type DataSource interface {
+	// Open opens the data file at name, which is a valid path as defined by fs.ValidPath. If there is no such file,
+	// the error wraps fs.ErrNotExist, since many of the data files are optional. The caller closes the returned reader.
+	Open(name string) (io.ReadCloser, error)
+}
+
+// DataSourceLister is a DataSource that can list the data files in a directory. The PEM reports are found by listing
+// their directory if the DataSource can do so, and otherwise from the dataset's manifest or, if it has none, by trying
+// the PEM report for every year.
+type DataSourceLister interface {
+	DataSource
+	// List returns the names of the data files in dir, in any order. If there is no such directory, the error wraps
+	// fs.ErrNotExist.
+	List(dir string) ([]string, error)
+}
+
+// NewFSDataSource returns a DataSource that reads the data files from fsys.
+func NewFSDataSource(fsys fs.FS) DataSource {
+	return fsDataSource{fsys}
+}
+
+// NewDirDataSource returns a DataSource that reads the data files from the directory dir, e.g. one that FetchReports
+// has downloaded the reports into.
+func NewDirDataSource(dir string) DataSource {
+	return fsDataSource{os.DirFS(dir)}
+}
+
+// EmbeddedDataSource returns a DataSource that reads the embedded data (see EmbeddedFS).
+func EmbeddedDataSource() DataSource {
+	return fsDataSource{f}
+}
+
+// NewArchiveDataSource reads and verifies an offline archive written by WriteArchive (see ReadArchive), and returns a
+// DataSource that reads the data files in it.
+func NewArchiveDataSource(filePath string) (DataSource, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
+	}
+	defer file.Close()
+	fsys, _, err := ReadArchive(file)
+	if err != nil {
+		return nil, err
+	}
+	return fsDataSource{fsys}, nil
+}
+
+// NewHTTPDataSource returns a DataSource that downloads the data files from baseURL, which serves them in the dataset
+// layout read by NewStore (e.g. a static web server, or a bucket that a release of the dataset has been copied to). A
+// nil client means http.DefaultClient. Since the PEM reports' directory cannot be listed, they are found from the
+// dataset's manifest, if it has one.
+func NewHTTPDataSource(client *http.Client, baseURL string) DataSource {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return httpDataSource{client: client, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// A DataSource that reads the data files from a file system.
+type fsDataSource struct {
+	fsys fs.FS
+}
+
+func (fds fsDataSource) Open(name string) (io.ReadCloser, error) {
+	return fds.fsys.Open(name)
+}
+
+func (fds fsDataSource) List(dir string) ([]string, error) {
+	entries, err := fs.ReadDir(fds.fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// A DataSource that downloads the data files from an HTTP server.
+type httpDataSource struct {
+	client  *http.Client
+	baseURL string
+}
+
+func (hds httpDataSource) Open(name string) (io.ReadCloser, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	url := hds.baseURL + "/" + name
+	resp, err := hds.client.Get(url)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	} else if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+		}
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fmt.Errorf("%s: HTTP %d", url, resp.StatusCode)}
+	}
+	return resp.Body, nil
+}
+
+// readSourceFile reads a data file from a DataSource.
+func readSourceFile(src DataSource, name string) ([]byte, error) {
+	rc, err := src.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(rc)
+	if closeErr := rc.Close(); err == nil {
+		err = closeErr
+	}
+	return data, err
+}
+
+// hasDataFile reports whether the Store's DataSource has a data file at filePath.
+func (s *Store) hasDataFile(filePath string) bool {
+	rc, err := s.src.Open(filePath)
+	if err != nil {
+		return false
+	}
+	rc.Close()
+	return true
+}
+
+// pemCSVPaths returns the paths of the PEM reports that the Store's DataSource may have, in ascending order. If the
+// DataSource cannot list their directory, the paths are taken from the dataset's manifest or, if it has none, are the
+// PEM report for every year, some of which may not exist.
+func (s *Store) pemCSVPaths() ([]string, error) {
+	var paths []string
+	if lister, ok := s.src.(DataSourceLister); ok {
+		names, err := lister.List(PEM_CSV_DIR)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			paths = append(paths, PEM_CSV_DIR+"/"+name)
+		}
+	} else if s.manifestChecksums != nil {
+		for filePath := range s.manifestChecksums {
+			if strings.HasPrefix(filePath, PEM_CSV_DIR+"/") {
+				paths = append(paths, filePath)
+			}
+		}
+		if len(paths) == 0 {
+			return nil, &fs.PathError{Op: "list", Path: PEM_CSV_DIR, Err: fs.ErrNotExist}
+		}
+	} else {
+		for year := PEM_CSV_FIRST_YEAR; year <= time.Now().UTC().Year(); year++ {
+			paths = append(paths, fmt.Sprintf("%s/%s%d", PEM_CSV_DIR, PEM_CSV_FILENAME_PREFIX, year))
+		}
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
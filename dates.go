@@ -0,0 +1,47 @@
+package ccadb_data
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Layouts of the dates written by CCADB and by the root program reports. CCADB's CSV reports use CCADB_DATE_LAYOUT for
+// every date column; the others are used by some reports and by older or hand-maintained data.
+const (
+	CCADB_DATE_LAYOUT            = time.DateOnly // e.g. "2025-08-31"
+	CCADB_DOTTED_DATE_LAYOUT     = "2006.01.02"
+	CCADB_SLASHED_DATE_LAYOUT    = "2006/01/02"
+	CCADB_MONTH_NAME_DATE_LAYOUT = "2006 Jan 2" // e.g. "2025 Aug 31"
+	CCADB_DATE_TIME_LAYOUT       = time.DateTime
+	CCADB_ISO_DATE_TIME_LAYOUT   = "2006-01-02T15:04:05"
+	CCADB_TIMESTAMP_LAYOUT       = time.RFC3339
+)
+
+// The layouts that ParseCCADBDate tries, in order.
+var ccadbDateLayouts = []string{
+	CCADB_DATE_LAYOUT,
+	CCADB_DOTTED_DATE_LAYOUT,
+	CCADB_SLASHED_DATE_LAYOUT,
+	CCADB_MONTH_NAME_DATE_LAYOUT,
+	CCADB_DATE_TIME_LAYOUT,
+	CCADB_ISO_DATE_TIME_LAYOUT,
+	CCADB_TIMESTAMP_LAYOUT,
+}
+
+// ParseCCADBDate parses a date (or date and time) in any of the layouts used by CCADB and the root program reports,
+// ignoring surrounding whitespace. Month names are matched regardless of case, and fractional seconds are accepted.
+// Dates without a time zone are in UTC (CCADB's "GMT"), and the result is always in UTC. An empty value returns the
+// zero time.
+func ParseCCADBDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range ccadbDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("Unrecognized date format: %q", value)
+}
@@ -0,0 +1,97 @@
+package ccadb_data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCCADBDate(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		want  time.Time
+	}{
+		{"", time.Time{}},
+		{"   ", time.Time{}},
+		{"2025-08-31", time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)},
+		{" 2025-08-31\t", time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)},
+		{"2025.08.31", time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)},
+		{"2025/08/31", time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)},
+		{"2025 Aug 31", time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)},
+		{"2025 aug 1", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025 AUG 01", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{"2025-08-31 12:34:56", time.Date(2025, 8, 31, 12, 34, 56, 0, time.UTC)},
+		{"2025-08-31 12:34:56.5", time.Date(2025, 8, 31, 12, 34, 56, 500000000, time.UTC)},
+		{"2025-08-31T12:34:56", time.Date(2025, 8, 31, 12, 34, 56, 0, time.UTC)},
+		{"2025-08-31T12:34:56.123", time.Date(2025, 8, 31, 12, 34, 56, 123000000, time.UTC)},
+		{"2025-08-31T12:34:56Z", time.Date(2025, 8, 31, 12, 34, 56, 0, time.UTC)},
+		{"2025-08-31T12:34:56.000Z", time.Date(2025, 8, 31, 12, 34, 56, 0, time.UTC)},
+		{"2025-08-31T12:34:56+02:00", time.Date(2025, 8, 31, 10, 34, 56, 0, time.UTC)},
+		{"2025-09-01T01:00:00-05:00", time.Date(2025, 9, 1, 6, 0, 0, 0, time.UTC)},
+	} {
+		got, err := ParseCCADBDate(tc.value)
+		if err != nil {
+			t.Errorf("ParseCCADBDate(%q) returned %v", tc.value, err)
+		} else if !got.Equal(tc.want) || got.Location() != time.UTC {
+			t.Errorf("ParseCCADBDate(%q) = %v, want %v", tc.value, got, tc.want)
+		}
+	}
+
+	for _, value := range []string{
+		"31/08/2025",
+		"08/31/2025",
+		"2025-8-31",
+		"2025-02-30",
+		"2025-13-01",
+		"2025-08-31 25:00:00",
+		"2025 August 31",
+		"2025-08-31T12:34:56+25:00",
+		"2025-08-31 12:34",
+		"2025-08-31junk",
+		"25-08-31",
+		"Aug 31, 2025",
+		"not a date",
+	} {
+		if got, err := ParseCCADBDate(value); err == nil {
+			t.Errorf("ParseCCADBDate(%q) = %v, want an error", value, got)
+		}
+	}
+}
+
+func FuzzParseCCADBDate(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"2025-08-31",
+		"2025.08.31",
+		"2025/08/31",
+		"2025 Aug 31",
+		"2025-08-31 12:34:56",
+		"2025-08-31T12:34:56",
+		"2025-08-31T12:34:56.123456789+05:30",
+		" 2025-02-29 ",
+		"0000-01-01",
+		"9999-12-31T23:59:59Z",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		got, err := ParseCCADBDate(value)
+		if err != nil {
+			if !got.IsZero() {
+				t.Fatalf("ParseCCADBDate(%q) returned %v along with %v", value, got, err)
+			}
+			return
+		}
+		if got.Location() != time.UTC {
+			t.Fatalf("ParseCCADBDate(%q) = %v, which is not in UTC", value, got)
+		}
+		// Every accepted date must be accepted again, unchanged, when written in the timestamp layout.
+		if got.IsZero() {
+			return
+		}
+		again, err := ParseCCADBDate(got.Format(time.RFC3339Nano))
+		if err != nil || !again.Equal(got) {
+			t.Fatalf("ParseCCADBDate(%q) = %v, but its RFC 3339 form parses as %v, %v", value, got, again, err)
+		}
+	})
+}
@@ -0,0 +1,22 @@
+// Package ccadb_data parses the CCADB CSV reports, and provides typed lookups of CA certificates' capabilities, records,
+// and root program statuses by SHA-256 fingerprint and by key identifier.
+//
+// # API stability
+//
+// This module is versioned v1: its releases are tagged v1.YYYYMMDD.HHMMSS, and its module path has no major version
+// suffix, since Go only uses one for v2 and later. Within v1, the exported API of this package and of the bucket,
+// ccadbtest, client, full, and roots subpackages only changes compatibly, which is checked against the latest release by
+// check_api.sh. The supported API includes:
+//   - the Store, NewStore and the other functions that load one, and the package-level functions that use the default
+//     Store;
+//   - the typed records that lookups return (CACertCapabilities, CertificateRecord, IssuerCapabilities, and
+//     CapabilitySources) and the types of their fields, such as RecordType, RevocationStatus, and Tristate;
+//   - the JSON representations described by JSONSchema (RecordJSON, IssuerJSON, and SearchResultJSON), including their
+//     JSON field names;
+//   - the sentinel errors (ErrDatasetNotLoaded, ErrMalformedDataset, ErrUnknownFingerprint, ErrUnknownKeyIdentifier,
+//     and the others), which returned errors wrap, so that they can be tested for with errors.Is.
+//
+// New functions, methods, types, constants, and struct fields may be added in any release. The text of error messages
+// and log entries, and the data itself (including DatasetDate and DatasetChecksums, which describe the embedded data),
+// may change in any release. The internal packages and the commands are not part of the API.
+package ccadb_data
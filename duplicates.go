@@ -0,0 +1,66 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// CCADB occasionally contains more than one record for the same CA certificate, e.g. when a root certificate is also
+// disclosed as an intermediate certificate under another CA Owner's root. Rather than letting the last record win, the
+// records are merged as follows:
+//
+//   - The primary record is the first Root Certificate record, or the first record if none is a Root Certificate
+//     record. Its fields (names, owners, parent, revocation status, root program statuses, validity, CRLs, and
+//     audits) are used as a whole, so that values from different records are never mixed.
+//   - The capabilities are merged across every record, since a capability disclosed in any of them applies to the
+//     certificate: each one is true if it is true in any record, and otherwise unknown if it is unknown in any record.
+//   - Every record is retained by LoadRawRecords, with the primary record first and the others in the order in which
+//     they appear in the report (see GetRawRecordsBySHA256).
+//
+// addCertificateRecord adds a CA certificate's record and capabilities, read from the given line of the report, merging
+// them with any earlier record for the same CA certificate according to this policy.
+func (s *Store) addCertificateRecord(sha256Fingerprint [sha256.Size]byte, cr *CertificateRecord, ccc *CACertCapabilities, lineNumber int) {
+	s.indexKeyIdentifier(cr.SubjectKeyIdentifier, sha256Fingerprint, *ccc)
+	i, ok := s.caIndex[sha256Fingerprint]
+	if !ok {
+		s.caIndex[sha256Fingerprint] = uint32(len(s.caRecords))
+		s.caCapabilities = append(s.caCapabilities, *ccc)
+		s.caRecords = append(s.caRecords, *cr)
+		return
+	}
+
+	logger.Debug("CSV data contains a duplicate record", zap.String("sha256_fingerprint", fmt.Sprintf("%X", sha256Fingerprint)), zap.Int("line", lineNumber))
+	existing := &s.caCapabilities[i]
+	if isPrimaryRecord(ccc.CertificateRecordType, existing.CertificateRecordType) {
+		s.caRecords[i] = *cr
+		ccc.merge(existing)
+		*existing = *ccc
+	} else {
+		existing.merge(ccc)
+	}
+}
+
+// isPrimaryRecord reports whether a record of the given type replaces an earlier record of the same CA certificate as
+// its primary record.
+func isPrimaryRecord(recordType, primaryRecordType RecordType) bool {
+	return recordType == RecordTypeRoot && primaryRecordType != RecordTypeRoot
+}
+
+// merge merges the capabilities of another CA certificate into ccc: each capability is true if it is true in either,
+// and otherwise unknown if it is unknown in either. The record type becomes RecordTypeRoot if either is a root.
+func (ccc *CACertCapabilities) merge(other *CACertCapabilities) {
+	if other.CertificateRecordType == RecordTypeRoot {
+		ccc.CertificateRecordType = RecordTypeRoot
+	}
+	ccc.TlsCapable = ccc.TlsCapable || other.TlsCapable
+	ccc.TlsEvCapable = ccc.TlsEvCapable || other.TlsEvCapable
+	ccc.SmimeCapable = ccc.SmimeCapable || other.SmimeCapable
+	ccc.CodeSigningCapable = ccc.CodeSigningCapable || other.CodeSigningCapable
+	ccc.HasVMCAudit = ccc.HasVMCAudit || other.HasVMCAudit
+	ccc.TlsCapability = ccc.TlsCapability.or(other.TlsCapability)
+	ccc.TlsEvCapability = ccc.TlsEvCapability.or(other.TlsEvCapability)
+	ccc.SmimeCapability = ccc.SmimeCapability.or(other.SmimeCapability)
+	ccc.CodeSigningCapability = ccc.CodeSigningCapability.or(other.CodeSigningCapability)
+}
@@ -0,0 +1,273 @@
+package ccadb_data
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RecordType is the CCADB "Certificate Record Type" of a CA certificate.
+type RecordType uint8
+
+const (
+	RecordTypeUnknown RecordType = iota
+	RecordTypeRoot
+	RecordTypeIntermediate
+)
+
+// ParseRecordType parses CCADB's textual record type (e.g. "Root Certificate"), ignoring case, surrounding whitespace,
+// and the "Certificate" suffix. Unrecognized values return RecordTypeUnknown.
+func ParseRecordType(s string) RecordType {
+	switch strings.TrimSuffix(normalizeEnumText(s), " certificate") {
+	case "root":
+		return RecordTypeRoot
+	case "intermediate":
+		return RecordTypeIntermediate
+	default:
+		return RecordTypeUnknown
+	}
+}
+
+// String returns the record type as written by CCADB.
+func (rt RecordType) String() string {
+	switch rt {
+	case RecordTypeRoot:
+		return CCADB_RECORD_ROOT
+	case RecordTypeIntermediate:
+		return CCADB_RECORD_INTERMEDIATE
+	default:
+		return ""
+	}
+}
+
+func (rt RecordType) MarshalText() ([]byte, error) {
+	return []byte(rt.String()), nil
+}
+
+func (rt *RecordType) UnmarshalText(text []byte) error {
+	if *rt = ParseRecordType(string(text)); *rt == RecordTypeUnknown && len(text) != 0 {
+		return fmt.Errorf("Unrecognized record type: %q", text)
+	}
+	return nil
+}
+
+// RevocationStatus is the CCADB "Revocation Status" of a CA certificate. CCADB doesn't report a revocation status for
+// root certificates, so their status is RevocationStatusNone.
+type RevocationStatus uint8
+
+const (
+	RevocationStatusNone RevocationStatus = iota
+	RevocationStatusNotRevoked
+	RevocationStatusRevoked
+	RevocationStatusParentRevoked
+	RevocationStatusUnknown
+)
+
+// ParseRevocationStatus parses CCADB's textual revocation status (e.g. "Parent Cert Revoked"), ignoring case and
+// surrounding whitespace, and accepting "Certificate" in place of "Cert". An empty value returns RevocationStatusNone,
+// and unrecognized values return RevocationStatusUnknown.
+func ParseRevocationStatus(s string) RevocationStatus {
+	switch strings.ReplaceAll(normalizeEnumText(s), "certificate", "cert") {
+	case "":
+		return RevocationStatusNone
+	case "not revoked":
+		return RevocationStatusNotRevoked
+	case "revoked":
+		return RevocationStatusRevoked
+	case "parent cert revoked", "parent revoked":
+		return RevocationStatusParentRevoked
+	default:
+		return RevocationStatusUnknown
+	}
+}
+
+// String returns the revocation status as written by CCADB.
+func (rs RevocationStatus) String() string {
+	switch rs {
+	case RevocationStatusNotRevoked:
+		return CCADB_NOT_REVOKED
+	case RevocationStatusRevoked:
+		return CCADB_REVOKED
+	case RevocationStatusParentRevoked:
+		return CCADB_PARENT_REVOKED
+	case RevocationStatusUnknown:
+		return "Unknown"
+	default:
+		return ""
+	}
+}
+
+func (rs RevocationStatus) MarshalText() ([]byte, error) {
+	return []byte(rs.String()), nil
+}
+
+func (rs *RevocationStatus) UnmarshalText(text []byte) error {
+	if *rs = ParseRevocationStatus(string(text)); *rs == RevocationStatusUnknown {
+		return fmt.Errorf("Unrecognized revocation status: %q", text)
+	}
+	return nil
+}
+
+// Tristate is the value of one of CCADB's boolean columns (e.g. "TLS Capable"), which may also be blank or hold some
+// other text. Such values are TristateUnknown, rather than being treated as false.
+type Tristate uint8
+
+const (
+	TristateUnknown Tristate = iota
+	TristateFalse
+	TristateTrue
+)
+
+// ParseTristate parses a boolean value such as CCADB's "True" and "False", ignoring case and surrounding whitespace, and
+// also accepting "yes", "no", "1", and "0". Blank and unrecognized values return TristateUnknown.
+func ParseTristate(s string) Tristate {
+	switch normalizeEnumText(s) {
+	case "true", "yes", "1":
+		return TristateTrue
+	case "false", "no", "0":
+		return TristateFalse
+	default:
+		return TristateUnknown
+	}
+}
+
+// String returns the value as written by CCADB, or "Unknown".
+func (t Tristate) String() string {
+	switch t {
+	case TristateTrue:
+		return "True"
+	case TristateFalse:
+		return "False"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsTrue reports whether the value is TristateTrue.
+func (t Tristate) IsTrue() bool {
+	return t == TristateTrue
+}
+
+// or combines two values of the same column from different CA certificates: true if either is true, otherwise unknown
+// if either is unknown.
+func (t Tristate) or(other Tristate) Tristate {
+	if t == TristateTrue || other == TristateTrue {
+		return TristateTrue
+	} else if t == TristateUnknown || other == TristateUnknown {
+		return TristateUnknown
+	}
+	return TristateFalse
+}
+
+func (t Tristate) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *Tristate) UnmarshalText(text []byte) error {
+	if *t = ParseTristate(string(text)); *t == TristateUnknown && normalizeEnumText(string(text)) != "unknown" && len(text) != 0 {
+		return fmt.Errorf("Unrecognized boolean value: %q", text)
+	}
+	return nil
+}
+
+// ALVResult is the outcome of the CCADB's Audit Letter Validation (ALV) of a CA certificate against one of its audit
+// statements, i.e. whether the CA certificate was found in the audit statement.
+type ALVResult uint8
+
+const (
+	ALVResultNone ALVResult = iota
+	ALVResultFound
+	ALVResultNotFound
+	ALVResultUnknown
+)
+
+// ParseALVResult parses an ALV result (e.g. "Pass" or "Not Found"), ignoring case and surrounding whitespace, and also
+// accepting boolean values such as "True" and "False". An empty value returns ALVResultNone, and unrecognized values
+// return ALVResultUnknown.
+func ParseALVResult(s string) ALVResult {
+	switch normalizeEnumText(s) {
+	case "":
+		return ALVResultNone
+	case "pass", "passed", "found", "true", "yes":
+		return ALVResultFound
+	case "fail", "failed", "not found", "false", "no":
+		return ALVResultNotFound
+	default:
+		return ALVResultUnknown
+	}
+}
+
+// String returns "Found", "Not Found", "Unknown", or "" for ALVResultNone.
+func (r ALVResult) String() string {
+	switch r {
+	case ALVResultFound:
+		return "Found"
+	case ALVResultNotFound:
+		return "Not Found"
+	case ALVResultUnknown:
+		return "Unknown"
+	default:
+		return ""
+	}
+}
+
+func (r ALVResult) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *ALVResult) UnmarshalText(text []byte) error {
+	if *r = ParseALVResult(string(text)); *r == ALVResultUnknown && normalizeEnumText(string(text)) != "unknown" {
+		return fmt.Errorf("Unrecognized ALV result: %q", text)
+	}
+	return nil
+}
+
+// normalizeEnumText lower-cases s and collapses runs of whitespace into a single space.
+func normalizeEnumText(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), " ")
+}
+
+// CTLogState is the state of a Certificate Transparency log in a CT log list.
+type CTLogState uint8
+
+const (
+	CTLogStateUnknown CTLogState = iota
+	CTLogStatePending
+	CTLogStateQualified
+	CTLogStateUsable
+	CTLogStateReadOnly
+	CTLogStateRetired
+	CTLogStateRejected
+)
+
+var ctLogStateNames = []string{"", "pending", "qualified", "usable", "readonly", "retired", "rejected"}
+
+// ParseCTLogState parses a CT log state as named in a v3 log list (e.g. "readonly"), ignoring case, surrounding
+// whitespace, and separators. Unrecognized values return CTLogStateUnknown.
+func ParseCTLogState(s string) CTLogState {
+	s = strings.NewReplacer(" ", "", "-", "", "_", "").Replace(normalizeEnumText(s))
+	for i, name := range ctLogStateNames {
+		if name != "" && s == name {
+			return CTLogState(i)
+		}
+	}
+	return CTLogStateUnknown
+}
+
+// String returns the CT log state as named in a v3 log list.
+func (ls CTLogState) String() string {
+	if int(ls) < len(ctLogStateNames) {
+		return ctLogStateNames[ls]
+	}
+	return ""
+}
+
+func (ls CTLogState) MarshalText() ([]byte, error) {
+	return []byte(ls.String()), nil
+}
+
+func (ls *CTLogState) UnmarshalText(text []byte) error {
+	if *ls = ParseCTLogState(string(text)); *ls == CTLogStateUnknown && len(text) != 0 {
+		return fmt.Errorf("Unrecognized CT log state: %q", text)
+	}
+	return nil
+}
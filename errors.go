@@ -0,0 +1,21 @@
+package ccadb_data
+
+import "errors"
+
+var (
+	// ErrDatasetNotLoaded indicates that the CCADB data could not be read, or that optional data has not been loaded.
+	ErrDatasetNotLoaded = errors.New("CCADB dataset not loaded")
+	// ErrMalformedDataset indicates that the CCADB data was read but could not be parsed.
+	ErrMalformedDataset = errors.New("CCADB dataset malformed")
+	// ErrUnknownFingerprint indicates that the dataset was loaded, but it contains no record for the SHA-256 fingerprint.
+	ErrUnknownFingerprint = errors.New("SHA-256 fingerprint not found in CCADB")
+	// ErrUnknownKeyIdentifier indicates that the dataset was loaded, but it contains no record for the key identifier.
+	ErrUnknownKeyIdentifier = errors.New("Key identifier not found in CCADB")
+	// ErrReportShrank indicates that a downloaded report has far fewer records than the previous download, which suggests
+	// that it was truncated.
+	ErrReportShrank = errors.New("CCADB report shrank")
+	// ErrImplausibleDataset indicates that the CCADB data was loaded, but failed one of the checks configured by
+	// WithMinimumRecords, WithExpectedPrograms, or WithVerifiedManifest, which suggests that it is truncated or
+	// incomplete.
+	ErrImplausibleDataset = errors.New("CCADB dataset implausible")
+)
@@ -0,0 +1,83 @@
+package ccadb_data
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ExpiringCACertificate is a CA certificate that expires soon, but that is still trusted by a root program or capable
+// of issuing certificates, as held in ExpiringCAOwner.
+type ExpiringCACertificate struct {
+	SHA256Fingerprint     [sha256.Size]byte
+	CertificateName       string
+	CertificateRecordType RecordType
+	ValidTo               time.Time
+	Capabilities          CACertCapabilities
+	// Root programs that include (or trust) the CA certificate, in alphabetical order.
+	TrustedBy []string
+	// Whether another CA certificate with the same Subject Key Identifier, which CCADB doesn't consider to be revoked,
+	// remains valid after the CA certificate expires. When there is no such replacement, trust paths that go through
+	// the CA certificate's key will break when it expires.
+	HasReplacement bool
+}
+
+// ExpiringCAOwner is a CA Owner's CA certificates that are about to expire, as returned by ListExpiringCACertificates.
+type ExpiringCAOwner struct {
+	CAOwner string
+	// Ordered by Valid To date.
+	Certificates []*ExpiringCACertificate
+}
+
+// listExpiringCACertificates groups the expiring CA certificates by CA Owner, in alphabetical order.
+func (s *Store) listExpiringCACertificates(now time.Time, within time.Duration) []*ExpiringCAOwner {
+	deadline := now.Add(within)
+	owners := make(map[string]*ExpiringCAOwner)
+	for sha256Fingerprint, cr := range s.certificateRecords() {
+		ccc := s.caCertCapabilities(sha256Fingerprint)
+		if ccc == nil || cr.isRevoked() || now.Before(cr.ValidFrom) || now.After(cr.ValidTo) || !cr.ValidTo.Before(deadline) {
+			continue
+		}
+
+		ecc := &ExpiringCACertificate{
+			SHA256Fingerprint:     sha256Fingerprint,
+			CertificateName:       cr.CertificateName,
+			CertificateRecordType: ccc.CertificateRecordType,
+			ValidTo:               cr.ValidTo,
+			Capabilities:          *ccc,
+		}
+		for _, rootProgram := range rootPrograms {
+			if cr.isTrustedBy(rootProgram) {
+				ecc.TrustedBy = append(ecc.TrustedBy, rootProgram)
+			}
+		}
+		if len(ecc.TrustedBy) == 0 && !ccc.TlsCapable && !ccc.SmimeCapable && !ccc.CodeSigningCapable {
+			continue
+		}
+		if cr.SubjectKeyIdentifier != "" {
+			ecc.HasReplacement = slices.ContainsFunc(s.sha256FingerprintsMap[cr.SubjectKeyIdentifier], func(other [sha256.Size]byte) bool {
+				ocr := s.certificateRecord(other)
+				return ocr != nil && !ocr.isRevoked() && !ocr.ValidTo.Before(deadline)
+			})
+		}
+
+		eco := owners[cr.CAOwner]
+		if eco == nil {
+			eco = &ExpiringCAOwner{CAOwner: cr.CAOwner}
+			owners[cr.CAOwner] = eco
+		}
+		eco.Certificates = append(eco.Certificates, ecc)
+	}
+
+	expiringCAOwners := make([]*ExpiringCAOwner, 0, len(owners))
+	for _, eco := range owners {
+		slices.SortFunc(eco.Certificates, func(a, b *ExpiringCACertificate) int {
+			return cmp.Or(a.ValidTo.Compare(b.ValidTo), compareSHA256Fingerprints(a.SHA256Fingerprint, b.SHA256Fingerprint))
+		})
+		expiringCAOwners = append(expiringCAOwners, eco)
+	}
+	slices.SortFunc(expiringCAOwners, func(a, b *ExpiringCAOwner) int { return strings.Compare(a.CAOwner, b.CAOwner) })
+	return expiringCAOwners
+}
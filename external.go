@@ -0,0 +1,68 @@
+package ccadb_data
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"slices"
+	"strings"
+	"time"
+)
+
+// isExternallyOperated reports whether the CA certificate is operated by an organization other than its CA Owner, i.e.
+// whether it has a Subordinate CA Owner that differs from its CA Owner. CCADB isn't consistent about the case of CA
+// Owner names, so they are compared case insensitively.
+func (cr *CertificateRecord) isExternallyOperated() bool {
+	return cr.SubordinateCAOwner != "" && !strings.EqualFold(cr.SubordinateCAOwner, cr.CAOwner)
+}
+
+// ExternallyOperatedCACertificate is a CA certificate operated by a subordinate CA Owner, as returned by
+// ListExternallyOperatedCACertificates.
+type ExternallyOperatedCACertificate struct {
+	SHA256Fingerprint  [sha256.Size]byte
+	CertificateName    string
+	CAOwner            string
+	SubordinateCAOwner string
+	// The CA Owner of the root certificate that the CA certificate chains up to, or "" if its disclosed parents don't
+	// lead to a root certificate (see GetUltimateRootOwner).
+	UltimateRootOwner string
+	ValidTo           time.Time
+	Capabilities      CACertCapabilities
+	// Root programs that include (or trust) the CA certificate, in alphabetical order.
+	TrustedBy []string
+}
+
+// listExternallyOperatedCACertificates returns the externally operated, TLS capable intermediate certificates that are
+// valid at now and that CCADB doesn't consider to be revoked, ordered by Subordinate CA Owner, CA Owner, Certificate
+// Name, and SHA-256 fingerprint.
+func (s *Store) listExternallyOperatedCACertificates(now time.Time) []*ExternallyOperatedCACertificate {
+	var eoccs []*ExternallyOperatedCACertificate
+	for sha256Fingerprint, cr := range s.certificateRecords() {
+		ccc := s.caCertCapabilities(sha256Fingerprint)
+		if ccc == nil || ccc.CertificateRecordType != RecordTypeIntermediate || !ccc.TlsCapable || !cr.isExternallyOperated() || cr.isRevoked() || now.Before(cr.ValidFrom) || now.After(cr.ValidTo) {
+			continue
+		}
+
+		eocc := &ExternallyOperatedCACertificate{
+			SHA256Fingerprint:  sha256Fingerprint,
+			CertificateName:    cr.CertificateName,
+			CAOwner:            cr.CAOwner,
+			SubordinateCAOwner: cr.SubordinateCAOwner,
+			ValidTo:            cr.ValidTo,
+			Capabilities:       *ccc,
+		}
+		if rootSHA256Fingerprint, ok := s.rootOf(sha256Fingerprint); ok {
+			eocc.UltimateRootOwner = s.certificateRecord(rootSHA256Fingerprint).CAOwner
+		}
+		for _, rootProgram := range rootPrograms {
+			if cr.isTrustedBy(rootProgram) {
+				eocc.TrustedBy = append(eocc.TrustedBy, rootProgram)
+			}
+		}
+		eoccs = append(eoccs, eocc)
+	}
+
+	slices.SortFunc(eoccs, func(a, b *ExternallyOperatedCACertificate) int {
+		return cmp.Or(cmp.Compare(a.SubordinateCAOwner, b.SubordinateCAOwner), cmp.Compare(a.CAOwner, b.CAOwner), cmp.Compare(a.CertificateName, b.CertificateName), compareSHA256Fingerprints(a.SHA256Fingerprint, b.SHA256Fingerprint))
+	})
+	return eoccs
+}
@@ -0,0 +1,357 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/crtsh/ccadb_data/internal/memfs"
+	"go.uber.org/zap"
+)
+
+const (
+	// The CCADB site, under which CCADB and the root programs publish their reports.
+	CCADB_SITE_URL          = "https://ccadb.my.salesforce-sites.com/"
+	CCADB_REPORT_BASE_URL   = CCADB_SITE_URL + "ccadb/"
+	CCADB_CSV_REPORT        = "AllCertificateRecordsCSVFormatV5"
+	PEM_CSV_REPORT          = "AllCertificatePEMsCSVFormat"
+	PEM_CSV_FILENAME_PREFIX = "AllCertificatePEMsCSVFormat_NotBeforeYear_"
+	PEM_CSV_FIRST_YEAR      = 1994
+
+	// The number of attempts that FetchReport makes to download a report, and the delay before the first retry, which
+	// doubles before each further retry.
+	FETCH_ATTEMPTS        = 4
+	FETCH_INITIAL_BACKOFF = 2 * time.Second
+)
+
+// WithCCADBProxyURL makes FetchReport (and so FetchReports, FetchStore, and Refresh) download the reports published on
+// the CCADB site via a caching reverse proxy at proxyURL, such as the one that ccadb_server serves at /reports/ with
+// -report-proxy, instead of from CCADB directly: a report under CCADB_SITE_URL is downloaded from the same path and
+// query under proxyURL. Other reports, such as the CT log list, are still downloaded directly. Reports are downloaded
+// from CCADB directly by default, or if proxyURL is "".
+func WithCCADBProxyURL(proxyURL string) FetchOption {
+	return func(o *fetchOptions) {
+		o.ccadbProxyURL = proxyURL
+	}
+}
+
+// proxiedURL returns the URL from which to download a report, which is on the CCADB proxy at proxyURL unless it is "".
+func proxiedURL(proxyURL, url string) string {
+	if proxyURL != "" {
+		if path, ok := strings.CutPrefix(url, CCADB_SITE_URL); ok {
+			return strings.TrimSuffix(proxyURL, "/") + "/" + path
+		}
+	}
+	return url
+}
+
+// FetchReport downloads a CCADB CSV report. A download that fails because CCADB is temporarily unavailable is retried,
+// up to FETCH_ATTEMPTS times in all, with exponential backoff. If a report cache is used (see WithReportCacheDir),
+// the cached report is returned when it hasn't changed or when every attempt fails because CCADB is unavailable. If a
+// CCADB proxy is used (see WithCCADBProxyURL), reports on the CCADB site are downloaded from it instead.
+func FetchReport(ctx context.Context, client *http.Client, url string, opts ...FetchOption) ([]byte, error) {
+	options := newFetchOptions(opts)
+	if client == nil {
+		client = http.DefaultClient
+	}
+	url = proxiedURL(options.ccadbProxyURL, url)
+
+	cached := readCachedReport(options.reportCacheDir, url)
+	backoff := FETCH_INITIAL_BACKOFF
+	for attempt := 1; ; attempt++ {
+		data, notModified, unavailable, err := fetchReportOnce(ctx, client, url, cached)
+		if notModified {
+			logger.Debug("Cached report is unchanged", zap.String("url", url), zap.Time("fetched_at", cached.FetchedAt))
+			return cached.data, nil
+		} else if err == nil {
+			writeCachedReport(options.reportCacheDir, url, data.etag, data.body)
+			return data.body, nil
+		} else if !unavailable {
+			return nil, err
+		} else if attempt == FETCH_ATTEMPTS {
+			if cached != nil {
+				logger.Warn("Report could not be downloaded, so the cached report is used", zap.Error(err), zap.String("url", url), zap.Time("fetched_at", cached.FetchedAt))
+				return cached.data, nil
+			}
+			return nil, err
+		}
+
+		logger.Info("Report could not be downloaded, so retrying", zap.Error(err), zap.String("url", url), zap.Int("attempt", attempt), zap.Duration("backoff", backoff))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// A downloaded report.
+type downloadedReport struct {
+	body []byte
+	etag string
+}
+
+// fetchReportOnce makes one attempt to download a report, revalidating the cached report if there is one. It reports
+// whether the cached report is unchanged, and whether a failure was because CCADB is temporarily unavailable.
+func fetchReportOnce(ctx context.Context, client *http.Client, url string, cached *cachedReport) (data *downloadedReport, notModified, unavailable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, false, err
+	}
+	if cached != nil && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, false, isCCADBUnavailable(ctx, err, 0), err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		return nil, true, false, nil
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, false, isCCADBUnavailable(ctx, nil, resp.StatusCode), fmt.Errorf("%s: HTTP %d", url, resp.StatusCode)
+	}
+
+	// A response that ends early (e.g. shorter than its Content-Length) fails here.
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, isCCADBUnavailable(ctx, err, 0), fmt.Errorf("%s: %w", url, err)
+	}
+	return &downloadedReport{body: body, etag: resp.Header.Get("ETag")}, false, false, nil
+}
+
+// FetchReports downloads the latest CCADB CSV reports and the CT log list into dir, using the dataset layout read by
+// NewStore, then generates the slim report, the SKI to SHA-256(SubjectPublicKeyInfo) and derived key identifiers CSVs
+// from the downloaded certificates, and the manifest, which records when the reports were fetched. Every report is
+// downloaded and validated before any file in dir is replaced, so that a failed or partial download never overwrites
+// good data: the reports must parse, and the number of CA certificate records and of certificates must not have shrunk
+// by more than the maximum fraction (see WithMaxReportShrinkage) since the reports previously downloaded into dir,
+// which would indicate a truncated export. The downloads are configured by opts.
+func FetchReports(ctx context.Context, client *http.Client, dir string, opts ...FetchOption) error {
+	options := newFetchOptions(opts)
+	fetchedAt := time.Now().UTC()
+	files := make(memfs.FS)
+
+	// Download the All Certificate Records report, and generate the slim report from it.
+	data, err := FetchReport(ctx, client, CCADB_REPORT_BASE_URL+CCADB_CSV_REPORT, opts...)
+	if err != nil {
+		return err
+	} else if len(data) == 0 {
+		return fmt.Errorf("%s: Report is empty", CCADB_CSV_REPORT)
+	}
+	files[CCADB_CSV_PATH] = data
+	if data, err = GenerateSlimCSV(data); err != nil {
+		return fmt.Errorf("%s: %w", CCADB_CSV_REPORT, err)
+	}
+	files[SLIM_CSV_PATH] = data
+
+	// Download the PEM reports for each year. Years for which there are no certificates are skipped.
+	for year := PEM_CSV_FIRST_YEAR; year <= time.Now().UTC().Year(); year++ {
+		yearStr := strconv.Itoa(year)
+		if data, err = FetchReport(ctx, client, CCADB_REPORT_BASE_URL+PEM_CSV_REPORT+"?NotBeforeYear="+yearStr, opts...); err != nil {
+			return err
+		} else if len(data) != 0 {
+			files[PEM_CSV_DIR+"/"+PEM_CSV_FILENAME_PREFIX+yearStr] = data
+		}
+	}
+
+	// Download the root program constraints reports.
+	for _, report := range constraintsReports {
+		if data, err = FetchReport(ctx, client, report.url, opts...); err != nil {
+			return err
+		} else if len(data) == 0 {
+			return fmt.Errorf("%s: Report is empty", report.url)
+		} else if report.decode != nil {
+			if data, err = report.decode(data); err != nil {
+				return fmt.Errorf("%s: %w", report.url, err)
+			}
+		}
+		if _, err = report.parse(data, report.filePath); err != nil {
+			return fmt.Errorf("%s: %w", report.url, err)
+		}
+		files[report.filePath] = data
+	}
+
+	// Download the CT log list.
+	if data, err = FetchReport(ctx, client, CT_LOG_LIST_URL, opts...); err != nil {
+		return err
+	} else if len(data) == 0 {
+		return fmt.Errorf("%s: Report is empty", CT_LOG_LIST_URL)
+	}
+	files[CT_LOG_LIST_PATH] = data
+
+	// Generate the SKI to SHA-256(SubjectPublicKeyInfo) CSV, and the derived key identifiers CSV for certificates that
+	// have no Subject Key Identifier.
+	if data, err = generateSKIAndSPKISHA256CSV(files); err != nil {
+		return err
+	}
+	files[SKI_SPKISHA256_PATH] = data
+	if data, err = generateDerivedSKICSV(files); err != nil {
+		return err
+	}
+	files[DERIVED_SKI_PATH] = data
+
+	// Compare with the reports previously downloaded into dir.
+	if err = checkReportShrinkage(os.DirFS(dir), files, options.maxReportShrinkage); err != nil {
+		return err
+	}
+
+	// Replace the files in dir, and generate the manifest last, once every other file has been written.
+	for _, filePath := range slices.Sorted(maps.Keys(files)) {
+		if err = writeFileAtomically(filepath.Join(dir, filepath.FromSlash(filePath)), files[filePath]); err != nil {
+			return err
+		}
+	}
+	if data, err = GenerateManifest(os.DirFS(dir), fetchedAt); err != nil {
+		return err
+	}
+	return writeFileAtomically(filepath.Join(dir, filepath.FromSlash(MANIFEST_PATH)), data)
+}
+
+// GenerateSlimCSV produces the slim report from an All Certificate Records CSV report in any supported format. The slim
+// report only contains the columns that are parsed into the typed lookup API, using the latest version's header names.
+func GenerateSlimCSV(data []byte) ([]byte, error) {
+	records := readCSVRecords(data, CCADB_CSV_PATH, 0)
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: CSV file is empty", ErrMalformedDataset)
+	}
+
+	format := detectCSVFormat(records[0].fields)
+	var csvIdx [MAX_IDX]int
+	for i, name := range csvHeaders {
+		if csvIdx[i] = format.headerIndex(records[0].fields, name); csvIdx[i] == -1 && !format.isAbsent(name) {
+			return nil, fmt.Errorf("%w: CSV data is missing the %q header", ErrMalformedDataset, name)
+		}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(csvHeaders[:])
+	fields := make([]string, MAX_IDX)
+	for _, record := range records[1:] {
+		for i := range csvHeaders {
+			fields[i] = csvField(record.fields, csvIdx[i])
+		}
+		w.Write(fields)
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// generateSKIAndSPKISHA256CSV produces the same output as cmd/ski_spki/gen_ski_spki_csv.sh.
+func generateSKIAndSPKISHA256CSV(fsys fs.FS) ([]byte, error) {
+	lines := make(map[string]struct{})
+	if err := forEachPEMCertificate(fsys, func(cert *x509.Certificate) {
+		if cert.SubjectKeyId != nil {
+			sha256Hash := SPKIHashOf(cert)
+			lines[fmt.Sprintf("%s,%s\n", KeyIdentifierToBase64(cert.SubjectKeyId), base64.StdEncoding.EncodeToString(sha256Hash[:]))] = struct{}{}
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return sortedCSV("Subject Key Identifier,SHA-256(Subject Public Key Info)\n", lines), nil
+}
+
+// generateDerivedSKICSV produces the same output as cmd/ski_spki/gen_ski_spki_csv.sh, for the certificates that have no
+// Subject Key Identifier extension.
+func generateDerivedSKICSV(fsys fs.FS) ([]byte, error) {
+	lines := make(map[string]struct{})
+	if err := forEachPEMCertificate(fsys, func(cert *x509.Certificate) {
+		if cert.SubjectKeyId == nil {
+			if keyIdentifier, err := subjectKeyIdentifierFromSPKI(cert.RawSubjectPublicKeyInfo); err == nil {
+				sha256Fingerprint := sha256.Sum256(cert.Raw)
+				sha256Hash := SPKIHashOf(cert)
+				lines[fmt.Sprintf("%X,%s,%s\n", sha256Fingerprint, KeyIdentifierToBase64(keyIdentifier), base64.StdEncoding.EncodeToString(sha256Hash[:]))] = struct{}{}
+			}
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return sortedCSV("SHA-256 Fingerprint,Subject Key Identifier,SHA-256(Subject Public Key Info)\n", lines), nil
+}
+
+// forEachPEMCertificate calls fn for each certificate in the PEM reports that can be parsed.
+func forEachPEMCertificate(fsys fs.FS, fn func(cert *x509.Certificate)) error {
+	entries, err := fs.ReadDir(fsys, PEM_CSV_DIR)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		filePath := PEM_CSV_DIR + "/" + entry.Name()
+		data, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+
+		records := readCSVRecords(data, filePath, 2)
+		if len(records) == 0 {
+			continue
+		}
+		for _, record := range records[1:] {
+			block, _ := pem.Decode([]byte(record.fields[1]))
+			if block == nil {
+				continue
+			}
+			if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+				fn(cert)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortedCSV returns a CSV header followed by the given lines in sorted order.
+func sortedCSV(header string, lines map[string]struct{}) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, line := range slices.Sorted(maps.Keys(lines)) {
+		buf.WriteString(line)
+	}
+	return buf.Bytes()
+}
+
+// writeFileAtomically writes data to a temporary file and then renames it, so that an interrupted write never leaves a
+// partial file at filePath.
+func writeFileAtomically(filePath string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err = tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	} else if err = tmpFile.Close(); err != nil {
+		return err
+	} else if err = os.Rename(tmpFile.Name(), filePath); err != nil {
+		return err
+	}
+	logger.Debug("Wrote file", zap.String("file_path", filePath), zap.Int("size", len(data)))
+	return nil
+}
@@ -10,6 +10,16 @@ if [ -s AllCertificateRecordsCSVFormatV5 ]; then
   mv AllCertificateRecordsCSVFormatV5.sorted AllCertificateRecordsCSVFormatV5
 fi
 
+wget -nv -O IncludedCACertificateReportPEMCSV https://ccadb.my.salesforce-sites.com/mozilla/IncludedCACertificateReportPEMCSV
+if [ -s IncludedCACertificateReportPEMCSV ]; then
+  csvsort IncludedCACertificateReportPEMCSV > IncludedCACertificateReportPEMCSV.sorted
+  mv IncludedCACertificateReportPEMCSV.sorted IncludedCACertificateReportPEMCSV
+fi
+
+wget -nv -O - "https://chromium.googlesource.com/chromium/src/+/main/net/data/ssl/chrome_root_store/root_store.textproto?format=TEXT" | base64 -d > root_store.textproto
+
+wget -nv -O log_list.json https://www.gstatic.com/ct/log_list/v3/log_list.json
+
 for i in $( seq 1994 `date +%Y` ); do
   wget -nv -O AllCertificatePEMsCSVFormat_NotBeforeYear_$i https://ccadb.my.salesforce-sites.com/ccadb/AllCertificatePEMsCSVFormat?NotBeforeYear=$i
   if [ -s AllCertificatePEMsCSVFormat_NotBeforeYear_$i ]; then
@@ -21,13 +31,29 @@ for i in $( seq 1994 `date +%Y` ); do
 done
 
 cd $CURDIR
-mkdir -p data
+mkdir -p data full/data full/cmd/ski_spki/data
 if [ -s $TMPDIR/AllCertificateRecordsCSVFormatV5 ]; then
-  mv $TMPDIR/AllCertificateRecordsCSVFormatV5 $CURDIR/data
+  mv $TMPDIR/AllCertificateRecordsCSVFormatV5 $CURDIR/full/data
 fi
-mv $TMPDIR/* $CURDIR/cmd/ski_spki/data
+if [ -s $TMPDIR/IncludedCACertificateReportPEMCSV ]; then
+  mv $TMPDIR/IncludedCACertificateReportPEMCSV $CURDIR/data
+fi
+rm -f $TMPDIR/IncludedCACertificateReportPEMCSV
+if [ -s $TMPDIR/root_store.textproto ]; then
+  mv $TMPDIR/root_store.textproto $CURDIR/data
+fi
+rm -f $TMPDIR/root_store.textproto
+if [ -s $TMPDIR/log_list.json ]; then
+  mv $TMPDIR/log_list.json $CURDIR/data
+fi
+rm -f $TMPDIR/log_list.json
+mv $TMPDIR/* $CURDIR/full/cmd/ski_spki/data
 rmdir $TMPDIR
 
 cd cmd/ski_spki
 ./gen_ski_spki_csv.sh
 cd $CURDIR
+
+go run ./cmd/slim_csv
+go run ./cmd/dataset_info
+go run ./cmd/roots_gen
@@ -0,0 +1,57 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"time"
+)
+
+// CapabilityFilter selects the CA certificates returned by ListFingerprints. A CA certificate must match every field
+// that is set; the zero value matches every CA certificate.
+type CapabilityFilter struct {
+	// Only match CA certificates of this record type, unless it is RecordTypeUnknown.
+	RecordType RecordType
+	// Only match CA certificates that have each capability that is set to true.
+	TlsCapable         bool
+	TlsEvCapable       bool
+	SmimeCapable       bool
+	CodeSigningCapable bool
+	HasVMCAudit        bool
+	// Only match CA certificates that are valid at this time, unless it is zero.
+	ValidAt time.Time
+	// Only match CA certificates that CCADB doesn't consider to be revoked (including by a revoked parent).
+	ExcludeRevoked bool
+	// Only match CA certificates that are included in (or trusted by) this root program, unless it is empty.
+	RootProgram string
+	// Only match CA certificates for which neither full nor partitioned CRL URLs are disclosed.
+	MissingCRLDisclosure bool
+}
+
+// matches reports whether the CA certificate matches the filter.
+func (filter *CapabilityFilter) matches(ccc *CACertCapabilities, cr *CertificateRecord) bool {
+	switch {
+	case filter.RecordType != RecordTypeUnknown && ccc.CertificateRecordType != filter.RecordType,
+		filter.TlsCapable && !ccc.TlsCapable,
+		filter.TlsEvCapable && !ccc.TlsEvCapable,
+		filter.SmimeCapable && !ccc.SmimeCapable,
+		filter.CodeSigningCapable && !ccc.CodeSigningCapable,
+		filter.HasVMCAudit && !ccc.HasVMCAudit,
+		!filter.ValidAt.IsZero() && (filter.ValidAt.Before(cr.ValidFrom) || filter.ValidAt.After(cr.ValidTo)),
+		filter.ExcludeRevoked && cr.isRevoked(),
+		filter.MissingCRLDisclosure && cr.HasCRLDisclosure():
+		return false
+	case filter.RootProgram != "":
+		return cr.isTrustedBy(filter.RootProgram)
+	default:
+		return true
+	}
+}
+
+// compareSHA256Fingerprints orders SHA-256 fingerprints bytewise.
+func compareSHA256Fingerprints(a, b [sha256.Size]byte) int {
+	for i := range a {
+		if a[i] != b[i] {
+			return int(a[i]) - int(b[i])
+		}
+	}
+	return 0
+}
@@ -0,0 +1,183 @@
+package ccadb_data
+
+import "slices"
+
+// A version of the CCADB "All Certificate Records" CSV report format.
+type csvFormat struct {
+	Version int
+	// Header that is only present in this version of the report.
+	identifyingHeader string
+	// Header names in this version of the report, indexed by the equivalent header name in the latest version.
+	renamedHeaders map[string]string
+	// Headers from the latest version of the report that are absent from this version.
+	absentHeaders []string
+}
+
+var csvFormats = []*csvFormat{
+	{
+		Version:           5,
+		identifyingHeader: "JSON Array of All Full CRL URLs",
+	},
+	{
+		Version:           3,
+		identifyingHeader: "Full CRL Issued By This CA",
+		renamedHeaders: map[string]string{
+			"JSON Array of All Full CRL URLs":      "Full CRL Issued By This CA",
+			"Audit Firm":                           "Auditor",
+			"TLS BR Audit URL":                     "BR Audit URL",
+			"TLS BR Audit Type":                    "BR Audit Type",
+			"TLS BR Audit Statement Date":          "BR Audit Statement Date",
+			"TLS BR Audit Period Start Date":       "BR Audit Period Start Date",
+			"TLS BR Audit Period End Date":         "BR Audit Period End Date",
+			"TLS EVG Audit URL":                    "EV SSL Audit URL",
+			"TLS EVG Audit Type":                   "EV SSL Audit Type",
+			"TLS EVG Audit Statement Date":         "EV SSL Audit Statement Date",
+			"TLS EVG Audit Period Start Date":      "EV SSL Audit Period Start Date",
+			"TLS EVG Audit Period End Date":        "EV SSL Audit Period End Date",
+			"Code Signing Audit URL":               "EV Code Signing Audit URL",
+			"Code Signing Audit Type":              "EV Code Signing Audit Type",
+			"Code Signing Audit Statement Date":    "EV Code Signing Audit Statement Date",
+			"Code Signing Audit Period Start Date": "EV Code Signing Audit Period Start Date",
+			"Code Signing Audit Period End Date":   "EV Code Signing Audit Period End Date",
+		},
+		absentHeaders: []string{
+			"VMC Audit URL",
+			"VMC Audit Type",
+			"VMC Audit Statement Date",
+			"VMC Audit Period Start Date",
+			"VMC Audit Period End Date",
+		},
+	},
+}
+
+// Every header in the latest version of the report, in order.
+var latestCSVHeaders = []string{
+	"CA Owner",
+	"Salesforce Record ID",
+	"Certificate Name",
+	"Parent Salesforce Record ID",
+	"Parent Certificate Name",
+	"Certificate Record Type",
+	"Subordinate CA Owner",
+	"Apple Status",
+	"Chrome Status",
+	"Microsoft Status",
+	"Mozilla Status",
+	"Status of Root Cert",
+	"Revocation Status",
+	"SHA-256 Fingerprint",
+	"Parent SHA-256 Fingerprint",
+	"Valid From (GMT)",
+	"Valid To (GMT)",
+	"Authority Key Identifier",
+	"Subject Key Identifier",
+	"Technically Constrained",
+	"Trust Bits for Root Cert",
+	"EV OIDs for Root Cert",
+	"Derived Trust Bits",
+	"JSON Array of All Full CRL URLs",
+	"JSON Array of Partitioned CRLs",
+	"DV ACME Directory URL(s)",
+	"OV ACME Directory URL(s)",
+	"EV ACME Directory URL(s)",
+	"IV ACME Directory URL(s)",
+	"Audit Firm",
+	"Audit Firm Location",
+	"Audits Same as Parent",
+	"Standard Audit URL",
+	"Standard Audit Type",
+	"Standard Audit Statement Date",
+	"Standard Audit Period Start Date",
+	"Standard Audit Period End Date",
+	"NetSec Audit URL",
+	"NetSec Audit Type",
+	"NetSec Audit Statement Date",
+	"NetSec Audit Period Start Date",
+	"NetSec Audit Period End Date",
+	"TLS BR Audit URL",
+	"TLS BR Audit Type",
+	"TLS BR Audit Statement Date",
+	"TLS BR Audit Period Start Date",
+	"TLS BR Audit Period End Date",
+	"TLS EVG Audit URL",
+	"TLS EVG Audit Type",
+	"TLS EVG Audit Statement Date",
+	"TLS EVG Audit Period Start Date",
+	"TLS EVG Audit Period End Date",
+	"Code Signing Audit URL",
+	"Code Signing Audit Type",
+	"Code Signing Audit Statement Date",
+	"Code Signing Audit Period Start Date",
+	"Code Signing Audit Period End Date",
+	"S/MIME BR Audit URL",
+	"S/MIME BR Audit Type",
+	"S/MIME BR Audit Statement Date",
+	"S/MIME BR Audit Period Start Date",
+	"S/MIME BR Audit Period End Date",
+	"VMC Audit URL",
+	"VMC Audit Type",
+	"VMC Audit Statement Date",
+	"VMC Audit Period Start Date",
+	"VMC Audit Period End Date",
+	"Policy Documentation",
+	"CA Document Repository",
+	"CP Same as Parent",
+	"Certificate Policy (CP) URL",
+	"CP Effective Date",
+	"CPS Same as Parent",
+	"Certificate Practice Statement (CPS) URL",
+	"CPS Effective Date",
+	"CP/CPS Same as Parent",
+	"Certificate Practice & Policy Statement",
+	"CP/CPS Effective Date",
+	"MD/AsciiDoc CP/CPS Same as Parent",
+	"MD/AsciiDoc CP/CPS URL",
+	"MD/AsciiDoc CP/CPS Effective Date",
+	"Test Website URL - Valid",
+	"Test Website URL - Expired",
+	"Test Website URL - Revoked",
+	"TLS Capable",
+	"TLS EV Capable",
+	"Code Signing Capable",
+	"S/MIME Capable",
+	"Country",
+}
+
+// detectCSVFormat determines the report format version from the CSV header. Unrecognized headers are assumed to
+// belong to a future version of the report that is compatible with the latest known version.
+func detectCSVFormat(header []string) *csvFormat {
+	for _, format := range csvFormats {
+		if slices.Contains(header, format.identifyingHeader) {
+			return format
+		}
+	}
+	return csvFormats[0]
+}
+
+// headerName returns the name used by this version of the report for the given latest-version header name.
+func (format *csvFormat) headerName(latestName string) string {
+	if name, ok := format.renamedHeaders[latestName]; ok {
+		return name
+	}
+	return latestName
+}
+
+// isAbsent reports whether the given latest-version header is expected to be absent from this version of the report.
+func (format *csvFormat) isAbsent(latestName string) bool {
+	return slices.Contains(format.absentHeaders, latestName)
+}
+
+// latestName returns the latest-version header name for the given header name used by this version of the report.
+func (format *csvFormat) latestName(name string) string {
+	for latestName, renamed := range format.renamedHeaders {
+		if renamed == name {
+			return latestName
+		}
+	}
+	return name
+}
+
+// headerIndex returns the index of the given latest-version header in the CSV header, or -1 if it is not present.
+func (format *csvFormat) headerIndex(header []string, latestName string) int {
+	return slices.Index(header, format.headerName(latestName))
+}
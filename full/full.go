@@ -0,0 +1,46 @@
+// Package full embeds the full CCADB dataset: the All Certificate Records report with every column, and the PEM
+// reports. Importing this package for its side effects makes the default Store load from the full dataset instead of
+// the slim one, so that LoadRawRecords retains every column and LoadAllCACertificates can load the certificates:
+//
+//	import _ "github.com/crtsh/ccadb_data/full"
+//
+// Programs that only need the typed lookup API should not import this package, since it adds around 30MB to the binary.
+package full
+
+import (
+	"embed"
+	"errors"
+	"io/fs"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+//go:embed data/* cmd/ski_spki/data/*
+var f embed.FS
+
+func init() {
+	ccadb_data.SetDefaultFS(FS())
+}
+
+// FS returns the full dataset, which uses the dataset layout read by ccadb_data.NewStore. Files that are not embedded
+// by this package (e.g. the key identifier CSVs) are read from ccadb_data.EmbeddedFS.
+func FS() fs.FS {
+	return overlayFS{f, ccadb_data.EmbeddedFS()}
+}
+
+// NewStore loads a Store from the full dataset, configured by opts.
+func NewStore(opts ...ccadb_data.StoreOption) (*ccadb_data.Store, error) {
+	return ccadb_data.NewStore(FS(), opts...)
+}
+
+// overlayFS reads each file from the first of its file systems that contains it.
+type overlayFS []fs.FS
+
+func (o overlayFS) Open(name string) (fs.File, error) {
+	for _, fsys := range o[:len(o)-1] {
+		if file, err := fsys.Open(name); !errors.Is(err, fs.ErrNotExist) {
+			return file, err
+		}
+	}
+	return o[len(o)-1].Open(name)
+}
@@ -0,0 +1,30 @@
+package full
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+// TestDatasetChecksums checks that every file in DatasetChecksums is in the full dataset with that checksum, and is listed
+// in its manifest with the same path and checksum.
+func TestDatasetChecksums(t *testing.T) {
+	manifest, err := fs.ReadFile(FS(), ccadb_data.MANIFEST_PATH)
+	if err != nil {
+		t.Fatalf("Manifest could not be read: %v", err)
+	}
+	for filePath, checksum := range ccadb_data.DatasetChecksums {
+		if data, err := fs.ReadFile(FS(), filePath); err != nil {
+			t.Errorf("%s could not be read: %v", filePath, err)
+		} else if sha256Hash := sha256.Sum256(data); hex.EncodeToString(sha256Hash[:]) != checksum {
+			t.Errorf("%s has checksum %x, want %s", filePath, sha256Hash, checksum)
+		}
+		if !strings.Contains("\n"+string(manifest), "\n"+checksum+"  "+filePath+"\n") {
+			t.Errorf("%s is not listed in the manifest with checksum %s", filePath, checksum)
+		}
+	}
+}
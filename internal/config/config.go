@@ -0,0 +1,151 @@
+// Package config loads the YAML configuration file shared by the command-line tools. Each setting in the file is the
+// name of a command-line flag and the value to set it to, so that flags given on the command line take precedence over
+// the file. For example:
+//
+//	# Settings for every tool that has the flag.
+//	timeout: 30s
+//	proxy: http://proxy.example.com:3128
+//	concurrency: 8
+//	exclude:
+//	  - "*.example.net"
+//
+//	# Settings for one tool, which take precedence over the settings for every tool.
+//	url_check:
+//	  format: sarif
+//	  output: url_check.sarif
+package config
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Environment variable that names the configuration file, when the -config flag isn't given.
+const ENV_CONFIG = "CCADB_CONFIG"
+
+// AddFlag registers the -config flag on flags.
+func AddFlag(flags *flag.FlagSet) *string {
+	return flags.String("config", "", "YAML configuration file (defaults to $"+ENV_CONFIG+")")
+}
+
+// Apply reads the configuration file at filePath (or, if it is empty, the one named by $CCADB_CONFIG, if any), and sets
+// each flag in flags that wasn't set on the command line from the settings for every tool and then from the settings
+// for the named tool. Settings for every tool that flags has no flag for are ignored, but settings for the named tool must
+// each have a flag. Apply must be called after flags has been parsed.
+func Apply(flags *flag.FlagSet, tool, filePath string) error {
+	if filePath == "" {
+		if filePath = os.Getenv(ENV_CONFIG); filePath == "" {
+			return nil
+		}
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	var settings map[string]any
+	if err = yaml.Unmarshal(data, &settings); err != nil {
+		return fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	setOnCommandLine := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) { setOnCommandLine[f.Name] = true })
+
+	// A setting whose value is a mapping holds the settings for one tool.
+	toolSettings, _ := settings[tool].(map[string]any)
+	for name, value := range settings {
+		if _, isMapping := value.(map[string]any); isMapping {
+			continue
+		} else if _, overridden := toolSettings[name]; overridden || flags.Lookup(name) == nil || setOnCommandLine[name] {
+			continue
+		} else if err = set(flags, name, value); err != nil {
+			return fmt.Errorf("%s: %w", filePath, err)
+		}
+	}
+	for name, value := range toolSettings {
+		if flags.Lookup(name) == nil {
+			return fmt.Errorf("%s: %s has no -%s flag", filePath, tool, name)
+		} else if setOnCommandLine[name] {
+			continue
+		} else if err = set(flags, name, value); err != nil {
+			return fmt.Errorf("%s: %w", filePath, err)
+		}
+	}
+	return nil
+}
+
+// set sets a flag from a setting. A list sets the flag once for each value, for flags that can be repeated.
+func set(flags *flag.FlagSet, name string, value any) error {
+	values, isList := value.([]any)
+	if !isList {
+		values = []any{value}
+	}
+	for _, v := range values {
+		switch v.(type) {
+		case nil:
+			continue
+		case map[string]any, []any:
+			return fmt.Errorf("%s: Value must be a scalar or a list of scalars", name)
+		}
+		if err := flags.Set(name, fmt.Sprint(v)); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Excludes is a repeatable flag of URLs to skip. Each pattern is either a hostname, which may contain shell wildcards
+// (e.g. "*.example.com"), or a URL prefix (e.g. "http://crl.example.com/old/").
+type Excludes []string
+
+// AddExcludesFlag registers the repeatable -exclude flag on flags.
+func AddExcludesFlag(flags *flag.FlagSet) *Excludes {
+	excludes := new(Excludes)
+	flags.Var(excludes, "exclude", "Skip URLs whose hostname matches this pattern (e.g. \"*.example.com\") or that start with this URL prefix (may be repeated)")
+	return excludes
+}
+
+func (e *Excludes) String() string {
+	return strings.Join(*e, ",")
+}
+
+func (e *Excludes) Set(pattern string) error {
+	if !strings.Contains(pattern, "://") {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("Invalid hostname pattern %q: %w", pattern, err)
+		}
+	}
+	*e = append(*e, pattern)
+	return nil
+}
+
+// Match reports whether rawURL should be skipped.
+func (e *Excludes) Match(rawURL string) bool {
+	var hostname string
+	if u, err := url.Parse(rawURL); err == nil {
+		hostname = strings.ToLower(u.Hostname())
+	}
+	for _, pattern := range *e {
+		if strings.Contains(pattern, "://") {
+			if strings.HasPrefix(rawURL, pattern) {
+				return true
+			}
+		} else if matched, _ := path.Match(strings.ToLower(pattern), hostname); matched && hostname != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateOutput creates the output file at filePath, or returns os.Stdout if filePath is empty.
+func CreateOutput(filePath string) (*os.File, error) {
+	if filePath == "" {
+		return os.Stdout, nil
+	}
+	return os.Create(filePath)
+}
@@ -0,0 +1,147 @@
+// Package export reads an AllCertificateRecords CSV report into typed columns, so that each of the export command's
+// output formats gives every column the same name and type, and parses its values in the same way.
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+// Column types.
+type ColumnType int
+
+const (
+	COLUMN_STRING ColumnType = iota
+	// A YYYY-MM-DD date, e.g. "Valid To (GMT)" or "CP Effective Date".
+	COLUMN_DATE
+	// "True" or "False", e.g. "TLS Capable" or "Audits Same as Parent".
+	COLUMN_BOOLEAN
+	// A JSON array of strings, e.g. "JSON Array of Partitioned CRLs".
+	COLUMN_STRING_LIST
+)
+
+// A column of the CSV report.
+type Column struct {
+	// The column's header in the CSV report, e.g. "Valid From (GMT)".
+	Header string
+	// The column's name in the exported data, e.g. "valid_from_gmt".
+	Name string
+	Type ColumnType
+}
+
+// The abbreviations in CSV headers that are kept together in column names.
+var nameReplacer = strings.NewReplacer("SHA-256", "SHA256", "S/MIME", "SMIME", "JSON Array of ", "")
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// NewColumn determines a column's name and type from its CSV header. Columns that aren't recognized as dates, booleans,
+// or lists are strings, so that columns added to the report in future are still exported.
+func NewColumn(header string) Column {
+	c := Column{Header: header, Name: strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(nameReplacer.Replace(header)), "_"), "_")}
+	switch {
+	case strings.HasPrefix(header, "JSON Array of "):
+		c.Type = COLUMN_STRING_LIST
+	case strings.HasSuffix(header, " Date") || strings.HasSuffix(header, " (GMT)"):
+		c.Type = COLUMN_DATE
+	case strings.HasSuffix(header, " Capable") || strings.HasSuffix(header, " Same as Parent") || header == "Technically Constrained":
+		c.Type = COLUMN_BOOLEAN
+	}
+	return c
+}
+
+// Parse parses a value of the column. An empty value returns nil, except in a list column, which returns an empty list.
+// Otherwise, a date column returns a time.Time (at midnight UTC), a boolean column returns a bool, a list column
+// returns a []string, and a string column returns the value unchanged.
+func (c *Column) Parse(value string) (any, error) {
+	switch {
+	case c.Type == COLUMN_STRING_LIST:
+		// Some records contain a JSON string (often an empty one) instead of an array.
+		list := []string{}
+		if value = strings.TrimSpace(value); strings.HasPrefix(value, `"`) {
+			var s string
+			if err := json.Unmarshal([]byte(value), &s); err != nil {
+				return nil, fmt.Errorf("Invalid JSON string in %q: %w", c.Header, err)
+			} else if s != "" {
+				list = append(list, s)
+			}
+		} else if value != "" {
+			if err := json.Unmarshal([]byte(value), &list); err != nil {
+				return nil, fmt.Errorf("Invalid JSON array in %q: %w", c.Header, err)
+			}
+		}
+		return list, nil
+	case value == "":
+		return nil, nil
+	case c.Type == COLUMN_DATE:
+		t, err := ccadb_data.ParseCCADBDate(value)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid date in %q: %w", c.Header, err)
+		}
+		return t, nil
+	case c.Type == COLUMN_BOOLEAN:
+		switch value {
+		case "True":
+			return true, nil
+		case "False":
+			return false, nil
+		default:
+			return nil, fmt.Errorf("Invalid boolean in %q: %q", c.Header, value)
+		}
+	default:
+		return value, nil
+	}
+}
+
+// A record of the CSV report, with its values parsed.
+type Record struct {
+	// Line number of the record in the CSV report.
+	Line int
+	// The parsed values, in the same order as the columns.
+	Values []any
+}
+
+// ReadReport reads an AllCertificateRecords CSV report, and parses every value of every record.
+func ReadReport(filePath string) ([]Column, []*Record, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte{0xEF, 0xBB, 0xBF})))
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, err
+	}
+	columns := make([]Column, len(header))
+	for i, h := range header {
+		columns[i] = NewColumn(h)
+	}
+
+	var records []*Record
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		record := &Record{Line: line, Values: make([]any, len(columns))}
+		for i := range columns {
+			if record.Values[i], err = columns[i].Parse(fields[i]); err != nil {
+				return nil, nil, fmt.Errorf("Line %d: %w", line, err)
+			}
+		}
+		records = append(records, record)
+	}
+	return columns, records, nil
+}
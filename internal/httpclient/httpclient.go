@@ -0,0 +1,133 @@
+// Package httpclient builds the *http.Client used by the command-line tools, so that proxies, custom roots, and TLS
+// certificate verification are configured the same way by each of them.
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Environment variables that override a tool's default Config. The standard HTTPS_PROXY, HTTP_PROXY, and NO_PROXY
+// environment variables are also honored when no proxy is configured.
+const (
+	ENV_PROXY    = "CCADB_PROXY"
+	ENV_CA_FILE  = "CCADB_CA_FILE"
+	ENV_INSECURE = "CCADB_INSECURE"
+	ENV_TIMEOUT  = "CCADB_HTTP_TIMEOUT"
+)
+
+// Config describes an HTTP client.
+type Config struct {
+	// Proxy URL. If empty, the proxy is determined by the standard environment variables.
+	ProxyURL string
+	// PEM file of root certificates to trust instead of the system roots.
+	CAFile string
+	// Whether to skip TLS certificate verification.
+	Insecure bool
+	// Time limit for each request, including reading the response body. Zero means no limit.
+	Timeout time.Duration
+}
+
+// FromEnv returns defaults, overridden by any of the environment variables that are set.
+func FromEnv(defaults Config) (Config, error) {
+	config := defaults
+	if v := os.Getenv(ENV_PROXY); v != "" {
+		config.ProxyURL = v
+	}
+	if v := os.Getenv(ENV_CA_FILE); v != "" {
+		config.CAFile = v
+	}
+	if v := os.Getenv(ENV_INSECURE); v != "" {
+		insecure, err := strconv.ParseBool(v)
+		if err != nil {
+			return config, fmt.Errorf("$%s: %w", ENV_INSECURE, err)
+		}
+		config.Insecure = insecure
+	}
+	if v := os.Getenv(ENV_TIMEOUT); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return config, fmt.Errorf("$%s: %w", ENV_TIMEOUT, err)
+		}
+		config.Timeout = timeout
+	}
+	return config, nil
+}
+
+// Flags are the command-line flags that configure the HTTP client, which take precedence over the environment
+// variables.
+type Flags struct {
+	flags    *flag.FlagSet
+	defaults Config
+	proxyURL *string
+	caFile   *string
+	insecure *bool
+	timeout  *time.Duration
+}
+
+// AddFlags registers the -proxy, -cafile, -insecure, and -timeout flags on flags.
+func AddFlags(flags *flag.FlagSet, defaults Config) *Flags {
+	return &Flags{
+		flags:    flags,
+		defaults: defaults,
+		proxyURL: flags.String("proxy", "", "Proxy URL (defaults to $"+ENV_PROXY+", or the standard proxy environment variables)"),
+		caFile:   flags.String("cafile", "", "PEM file of root certificates to trust instead of the system roots (defaults to $"+ENV_CA_FILE+")"),
+		insecure: flags.Bool("insecure", false, "Skip TLS certificate verification (defaults to $"+ENV_INSECURE+")"),
+		timeout:  flags.Duration("timeout", defaults.Timeout, "Time limit for each request, including reading the response body (defaults to $"+ENV_TIMEOUT+", if set)"),
+	}
+}
+
+// Config returns the defaults, overridden by any of the environment variables that are set, and then by any of the
+// flags that were set.
+func (f *Flags) Config() (Config, error) {
+	config, err := FromEnv(f.defaults)
+	if err != nil {
+		return config, err
+	}
+	f.flags.Visit(func(fl *flag.Flag) {
+		switch fl.Name {
+		case "proxy":
+			config.ProxyURL = *f.proxyURL
+		case "cafile":
+			config.CAFile = *f.caFile
+		case "insecure":
+			config.Insecure = *f.insecure
+		case "timeout":
+			config.Timeout = *f.timeout
+		}
+	})
+	return config, nil
+}
+
+// New builds an HTTP client from config.
+func New(config Config) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: config.Insecure}
+	if config.CAFile != "" {
+		data, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+		if !transport.TLSClientConfig.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("%s: No PEM certificates found", config.CAFile)
+		}
+	}
+
+	return &http.Client{Transport: transport, Timeout: config.Timeout}, nil
+}
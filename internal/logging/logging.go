@@ -0,0 +1,48 @@
+// Package logging builds the zap logger used by the command-line tools, so that their log messages (and those of the
+// ccadb_data package, which they inject it into) are structured and configured the same way by each of them.
+package logging
+
+import (
+	"flag"
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Flags are the command-line flags that configure the logger.
+type Flags struct {
+	level  zapcore.Level
+	format string
+}
+
+// AddFlags registers the -log-level and -log-format flags on flags.
+func AddFlags(flags *flag.FlagSet) *Flags {
+	f := new(Flags)
+	flags.TextVar(&f.level, "log-level", zapcore.InfoLevel, "Minimum level of log messages: debug, info, warn, or error")
+	flags.StringVar(&f.format, "log-format", "json", "Format of log messages: json, or console")
+	return f
+}
+
+// Logger builds a logger that writes to stderr at the level and in the format given on the command line, and is
+// otherwise configured in the same way as the ccadb_data package's default logger, apart from omitting stack traces and
+// applying opts.
+func (f *Flags) Logger(opts ...zap.Option) (*zap.Logger, error) {
+	cfg := zap.NewProductionConfig()
+	cfg.Level = zap.NewAtomicLevelAt(f.level)
+	cfg.DisableCaller = true
+	cfg.DisableStacktrace = true
+	cfg.EncoderConfig.TimeKey = "@timestamp"
+	cfg.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	cfg.EncoderConfig.EncodeDuration = zapcore.NanosDurationEncoder
+	switch f.format {
+	case "json":
+	case "console":
+		cfg.Encoding = "console"
+		cfg.EncoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+		cfg.EncoderConfig.EncodeDuration = zapcore.StringDurationEncoder
+	default:
+		return nil, fmt.Errorf("Unknown log format %q", f.format)
+	}
+	return cfg.Build(opts...)
+}
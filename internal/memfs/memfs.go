@@ -0,0 +1,134 @@
+// Package memfs provides a minimal read-only in-memory filesystem, so that the library can present data that it has
+// downloaded or unpacked as an fs.FS without depending on testing/fstest.
+package memfs
+
+import (
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// FS is an fs.FS whose files are held in memory, indexed by their slash-separated paths (e.g. "data/MANIFEST").
+// Directories are implied by the paths of the files that they contain. An FS must not be modified while it is in use.
+type FS map[string][]byte
+
+// Open opens the named file or directory.
+func (fsys FS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if data, ok := fsys[name]; ok {
+		return &file{info: fileInfo{name: path.Base(name), size: int64(len(data))}, data: data}, nil
+	}
+
+	// Collect the entries of the directory, if there is one.
+	prefix := name + "/"
+	if name == "." {
+		prefix = ""
+	}
+	seen := make(map[string]bool)
+	var entries []fs.DirEntry
+	for filePath, data := range fsys {
+		rest, ok := strings.CutPrefix(filePath, prefix)
+		if !ok || rest == "" {
+			continue
+		}
+		entryName, _, isDir := strings.Cut(rest, "/")
+		if seen[entryName] {
+			continue
+		}
+		seen[entryName] = true
+		info := fileInfo{name: entryName, size: int64(len(data)), dir: isDir}
+		if isDir {
+			info.size = 0
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	if len(entries) == 0 && name != "." {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int { return strings.Compare(a.Name(), b.Name()) })
+	return &dir{info: fileInfo{name: path.Base(name), dir: true}, entries: entries}, nil
+}
+
+// ReadFile returns a copy of the contents of the named file.
+func (fsys FS) ReadFile(name string) ([]byte, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+	data, ok := fsys[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrNotExist}
+	}
+	return slices.Clone(data), nil
+}
+
+// fileInfo describes a file or directory in an FS.
+type fileInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (fi fileInfo) Name() string       { return fi.name }
+func (fi fileInfo) Size() int64        { return fi.size }
+func (fi fileInfo) ModTime() time.Time { return time.Time{} }
+func (fi fileInfo) IsDir() bool        { return fi.dir }
+func (fi fileInfo) Sys() any           { return nil }
+
+func (fi fileInfo) Mode() fs.FileMode {
+	if fi.dir {
+		return fs.ModeDir | 0555
+	}
+	return 0444
+}
+
+// file is an open file in an FS.
+type file struct {
+	info   fileInfo
+	data   []byte
+	offset int64
+}
+
+func (f *file) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *file) Close() error               { return nil }
+
+func (f *file) Read(b []byte) (int, error) {
+	if f.offset >= int64(len(f.data)) {
+		return 0, io.EOF
+	}
+	n := copy(b, f.data[f.offset:])
+	f.offset += int64(n)
+	return n, nil
+}
+
+// dir is an open directory in an FS.
+type dir struct {
+	info    fileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *dir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *dir) Close() error               { return nil }
+
+func (d *dir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+// ReadDir reads the directory's entries in name order, as described by fs.ReadDirFile.
+func (d *dir) ReadDir(n int) ([]fs.DirEntry, error) {
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return slices.Clone(remaining), nil
+	} else if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(remaining))
+	d.offset += n
+	return slices.Clone(remaining[:n]), nil
+}
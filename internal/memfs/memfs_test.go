@@ -0,0 +1,19 @@
+package memfs
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestFS(t *testing.T) {
+	fsys := FS{
+		"data/MANIFEST":                     []byte("manifest"),
+		"data/ski_spkisha256.csv":           []byte("Subject Key Identifier,SHA-256(Subject Public Key Info)\n"),
+		"cmd/ski_spki/data/2020":            []byte("pem"),
+		"cmd/ski_spki/data/2021":            nil,
+		"full/data/AllCertificateRecordsV5": []byte("records"),
+	}
+	if err := fstest.TestFS(fsys, "data/MANIFEST", "data/ski_spkisha256.csv", "cmd/ski_spki/data/2020", "cmd/ski_spki/data/2021", "full/data/AllCertificateRecordsV5"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,174 @@
+// Package openapi describes the HTTP API served by cmd/ccadb_server as an OpenAPI 3.1 document, from which
+// cmd/client_gen generates the client package.
+package openapi
+
+import (
+	"maps"
+
+	"github.com/crtsh/ccadb_data"
+)
+
+const (
+	OPENAPI_VERSION = "3.1.0"
+	// The version of the HTTP API, which changes when an endpoint or a JSON representation changes incompatibly.
+	API_VERSION = "1.0.0"
+	// The default and maximum number of results returned by /search.
+	DEFAULT_SEARCH_LIMIT = 100
+	MAX_SEARCH_LIMIT     = 1000
+)
+
+// Document returns the OpenAPI document of the HTTP API. Each operation has an operationId, from which the name of the
+// client's method is derived.
+func Document() map[string]any {
+	schemas := ccadb_data.JSONSchemaDefinitions("#/components/schemas/")
+	maps.Copy(schemas, graphQLSchemas())
+
+	return map[string]any{
+		"openapi":           OPENAPI_VERSION,
+		"jsonSchemaDialect": ccadb_data.JSON_SCHEMA_DIALECT,
+		"info": map[string]any{
+			"title":       "CCADB data server",
+			"description": "Lookups of CA certificates' CCADB records and capabilities, served by cmd/ccadb_server from github.com/crtsh/ccadb_data.",
+			"version":     API_VERSION,
+		},
+		"paths": map[string]any{
+			"/search": map[string]any{
+				"get": operation("search", "Returns the CA certificates whose names contain a query, ordered by CA Owner, Certificate Name, and SHA-256 fingerprint.",
+					[]any{
+						queryParameter("q", "The query, or a case-insensitive regular expression enclosed in slashes.", map[string]any{"type": "string"}, true),
+						queryParameter("limit", "The maximum number of results.", map[string]any{"type": "integer", "minimum": 1, "maximum": MAX_SEARCH_LIMIT, "default": DEFAULT_SEARCH_LIMIT}, false),
+					},
+					map[string]any{"type": "array", "items": ref("SearchResultJSON")}, "The CA certificates found."),
+			},
+			"/record": map[string]any{
+				"get": operation("getRecord", "Returns the CCADB record and capabilities of the CA certificate with a SHA-256 fingerprint.",
+					[]any{
+						queryParameter("sha256", "The SHA-256 fingerprint, as hex digits in either case, optionally separated by colons.", map[string]any{"type": "string"}, true),
+					},
+					ref("RecordJSON"), "The CA certificate.", http404("No CA certificate has the SHA-256 fingerprint.")),
+			},
+			"/issuer": map[string]any{
+				"get": operation("getIssuer", "Returns the CA certificates with a key identifier, and their merged capabilities.",
+					[]any{
+						queryParameter("key_identifier", "The key identifier, as hex or Base64.", map[string]any{"type": "string"}, true),
+					},
+					ref("IssuerJSON"), "The CA certificates with the key identifier.", http404("No CA certificate has the key identifier.")),
+			},
+			"/graphql": map[string]any{
+				"post": operation("graphQL", "Executes a GraphQL query. Errors in the query itself are reported in the response's errors field.",
+					nil, ref("GraphQLResponse"), "The result of the query.",
+					map[string]any{"requestBody": map[string]any{
+						"required": true,
+						"content":  map[string]any{"application/json": map[string]any{"schema": ref("GraphQLRequest")}},
+					}}),
+			},
+			"/schema.json": map[string]any{
+				"get": operation("getJSONSchema", "Returns the JSON Schema of the JSON representations that the other endpoints return.",
+					nil, map[string]any{"type": "object"}, "The JSON Schema document."),
+			},
+			"/openapi.json": map[string]any{
+				"get": operation("getOpenAPI", "Returns this OpenAPI document.", nil, map[string]any{"type": "object"}, "The OpenAPI document."),
+			},
+		},
+		"components": map[string]any{
+			"schemas": schemas,
+		},
+	}
+}
+
+// operation returns an operation with the given parameters, whose successful response is JSON with the given schema,
+// and which responds with a plain text error message if a request is invalid. extras are added to the operation (e.g.
+// its requestBody) or, if they are responses, to its responses.
+func operation(operationID, summary string, parameters []any, responseSchema map[string]any, responseDescription string, extras ...map[string]any) map[string]any {
+	responses := map[string]any{
+		"200": map[string]any{
+			"description": responseDescription,
+			"content":     map[string]any{"application/json": map[string]any{"schema": responseSchema}},
+		},
+		"400": textResponse("The request is invalid."),
+	}
+	op := map[string]any{
+		"operationId": operationID,
+		"summary":     summary,
+		"responses":   responses,
+	}
+	if parameters != nil {
+		op["parameters"] = parameters
+	}
+	for _, extra := range extras {
+		if extraResponses, ok := extra["responses"].(map[string]any); ok {
+			maps.Copy(responses, extraResponses)
+		} else {
+			maps.Copy(op, extra)
+		}
+	}
+	return op
+}
+
+func queryParameter(name, description string, schema map[string]any, required bool) map[string]any {
+	return map[string]any{
+		"name":        name,
+		"in":          "query",
+		"description": description,
+		"required":    required,
+		"schema":      schema,
+	}
+}
+
+func http404(description string) map[string]any {
+	return map[string]any{"responses": map[string]any{"404": textResponse(description)}}
+}
+
+func textResponse(description string) map[string]any {
+	return map[string]any{
+		"description": description,
+		"content":     map[string]any{"text/plain": map[string]any{"schema": map[string]any{"type": "string"}}},
+	}
+}
+
+func ref(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// graphQLSchemas returns the schemas of a GraphQL request and response, as POSTed to and returned by /graphql.
+func graphQLSchemas() map[string]any {
+	return map[string]any{
+		"GraphQLRequest": map[string]any{
+			"description": "A GraphQL request.",
+			"type":        "object",
+			"properties": map[string]any{
+				"query":         map[string]any{"type": "string", "description": "The GraphQL query."},
+				"operationName": map[string]any{"type": "string", "description": "The operation to execute, if the query has several."},
+				"variables":     map[string]any{"type": "object", "additionalProperties": map[string]any{}, "description": "The values of the query's variables."},
+			},
+			"required": []string{"query"},
+		},
+		"GraphQLResponse": map[string]any{
+			"description": "The result of a GraphQL query.",
+			"type":        "object",
+			"properties": map[string]any{
+				"data":   map[string]any{"description": "The requested data, shaped like the query."},
+				"errors": map[string]any{"type": "array", "items": ref("GraphQLError"), "description": "The errors that the query caused, if any."},
+			},
+		},
+		"GraphQLError": map[string]any{
+			"description": "An error caused by a GraphQL query.",
+			"type":        "object",
+			"properties": map[string]any{
+				"message":   map[string]any{"type": "string"},
+				"locations": map[string]any{"type": "array", "items": ref("GraphQLLocation"), "description": "The locations in the query of the error."},
+				"path":      map[string]any{"type": "array", "items": map[string]any{}, "description": "The path of the response field that caused the error, as field names and list indexes."},
+			},
+			"required": []string{"message"},
+		},
+		"GraphQLLocation": map[string]any{
+			"description": "A location in a GraphQL query.",
+			"type":        "object",
+			"properties": map[string]any{
+				"line":   map[string]any{"type": "integer"},
+				"column": map[string]any{"type": "integer"},
+			},
+			"required": []string{"line", "column"},
+		},
+	}
+}
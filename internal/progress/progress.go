@@ -0,0 +1,81 @@
+// Package progress periodically logs the progress of the command-line tools' long-running operations, so that operators
+// running them interactively can see that they are still alive and how long they have left.
+package progress
+
+import (
+	"flag"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// AddFlag registers the -progress-interval flag on flags.
+func AddFlag(flags *flag.FlagSet) *time.Duration {
+	return flags.Duration("progress-interval", 10*time.Second, "How often to log progress (0 to disable)")
+}
+
+// Tracker counts the completed items of an operation, and logs its progress until it is stopped.
+type Tracker struct {
+	logger   *zap.Logger
+	message  string
+	total    int
+	start    time.Time
+	done     atomic.Int64
+	failed   atomic.Int64
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// Start starts tracking an operation of total items, logging message and the progress every interval. An interval of
+// zero disables the progress log, but the Tracker still counts.
+func Start(logger *zap.Logger, message string, total int, interval time.Duration) *Tracker {
+	t := &Tracker{logger: logger, message: message, total: total, start: time.Now(), stop: make(chan struct{})}
+	if interval > 0 {
+		t.wg.Go(func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					t.log()
+				case <-t.stop:
+					return
+				}
+			}
+		})
+	}
+	return t
+}
+
+// Add records that an item has completed, and whether it failed. It is safe for concurrent use.
+func (t *Tracker) Add(failed bool) {
+	t.done.Add(1)
+	if failed {
+		t.failed.Add(1)
+	}
+}
+
+// Failed returns the number of items that failed so far.
+func (t *Tracker) Failed() int {
+	return int(t.failed.Load())
+}
+
+// Stop stops the progress log.
+func (t *Tracker) Stop() {
+	t.stopOnce.Do(func() { close(t.stop) })
+	t.wg.Wait()
+}
+
+// log logs the number of completed and failed items, and estimates the time remaining from the average rate so far.
+func (t *Tracker) log() {
+	done := int(t.done.Load())
+	fields := []zap.Field{zap.Int("done", done), zap.Int("total", t.total), zap.Int("failed_count", t.Failed()), zap.Duration("elapsed", time.Since(t.start).Round(time.Second))}
+	if done > 0 && done < t.total {
+		eta := time.Duration(float64(time.Since(t.start)) * float64(t.total-done) / float64(done))
+		fields = append(fields, zap.Duration("eta", eta.Round(time.Second)))
+	}
+	t.logger.Info(t.message, fields...)
+}
@@ -0,0 +1,76 @@
+// Package summary defines the exit statuses of the command-line tools, and the machine-parseable summary line that each
+// of them writes to stderr as it exits, so that CI jobs can tell findings apart from failures of the tool itself.
+package summary
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Exit statuses.
+const (
+	// The tool ran successfully, and found nothing to report.
+	EXIT_OK = 0
+	// The tool ran successfully, and found something to report (e.g. unreachable URLs, or audit gaps).
+	EXIT_FINDINGS = 1
+	// The tool failed, e.g. because of invalid arguments or an unreadable input file.
+	EXIT_ERROR = 2
+)
+
+// The status reported in the summary line for each exit status.
+var statuses = map[int]string{EXIT_OK: "ok", EXIT_FINDINGS: "findings", EXIT_ERROR: "error"}
+
+// Summary counts the outcomes of one run of a tool by category, e.g. "checked" and "failed".
+type Summary struct {
+	mu     sync.Mutex
+	tool   string
+	counts map[string]int
+}
+
+// The summary line, written as one JSON object.
+type line struct {
+	Tool     string         `json:"summary"`
+	Status   string         `json:"status"`
+	ExitCode int            `json:"exit_code"`
+	Counts   map[string]int `json:"counts"`
+}
+
+// New returns an empty Summary for the named tool.
+func New(tool string) *Summary {
+	return &Summary{tool: tool, counts: make(map[string]int)}
+}
+
+// Add adds n to the count of a category. It is safe for concurrent use.
+func (s *Summary) Add(category string, n int) {
+	s.mu.Lock()
+	s.counts[category] += n
+	s.mu.Unlock()
+}
+
+// Exit writes the summary line and exits, with EXIT_FINDINGS if findings is positive and EXIT_OK otherwise.
+func (s *Summary) Exit(findings int) {
+	if findings > 0 {
+		s.exit(EXIT_FINDINGS)
+	}
+	s.exit(EXIT_OK)
+}
+
+// OnWrite implements zapcore.CheckWriteHook, so that a Summary can be installed with zap.WithFatalHook: a fatal log
+// message writes the summary line and exits with EXIT_ERROR.
+func (s *Summary) OnWrite(*zapcore.CheckedEntry, []zapcore.Field) {
+	s.exit(EXIT_ERROR)
+}
+
+func (s *Summary) exit(code int) {
+	s.mu.Lock()
+	data, err := json.Marshal(&line{Tool: s.tool, Status: statuses[code], ExitCode: code, Counts: s.counts})
+	s.mu.Unlock()
+	if err == nil {
+		fmt.Fprintf(os.Stderr, "%s\n", data)
+	}
+	os.Exit(code)
+}
@@ -0,0 +1,67 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"slices"
+	"time"
+)
+
+// IssuerStatus is the status of the CA certificates with a key identifier, as returned by
+// GetIssuerStatusByKeyIdentifier.
+type IssuerStatus struct {
+	// Number of CA certificates with the key identifier. Each is counted as exactly one of valid, expired, or revoked.
+	CertificateCount int
+	// Number of CA certificates that are unexpired and that CCADB doesn't consider to be revoked.
+	ValidCount int
+	// Number of CA certificates that have expired, but that CCADB doesn't consider to be revoked.
+	ExpiredCount int
+	// Number of CA certificates that CCADB considers to be revoked (including by a revoked parent).
+	RevokedCount int
+	// Earliest and latest "Valid To" dates of the CA certificates.
+	EarliestExpiry time.Time
+	LatestExpiry   time.Time
+	// Root programs that include (or trust) at least one of the valid CA certificates, in alphabetical order.
+	TrustedBy []string
+}
+
+// IsOperational reports whether at least one CA certificate with the key identifier is still valid, i.e. whether the
+// issuer can still issue certificates that relying parties would accept.
+func (is *IssuerStatus) IsOperational() bool {
+	return is.ValidCount > 0
+}
+
+// rollUpIssuerStatus rolls up the CA certificates with the given SHA-256 fingerprints, as of the given time.
+func (s *Store) rollUpIssuerStatus(sha256Fingerprints [][sha256.Size]byte, now time.Time) *IssuerStatus {
+	is := &IssuerStatus{}
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		cr := s.certificateRecord(sha256Fingerprint)
+		if cr == nil {
+			continue
+		}
+
+		is.CertificateCount++
+		switch {
+		case cr.isRevoked():
+			is.RevokedCount++
+		case now.After(cr.ValidTo):
+			is.ExpiredCount++
+		default:
+			is.ValidCount++
+			for _, rootProgram := range rootPrograms {
+				if cr.isTrustedBy(rootProgram) && !slices.Contains(is.TrustedBy, rootProgram) {
+					is.TrustedBy = append(is.TrustedBy, rootProgram)
+				}
+			}
+		}
+
+		if is.EarliestExpiry.IsZero() || cr.ValidTo.Before(is.EarliestExpiry) {
+			is.EarliestExpiry = cr.ValidTo
+		}
+		if cr.ValidTo.After(is.LatestExpiry) {
+			is.LatestExpiry = cr.ValidTo
+		}
+	}
+
+	slices.Sort(is.TrustedBy)
+	return is
+}
@@ -0,0 +1,187 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+)
+
+// The JSON representations of CA certificates, issuers, and search results that the export and serve tools write, and
+// that JSONSchema describes. Their JSON field names and types are part of the supported API, so that HTTP and JSON
+// consumers can generate clients from the schema. SHA-256 fingerprints are written as uppercase hex, key identifiers as
+// standard Base64, and dates as YYYY-MM-DD.
+
+// RecordJSON is the JSON representation of a CA certificate's CCADB record and capabilities.
+type RecordJSON struct {
+	SHA256Fingerprint          string            `json:"sha256_fingerprint" jsonschema:"pattern=^[0-9A-F]{64}$" description:"The SHA-256 fingerprint of the CA certificate."`
+	CertificateName            string            `json:"certificate_name" description:"The certificate's name, as reported by CCADB."`
+	CAOwner                    string            `json:"ca_owner" description:"The CA Owner."`
+	SubordinateCAOwner         string            `json:"subordinate_ca_owner,omitempty" description:"The Subordinate CA Owner, if the CA certificate is operated by another organization."`
+	ParentSHA256Fingerprint    string            `json:"parent_sha256_fingerprint,omitempty" jsonschema:"pattern=^[0-9A-F]{64}$" description:"The SHA-256 fingerprint of the parent CA certificate, for an intermediate certificate."`
+	CertificateRecordType      RecordType        `json:"certificate_record_type"`
+	RevocationStatus           RevocationStatus  `json:"revocation_status,omitempty" description:"The revocation status, which CCADB doesn't report for root certificates."`
+	AuthorityKeyIdentifier     string            `json:"authority_key_identifier,omitempty" description:"The Base64 Authority Key Identifier."`
+	SubjectKeyIdentifier       string            `json:"subject_key_identifier,omitempty" description:"The Base64 Subject Key Identifier."`
+	ValidFrom                  string            `json:"valid_from" jsonschema:"format=date"`
+	ValidTo                    string            `json:"valid_to" jsonschema:"format=date"`
+	RootProgramStatus          map[string]string `json:"root_program_status" description:"The status reported by each root program that reports one (Apple, Chrome, Microsoft, and Mozilla), e.g. Included or Trusted."`
+	Capabilities               CapabilitiesJSON  `json:"capabilities"`
+	FullCRLURLs                []string          `json:"full_crl_urls" description:"The URLs of the full CRLs issued by the CA."`
+	PartitionedCRLURLs         []string          `json:"partitioned_crl_urls" description:"The URLs of the partitioned CRLs that together cover the CA's full scope."`
+	AuditsSameAsParent         bool              `json:"audits_same_as_parent" description:"Whether the CA certificate is covered by its parent's audits instead of its own."`
+	StandardAuditPeriodEndDate string            `json:"standard_audit_period_end_date,omitempty" jsonschema:"format=date" description:"The end of the period covered by the most recent standard audit, if one is disclosed."`
+}
+
+// CapabilitiesJSON is the JSON representation of the capabilities of a CA certificate, or the merged capabilities of
+// an issuer. A capability is only true when CCADB reports it as true.
+type CapabilitiesJSON struct {
+	TlsCapable         bool `json:"tls_capable"`
+	TlsEvCapable       bool `json:"tls_ev_capable"`
+	SmimeCapable       bool `json:"smime_capable"`
+	CodeSigningCapable bool `json:"code_signing_capable"`
+	HasVMCAudit        bool `json:"has_vmc_audit"`
+}
+
+// IssuerJSON is the JSON representation of the CA certificates that share a key identifier.
+type IssuerJSON struct {
+	KeyIdentifier         string                `json:"key_identifier" description:"The Base64 key identifier."`
+	SHA256Fingerprints    []string              `json:"sha256_fingerprints" jsonschema:"pattern=^[0-9A-F]{64}$" description:"The SHA-256 fingerprints of the CA certificates with the key identifier."`
+	SPKISHA256            string                `json:"spki_sha256,omitempty" jsonschema:"pattern=^[0-9A-F]{64}$" description:"The SHA-256 hash of the issuer's SubjectPublicKeyInfo, if known."`
+	CertificateRecordType RecordType            `json:"certificate_record_type" description:"Root Certificate if any of the CA certificates is a root certificate."`
+	Capabilities          CapabilitiesJSON      `json:"capabilities" description:"The merged capabilities of the CA certificates."`
+	CapabilitySources     CapabilitySourcesJSON `json:"capability_sources"`
+}
+
+// CapabilitySourcesJSON is the JSON representation of the CA certificates that contributed each of an issuer's merged
+// capabilities.
+type CapabilitySourcesJSON struct {
+	Root               []string `json:"root" jsonschema:"pattern=^[0-9A-F]{64}$"`
+	TlsCapable         []string `json:"tls_capable" jsonschema:"pattern=^[0-9A-F]{64}$"`
+	TlsEvCapable       []string `json:"tls_ev_capable" jsonschema:"pattern=^[0-9A-F]{64}$"`
+	SmimeCapable       []string `json:"smime_capable" jsonschema:"pattern=^[0-9A-F]{64}$"`
+	CodeSigningCapable []string `json:"code_signing_capable" jsonschema:"pattern=^[0-9A-F]{64}$"`
+	HasVMCAudit        []string `json:"has_vmc_audit" jsonschema:"pattern=^[0-9A-F]{64}$"`
+}
+
+// SearchResultJSON is the JSON representation of a CA certificate found by SearchRecords.
+type SearchResultJSON struct {
+	SHA256Fingerprint  string   `json:"sha256_fingerprint" jsonschema:"pattern=^[0-9A-F]{64}$"`
+	CertificateName    string   `json:"certificate_name"`
+	CAOwner            string   `json:"ca_owner"`
+	SubordinateCAOwner string   `json:"subordinate_ca_owner,omitempty"`
+	MatchedFields      []string `json:"matched_fields" description:"The names that matched the query, e.g. Certificate Name or Subject O."`
+}
+
+func GetRecordJSONBySHA256(sha256Fingerprint [sha256.Size]byte) *RecordJSON {
+	return GetDefaultStore().GetRecordJSONBySHA256(sha256Fingerprint)
+}
+
+// GetRecordJSONBySHA256 returns the JSON representation of the CA certificate identified by its SHA-256 fingerprint, or
+// nil if it is not in CCADB.
+func (s *Store) GetRecordJSONBySHA256(sha256Fingerprint [sha256.Size]byte) *RecordJSON {
+	i, ok := s.caPosition(sha256Fingerprint)
+	observeLookup("GetRecordJSONBySHA256", ok)
+	if !ok {
+		return nil
+	}
+	cr, ccc := &s.caRecords[i], &s.caCapabilities[i]
+	rj := &RecordJSON{
+		SHA256Fingerprint:      hexFingerprint(sha256Fingerprint),
+		CertificateName:        cr.CertificateName,
+		CAOwner:                cr.CAOwner,
+		SubordinateCAOwner:     cr.SubordinateCAOwner,
+		CertificateRecordType:  ccc.CertificateRecordType,
+		RevocationStatus:       cr.RevocationStatus,
+		AuthorityKeyIdentifier: cr.AuthorityKeyIdentifier,
+		SubjectKeyIdentifier:   cr.SubjectKeyIdentifier,
+		ValidFrom:              cr.ValidFrom.Format(time.DateOnly),
+		ValidTo:                cr.ValidTo.Format(time.DateOnly),
+		RootProgramStatus:      make(map[string]string),
+		Capabilities:           newCapabilitiesJSON(ccc),
+		FullCRLURLs:            append([]string{}, cr.FullCRLURLs...),
+		PartitionedCRLURLs:     append([]string{}, cr.PartitionedCRLURLs...),
+		AuditsSameAsParent:     cr.AuditsSameAsParent,
+	}
+	if cr.ParentSHA256Fingerprint != ([sha256.Size]byte{}) {
+		rj.ParentSHA256Fingerprint = hexFingerprint(cr.ParentSHA256Fingerprint)
+	}
+	for _, rootProgram := range rootPrograms {
+		if status := cr.rootProgramStatus(rootProgram); status != "" {
+			rj.RootProgramStatus[rootProgram] = status
+		}
+	}
+	if !cr.StandardAuditPeriodEndDate.IsZero() {
+		rj.StandardAuditPeriodEndDate = cr.StandardAuditPeriodEndDate.Format(time.DateOnly)
+	}
+	return rj
+}
+
+func GetIssuerJSONByKeyIdentifier(b64KeyIdentifier string) *IssuerJSON {
+	return GetDefaultStore().GetIssuerJSONByKeyIdentifier(b64KeyIdentifier)
+}
+
+// GetIssuerJSONByKeyIdentifier returns the JSON representation of the CA certificates with the key identifier, or nil
+// if there are none in CCADB.
+func (s *Store) GetIssuerJSONByKeyIdentifier(b64KeyIdentifier string) *IssuerJSON {
+	keyIdentifier := b64KeyIdentifier
+	ic := s.issuerCapabilitiesMap[keyIdentifier]
+	if ic == nil {
+		keyIdentifier = canonicalKeyIdentifier(b64KeyIdentifier)
+		ic = s.issuerCapabilitiesMap[keyIdentifier]
+	}
+	observeLookup("GetIssuerJSONByKeyIdentifier", ic != nil)
+	if ic == nil {
+		return nil
+	}
+	ij := &IssuerJSON{
+		KeyIdentifier:         keyIdentifier,
+		SHA256Fingerprints:    hexFingerprints(s.sha256FingerprintsMap[keyIdentifier]),
+		CertificateRecordType: ic.CertificateRecordType,
+		Capabilities:          newCapabilitiesJSON(&ic.CACertCapabilities),
+		CapabilitySources: CapabilitySourcesJSON{
+			Root:               hexFingerprints(ic.Sources.Root),
+			TlsCapable:         hexFingerprints(ic.Sources.TlsCapable),
+			TlsEvCapable:       hexFingerprints(ic.Sources.TlsEvCapable),
+			SmimeCapable:       hexFingerprints(ic.Sources.SmimeCapable),
+			CodeSigningCapable: hexFingerprints(ic.Sources.CodeSigningCapable),
+			HasVMCAudit:        hexFingerprints(ic.Sources.HasVMCAudit),
+		},
+	}
+	if spkiSHA256, ok := s.issuerSPKISHA256Map[keyIdentifier]; ok {
+		ij.SPKISHA256 = hexFingerprint(spkiSHA256)
+	}
+	return ij
+}
+
+// NewSearchResultJSON returns the JSON representation of a CA certificate found by SearchRecords.
+func NewSearchResultJSON(sr *SearchResult) *SearchResultJSON {
+	return &SearchResultJSON{
+		SHA256Fingerprint:  hexFingerprint(sr.SHA256Fingerprint),
+		CertificateName:    sr.CertificateName,
+		CAOwner:            sr.CAOwner,
+		SubordinateCAOwner: sr.SubordinateCAOwner,
+		MatchedFields:      append([]string{}, sr.MatchedFields...),
+	}
+}
+
+func newCapabilitiesJSON(ccc *CACertCapabilities) CapabilitiesJSON {
+	return CapabilitiesJSON{
+		TlsCapable:         ccc.TlsCapable,
+		TlsEvCapable:       ccc.TlsEvCapable,
+		SmimeCapable:       ccc.SmimeCapable,
+		CodeSigningCapable: ccc.CodeSigningCapable,
+		HasVMCAudit:        ccc.HasVMCAudit,
+	}
+}
+
+func hexFingerprint(sha256Fingerprint [sha256.Size]byte) string {
+	return fmt.Sprintf("%X", sha256Fingerprint)
+}
+
+func hexFingerprints(sha256Fingerprints [][sha256.Size]byte) []string {
+	hexFingerprints := make([]string, len(sha256Fingerprints))
+	for i, sha256Fingerprint := range sha256Fingerprints {
+		hexFingerprints[i] = hexFingerprint(sha256Fingerprint)
+	}
+	return hexFingerprints
+}
@@ -0,0 +1,105 @@
+package ccadb_data
+
+import (
+	"reflect"
+	"strings"
+)
+
+// The JSON Schema dialect of the schema that JSONSchema returns.
+const JSON_SCHEMA_DIALECT = "https://json-schema.org/draft/2020-12/schema"
+
+// The types that JSONSchema describes, and their descriptions.
+var jsonSchemaTypes = []struct {
+	t           reflect.Type
+	description string
+}{
+	{reflect.TypeFor[RecordJSON](), "The JSON representation of a CA certificate's CCADB record and capabilities."},
+	{reflect.TypeFor[CapabilitiesJSON](), "The JSON representation of the capabilities of a CA certificate, or the merged capabilities of an issuer."},
+	{reflect.TypeFor[IssuerJSON](), "The JSON representation of the CA certificates that share a key identifier."},
+	{reflect.TypeFor[CapabilitySourcesJSON](), "The JSON representation of the CA certificates that contributed each of an issuer's merged capabilities."},
+	{reflect.TypeFor[SearchResultJSON](), "The JSON representation of a CA certificate found by a search."},
+}
+
+// The values that the enums are written as in JSON, which are the strings that they marshal to.
+var jsonSchemaEnums = map[reflect.Type][]any{
+	reflect.TypeFor[RecordType]():       {CCADB_RECORD_ROOT, CCADB_RECORD_INTERMEDIATE, ""},
+	reflect.TypeFor[RevocationStatus](): {CCADB_NOT_REVOKED, CCADB_REVOKED, CCADB_PARENT_REVOKED, "Unknown"},
+}
+
+// JSONSchema returns a JSON Schema (draft 2020-12) document that describes the JSON representations of CA certificates,
+// issuers, and search results (RecordJSON, IssuerJSON, and SearchResultJSON), so that JSON consumers can validate them
+// or generate clients from them. The types are defined in $defs, by their Go type names.
+func JSONSchema() map[string]any {
+	return map[string]any{
+		"$schema":     JSON_SCHEMA_DIALECT,
+		"title":       "CCADB data",
+		"description": "The JSON representations of CA certificates, issuers, and search results written by github.com/crtsh/ccadb_data.",
+		"$defs":       JSONSchemaDefinitions("#/$defs/"),
+	}
+}
+
+// JSONSchemaDefinitions returns the JSON Schema definitions of the types that JSONSchema describes, by their Go type
+// names, in which references to each other are prefixed with refPrefix (e.g. "#/components/schemas/" for an OpenAPI
+// document).
+func JSONSchemaDefinitions(refPrefix string) map[string]any {
+	definitions := make(map[string]any)
+	for _, jst := range jsonSchemaTypes {
+		definition := jsonSchemaObject(jst.t, refPrefix)
+		definition["description"] = jst.description
+		definitions[jst.t.Name()] = definition
+	}
+	return definitions
+}
+
+// jsonSchemaObject returns the schema of a struct, whose properties are its JSON fields. Fields without omitempty are
+// always written, so they are required.
+func jsonSchemaObject(t reflect.Type, refPrefix string) map[string]any {
+	properties := make(map[string]any)
+	required := []string{}
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		schema := jsonSchemaOf(field.Type, refPrefix)
+		for option := range strings.SplitSeq(field.Tag.Get("jsonschema"), ",") {
+			if key, value, ok := strings.Cut(option, "="); ok {
+				if _, isArray := schema["items"]; isArray {
+					schema["items"].(map[string]any)[key] = value
+				} else {
+					schema[key] = value
+				}
+			}
+		}
+		if description := field.Tag.Get("description"); description != "" {
+			schema["description"] = description
+		}
+		properties[name] = schema
+		if !strings.Contains(options, "omitempty") {
+			required = append(required, name)
+		}
+	}
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// jsonSchemaOf returns the schema of a field's type, referring to the types that JSONSchema describes by name.
+func jsonSchemaOf(t reflect.Type, refPrefix string) map[string]any {
+	if enum, ok := jsonSchemaEnums[t]; ok {
+		return map[string]any{"type": "string", "enum": enum}
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		return map[string]any{"$ref": refPrefix + t.Name()}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": jsonSchemaOf(t.Elem(), refPrefix)}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": jsonSchemaOf(t.Elem(), refPrefix)}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
@@ -0,0 +1,134 @@
+package ccadb_data
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Base64 encodings accepted for key identifiers, in order of preference.
+var keyIdentifierEncodings = []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding}
+
+// canonicalKeyIdentifier converts a key identifier encoded as hex (optionally colon-separated, as printed by OpenSSL),
+// standard Base64, or URL-safe Base64 (with or without padding) to the padded standard Base64 encoding used by CCADB.
+// Input that matches none of these encodings is returned unchanged.
+func canonicalKeyIdentifier(keyIdentifier string) string {
+	if isCanonicalKeyIdentifier(keyIdentifier) {
+		return keyIdentifier
+	}
+	keyIdentifier = strings.TrimSpace(keyIdentifier)
+	if hexKeyIdentifier := strings.ReplaceAll(keyIdentifier, ":", ""); hexKeyIdentifier != "" {
+		if decoded, err := hex.DecodeString(hexKeyIdentifier); err == nil {
+			return base64.StdEncoding.EncodeToString(decoded)
+		}
+	}
+	for _, encoding := range keyIdentifierEncodings {
+		if decoded, err := encoding.DecodeString(keyIdentifier); err == nil && len(decoded) > 0 {
+			return base64.StdEncoding.EncodeToString(decoded)
+		}
+	}
+	return keyIdentifier
+}
+
+// The padded standard Base64 alphabet.
+const STD_BASE64_ALPHABET = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// The value of each character in STD_BASE64_ALPHABET, and -1 for every other character.
+var stdBase64Values = func() (values [256]int8) {
+	for c := range values {
+		values[c] = int8(strings.IndexByte(STD_BASE64_ALPHABET, byte(c)))
+	}
+	return values
+}()
+
+// isCanonicalKeyIdentifier reports whether canonicalKeyIdentifier would return keyIdentifier unchanged because it is
+// already in the padded standard Base64 encoding (with the unused bits of its last character clear), and isn't also
+// valid hex. It doesn't allocate, so that lookups that miss stay allocation-free.
+func isCanonicalKeyIdentifier(keyIdentifier string) bool {
+	n := len(keyIdentifier)
+	padding := n - len(strings.TrimRight(keyIdentifier, "="))
+	if n == 0 || n%4 != 0 || padding > 2 {
+		return false
+	}
+	isHex := padding == 0
+	for i := range n - padding {
+		c := keyIdentifier[i]
+		v := stdBase64Values[c]
+		if v < 0 || (i == n-padding-1 && v&(1<<(2*padding)-1) != 0) {
+			return false
+		}
+		isHex = isHex && ('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F')
+	}
+	return !isHex
+}
+
+// KEY_IDENTIFIER_BUFFER_SIZE is the size of the buffers that the lookups by raw key identifier encode key identifiers
+// into, which is large enough for key identifiers of up to 64 bytes. Larger ones are still looked up, but allocate.
+const KEY_IDENTIFIER_BUFFER_SIZE = 88
+
+// appendBase64KeyIdentifier appends the padded standard Base64 encoding of a raw key identifier to dst. Indexing a map
+// with string(appendBase64KeyIdentifier(buf[:0], keyIdentifier)), where buf is a local array, doesn't allocate.
+func appendBase64KeyIdentifier(dst, keyIdentifier []byte) []byte {
+	return base64.StdEncoding.AppendEncode(dst, keyIdentifier)
+}
+
+// subjectKeyIdentifierFromSPKI computes a key identifier using method 1 of RFC 5280 section 4.2.1.2: the SHA-1 hash of
+// the value of the subjectPublicKey BIT STRING (excluding the tag, length, and number of unused bits).
+func subjectKeyIdentifierFromSPKI(rawSPKI []byte) ([]byte, error) {
+	var spki struct {
+		Algorithm        pkix.AlgorithmIdentifier
+		SubjectPublicKey asn1.BitString
+	}
+	if rest, err := asn1.Unmarshal(rawSPKI, &spki); err != nil {
+		return nil, err
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("Trailing data after SubjectPublicKeyInfo")
+	}
+	sha1Hash := sha1.Sum(spki.SubjectPublicKey.Bytes)
+	return sha1Hash[:], nil
+}
+
+// HexFingerprintToArray decodes a hex-encoded SHA-256 fingerprint, as written by CCADB, to the form used by the lookup
+// functions. ok is false unless hexFingerprint is exactly 64 hex digits (in either case).
+func HexFingerprintToArray(hexFingerprint string) (sha256Fingerprint [sha256.Size]byte, ok bool) {
+	if len(hexFingerprint) != 2*sha256.Size {
+		return sha256Fingerprint, false
+	} else if _, err := hex.Decode(sha256Fingerprint[:], []byte(hexFingerprint)); err != nil {
+		return [sha256.Size]byte{}, false
+	}
+	return sha256Fingerprint, true
+}
+
+// ParseSHA256Fingerprint parses a SHA-256 fingerprint as printed by CCADB, crt.sh, OpenSSL, or a browser: hex digits in
+// either case, optionally separated by colons or whitespace.
+func ParseSHA256Fingerprint(s string) ([sha256.Size]byte, error) {
+	hexFingerprint := strings.Map(func(r rune) rune {
+		if r == ':' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+	if sha256Fingerprint, ok := HexFingerprintToArray(hexFingerprint); ok {
+		return sha256Fingerprint, nil
+	}
+	return [sha256.Size]byte{}, fmt.Errorf("Invalid SHA-256 fingerprint: %q", s)
+}
+
+// KeyIdentifierToBase64 encodes a key identifier (e.g. the SubjectKeyId or AuthorityKeyId of an x509.Certificate) in
+// the padded standard Base64 encoding used by CCADB and by the lookup functions.
+func KeyIdentifierToBase64(keyIdentifier []byte) string {
+	return base64.StdEncoding.EncodeToString(keyIdentifier)
+}
+
+// SPKIHashOf returns the SHA-256 hash of a certificate's DER-encoded SubjectPublicKeyInfo, which identifies its public
+// key regardless of the certificate's issuer, and which CT uses as the issuer key hash.
+func SPKIHashOf(cert *x509.Certificate) [sha256.Size]byte {
+	return sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+}
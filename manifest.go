@@ -0,0 +1,155 @@
+package ccadb_data
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// MANIFEST_PATH is the dataset's manifest, which lists the SHA-256 checksum of every other data file in the same
+	// format as sha256sum, e.g. "<hex checksum>  data/AllCertificateRecordsSlim.csv".
+	MANIFEST_PATH = "data/MANIFEST"
+	// The manifest's first line may record when the dataset was fetched, e.g. "# Dataset date: 2026-10-17T01:18:38Z", as
+	// a comment line, which sha256sum ignores.
+	MANIFEST_DATASET_DATE_PREFIX = "# Dataset date: "
+)
+
+// The outcome of verifying a data file against the dataset's manifest.
+type manifestResult int
+
+const (
+	manifestVerified manifestResult = iota
+	manifestMismatched
+	manifestUnlisted
+)
+
+// ErrManifestMismatch indicates that a data file's SHA-256 checksum doesn't match the one in the dataset's manifest.
+var ErrManifestMismatch = errors.New("Data file does not match the dataset manifest")
+
+// ManifestCheck is the outcome of verifying the data files that a Store has read against the dataset's manifest, as
+// returned by GetManifestCheck. Files are verified as they are read, so the data loaded on demand by
+// LoadAllCACertificates and LoadRawRecords is only included once it has been loaded.
+type ManifestCheck struct {
+	// Whether the dataset has a manifest. If not, no files are verified.
+	HasManifest bool
+	// The data files that match their checksums in the manifest.
+	Verified []string
+	// The data files that don't match their checksums in the manifest, and so were not loaded.
+	Mismatched []string
+	// The data files that aren't listed in the manifest, which were loaded anyway.
+	Unlisted []string
+}
+
+// OK reports whether the dataset has a manifest, and every data file that has been read is listed in it and matches.
+func (mc *ManifestCheck) OK() bool {
+	return mc.HasManifest && len(mc.Mismatched) == 0 && len(mc.Unlisted) == 0
+}
+
+// FormatManifest formats a manifest from the date when the dataset was fetched, unless it is zero, and the SHA-256
+// checksums (hex-encoded) of the data files, indexed by path.
+func FormatManifest(datasetDate time.Time, checksums map[string]string) []byte {
+	var buf bytes.Buffer
+	if !datasetDate.IsZero() {
+		fmt.Fprintf(&buf, "%s%s\n", MANIFEST_DATASET_DATE_PREFIX, datasetDate.UTC().Format(time.RFC3339))
+	}
+	for _, filePath := range slices.Sorted(maps.Keys(checksums)) {
+		fmt.Fprintf(&buf, "%s  %s\n", checksums[filePath], filePath)
+	}
+	return buf.Bytes()
+}
+
+// GenerateManifest generates the manifest for the dataset in fsys, which uses the dataset layout read by NewStore, and
+// was fetched at datasetDate. Every data file that a Store reads is listed, except the manifest itself.
+func GenerateManifest(fsys fs.FS, datasetDate time.Time) ([]byte, error) {
+	paths, err := archivePaths(fsys)
+	if err != nil {
+		return nil, err
+	}
+	checksums := make(map[string]string)
+	for _, filePath := range paths {
+		if filePath == MANIFEST_PATH {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return nil, err
+		}
+		sha256Hash := sha256.Sum256(data)
+		checksums[filePath] = hex.EncodeToString(sha256Hash[:])
+	}
+	return FormatManifest(datasetDate, checksums), nil
+}
+
+// parseManifest parses a manifest into the SHA-256 checksums of the data files, indexed by path, and the date when the
+// dataset was fetched, which is zero if the manifest doesn't record it.
+func parseManifest(data []byte) (checksums map[string][sha256.Size]byte, datasetDate time.Time, err error) {
+	checksums = make(map[string][sha256.Size]byte)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		if date, ok := strings.CutPrefix(scanner.Text(), MANIFEST_DATASET_DATE_PREFIX); ok {
+			if datasetDate, err = time.Parse(time.RFC3339, date); err != nil {
+				return nil, time.Time{}, fmt.Errorf("%w: %s: Line %d is not a valid dataset date", ErrMalformedDataset, MANIFEST_PATH, lineNumber)
+			}
+			continue
+		} else if scanner.Text() == "" || strings.HasPrefix(scanner.Text(), "#") {
+			continue
+		}
+		checksum, filePath, ok := strings.Cut(scanner.Text(), "  ")
+		sha256Hash, err := hex.DecodeString(checksum)
+		if !ok || err != nil || len(sha256Hash) != sha256.Size || filePath == "" {
+			return nil, time.Time{}, fmt.Errorf("%w: %s: Line %d is not a SHA-256 checksum and a path", ErrMalformedDataset, MANIFEST_PATH, lineNumber)
+		}
+		checksums[filePath] = [sha256.Size]byte(sha256Hash)
+	}
+	return checksums, datasetDate, scanner.Err()
+}
+
+// readManifest reads the dataset's manifest, if it has one, along with the date when the dataset was fetched.
+func (s *Store) readManifest() error {
+	data, err := readSourceFile(s.src, MANIFEST_PATH)
+	if errors.Is(err, fs.ErrNotExist) {
+		logger.Debug("Dataset manifest does not exist", zap.String("file_path", MANIFEST_PATH))
+		return nil
+	} else if err != nil {
+		logger.Info("Dataset manifest could not be read", zap.Error(err), zap.String("file_path", MANIFEST_PATH))
+		return fmt.Errorf("%w: %w", ErrDatasetNotLoaded, err)
+	}
+
+	s.manifestChecksums, s.datasetDate, err = parseManifest(data)
+	return err
+}
+
+// readDataFile reads a data file and, if the dataset has a manifest, verifies the file against it. A file that doesn't
+// match is not returned, so that a partially written or corrupted file isn't silently loaded as an empty or truncated
+// dataset.
+func (s *Store) readDataFile(filePath string) ([]byte, error) {
+	data, err := readSourceFile(s.src, filePath)
+	if err != nil || s.manifestChecksums == nil {
+		return data, err
+	}
+
+	result := manifestVerified
+	if expected, ok := s.manifestChecksums[filePath]; !ok {
+		logger.Warn("Data file is not listed in the dataset manifest", zap.String("file_path", filePath))
+		result = manifestUnlisted
+	} else if sha256.Sum256(data) != expected {
+		logger.Error("Data file does not match the dataset manifest", zap.String("file_path", filePath), zap.String("expected_sha256", hex.EncodeToString(expected[:])))
+		result = manifestMismatched
+		data, err = nil, fmt.Errorf("%w: %s", ErrManifestMismatch, filePath)
+	}
+	s.manifestMu.Lock()
+	s.manifestResults[filePath] = result
+	s.manifestMu.Unlock()
+	return data, err
+}
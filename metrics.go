@@ -0,0 +1,66 @@
+package ccadb_data
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Instrumentation receives statistics about the lookups performed by this package, e.g. so that services can expose
+// "CCADB miss rate" dashboards. Implementations must be safe for concurrent use.
+type Instrumentation interface {
+	// ObserveLookup is called once per lookup, with the name of the lookup function and whether a result was found.
+	ObserveLookup(lookup string, hit bool)
+}
+
+type instrumentationHolder struct {
+	Instrumentation
+}
+
+var instrumentation atomic.Pointer[instrumentationHolder]
+
+func observeLookup(lookup string, hit bool) {
+	if ih := instrumentation.Load(); ih != nil {
+		ih.ObserveLookup(lookup, hit)
+	}
+}
+
+// LookupStatistics is an Instrumentation that counts lookups, hits, and misses for each lookup function.
+type LookupStatistics struct {
+	counters sync.Map // Lookup function name => *lookupCounters.
+}
+
+type lookupCounters struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// LookupCount is a snapshot of the counters for one lookup function.
+type LookupCount struct {
+	Lookups uint64
+	Hits    uint64
+	Misses  uint64
+}
+
+func (ls *LookupStatistics) ObserveLookup(lookup string, hit bool) {
+	lc, ok := ls.counters.Load(lookup)
+	if !ok {
+		lc, _ = ls.counters.LoadOrStore(lookup, &lookupCounters{})
+	}
+	if hit {
+		lc.(*lookupCounters).hits.Add(1)
+	} else {
+		lc.(*lookupCounters).misses.Add(1)
+	}
+}
+
+// Snapshot returns the current counters, indexed by lookup function name.
+func (ls *LookupStatistics) Snapshot() map[string]LookupCount {
+	snapshot := make(map[string]LookupCount)
+	ls.counters.Range(func(key, value any) bool {
+		lc := value.(*lookupCounters)
+		hits, misses := lc.hits.Load(), lc.misses.Load()
+		snapshot[key.(string)] = LookupCount{Lookups: hits + misses, Hits: hits, Misses: misses}
+		return true
+	})
+	return snapshot
+}
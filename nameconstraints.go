@@ -0,0 +1,84 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"strings"
+	"time"
+)
+
+// isRevoked reports whether CCADB considers the CA certificate or one of its parents to be revoked.
+func (cr *CertificateRecord) isRevoked() bool {
+	return cr.RevocationStatus == RevocationStatusRevoked || cr.RevocationStatus == RevocationStatusParentRevoked
+}
+
+// matchesDNSConstraint reports whether dnsName is within the subtree described by an RFC 5280 dNSName constraint.
+// A constraint with a leading "." only matches subdomains.
+func matchesDNSConstraint(dnsName, constraint string) bool {
+	constraint = strings.ToLower(strings.TrimSuffix(constraint, "."))
+	if constraint == "" {
+		return true
+	} else if strings.HasPrefix(constraint, ".") {
+		return strings.HasSuffix(dnsName, constraint)
+	}
+	return dnsName == constraint || strings.HasSuffix(dnsName, "."+constraint)
+}
+
+// permitsDNSName reports whether the certificate's name constraints (if any) permit dnsName.
+func permitsDNSName(cert *x509.Certificate, dnsName string) bool {
+	for _, excluded := range cert.ExcludedDNSDomains {
+		if matchesDNSConstraint(dnsName, excluded) {
+			return false
+		}
+	}
+	if len(cert.PermittedDNSDomains) == 0 {
+		return true
+	}
+	for _, permitted := range cert.PermittedDNSDomains {
+		if matchesDNSConstraint(dnsName, permitted) {
+			return true
+		}
+	}
+	return false
+}
+
+// canIssueForDNSName reports whether the CA certificate is disclosed, unrevoked, unexpired, and TLS-capable, and
+// whether the name constraints in it and its disclosed parents permit dnsName.
+func (s *Store) canIssueForDNSName(sha256Fingerprint [sha256.Size]byte, dnsName string, now time.Time) bool {
+	cr := s.certificateRecord(sha256Fingerprint)
+	if cr == nil || cr.isRevoked() || now.After(cr.ValidTo) {
+		return false
+	} else if ccc := s.caCertCapabilities(sha256Fingerprint); ccc == nil || !ccc.TlsCapable {
+		return false
+	}
+
+	// Name constraints accumulate along the chain, so check every disclosed certificate up to the root.
+	seen := make(map[[sha256.Size]byte]bool)
+	for current := sha256Fingerprint; current != [sha256.Size]byte{} && !seen[current]; {
+		seen[current] = true
+		cert := s.parsedCertificate(current)
+		if cert == nil {
+			// The constraints are unknown, so don't assume that the name is permitted.
+			return false
+		} else if !permitsDNSName(cert, dnsName) {
+			return false
+		}
+		if parent := s.certificateRecord(current); parent != nil {
+			current = parent.ParentSHA256Fingerprint
+		} else {
+			break
+		}
+	}
+
+	return true
+}
+
+// normalizeDNSName lower-cases dnsName and, for a wildcard name, substitutes a representative label so that it is
+// checked against the constraints as a subdomain.
+func normalizeDNSName(dnsName string) string {
+	dnsName = strings.ToLower(strings.TrimSuffix(dnsName, "."))
+	if rest, ok := strings.CutPrefix(dnsName, "*."); ok {
+		return "x." + rest
+	}
+	return dnsName
+}
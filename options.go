@@ -0,0 +1,106 @@
+package ccadb_data
+
+// StoreOption configures how NewStore loads and indexes a dataset.
+type StoreOption func(*storeOptions)
+
+type storeOptions struct {
+	loadPolicy       LoadPolicy
+	compactIndex     bool
+	minimumRecords   int
+	expectedPrograms []string
+	verifiedManifest bool
+}
+
+// LoadPolicy controls what happens when a Store fails to load, e.g. because the dataset is missing, malformed, or
+// implausible.
+type LoadPolicy uint8
+
+const (
+	// LoadPolicyLenient returns a Store that fails to load, containing whatever data could be loaded, along with the
+	// error. If the default Store fails to load, the error is logged and the Lookup functions return it. This is the
+	// default.
+	LoadPolicyLenient LoadPolicy = iota
+	// LoadPolicyStrict never returns a Store that fails to load: the error is returned with a nil Store, and if the
+	// default Store fails to load, GetDefaultStore (and so the first package-level lookup) panics.
+	LoadPolicyStrict
+)
+
+// The options that the default Store is loaded with, and that Refresh loads its replacements with.
+var defaultStoreOptions []StoreOption
+
+// WithLoadPolicy sets what happens when the Store fails to load (see LoadPolicy). Consumers that would rather crash at
+// startup than run with an empty dataset should call SetDefaultStoreOptions(WithLoadPolicy(LoadPolicyStrict)), and then
+// GetDefaultStore during startup, so that it panics there rather than at the first lookup.
+func WithLoadPolicy(policy LoadPolicy) StoreOption {
+	return func(o *storeOptions) {
+		o.loadPolicy = policy
+	}
+}
+
+// WithCompactIndex indexes the CA certificates by SHA-256 fingerprint in sorted slices instead of in a map, once
+// loading has finished. For the current CCADB dataset, the index is about a third smaller than the map and quicker to
+// build than it, but lookups by SHA-256 fingerprint take about twice as long (though still well under a microsecond).
+// It suits memory-constrained consumers.
+func WithCompactIndex() StoreOption {
+	return func(o *storeOptions) {
+		o.compactIndex = true
+	}
+}
+
+// WithMinimumRecords fails the Store's construction, with an error that wraps ErrImplausibleDataset, if the dataset has
+// records for fewer than n distinct CA certificates, so that a truncated snapshot isn't silently used. The current CCADB
+// dataset has records for about 10,000 CA certificates.
+func WithMinimumRecords(n int) StoreOption {
+	return func(o *storeOptions) {
+		o.minimumRecords = n
+	}
+}
+
+// WithExpectedPrograms fails the Store's construction, with an error that wraps ErrImplausibleDataset, if no CA
+// certificate in the dataset is currently included in (or trusted by) each of the root programs, e.g.
+// ROOT_PROGRAM_MOZILLA, so that a snapshot that is missing a root program's statuses isn't silently used.
+func WithExpectedPrograms(rootPrograms ...string) StoreOption {
+	return func(o *storeOptions) {
+		o.expectedPrograms = append(o.expectedPrograms, rootPrograms...)
+	}
+}
+
+// WithVerifiedManifest fails the Store's construction, with an error that wraps ErrImplausibleDataset, unless the
+// dataset has a manifest that lists every data file read while loading it. Files that don't match their checksums in
+// the manifest always fail the Store's construction.
+func WithVerifiedManifest() StoreOption {
+	return func(o *storeOptions) {
+		o.verifiedManifest = true
+	}
+}
+
+// SetDefaultStoreOptions replaces the options that the default Store is loaded with when it is first used, and that
+// Refresh loads its replacements with. Like SetDefaultFS, it must be called before the default Store is first used.
+func SetDefaultStoreOptions(opts ...StoreOption) {
+	defaultStoreOptions = opts
+}
+
+func newStoreOptions(opts []StoreOption) storeOptions {
+	var o storeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// FetchOption configures how FetchReport and FetchReports download the CCADB reports.
+type FetchOption func(*fetchOptions)
+
+type fetchOptions struct {
+	maxReportShrinkage float64
+	reportCacheDir     string
+	ccadbProxyURL      string
+}
+
+func newFetchOptions(opts []FetchOption) fetchOptions {
+	o := fetchOptions{maxReportShrinkage: DEFAULT_MAX_REPORT_SHRINKAGE}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,53 @@
+package ccadb_data
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"slices"
+)
+
+// CAOwnership is the CA Owner, and Subordinate CA Owner if any, of a CA certificate with a key identifier, as returned
+// by GetCAOwnersByKeyIdentifier.
+type CAOwnership struct {
+	CAOwner string
+	// The Subordinate CA Owner, or "" if the CA certificate is operated by the CA Owner itself.
+	SubordinateCAOwner string
+}
+
+// caOwnersOf returns the distinct CA Owner and Subordinate CA Owner pairs of the given CA certificates, ordered by CA
+// Owner and then Subordinate CA Owner.
+func (s *Store) caOwnersOf(sha256Fingerprints [][sha256.Size]byte) []*CAOwnership {
+	var owners []*CAOwnership
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		cr := s.certificateRecord(sha256Fingerprint)
+		if cr == nil {
+			continue
+		}
+		if !slices.ContainsFunc(owners, func(o *CAOwnership) bool {
+			return o.CAOwner == cr.CAOwner && o.SubordinateCAOwner == cr.SubordinateCAOwner
+		}) {
+			owners = append(owners, &CAOwnership{CAOwner: cr.CAOwner, SubordinateCAOwner: cr.SubordinateCAOwner})
+		}
+	}
+	slices.SortFunc(owners, func(a, b *CAOwnership) int {
+		return cmp.Or(cmp.Compare(a.CAOwner, b.CAOwner), cmp.Compare(a.SubordinateCAOwner, b.SubordinateCAOwner))
+	})
+	return owners
+}
+
+// rootOf returns the SHA-256 fingerprint of the root certificate that is reached by following the CA certificate's
+// disclosed parents, and whether one was reached. A root certificate is its own root.
+func (s *Store) rootOf(sha256Fingerprint [sha256.Size]byte) ([sha256.Size]byte, bool) {
+	seen := make(map[[sha256.Size]byte]bool)
+	for current := sha256Fingerprint; current != [sha256.Size]byte{} && !seen[current]; {
+		seen[current] = true
+		cr := s.certificateRecord(current)
+		if cr == nil {
+			break
+		} else if ccc := s.caCertCapabilities(current); ccc != nil && ccc.CertificateRecordType == RecordTypeRoot {
+			return current, true
+		}
+		current = cr.ParentSHA256Fingerprint
+	}
+	return [sha256.Size]byte{}, false
+}
@@ -0,0 +1,47 @@
+package ccadb_data
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// checkPlausibility checks the loaded dataset against the checks configured by o (see WithMinimumRecords,
+// WithExpectedPrograms, and WithVerifiedManifest), and returns an error that wraps ErrImplausibleDataset for the first
+// one that fails.
+func (s *Store) checkPlausibility(o storeOptions) error {
+	err := s.plausibilityError(o)
+	if err != nil {
+		logger.Error("Dataset is implausible", zap.Error(err))
+	}
+	return err
+}
+
+func (s *Store) plausibilityError(o storeOptions) error {
+	if records := len(s.caRecords); records < o.minimumRecords {
+		return fmt.Errorf("%w: Records for %d CA certificates, fewer than the minimum of %d", ErrImplausibleDataset, records, o.minimumRecords)
+	}
+
+	for _, rootProgram := range o.expectedPrograms {
+		trusted := false
+		for _, cr := range s.certificateRecords() {
+			if cr.isTrustedBy(rootProgram) {
+				trusted = true
+				break
+			}
+		}
+		if !trusted {
+			return fmt.Errorf("%w: No CA certificates are included in or trusted by the %q root program", ErrImplausibleDataset, rootProgram)
+		}
+	}
+
+	if o.verifiedManifest {
+		if mc := s.GetManifestCheck(); !mc.HasManifest {
+			return fmt.Errorf("%w: Dataset has no manifest", ErrImplausibleDataset)
+		} else if len(mc.Unlisted) > 0 {
+			return fmt.Errorf("%w: Data files are not listed in the manifest: %s", ErrImplausibleDataset, strings.Join(mc.Unlisted, ", "))
+		}
+	}
+	return nil
+}
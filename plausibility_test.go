@@ -0,0 +1,108 @@
+package ccadb_data
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+// fixtureMapFS returns a copy of a ccadbtest fixture, without the given data files.
+func fixtureMapFS(t testing.TB, name string, without ...string) fstest.MapFS {
+	t.Helper()
+	fsys := os.DirFS("ccadbtest/fixtures/" + name)
+	mapFS := make(fstest.MapFS)
+	if err := fs.WalkDir(fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		for _, w := range without {
+			if filePath == w {
+				return nil
+			}
+		}
+		data, err := fs.ReadFile(fsys, filePath)
+		mapFS[filePath] = &fstest.MapFile{Data: data}
+		return err
+	}); err != nil {
+		t.Fatalf("Fixture %q could not be read: %v", name, err)
+	}
+	return mapFS
+}
+
+// TestOptionalDataFiles checks that a dataset without a root program constraints report or the CT log list loads, with
+// nothing known from the missing file.
+func TestOptionalDataFiles(t *testing.T) {
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	for _, report := range constraintsReports {
+		fsys := fixtureMapFS(t, "v5", report.filePath)
+		s, err := NewStore(fsys, WithMinimumRecords(1))
+		if err != nil {
+			t.Errorf("NewStore() without %s returned %v", report.filePath, err)
+			continue
+		}
+		for _, rsc := range s.GetRootStoreConstraintsBySHA256(isrgRootX1) {
+			if rsc.RootProgram == report.rootProgram {
+				t.Errorf("NewStore() without %s returned %s constraints for ISRG Root X1", report.filePath, report.rootProgram)
+			}
+		}
+	}
+
+	s, err := NewStore(fixtureMapFS(t, "v5", CT_LOG_LIST_PATH), WithMinimumRecords(1))
+	if err != nil {
+		t.Errorf("NewStore() without %s returned %v", CT_LOG_LIST_PATH, err)
+	} else if cl := s.GetCTLogByID(testBase64SHA256(t, TEST_ICARUS_LOG_ID)); cl != nil {
+		t.Errorf("NewStore() without %s returned CT log %+v", CT_LOG_LIST_PATH, cl)
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	s, err := NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1000000))
+	if !errors.Is(err, ErrImplausibleDataset) || s == nil {
+		t.Fatalf("NewStore() returned %v, %v, want a Store and %v", s, err, ErrImplausibleDataset)
+	}
+	// The Lookup functions return the error, and the Get functions use the data that was loaded.
+	if _, lookupErr := s.LookupCertificateRecordBySHA256(isrgRootX1); lookupErr != err {
+		t.Errorf("LookupCertificateRecordBySHA256() returned %v, want %v", lookupErr, err)
+	}
+	if s.GetCertificateRecordBySHA256(isrgRootX1) == nil {
+		t.Error("GetCertificateRecordBySHA256() returned nil")
+	}
+
+	if s, err = NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1000000), WithLoadPolicy(LoadPolicyStrict)); s != nil || !errors.Is(err, ErrImplausibleDataset) {
+		t.Errorf("NewStore(WithLoadPolicy(LoadPolicyStrict)) returned %v, %v, want nil and %v", s, err, ErrImplausibleDataset)
+	}
+}
+
+// TestPlausibilityChecks checks that each plausibility check fails the Store's construction only if the dataset
+// fails it.
+func TestPlausibilityChecks(t *testing.T) {
+	withoutMozilla := editedFixtureMapFS(t, "v5", func(header, record []string) []string {
+		record[slices.Index(header, "Mozilla Status")] = ""
+		return record
+	})
+	for _, tc := range []struct {
+		name        string
+		fsys        fs.FS
+		opts        []StoreOption
+		implausible bool
+	}{
+		{"EnoughRecords", fixtureMapFS(t, "v5"), []StoreOption{WithMinimumRecords(13)}, false},
+		{"TooFewRecords", fixtureMapFS(t, "v5"), []StoreOption{WithMinimumRecords(14)}, true},
+		{"ExpectedPrograms", fixtureMapFS(t, "v5"), []StoreOption{WithMinimumRecords(1), WithExpectedPrograms(ROOT_PROGRAM_MOZILLA, ROOT_PROGRAM_MICROSOFT)}, false},
+		{"MissingProgram", withoutMozilla, []StoreOption{WithMinimumRecords(1), WithExpectedPrograms(ROOT_PROGRAM_MOZILLA)}, true},
+		{"VerifiedManifest", EmbeddedFS(), []StoreOption{WithVerifiedManifest()}, false},
+		{"NoManifest", fixtureMapFS(t, "v5"), []StoreOption{WithMinimumRecords(1), WithVerifiedManifest()}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewStore(tc.fsys, tc.opts...); errors.Is(err, ErrImplausibleDataset) != tc.implausible {
+				t.Errorf("NewStore() returned %v, want implausible %t", err, tc.implausible)
+			} else if !tc.implausible && err != nil {
+				t.Errorf("NewStore() returned %v", err)
+			}
+		})
+	}
+}
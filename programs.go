@@ -0,0 +1,67 @@
+package ccadb_data
+
+import "time"
+
+// Root programs that report a status for each CA certificate in CCADB.
+const (
+	ROOT_PROGRAM_APPLE     = "Apple"
+	ROOT_PROGRAM_CHROME    = "Chrome"
+	ROOT_PROGRAM_MICROSOFT = "Microsoft"
+	ROOT_PROGRAM_MOZILLA   = "Mozilla"
+)
+
+// Every root program that reports a status, in alphabetical order.
+var rootPrograms = []string{ROOT_PROGRAM_APPLE, ROOT_PROGRAM_CHROME, ROOT_PROGRAM_MICROSOFT, ROOT_PROGRAM_MOZILLA}
+
+// Root program statuses. "Included" and "Trusted" are reported for roots and intermediates respectively.
+const (
+	ROOT_PROGRAM_STATUS_INCLUDED         = "Included"
+	ROOT_PROGRAM_STATUS_TRUSTED          = "Trusted"
+	ROOT_PROGRAM_STATUS_NOT_INCLUDED     = "Not Included"
+	ROOT_PROGRAM_STATUS_NOT_YET_INCLUDED = "Not Yet Included"
+	ROOT_PROGRAM_STATUS_NOT_TRUSTED      = "Not Trusted"
+	ROOT_PROGRAM_STATUS_REMOVED          = "Removed"
+	ROOT_PROGRAM_STATUS_BLOCKED          = "Blocked"
+	ROOT_PROGRAM_STATUS_DISABLED         = "Disabled"
+)
+
+// rootProgramStatus returns the status reported by the given root program.
+func (cr *CertificateRecord) rootProgramStatus(rootProgram string) string {
+	switch rootProgram {
+	case ROOT_PROGRAM_APPLE:
+		return cr.AppleStatus
+	case ROOT_PROGRAM_CHROME:
+		return cr.ChromeStatus
+	case ROOT_PROGRAM_MICROSOFT:
+		return cr.MicrosoftStatus
+	case ROOT_PROGRAM_MOZILLA:
+		return cr.MozillaStatus
+	default:
+		return ""
+	}
+}
+
+// isTrustedBy reports whether the CA certificate is currently included in (or trusted by) the given root program.
+func (cr *CertificateRecord) isTrustedBy(rootProgram string) bool {
+	status := cr.rootProgramStatus(rootProgram)
+	return status == ROOT_PROGRAM_STATUS_INCLUDED || status == ROOT_PROGRAM_STATUS_TRUSTED
+}
+
+// wasIncluded reports whether the CA certificate was included in (or trusted by) the given root program at the given
+// date. CCADB only reports each root program's current status, and does not disclose inclusion or removal dates, so
+// known is false for a date before now unless the CA certificate was outside its validity period or has never been
+// included. When known is false, included is the current status.
+func (cr *CertificateRecord) wasIncluded(rootProgram string, date, now time.Time) (included bool, known bool) {
+	if date.Before(cr.ValidFrom) || date.After(cr.ValidTo) {
+		return false, true
+	}
+	switch status := cr.rootProgramStatus(rootProgram); status {
+	case "":
+		return false, false
+	case ROOT_PROGRAM_STATUS_NOT_INCLUDED, ROOT_PROGRAM_STATUS_NOT_YET_INCLUDED:
+		return false, true
+	default:
+		included = status == ROOT_PROGRAM_STATUS_INCLUDED || status == ROOT_PROGRAM_STATUS_TRUSTED
+		return included, !date.Before(now)
+	}
+}
@@ -0,0 +1,43 @@
+package ccadb_data
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWasIncluded(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	validFrom := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	validTo := time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC)
+	past := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	future := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		status       string
+		date         time.Time
+		wantIncluded bool
+		wantKnown    bool
+	}{
+		{ROOT_PROGRAM_STATUS_INCLUDED, now, true, true},
+		{ROOT_PROGRAM_STATUS_INCLUDED, future, true, true},
+		// The root might have been added to the root program after 2020.
+		{ROOT_PROGRAM_STATUS_INCLUDED, past, true, false},
+		{ROOT_PROGRAM_STATUS_INCLUDED, validFrom.AddDate(0, 0, -1), false, true},
+		{ROOT_PROGRAM_STATUS_INCLUDED, validTo.AddDate(0, 0, 1), false, true},
+		{ROOT_PROGRAM_STATUS_TRUSTED, past, true, false},
+		{ROOT_PROGRAM_STATUS_REMOVED, now, false, true},
+		// The root might have been removed after 2020.
+		{ROOT_PROGRAM_STATUS_REMOVED, past, false, false},
+		{ROOT_PROGRAM_STATUS_BLOCKED, past, false, false},
+		{ROOT_PROGRAM_STATUS_NOT_TRUSTED, past, false, false},
+		{ROOT_PROGRAM_STATUS_NOT_TRUSTED, future, false, true},
+		{ROOT_PROGRAM_STATUS_NOT_INCLUDED, past, false, true},
+		{ROOT_PROGRAM_STATUS_NOT_YET_INCLUDED, past, false, true},
+		{"", now, false, false},
+	} {
+		cr := &CertificateRecord{ValidFrom: validFrom, ValidTo: validTo, MozillaStatus: tc.status}
+		if included, known := cr.wasIncluded(ROOT_PROGRAM_MOZILLA, tc.date, now); included != tc.wantIncluded || known != tc.wantKnown {
+			t.Errorf("wasIncluded(%q, %s) = %t, %t, want %t, %t", tc.status, tc.date.Format(time.DateOnly), included, known, tc.wantIncluded, tc.wantKnown)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"time"
+)
+
+// A predicate on a CA certificate's capabilities and CCADB record.
+type predicate func(ccc *CACertCapabilities, cr *CertificateRecord) bool
+
+// Query selects the CA certificates returned by ListFingerprintsWhere. It is built by chaining conditions onto Where,
+// e.g. Where().RecordType(RecordTypeRoot).TLSCapable().NotExpired().CAOwner("Internet Security Research Group"), and a
+// CA certificate must satisfy every condition. Each condition returns a new Query, so a partially built Query can be
+// shared and extended safely.
+type Query struct {
+	predicates []predicate
+}
+
+// Where returns a Query that matches every CA certificate.
+func Where() *Query {
+	return &Query{}
+}
+
+// and returns a copy of the query with an additional condition.
+func (q *Query) and(p predicate) *Query {
+	predicates := make([]predicate, len(q.predicates), len(q.predicates)+1)
+	copy(predicates, q.predicates)
+	return &Query{predicates: append(predicates, p)}
+}
+
+// matches reports whether the CA certificate satisfies every condition of the query.
+func (q *Query) matches(ccc *CACertCapabilities, cr *CertificateRecord) bool {
+	for _, p := range q.predicates {
+		if !p(ccc, cr) {
+			return false
+		}
+	}
+	return true
+}
+
+// RecordType only matches CA certificates of the given record type.
+func (q *Query) RecordType(rt RecordType) *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return ccc.CertificateRecordType == rt })
+}
+
+// TLSCapable only matches CA certificates that are capable of issuing TLS certificates.
+func (q *Query) TLSCapable() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return ccc.TlsCapable })
+}
+
+// TLSEVCapable only matches CA certificates that are capable of issuing TLS EV certificates.
+func (q *Query) TLSEVCapable() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return ccc.TlsEvCapable })
+}
+
+// SMIMECapable only matches CA certificates that are capable of issuing S/MIME certificates.
+func (q *Query) SMIMECapable() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return ccc.SmimeCapable })
+}
+
+// CodeSigningCapable only matches CA certificates that are capable of issuing Code Signing certificates.
+func (q *Query) CodeSigningCapable() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return ccc.CodeSigningCapable })
+}
+
+// HasVMCAudit only matches CA certificates that have a VMC audit.
+func (q *Query) HasVMCAudit() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return ccc.HasVMCAudit })
+}
+
+// ValidAt only matches CA certificates that are valid at the given time.
+func (q *Query) ValidAt(t time.Time) *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool {
+		return !t.Before(cr.ValidFrom) && !t.After(cr.ValidTo)
+	})
+}
+
+// NotExpired only matches CA certificates that haven't expired when the query is evaluated.
+func (q *Query) NotExpired() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return !time.Now().After(cr.ValidTo) })
+}
+
+// NotRevoked only matches CA certificates that CCADB doesn't consider to be revoked (including by a revoked parent).
+func (q *Query) NotRevoked() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return !cr.isRevoked() })
+}
+
+// CAOwner only matches CA certificates whose CA Owner is exactly the given name.
+func (q *Query) CAOwner(caOwner string) *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return cr.CAOwner == caOwner })
+}
+
+// IncludedIn only matches CA certificates that are included in (or trusted by) the given root program.
+func (q *Query) IncludedIn(rootProgram string) *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return cr.isTrustedBy(rootProgram) })
+}
+
+// MissingCRLDisclosure only matches CA certificates for which neither full nor partitioned CRL URLs are disclosed.
+func (q *Query) MissingCRLDisclosure() *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return !cr.HasCRLDisclosure() })
+}
+
+// Filter only matches CA certificates that match a CapabilityFilter.
+func (q *Query) Filter(filter CapabilityFilter) *Query {
+	return q.and(filter.matches)
+}
+
+// Not only matches CA certificates that don't match the given query.
+func (q *Query) Not(other *Query) *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool { return !other.matches(ccc, cr) })
+}
+
+// Or only matches CA certificates that match at least one of the given queries.
+func (q *Query) Or(others ...*Query) *Query {
+	return q.and(func(ccc *CACertCapabilities, cr *CertificateRecord) bool {
+		for _, other := range others {
+			if other.matches(ccc, cr) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// listFingerprintsWhere returns the SHA-256 fingerprints of every CA certificate that matches the query, unsorted.
+func (s *Store) listFingerprintsWhere(q *Query) [][sha256.Size]byte {
+	var sha256Fingerprints [][sha256.Size]byte
+	for sha256Fingerprint, cr := range s.certificateRecords() {
+		if ccc := s.caCertCapabilities(sha256Fingerprint); ccc != nil && q.matches(ccc, cr) {
+			sha256Fingerprints = append(sha256Fingerprints, sha256Fingerprint)
+		}
+	}
+	return sha256Fingerprints
+}
@@ -0,0 +1,104 @@
+package ccadb_data
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WithReportCacheDir makes FetchReport (and so FetchReports, FetchStore, and Refresh) cache each downloaded report in
+// dir, keyed by its URL and ETag. A cached report is reused when CCADB reports that it hasn't changed, including after a
+// restart, and when CCADB is temporarily unavailable. The cache is never required: if dir can't be written, reports are
+// still downloaded and any existing cache entries are still used. Reports aren't cached by default, or if dir is "".
+func WithReportCacheDir(dir string) FetchOption {
+	return func(o *fetchOptions) {
+		o.reportCacheDir = dir
+	}
+}
+
+// The metadata of a cached report, which is written after the report itself.
+type reportCacheEntry struct {
+	URL       string    `json:"url"`
+	ETag      string    `json:"etag"`
+	FetchedAt time.Time `json:"fetched_at"`
+	// The SHA-256 checksum of the report, so that a report that was partly overwritten is never used.
+	SHA256 string `json:"sha256"`
+}
+
+// A report read from the cache.
+type cachedReport struct {
+	reportCacheEntry
+	data []byte
+}
+
+// reportCachePaths returns the paths of the cached report for a URL and of its metadata.
+func reportCachePaths(dir, url string) (dataPath, entryPath string) {
+	key := sha256.Sum256([]byte(url))
+	name := hex.EncodeToString(key[:])
+	return filepath.Join(dir, name+".data"), filepath.Join(dir, name+".json")
+}
+
+// readCachedReport returns the cached report for a URL in dir, or nil if there is none or it is unusable, or dir is "".
+func readCachedReport(dir, url string) *cachedReport {
+	if dir == "" {
+		return nil
+	}
+	dataPath, entryPath := reportCachePaths(dir, url)
+	entryJSON, err := os.ReadFile(entryPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Warn("Report cache entry could not be read", zap.Error(err), zap.String("file_path", entryPath))
+		}
+		return nil
+	}
+	cr := &cachedReport{}
+	if err = json.Unmarshal(entryJSON, &cr.reportCacheEntry); err != nil || cr.URL != url {
+		logger.Warn("Report cache entry is malformed", zap.Error(err), zap.String("file_path", entryPath))
+		return nil
+	}
+	if cr.data, err = os.ReadFile(dataPath); err != nil {
+		logger.Warn("Cached report could not be read", zap.Error(err), zap.String("file_path", dataPath))
+		return nil
+	}
+	if checksum := sha256.Sum256(cr.data); hex.EncodeToString(checksum[:]) != cr.SHA256 {
+		logger.Warn("Cached report does not match its checksum", zap.String("file_path", dataPath))
+		return nil
+	}
+	return cr
+}
+
+// writeCachedReport caches a downloaded report in dir, unless it is "". Failures are logged, since the cache is never
+// required.
+func writeCachedReport(dir, url, etag string, data []byte) {
+	if dir == "" {
+		return
+	}
+	dataPath, entryPath := reportCachePaths(dir, url)
+	checksum := sha256.Sum256(data)
+	entryJSON, err := json.Marshal(&reportCacheEntry{URL: url, ETag: etag, FetchedAt: time.Now().UTC(), SHA256: hex.EncodeToString(checksum[:])})
+	if err == nil {
+		if err = writeFileAtomically(dataPath, data); err == nil {
+			err = writeFileAtomically(entryPath, entryJSON)
+		}
+	}
+	if err != nil {
+		logger.Warn("Report could not be cached", zap.Error(err), zap.String("url", url), zap.String("dir", dir))
+	}
+}
+
+// isCCADBUnavailable reports whether a failed request (with err, or else with the response's status code) indicates
+// that CCADB is temporarily unavailable, rather than that the report doesn't exist or the request was cancelled.
+func isCCADBUnavailable(ctx context.Context, err error, statusCode int) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	return statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
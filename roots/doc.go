@@ -0,0 +1,5 @@
+// Package roots contains the SHA-256 fingerprints of the root certificates that are currently included in each root
+// program, as reported by CCADB, grouped by root program and capability (e.g. MozillaTLS). It is generated from the
+// same data as the ccadb_data package, but does not embed that data, so it is cheap to import into tests and pinning
+// configurations.
+package roots
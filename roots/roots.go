@@ -0,0 +1,1577 @@
+// Code generated by cmd/roots_gen; DO NOT EDIT.
+
+package roots
+
+import "crypto/sha256"
+
+// SHA-256 fingerprints of the root certificates that are included in the Apple root store.
+var Apple = [][sha256.Size]byte{
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x03, 0x76, 0xAB, 0x1D, 0x54, 0xC5, 0xF9, 0x80, 0x3C, 0xE4, 0xB2, 0xE2, 0x01, 0xA0, 0xEE, 0x7E, 0xEF, 0x7B, 0x57, 0xB6, 0x36, 0xE8, 0xA9, 0x3C, 0x9B, 0x8D, 0x48, 0x60, 0xC9, 0x6F, 0x5F, 0xA7}, // AffirmTrust Commercial
+	{0x0A, 0x81, 0xEC, 0x5A, 0x92, 0x97, 0x77, 0xF1, 0x45, 0x90, 0x4A, 0xF3, 0x8D, 0x5D, 0x50, 0x9F, 0x66, 0xB5, 0xE2, 0xC5, 0x8F, 0xCD, 0xB5, 0x31, 0x05, 0x8B, 0x0E, 0x17, 0xF3, 0xF0, 0xB4, 0x1B}, // AffirmTrust Networking
+	{0x70, 0xA7, 0x3F, 0x7F, 0x37, 0x6B, 0x60, 0x07, 0x42, 0x48, 0x90, 0x45, 0x34, 0xB1, 0x14, 0x82, 0xD5, 0xBF, 0x0E, 0x69, 0x8E, 0xCC, 0x49, 0x8D, 0xF5, 0x25, 0x77, 0xEB, 0xF2, 0xE9, 0x3B, 0x9A}, // AffirmTrust Premium
+	{0xBD, 0x71, 0xFD, 0xF6, 0xDA, 0x97, 0xE4, 0xCF, 0x62, 0xD1, 0x64, 0x7A, 0xDD, 0x25, 0x81, 0xB0, 0x7D, 0x79, 0xAD, 0xF8, 0x39, 0x7E, 0xB4, 0xEC, 0xBA, 0x9C, 0x5E, 0x84, 0x88, 0x82, 0x14, 0x23}, // AffirmTrust Premium ECC
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xE3, 0x86, 0x55, 0xF4, 0xB0, 0x19, 0x0C, 0x84, 0xD3, 0xB3, 0x89, 0x3D, 0x84, 0x0A, 0x68, 0x7E, 0x19, 0x0A, 0x25, 0x6D, 0x98, 0x05, 0x2F, 0x15, 0x9E, 0x6D, 0x4A, 0x39, 0xF5, 0x89, 0xA6, 0xEB}, // Atos TrustedRoot Root CA ECC G2 2020
+	{0xB2, 0xFA, 0xE5, 0x3E, 0x14, 0xCC, 0xD7, 0xAB, 0x92, 0x12, 0x06, 0x47, 0x01, 0xAE, 0x27, 0x9C, 0x1D, 0x89, 0x88, 0xFA, 0xCB, 0x77, 0x5F, 0xA8, 0xA0, 0x08, 0x91, 0x4E, 0x66, 0x39, 0x88, 0xA8}, // Atos TrustedRoot Root CA ECC TLS 2021
+	{0x78, 0x83, 0x3A, 0x78, 0x3B, 0xB2, 0x98, 0x6C, 0x25, 0x4B, 0x93, 0x70, 0xD3, 0xC2, 0x0E, 0x5E, 0xBA, 0x8F, 0xA7, 0x84, 0x0C, 0xBF, 0x63, 0xFE, 0x17, 0x29, 0x7A, 0x0B, 0x01, 0x19, 0x68, 0x5E}, // Atos TrustedRoot Root CA RSA G2 2020
+	{0x81, 0xA9, 0x08, 0x8E, 0xA5, 0x9F, 0xB3, 0x64, 0xC5, 0x48, 0xA6, 0xF8, 0x55, 0x59, 0x09, 0x9B, 0x6F, 0x04, 0x05, 0xEF, 0xBF, 0x18, 0xE5, 0x32, 0x4E, 0xC9, 0xF4, 0x57, 0xBA, 0x00, 0x11, 0x2F}, // Atos TrustedRoot Root CA RSA TLS 2021
+	{0x04, 0x04, 0x80, 0x28, 0xBF, 0x1F, 0x28, 0x64, 0xD4, 0x8F, 0x9A, 0xD4, 0xD8, 0x32, 0x94, 0x36, 0x6A, 0x82, 0x88, 0x56, 0x55, 0x3F, 0x3B, 0x14, 0x30, 0x3F, 0x90, 0x14, 0x7F, 0x5D, 0x40, 0xEF}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0x9A, 0x11, 0x40, 0x25, 0x19, 0x7C, 0x5B, 0xB9, 0x5D, 0x94, 0xE6, 0x3D, 0x55, 0xCD, 0x43, 0x79, 0x08, 0x47, 0xB6, 0x46, 0xB2, 0x3C, 0xDF, 0x11, 0xAD, 0xA4, 0xA0, 0x0E, 0xFF, 0x15, 0xFB, 0x48}, // Buypass Class 2 Root CA
+	{0xED, 0xF7, 0xEB, 0xBC, 0xA2, 0x7A, 0x2A, 0x38, 0x4D, 0x38, 0x7B, 0x7D, 0x40, 0x10, 0xC6, 0x66, 0xE2, 0xED, 0xB4, 0x84, 0x3E, 0x4C, 0x29, 0xB4, 0xAE, 0x1D, 0x5B, 0x93, 0x32, 0xE6, 0xB2, 0x4D}, // Buypass Class 3 Root CA
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x5C, 0xC3, 0xD7, 0x8E, 0x4E, 0x1D, 0x5E, 0x45, 0x54, 0x7A, 0x04, 0xE6, 0x87, 0x3E, 0x64, 0xF9, 0x0C, 0xF9, 0x53, 0x6D, 0x1C, 0xCC, 0x2E, 0xF8, 0x00, 0xF3, 0x55, 0xC4, 0xC5, 0xFD, 0x70, 0xFD}, // CFCA EV ROOT
+	{0x0C, 0x2C, 0xD6, 0x3D, 0xF7, 0x80, 0x6F, 0xA3, 0x99, 0xED, 0xE8, 0x09, 0x11, 0x6B, 0x57, 0x5B, 0xF8, 0x79, 0x89, 0xF0, 0x65, 0x18, 0xF9, 0x80, 0x8C, 0x86, 0x05, 0x03, 0x17, 0x8B, 0xAF, 0x66}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xB4, 0x58, 0x5F, 0x22, 0xE4, 0xAC, 0x75, 0x6A, 0x4E, 0x86, 0x12, 0xA1, 0x36, 0x1C, 0x5D, 0x9D, 0x03, 0x1A, 0x93, 0xFD, 0x84, 0xFE, 0xBB, 0x77, 0x8F, 0xA3, 0x06, 0x8B, 0x0F, 0xC4, 0x2D, 0xC2}, // Certainly Root E1
+	{0x77, 0xB8, 0x2C, 0xD8, 0x64, 0x4C, 0x43, 0x05, 0xF7, 0xAC, 0xC5, 0xCB, 0x15, 0x6B, 0x45, 0x67, 0x50, 0x04, 0x03, 0x3D, 0x51, 0xC6, 0x0C, 0x62, 0x02, 0xA8, 0xE0, 0xC3, 0x34, 0x67, 0xD3, 0xA0}, // Certainly Root R1
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD8, 0xE0, 0xFE, 0xBC, 0x1D, 0xB2, 0xE3, 0x8D, 0x00, 0x94, 0x0F, 0x37, 0xD2, 0x7D, 0x41, 0x34, 0x4D, 0x99, 0x3E, 0x73, 0x4B, 0x99, 0xD5, 0x65, 0x6D, 0x97, 0x78, 0xD4, 0xD8, 0x14, 0x36, 0x24}, // Certum CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0xA1, 0xA8, 0x6D, 0x04, 0x12, 0x1E, 0xB8, 0x7F, 0x02, 0x7C, 0x66, 0xF5, 0x33, 0x03, 0xC2, 0x8E, 0x57, 0x39, 0xF9, 0x43, 0xFC, 0x84, 0xB3, 0x8A, 0xD6, 0xAF, 0x00, 0x90, 0x35, 0xDD, 0x94, 0x57}, // D-TRUST Root CA 3 2013
+	{0x49, 0xE7, 0xA4, 0x42, 0xAC, 0xF0, 0xEA, 0x62, 0x87, 0x05, 0x00, 0x54, 0xB5, 0x25, 0x64, 0xB6, 0x50, 0xE4, 0xF4, 0x9E, 0x42, 0xE3, 0x48, 0xD6, 0xAA, 0x38, 0xE0, 0x39, 0xE9, 0x57, 0xB1, 0xC1}, // D-TRUST Root Class 3 CA 2 2009
+	{0xEE, 0xC5, 0x49, 0x6B, 0x98, 0x8C, 0xE9, 0x86, 0x25, 0xB9, 0x34, 0x09, 0x2E, 0xEC, 0x29, 0x08, 0xBE, 0xD0, 0xB0, 0xF3, 0x16, 0xC2, 0xD4, 0x73, 0x0C, 0x84, 0xEA, 0xF1, 0xF3, 0xD3, 0x48, 0x81}, // D-TRUST Root Class 3 CA 2 EV 2009
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0xE8, 0xE8, 0x17, 0x65, 0x36, 0xA6, 0x0C, 0xC2, 0xC4, 0xE1, 0x01, 0x87, 0xC3, 0xBE, 0xFC, 0xA2, 0x0E, 0xF2, 0x63, 0x49, 0x70, 0x18, 0xF5, 0x66, 0xD5, 0xBE, 0xA0, 0xF9, 0x4D, 0x0C, 0x11, 0x1B}, // DigiCert SMIME ECC P384 Root G5
+	{0x90, 0x37, 0x0D, 0x3E, 0xFA, 0x88, 0xBF, 0x58, 0xC3, 0x01, 0x05, 0xBA, 0x25, 0x10, 0x4A, 0x35, 0x84, 0x60, 0xA7, 0xFA, 0x52, 0xDF, 0xC2, 0x01, 0x1D, 0xF2, 0x33, 0xA0, 0xF4, 0x17, 0x91, 0x2A}, // DigiCert SMIME RSA4096 Root G5
+	{0x01, 0x8E, 0x13, 0xF0, 0x77, 0x25, 0x32, 0xCF, 0x80, 0x9B, 0xD1, 0xB1, 0x72, 0x81, 0x86, 0x72, 0x83, 0xFC, 0x48, 0xC6, 0xE1, 0x3B, 0xE9, 0xC6, 0x98, 0x12, 0x85, 0x4A, 0x49, 0x0C, 0x1B, 0x05}, // DigiCert TLS ECC P384 Root G5
+	{0x37, 0x1A, 0x00, 0xDC, 0x05, 0x33, 0xB3, 0x72, 0x1A, 0x7E, 0xEB, 0x40, 0xE8, 0x41, 0x9E, 0x70, 0x79, 0x9D, 0x2B, 0x0A, 0x0F, 0x2C, 0x1D, 0x80, 0x69, 0x31, 0x65, 0xF7, 0xCE, 0xC4, 0xAD, 0x75}, // DigiCert TLS RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x50, 0x43, 0x86, 0xC9, 0xEE, 0x89, 0x32, 0xFE, 0xCC, 0x95, 0xFA, 0xDE, 0x42, 0x7F, 0x69, 0xC3, 0xE2, 0x53, 0x4B, 0x73, 0x10, 0x48, 0x9E, 0x30, 0x0F, 0xEE, 0x44, 0x8E, 0x33, 0xC4, 0x6B, 0x42}, // DigiCert Verified Mark Root CA
+	{0x73, 0xC1, 0x76, 0x43, 0x4F, 0x1B, 0xC6, 0xD5, 0xAD, 0xF4, 0x5B, 0x0E, 0x76, 0xE7, 0x27, 0x28, 0x7C, 0x8D, 0xE5, 0x76, 0x16, 0xC1, 0xE6, 0xE6, 0x14, 0x1A, 0x2B, 0x2C, 0xBC, 0x7D, 0x8E, 0x4C}, // Entrust Root Certification Authority
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0x78, 0x31, 0xD9, 0x5A, 0x47, 0xD4, 0x25, 0x08, 0xCD, 0x5C, 0x9E, 0x62, 0x64, 0xF9, 0x09, 0x6B, 0xAC, 0x19, 0xF0, 0x4E, 0xB9, 0xB7, 0xC8, 0xBD, 0xD3, 0x5F, 0xFF, 0xC7, 0x1C, 0x18, 0x96, 0x17}, // Entrust Verified Mark Root Certification Authority - VMCR1
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0x2A, 0x57, 0x54, 0x71, 0xE3, 0x13, 0x40, 0xBC, 0x21, 0x58, 0x1C, 0xBD, 0x2C, 0xF1, 0x3E, 0x15, 0x84, 0x63, 0x20, 0x3E, 0xCE, 0x94, 0xBC, 0xF9, 0xD3, 0xCC, 0x19, 0x6B, 0xF0, 0x9A, 0x54, 0x72}, // GTS Root R1
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0xC4, 0x5D, 0x7B, 0xB0, 0x8E, 0x6D, 0x67, 0xE6, 0x2E, 0x42, 0x35, 0x11, 0x0B, 0x56, 0x4E, 0x5F, 0x78, 0xFD, 0x92, 0xEF, 0x05, 0x8C, 0x84, 0x0A, 0xEA, 0x4E, 0x64, 0x55, 0xD7, 0x58, 0x5C, 0x60}, // GTS Root R2
+	{0x15, 0xD5, 0xB8, 0x77, 0x46, 0x19, 0xEA, 0x7D, 0x54, 0xCE, 0x1C, 0xA6, 0xD0, 0xB0, 0xC4, 0x03, 0xE0, 0x37, 0xA9, 0x17, 0xF1, 0x31, 0xE8, 0xA0, 0x4E, 0x1E, 0x6B, 0x7A, 0x71, 0xBA, 0xBC, 0xE5}, // GTS Root R3
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x71, 0xCC, 0xA5, 0x39, 0x1F, 0x9E, 0x79, 0x4B, 0x04, 0x80, 0x25, 0x30, 0xB3, 0x63, 0xE1, 0x21, 0xDA, 0x8A, 0x30, 0x43, 0xBB, 0x26, 0x66, 0x2F, 0xEA, 0x4D, 0xCA, 0x7F, 0xC9, 0x51, 0xA4, 0xBD}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xBE, 0xC9, 0x49, 0x11, 0xC2, 0x95, 0x56, 0x76, 0xDB, 0x6C, 0x0A, 0x55, 0x09, 0x86, 0xD7, 0x6E, 0x3B, 0xA0, 0x05, 0x66, 0x7C, 0x44, 0x2C, 0x97, 0x62, 0xB4, 0xFB, 0xB7, 0x73, 0xDE, 0x22, 0x8C}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0xCB, 0xB9, 0xC4, 0x4D, 0x84, 0xB8, 0x04, 0x3E, 0x10, 0x50, 0xEA, 0x31, 0xA6, 0x9F, 0x51, 0x49, 0x55, 0xD7, 0xBF, 0xD2, 0xE2, 0xC6, 0xB4, 0x93, 0x01, 0x01, 0x9A, 0xD6, 0x1D, 0x9F, 0x50, 0x58}, // GlobalSign Root E46
+	{0x4F, 0xA3, 0x12, 0x6D, 0x8D, 0x3A, 0x11, 0xD1, 0xC4, 0x85, 0x5A, 0x4F, 0x80, 0x7C, 0xBA, 0xD6, 0xCF, 0x91, 0x9D, 0x3A, 0x5A, 0x88, 0xB0, 0x3B, 0xEA, 0x2C, 0x63, 0x72, 0xD9, 0x3C, 0x40, 0xC9}, // GlobalSign Root R46
+	{0x5C, 0xBF, 0x6F, 0xB8, 0x1F, 0xD4, 0x17, 0xEA, 0x41, 0x28, 0xCD, 0x6F, 0x81, 0x72, 0xA3, 0xC9, 0x40, 0x20, 0x94, 0xF7, 0x4A, 0xB2, 0xED, 0x3A, 0x06, 0xB4, 0x40, 0x5D, 0x04, 0xF3, 0x0B, 0x19}, // GlobalSign Secure Mail Root E45
+	{0x31, 0x9A, 0xF0, 0xA7, 0x72, 0x9E, 0x6F, 0x89, 0x26, 0x9C, 0x13, 0x1E, 0xA6, 0xA3, 0xA1, 0x6F, 0xCD, 0x86, 0x38, 0x9F, 0xDC, 0xAB, 0x3C, 0x47, 0xA4, 0xA6, 0x75, 0xC1, 0x61, 0xA3, 0xF9, 0x74}, // GlobalSign Secure Mail Root R45
+	{0xCD, 0x12, 0x2C, 0xB8, 0x77, 0xC6, 0x92, 0x8B, 0x90, 0x17, 0xB0, 0xF0, 0xB8, 0x0D, 0xBD, 0x50, 0x81, 0x96, 0x30, 0x0B, 0xBD, 0x03, 0xCD, 0x73, 0x56, 0xC3, 0xBE, 0xEF, 0x52, 0x4E, 0x7E, 0x0B}, // GlobalSign Verified Mark Root R42
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x8D, 0xD4, 0xB5, 0x37, 0x3C, 0xB0, 0xDE, 0x36, 0x76, 0x9C, 0x12, 0x33, 0x92, 0x80, 0xD8, 0x27, 0x46, 0xB3, 0xAA, 0x6C, 0xD4, 0x26, 0xE7, 0x97, 0xA3, 0x1B, 0xAB, 0xE4, 0x27, 0x9C, 0xF0, 0x0B}, // HARICA Client ECC Root CA 2021
+	{0x1B, 0xE7, 0xAB, 0xE3, 0x06, 0x86, 0xB1, 0x63, 0x48, 0xAF, 0xD1, 0xC6, 0x1B, 0x68, 0x66, 0xA0, 0xEA, 0x7F, 0x48, 0x21, 0xE6, 0x7D, 0x5E, 0x8A, 0xF9, 0x37, 0xCF, 0x80, 0x11, 0xBC, 0x75, 0x0D}, // HARICA Client RSA Root CA 2021
+	{0x3F, 0x99, 0xCC, 0x47, 0x4A, 0xCF, 0xCE, 0x4D, 0xFE, 0xD5, 0x87, 0x94, 0x66, 0x5E, 0x47, 0x8D, 0x15, 0x47, 0x73, 0x9F, 0x2E, 0x78, 0x0F, 0x1B, 0xB4, 0xCA, 0x9B, 0x13, 0x30, 0x97, 0xD4, 0x01}, // HARICA TLS ECC Root CA 2021
+	{0xD9, 0x5D, 0x0E, 0x8E, 0xDA, 0x79, 0x52, 0x5B, 0xF9, 0xBE, 0xB1, 0x1B, 0x14, 0xD2, 0x10, 0x0D, 0x32, 0x94, 0x98, 0x5F, 0x0C, 0x62, 0xD9, 0xFA, 0xBD, 0x9C, 0xD9, 0x99, 0xEC, 0xCB, 0x7B, 0x1D}, // HARICA TLS RSA Root CA 2021
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0xF0, 0x15, 0xCE, 0x3C, 0xC2, 0x39, 0xBF, 0xEF, 0x06, 0x4B, 0xE9, 0xF1, 0xD2, 0xC4, 0x17, 0xE1, 0xA0, 0x26, 0x4A, 0x0A, 0x94, 0xBE, 0x1F, 0x0C, 0x8D, 0x12, 0x18, 0x64, 0xEB, 0x69, 0x49, 0xCC}, // HiPKI Root CA - G1
+	{0x5A, 0x2F, 0xC0, 0x3F, 0x0C, 0x83, 0xB0, 0x90, 0xBB, 0xFA, 0x40, 0x60, 0x4B, 0x09, 0x88, 0x44, 0x6C, 0x76, 0x36, 0x18, 0x3D, 0xF9, 0x84, 0x6E, 0x17, 0x10, 0x1A, 0x44, 0x7F, 0xB8, 0xEF, 0xD6}, // Hongkong Post Root CA 3
+	{0x96, 0xBC, 0xEC, 0x06, 0x26, 0x49, 0x76, 0xF3, 0x74, 0x60, 0x77, 0x9A, 0xCF, 0x28, 0xC5, 0xA7, 0xCF, 0xE8, 0xA3, 0xC0, 0xAA, 0xE1, 0x1A, 0x8F, 0xFC, 0xEE, 0x05, 0xC0, 0xBD, 0xDF, 0x08, 0xC6}, // ISRG Root X1
+	{0x69, 0x72, 0x9B, 0x8E, 0x15, 0xA8, 0x6E, 0xFC, 0x17, 0x7A, 0x57, 0xAF, 0xB7, 0x17, 0x1D, 0xFC, 0x64, 0xAD, 0xD2, 0x8C, 0x2F, 0xCA, 0x8C, 0xF1, 0x50, 0x7E, 0x34, 0x45, 0x3C, 0xCB, 0x14, 0x70}, // ISRG Root X2
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x25, 0x30, 0xCC, 0x8E, 0x98, 0x32, 0x15, 0x02, 0xBA, 0xD9, 0x6F, 0x9B, 0x1F, 0xBA, 0x1B, 0x09, 0x9E, 0x2D, 0x29, 0x9E, 0x0F, 0x45, 0x48, 0xBB, 0x91, 0x4F, 0x36, 0x3B, 0xC0, 0xD4, 0x53, 0x1F}, // Izenpe.com
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x35, 0x8D, 0xF3, 0x9D, 0x76, 0x4A, 0xF9, 0xE1, 0xB7, 0x66, 0xE9, 0xC9, 0x72, 0xDF, 0x35, 0x2E, 0xE1, 0x5C, 0xFA, 0xC2, 0x27, 0xAF, 0x6A, 0xD1, 0xD7, 0x0E, 0x8E, 0x4A, 0x6E, 0xDC, 0xBA, 0x02}, // Microsoft ECC Root Certificate Authority 2017
+	{0xC7, 0x41, 0xF7, 0x0F, 0x4B, 0x2A, 0x8D, 0x88, 0xBF, 0x2E, 0x71, 0xC1, 0x41, 0x22, 0xEF, 0x53, 0xEF, 0x10, 0xEB, 0xA0, 0xCF, 0xA5, 0xE6, 0x4C, 0xFA, 0x20, 0xF4, 0x18, 0x85, 0x30, 0x73, 0xE0}, // Microsoft RSA Root Certificate Authority 2017
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x41, 0xC9, 0x23, 0x86, 0x6A, 0xB4, 0xCA, 0xD6, 0xB7, 0xAD, 0x57, 0x80, 0x81, 0x58, 0x2E, 0x02, 0x07, 0x97, 0xA6, 0xCB, 0xDF, 0x4F, 0xFF, 0x78, 0xCE, 0x83, 0x96, 0xB3, 0x89, 0x37, 0xD7, 0xF5}, // OISTE WISeKey Global Root GA CA
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x36, 0x29, 0xE7, 0x18, 0x8E, 0x00, 0xA7, 0xCB, 0x32, 0x32, 0xC4, 0x42, 0x6B, 0xC8, 0x49, 0x12, 0xF1, 0x21, 0x8B, 0x1A, 0x9A, 0xE6, 0x76, 0xC0, 0xB0, 0xAB, 0xE1, 0xDB, 0xFE, 0x21, 0x82, 0xB5}, // SECOM SMIME RSA Root CA 2024
+	{0x6A, 0xB2, 0xAB, 0x75, 0xF5, 0x1C, 0xB4, 0xF4, 0xF0, 0x15, 0x62, 0x03, 0xFB, 0xF6, 0xF6, 0x46, 0x23, 0x2F, 0x51, 0x4B, 0xE0, 0x59, 0xF6, 0x28, 0x33, 0x30, 0x8B, 0x82, 0xB4, 0xD7, 0x2D, 0xB1}, // SECOM TLS ECC Root CA 2024
+	{0x14, 0x35, 0xF2, 0x25, 0xC5, 0xD2, 0x52, 0xD7, 0xA2, 0x19, 0x48, 0xCC, 0x3C, 0xE6, 0x2A, 0xEC, 0xFA, 0x88, 0x00, 0x1E, 0x3D, 0xD7, 0x2D, 0x1C, 0xC3, 0x55, 0x51, 0x00, 0xEB, 0x37, 0x2F, 0x93}, // SECOM TLS RSA Root CA 2024
+	{0xAD, 0x7D, 0xD5, 0x8D, 0x03, 0xAE, 0xDB, 0x22, 0xA3, 0x0B, 0x50, 0x84, 0x39, 0x49, 0x20, 0xCE, 0x12, 0x23, 0x0C, 0x2D, 0x80, 0x17, 0xAD, 0x9B, 0x81, 0xAB, 0x04, 0x07, 0x9B, 0xDD, 0x02, 0x6B}, // SSL.com Client ECC Root CA 2022
+	{0x1D, 0x4C, 0xA4, 0xA2, 0xAB, 0x21, 0xD0, 0x09, 0x36, 0x59, 0x80, 0x4F, 0xC0, 0xEB, 0x21, 0x75, 0xA6, 0x17, 0x27, 0x9B, 0x56, 0xA2, 0x47, 0x52, 0x45, 0xC9, 0x51, 0x7A, 0xFE, 0xB5, 0x91, 0x53}, // SSL.com Client RSA Root CA 2022
+	{0x22, 0xA2, 0xC1, 0xF7, 0xBD, 0xED, 0x70, 0x4C, 0xC1, 0xE7, 0x01, 0xB5, 0xF4, 0x08, 0xC3, 0x10, 0x88, 0x0F, 0xE9, 0x56, 0xB5, 0xDE, 0x2A, 0x4A, 0x44, 0xF9, 0x9C, 0x87, 0x3A, 0x25, 0xA7, 0xC8}, // SSL.com EV Root Certification Authority ECC
+	{0x2E, 0x7B, 0xF1, 0x6C, 0xC2, 0x24, 0x85, 0xA7, 0xBB, 0xE2, 0xAA, 0x86, 0x96, 0x75, 0x07, 0x61, 0xB0, 0xAE, 0x39, 0xBE, 0x3B, 0x2F, 0xE9, 0xD0, 0xCC, 0x6D, 0x4E, 0xF7, 0x34, 0x91, 0x42, 0x5C}, // SSL.com EV Root Certification Authority RSA R2
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xC3, 0x2F, 0xFD, 0x9F, 0x46, 0xF9, 0x36, 0xD1, 0x6C, 0x36, 0x73, 0x99, 0x09, 0x59, 0x43, 0x4B, 0x9A, 0xD6, 0x0A, 0xAF, 0xBB, 0x9E, 0x7C, 0xF3, 0x36, 0x54, 0xF1, 0x44, 0xCC, 0x1B, 0xA1, 0x43}, // SSL.com TLS ECC Root CA 2022
+	{0x8F, 0xAF, 0x7D, 0x2E, 0x2C, 0xB4, 0x70, 0x9B, 0xB8, 0xE0, 0xB3, 0x36, 0x66, 0xBF, 0x75, 0xA5, 0xDD, 0x45, 0xB5, 0xDE, 0x48, 0x0F, 0x8E, 0xA8, 0xD4, 0xBF, 0xE6, 0xBE, 0xBC, 0x17, 0xF2, 0xED}, // SSL.com TLS RSA Root CA 2022
+	{0x22, 0xD9, 0x59, 0x92, 0x34, 0xD6, 0x0F, 0x1D, 0x4B, 0xC7, 0xC7, 0xE9, 0x6F, 0x43, 0xFA, 0x55, 0x5B, 0x07, 0x30, 0x1F, 0xD4, 0x75, 0x17, 0x50, 0x89, 0xDA, 0xFB, 0x8C, 0x25, 0xE4, 0x77, 0xB3}, // Sectigo Public Email Protection Root E46
+	{0xD5, 0x91, 0x7A, 0x77, 0x91, 0xEB, 0x7C, 0xF2, 0x0A, 0x2E, 0x57, 0xEB, 0x98, 0x28, 0x4A, 0x67, 0xB2, 0x8A, 0x57, 0xE8, 0x91, 0x82, 0xDA, 0x53, 0xD5, 0x46, 0x67, 0x8C, 0x9F, 0xDE, 0x2B, 0x4F}, // Sectigo Public Email Protection Root R46
+	{0xC9, 0x0F, 0x26, 0xF0, 0xFB, 0x1B, 0x40, 0x18, 0xB2, 0x22, 0x27, 0x51, 0x9B, 0x5C, 0xA2, 0xB5, 0x3E, 0x2C, 0xA5, 0xB3, 0xBE, 0x5C, 0xF1, 0x8E, 0xFE, 0x1B, 0xEF, 0x47, 0x38, 0x0C, 0x53, 0x83}, // Sectigo Public Server Authentication Root E46
+	{0x7B, 0xB6, 0x47, 0xA6, 0x2A, 0xEE, 0xAC, 0x88, 0xBF, 0x25, 0x7A, 0xA5, 0x22, 0xD0, 0x1F, 0xFE, 0xA3, 0x95, 0xE0, 0xAB, 0x45, 0xC7, 0x3F, 0x93, 0xF6, 0x56, 0x54, 0xEC, 0x38, 0xF2, 0x5A, 0x06}, // Sectigo Public Server Authentication Root R46
+	{0xE4, 0x4D, 0xDB, 0x79, 0x52, 0x26, 0x1F, 0x15, 0x00, 0x5C, 0xD6, 0x0C, 0x1D, 0x0C, 0x38, 0xC1, 0x8C, 0xBF, 0xD1, 0x7C, 0x27, 0x3A, 0x31, 0xF8, 0xED, 0x4C, 0x8F, 0x53, 0xE2, 0x68, 0x5F, 0x32}, // Sectigo Public Time Stamping Root E46
+	{0x49, 0x41, 0xB0, 0x01, 0xB8, 0xA9, 0x7E, 0x96, 0x1B, 0x78, 0x17, 0xC9, 0xD9, 0xE9, 0x60, 0xEC, 0x4B, 0x05, 0x6B, 0xFC, 0x91, 0x5A, 0x8C, 0x1A, 0xAB, 0xF6, 0xEF, 0x6B, 0x3A, 0xC0, 0x46, 0xA5}, // Sectigo Public Time Stamping Root R46
+	{0xF1, 0xC1, 0xB5, 0x0A, 0xE5, 0xA2, 0x0D, 0xD8, 0x03, 0x0E, 0xC9, 0xF6, 0xBC, 0x24, 0x82, 0x3D, 0xD3, 0x67, 0xB5, 0x25, 0x57, 0x59, 0xB4, 0xE7, 0x1B, 0x61, 0xFC, 0xE9, 0xF7, 0x37, 0x5D, 0x73}, // SecureTrust CA
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x2C, 0xE1, 0xCB, 0x0B, 0xF9, 0xD2, 0xF9, 0xE1, 0x02, 0x99, 0x3F, 0xBE, 0x21, 0x51, 0x52, 0xC3, 0xB2, 0xDD, 0x0C, 0xAB, 0xDE, 0x1C, 0x68, 0xE5, 0x31, 0x9B, 0x83, 0x91, 0x54, 0xDB, 0xB7, 0xF5}, // Starfield Root Certificate Authority - G2
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0xBE, 0x6C, 0x4D, 0xA2, 0xBB, 0xB9, 0xBA, 0x59, 0xB6, 0xF3, 0x93, 0x97, 0x68, 0x37, 0x42, 0x46, 0xC3, 0xC0, 0x05, 0x99, 0x3F, 0xA9, 0x8F, 0x02, 0x0D, 0x1D, 0xED, 0xBE, 0xD4, 0x8A, 0x81, 0xD5}, // SwissSign Silver CA - G2
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0xFD, 0x73, 0xDA, 0xD3, 0x1C, 0x64, 0x4F, 0xF1, 0xB4, 0x3B, 0xEF, 0x0C, 0xCD, 0xDA, 0x96, 0x71, 0x0B, 0x9C, 0xD9, 0x87, 0x5E, 0xCA, 0x7E, 0x31, 0x70, 0x7A, 0xF3, 0xE9, 0x6D, 0x52, 0x2B, 0xBD}, // T-TeleSec GlobalRoot Class 3
+	{0x46, 0xED, 0xC3, 0x68, 0x90, 0x46, 0xD5, 0x3A, 0x45, 0x3F, 0xB3, 0x10, 0x4A, 0xB8, 0x0D, 0xCA, 0xEC, 0x65, 0x8B, 0x26, 0x60, 0xEA, 0x16, 0x29, 0xDD, 0x7E, 0x86, 0x79, 0x90, 0x64, 0x87, 0x16}, // TUBITAK Kamu SM SSL Kok Sertifikasi - Surum 1
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xDD, 0x69, 0x36, 0xFE, 0x21, 0xF8, 0xF0, 0x77, 0xC1, 0x23, 0xA1, 0xA5, 0x21, 0xC1, 0x22, 0x24, 0xF7, 0x22, 0x55, 0xB7, 0x3E, 0x03, 0xA7, 0x26, 0x06, 0x93, 0xE8, 0xA2, 0x4B, 0x0F, 0xA3, 0x89}, // TeliaSonera Root CA v1
+	{0x97, 0x55, 0x20, 0x15, 0xF5, 0xDD, 0xFC, 0x3C, 0x87, 0x88, 0xC0, 0x06, 0x94, 0x45, 0x55, 0x40, 0x88, 0x94, 0x45, 0x00, 0x84, 0xF1, 0x00, 0x86, 0x70, 0x86, 0xBC, 0x1A, 0x2B, 0xB5, 0x8D, 0xC8}, // Trustwave Global Certification Authority
+	{0x94, 0x5B, 0xBC, 0x82, 0x5E, 0xA5, 0x54, 0xF4, 0x89, 0xD1, 0xFD, 0x51, 0xA7, 0x3D, 0xDF, 0x2E, 0xA6, 0x24, 0xAC, 0x70, 0x19, 0xA0, 0x52, 0x05, 0x22, 0x5C, 0x22, 0xA7, 0x8C, 0xCF, 0xA8, 0xB4}, // Trustwave Global ECC P256 Certification Authority
+	{0x55, 0x90, 0x38, 0x59, 0xC8, 0xC0, 0xC3, 0xEB, 0xB8, 0x75, 0x9E, 0xCE, 0x4E, 0x25, 0x57, 0x22, 0x5F, 0xF5, 0x75, 0x8B, 0xBD, 0x38, 0xEB, 0xD4, 0x82, 0x76, 0x60, 0x1E, 0x1B, 0xD5, 0x80, 0x97}, // Trustwave Global ECC P384 Certification Authority
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0xCE, 0xCD, 0xDC, 0x90, 0x50, 0x99, 0xD8, 0xDA, 0xDF, 0xC5, 0xB1, 0xD2, 0x09, 0xB7, 0x37, 0xCB, 0xE2, 0xC1, 0x8C, 0xFB, 0x2C, 0x10, 0xC0, 0xFF, 0x0B, 0xCF, 0x0D, 0x32, 0x86, 0xFC, 0x1A, 0xA2}, // XRamp Global Certification Authority
+	{0xEA, 0xA9, 0x62, 0xC4, 0xFA, 0x4A, 0x6B, 0xAF, 0xEB, 0xE4, 0x15, 0x19, 0x6D, 0x35, 0x1C, 0xCD, 0x88, 0x8D, 0x4F, 0x53, 0xF3, 0xFA, 0x8A, 0xE6, 0xD7, 0xC4, 0x66, 0xA9, 0x4E, 0x60, 0x42, 0xBB}, // certSIGN ROOT CA
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Apple root store and capable of issuing TLS certificates.
+var AppleTLS = [][sha256.Size]byte{
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x03, 0x76, 0xAB, 0x1D, 0x54, 0xC5, 0xF9, 0x80, 0x3C, 0xE4, 0xB2, 0xE2, 0x01, 0xA0, 0xEE, 0x7E, 0xEF, 0x7B, 0x57, 0xB6, 0x36, 0xE8, 0xA9, 0x3C, 0x9B, 0x8D, 0x48, 0x60, 0xC9, 0x6F, 0x5F, 0xA7}, // AffirmTrust Commercial
+	{0x0A, 0x81, 0xEC, 0x5A, 0x92, 0x97, 0x77, 0xF1, 0x45, 0x90, 0x4A, 0xF3, 0x8D, 0x5D, 0x50, 0x9F, 0x66, 0xB5, 0xE2, 0xC5, 0x8F, 0xCD, 0xB5, 0x31, 0x05, 0x8B, 0x0E, 0x17, 0xF3, 0xF0, 0xB4, 0x1B}, // AffirmTrust Networking
+	{0x70, 0xA7, 0x3F, 0x7F, 0x37, 0x6B, 0x60, 0x07, 0x42, 0x48, 0x90, 0x45, 0x34, 0xB1, 0x14, 0x82, 0xD5, 0xBF, 0x0E, 0x69, 0x8E, 0xCC, 0x49, 0x8D, 0xF5, 0x25, 0x77, 0xEB, 0xF2, 0xE9, 0x3B, 0x9A}, // AffirmTrust Premium
+	{0xBD, 0x71, 0xFD, 0xF6, 0xDA, 0x97, 0xE4, 0xCF, 0x62, 0xD1, 0x64, 0x7A, 0xDD, 0x25, 0x81, 0xB0, 0x7D, 0x79, 0xAD, 0xF8, 0x39, 0x7E, 0xB4, 0xEC, 0xBA, 0x9C, 0x5E, 0x84, 0x88, 0x82, 0x14, 0x23}, // AffirmTrust Premium ECC
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xB2, 0xFA, 0xE5, 0x3E, 0x14, 0xCC, 0xD7, 0xAB, 0x92, 0x12, 0x06, 0x47, 0x01, 0xAE, 0x27, 0x9C, 0x1D, 0x89, 0x88, 0xFA, 0xCB, 0x77, 0x5F, 0xA8, 0xA0, 0x08, 0x91, 0x4E, 0x66, 0x39, 0x88, 0xA8}, // Atos TrustedRoot Root CA ECC TLS 2021
+	{0x81, 0xA9, 0x08, 0x8E, 0xA5, 0x9F, 0xB3, 0x64, 0xC5, 0x48, 0xA6, 0xF8, 0x55, 0x59, 0x09, 0x9B, 0x6F, 0x04, 0x05, 0xEF, 0xBF, 0x18, 0xE5, 0x32, 0x4E, 0xC9, 0xF4, 0x57, 0xBA, 0x00, 0x11, 0x2F}, // Atos TrustedRoot Root CA RSA TLS 2021
+	{0x04, 0x04, 0x80, 0x28, 0xBF, 0x1F, 0x28, 0x64, 0xD4, 0x8F, 0x9A, 0xD4, 0xD8, 0x32, 0x94, 0x36, 0x6A, 0x82, 0x88, 0x56, 0x55, 0x3F, 0x3B, 0x14, 0x30, 0x3F, 0x90, 0x14, 0x7F, 0x5D, 0x40, 0xEF}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0x9A, 0x11, 0x40, 0x25, 0x19, 0x7C, 0x5B, 0xB9, 0x5D, 0x94, 0xE6, 0x3D, 0x55, 0xCD, 0x43, 0x79, 0x08, 0x47, 0xB6, 0x46, 0xB2, 0x3C, 0xDF, 0x11, 0xAD, 0xA4, 0xA0, 0x0E, 0xFF, 0x15, 0xFB, 0x48}, // Buypass Class 2 Root CA
+	{0xED, 0xF7, 0xEB, 0xBC, 0xA2, 0x7A, 0x2A, 0x38, 0x4D, 0x38, 0x7B, 0x7D, 0x40, 0x10, 0xC6, 0x66, 0xE2, 0xED, 0xB4, 0x84, 0x3E, 0x4C, 0x29, 0xB4, 0xAE, 0x1D, 0x5B, 0x93, 0x32, 0xE6, 0xB2, 0x4D}, // Buypass Class 3 Root CA
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x5C, 0xC3, 0xD7, 0x8E, 0x4E, 0x1D, 0x5E, 0x45, 0x54, 0x7A, 0x04, 0xE6, 0x87, 0x3E, 0x64, 0xF9, 0x0C, 0xF9, 0x53, 0x6D, 0x1C, 0xCC, 0x2E, 0xF8, 0x00, 0xF3, 0x55, 0xC4, 0xC5, 0xFD, 0x70, 0xFD}, // CFCA EV ROOT
+	{0x0C, 0x2C, 0xD6, 0x3D, 0xF7, 0x80, 0x6F, 0xA3, 0x99, 0xED, 0xE8, 0x09, 0x11, 0x6B, 0x57, 0x5B, 0xF8, 0x79, 0x89, 0xF0, 0x65, 0x18, 0xF9, 0x80, 0x8C, 0x86, 0x05, 0x03, 0x17, 0x8B, 0xAF, 0x66}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xB4, 0x58, 0x5F, 0x22, 0xE4, 0xAC, 0x75, 0x6A, 0x4E, 0x86, 0x12, 0xA1, 0x36, 0x1C, 0x5D, 0x9D, 0x03, 0x1A, 0x93, 0xFD, 0x84, 0xFE, 0xBB, 0x77, 0x8F, 0xA3, 0x06, 0x8B, 0x0F, 0xC4, 0x2D, 0xC2}, // Certainly Root E1
+	{0x77, 0xB8, 0x2C, 0xD8, 0x64, 0x4C, 0x43, 0x05, 0xF7, 0xAC, 0xC5, 0xCB, 0x15, 0x6B, 0x45, 0x67, 0x50, 0x04, 0x03, 0x3D, 0x51, 0xC6, 0x0C, 0x62, 0x02, 0xA8, 0xE0, 0xC3, 0x34, 0x67, 0xD3, 0xA0}, // Certainly Root R1
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0x49, 0xE7, 0xA4, 0x42, 0xAC, 0xF0, 0xEA, 0x62, 0x87, 0x05, 0x00, 0x54, 0xB5, 0x25, 0x64, 0xB6, 0x50, 0xE4, 0xF4, 0x9E, 0x42, 0xE3, 0x48, 0xD6, 0xAA, 0x38, 0xE0, 0x39, 0xE9, 0x57, 0xB1, 0xC1}, // D-TRUST Root Class 3 CA 2 2009
+	{0xEE, 0xC5, 0x49, 0x6B, 0x98, 0x8C, 0xE9, 0x86, 0x25, 0xB9, 0x34, 0x09, 0x2E, 0xEC, 0x29, 0x08, 0xBE, 0xD0, 0xB0, 0xF3, 0x16, 0xC2, 0xD4, 0x73, 0x0C, 0x84, 0xEA, 0xF1, 0xF3, 0xD3, 0x48, 0x81}, // D-TRUST Root Class 3 CA 2 EV 2009
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0x01, 0x8E, 0x13, 0xF0, 0x77, 0x25, 0x32, 0xCF, 0x80, 0x9B, 0xD1, 0xB1, 0x72, 0x81, 0x86, 0x72, 0x83, 0xFC, 0x48, 0xC6, 0xE1, 0x3B, 0xE9, 0xC6, 0x98, 0x12, 0x85, 0x4A, 0x49, 0x0C, 0x1B, 0x05}, // DigiCert TLS ECC P384 Root G5
+	{0x37, 0x1A, 0x00, 0xDC, 0x05, 0x33, 0xB3, 0x72, 0x1A, 0x7E, 0xEB, 0x40, 0xE8, 0x41, 0x9E, 0x70, 0x79, 0x9D, 0x2B, 0x0A, 0x0F, 0x2C, 0x1D, 0x80, 0x69, 0x31, 0x65, 0xF7, 0xCE, 0xC4, 0xAD, 0x75}, // DigiCert TLS RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x73, 0xC1, 0x76, 0x43, 0x4F, 0x1B, 0xC6, 0xD5, 0xAD, 0xF4, 0x5B, 0x0E, 0x76, 0xE7, 0x27, 0x28, 0x7C, 0x8D, 0xE5, 0x76, 0x16, 0xC1, 0xE6, 0xE6, 0x14, 0x1A, 0x2B, 0x2C, 0xBC, 0x7D, 0x8E, 0x4C}, // Entrust Root Certification Authority
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0x2A, 0x57, 0x54, 0x71, 0xE3, 0x13, 0x40, 0xBC, 0x21, 0x58, 0x1C, 0xBD, 0x2C, 0xF1, 0x3E, 0x15, 0x84, 0x63, 0x20, 0x3E, 0xCE, 0x94, 0xBC, 0xF9, 0xD3, 0xCC, 0x19, 0x6B, 0xF0, 0x9A, 0x54, 0x72}, // GTS Root R1
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0xC4, 0x5D, 0x7B, 0xB0, 0x8E, 0x6D, 0x67, 0xE6, 0x2E, 0x42, 0x35, 0x11, 0x0B, 0x56, 0x4E, 0x5F, 0x78, 0xFD, 0x92, 0xEF, 0x05, 0x8C, 0x84, 0x0A, 0xEA, 0x4E, 0x64, 0x55, 0xD7, 0x58, 0x5C, 0x60}, // GTS Root R2
+	{0x15, 0xD5, 0xB8, 0x77, 0x46, 0x19, 0xEA, 0x7D, 0x54, 0xCE, 0x1C, 0xA6, 0xD0, 0xB0, 0xC4, 0x03, 0xE0, 0x37, 0xA9, 0x17, 0xF1, 0x31, 0xE8, 0xA0, 0x4E, 0x1E, 0x6B, 0x7A, 0x71, 0xBA, 0xBC, 0xE5}, // GTS Root R3
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x71, 0xCC, 0xA5, 0x39, 0x1F, 0x9E, 0x79, 0x4B, 0x04, 0x80, 0x25, 0x30, 0xB3, 0x63, 0xE1, 0x21, 0xDA, 0x8A, 0x30, 0x43, 0xBB, 0x26, 0x66, 0x2F, 0xEA, 0x4D, 0xCA, 0x7F, 0xC9, 0x51, 0xA4, 0xBD}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xBE, 0xC9, 0x49, 0x11, 0xC2, 0x95, 0x56, 0x76, 0xDB, 0x6C, 0x0A, 0x55, 0x09, 0x86, 0xD7, 0x6E, 0x3B, 0xA0, 0x05, 0x66, 0x7C, 0x44, 0x2C, 0x97, 0x62, 0xB4, 0xFB, 0xB7, 0x73, 0xDE, 0x22, 0x8C}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0xCB, 0xB9, 0xC4, 0x4D, 0x84, 0xB8, 0x04, 0x3E, 0x10, 0x50, 0xEA, 0x31, 0xA6, 0x9F, 0x51, 0x49, 0x55, 0xD7, 0xBF, 0xD2, 0xE2, 0xC6, 0xB4, 0x93, 0x01, 0x01, 0x9A, 0xD6, 0x1D, 0x9F, 0x50, 0x58}, // GlobalSign Root E46
+	{0x4F, 0xA3, 0x12, 0x6D, 0x8D, 0x3A, 0x11, 0xD1, 0xC4, 0x85, 0x5A, 0x4F, 0x80, 0x7C, 0xBA, 0xD6, 0xCF, 0x91, 0x9D, 0x3A, 0x5A, 0x88, 0xB0, 0x3B, 0xEA, 0x2C, 0x63, 0x72, 0xD9, 0x3C, 0x40, 0xC9}, // GlobalSign Root R46
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x3F, 0x99, 0xCC, 0x47, 0x4A, 0xCF, 0xCE, 0x4D, 0xFE, 0xD5, 0x87, 0x94, 0x66, 0x5E, 0x47, 0x8D, 0x15, 0x47, 0x73, 0x9F, 0x2E, 0x78, 0x0F, 0x1B, 0xB4, 0xCA, 0x9B, 0x13, 0x30, 0x97, 0xD4, 0x01}, // HARICA TLS ECC Root CA 2021
+	{0xD9, 0x5D, 0x0E, 0x8E, 0xDA, 0x79, 0x52, 0x5B, 0xF9, 0xBE, 0xB1, 0x1B, 0x14, 0xD2, 0x10, 0x0D, 0x32, 0x94, 0x98, 0x5F, 0x0C, 0x62, 0xD9, 0xFA, 0xBD, 0x9C, 0xD9, 0x99, 0xEC, 0xCB, 0x7B, 0x1D}, // HARICA TLS RSA Root CA 2021
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0xF0, 0x15, 0xCE, 0x3C, 0xC2, 0x39, 0xBF, 0xEF, 0x06, 0x4B, 0xE9, 0xF1, 0xD2, 0xC4, 0x17, 0xE1, 0xA0, 0x26, 0x4A, 0x0A, 0x94, 0xBE, 0x1F, 0x0C, 0x8D, 0x12, 0x18, 0x64, 0xEB, 0x69, 0x49, 0xCC}, // HiPKI Root CA - G1
+	{0x5A, 0x2F, 0xC0, 0x3F, 0x0C, 0x83, 0xB0, 0x90, 0xBB, 0xFA, 0x40, 0x60, 0x4B, 0x09, 0x88, 0x44, 0x6C, 0x76, 0x36, 0x18, 0x3D, 0xF9, 0x84, 0x6E, 0x17, 0x10, 0x1A, 0x44, 0x7F, 0xB8, 0xEF, 0xD6}, // Hongkong Post Root CA 3
+	{0x96, 0xBC, 0xEC, 0x06, 0x26, 0x49, 0x76, 0xF3, 0x74, 0x60, 0x77, 0x9A, 0xCF, 0x28, 0xC5, 0xA7, 0xCF, 0xE8, 0xA3, 0xC0, 0xAA, 0xE1, 0x1A, 0x8F, 0xFC, 0xEE, 0x05, 0xC0, 0xBD, 0xDF, 0x08, 0xC6}, // ISRG Root X1
+	{0x69, 0x72, 0x9B, 0x8E, 0x15, 0xA8, 0x6E, 0xFC, 0x17, 0x7A, 0x57, 0xAF, 0xB7, 0x17, 0x1D, 0xFC, 0x64, 0xAD, 0xD2, 0x8C, 0x2F, 0xCA, 0x8C, 0xF1, 0x50, 0x7E, 0x34, 0x45, 0x3C, 0xCB, 0x14, 0x70}, // ISRG Root X2
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x25, 0x30, 0xCC, 0x8E, 0x98, 0x32, 0x15, 0x02, 0xBA, 0xD9, 0x6F, 0x9B, 0x1F, 0xBA, 0x1B, 0x09, 0x9E, 0x2D, 0x29, 0x9E, 0x0F, 0x45, 0x48, 0xBB, 0x91, 0x4F, 0x36, 0x3B, 0xC0, 0xD4, 0x53, 0x1F}, // Izenpe.com
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x35, 0x8D, 0xF3, 0x9D, 0x76, 0x4A, 0xF9, 0xE1, 0xB7, 0x66, 0xE9, 0xC9, 0x72, 0xDF, 0x35, 0x2E, 0xE1, 0x5C, 0xFA, 0xC2, 0x27, 0xAF, 0x6A, 0xD1, 0xD7, 0x0E, 0x8E, 0x4A, 0x6E, 0xDC, 0xBA, 0x02}, // Microsoft ECC Root Certificate Authority 2017
+	{0xC7, 0x41, 0xF7, 0x0F, 0x4B, 0x2A, 0x8D, 0x88, 0xBF, 0x2E, 0x71, 0xC1, 0x41, 0x22, 0xEF, 0x53, 0xEF, 0x10, 0xEB, 0xA0, 0xCF, 0xA5, 0xE6, 0x4C, 0xFA, 0x20, 0xF4, 0x18, 0x85, 0x30, 0x73, 0xE0}, // Microsoft RSA Root Certificate Authority 2017
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x6A, 0xB2, 0xAB, 0x75, 0xF5, 0x1C, 0xB4, 0xF4, 0xF0, 0x15, 0x62, 0x03, 0xFB, 0xF6, 0xF6, 0x46, 0x23, 0x2F, 0x51, 0x4B, 0xE0, 0x59, 0xF6, 0x28, 0x33, 0x30, 0x8B, 0x82, 0xB4, 0xD7, 0x2D, 0xB1}, // SECOM TLS ECC Root CA 2024
+	{0x14, 0x35, 0xF2, 0x25, 0xC5, 0xD2, 0x52, 0xD7, 0xA2, 0x19, 0x48, 0xCC, 0x3C, 0xE6, 0x2A, 0xEC, 0xFA, 0x88, 0x00, 0x1E, 0x3D, 0xD7, 0x2D, 0x1C, 0xC3, 0x55, 0x51, 0x00, 0xEB, 0x37, 0x2F, 0x93}, // SECOM TLS RSA Root CA 2024
+	{0x22, 0xA2, 0xC1, 0xF7, 0xBD, 0xED, 0x70, 0x4C, 0xC1, 0xE7, 0x01, 0xB5, 0xF4, 0x08, 0xC3, 0x10, 0x88, 0x0F, 0xE9, 0x56, 0xB5, 0xDE, 0x2A, 0x4A, 0x44, 0xF9, 0x9C, 0x87, 0x3A, 0x25, 0xA7, 0xC8}, // SSL.com EV Root Certification Authority ECC
+	{0x2E, 0x7B, 0xF1, 0x6C, 0xC2, 0x24, 0x85, 0xA7, 0xBB, 0xE2, 0xAA, 0x86, 0x96, 0x75, 0x07, 0x61, 0xB0, 0xAE, 0x39, 0xBE, 0x3B, 0x2F, 0xE9, 0xD0, 0xCC, 0x6D, 0x4E, 0xF7, 0x34, 0x91, 0x42, 0x5C}, // SSL.com EV Root Certification Authority RSA R2
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xC3, 0x2F, 0xFD, 0x9F, 0x46, 0xF9, 0x36, 0xD1, 0x6C, 0x36, 0x73, 0x99, 0x09, 0x59, 0x43, 0x4B, 0x9A, 0xD6, 0x0A, 0xAF, 0xBB, 0x9E, 0x7C, 0xF3, 0x36, 0x54, 0xF1, 0x44, 0xCC, 0x1B, 0xA1, 0x43}, // SSL.com TLS ECC Root CA 2022
+	{0x8F, 0xAF, 0x7D, 0x2E, 0x2C, 0xB4, 0x70, 0x9B, 0xB8, 0xE0, 0xB3, 0x36, 0x66, 0xBF, 0x75, 0xA5, 0xDD, 0x45, 0xB5, 0xDE, 0x48, 0x0F, 0x8E, 0xA8, 0xD4, 0xBF, 0xE6, 0xBE, 0xBC, 0x17, 0xF2, 0xED}, // SSL.com TLS RSA Root CA 2022
+	{0xC9, 0x0F, 0x26, 0xF0, 0xFB, 0x1B, 0x40, 0x18, 0xB2, 0x22, 0x27, 0x51, 0x9B, 0x5C, 0xA2, 0xB5, 0x3E, 0x2C, 0xA5, 0xB3, 0xBE, 0x5C, 0xF1, 0x8E, 0xFE, 0x1B, 0xEF, 0x47, 0x38, 0x0C, 0x53, 0x83}, // Sectigo Public Server Authentication Root E46
+	{0x7B, 0xB6, 0x47, 0xA6, 0x2A, 0xEE, 0xAC, 0x88, 0xBF, 0x25, 0x7A, 0xA5, 0x22, 0xD0, 0x1F, 0xFE, 0xA3, 0x95, 0xE0, 0xAB, 0x45, 0xC7, 0x3F, 0x93, 0xF6, 0x56, 0x54, 0xEC, 0x38, 0xF2, 0x5A, 0x06}, // Sectigo Public Server Authentication Root R46
+	{0xF1, 0xC1, 0xB5, 0x0A, 0xE5, 0xA2, 0x0D, 0xD8, 0x03, 0x0E, 0xC9, 0xF6, 0xBC, 0x24, 0x82, 0x3D, 0xD3, 0x67, 0xB5, 0x25, 0x57, 0x59, 0xB4, 0xE7, 0x1B, 0x61, 0xFC, 0xE9, 0xF7, 0x37, 0x5D, 0x73}, // SecureTrust CA
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x2C, 0xE1, 0xCB, 0x0B, 0xF9, 0xD2, 0xF9, 0xE1, 0x02, 0x99, 0x3F, 0xBE, 0x21, 0x51, 0x52, 0xC3, 0xB2, 0xDD, 0x0C, 0xAB, 0xDE, 0x1C, 0x68, 0xE5, 0x31, 0x9B, 0x83, 0x91, 0x54, 0xDB, 0xB7, 0xF5}, // Starfield Root Certificate Authority - G2
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0xBE, 0x6C, 0x4D, 0xA2, 0xBB, 0xB9, 0xBA, 0x59, 0xB6, 0xF3, 0x93, 0x97, 0x68, 0x37, 0x42, 0x46, 0xC3, 0xC0, 0x05, 0x99, 0x3F, 0xA9, 0x8F, 0x02, 0x0D, 0x1D, 0xED, 0xBE, 0xD4, 0x8A, 0x81, 0xD5}, // SwissSign Silver CA - G2
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0xFD, 0x73, 0xDA, 0xD3, 0x1C, 0x64, 0x4F, 0xF1, 0xB4, 0x3B, 0xEF, 0x0C, 0xCD, 0xDA, 0x96, 0x71, 0x0B, 0x9C, 0xD9, 0x87, 0x5E, 0xCA, 0x7E, 0x31, 0x70, 0x7A, 0xF3, 0xE9, 0x6D, 0x52, 0x2B, 0xBD}, // T-TeleSec GlobalRoot Class 3
+	{0x46, 0xED, 0xC3, 0x68, 0x90, 0x46, 0xD5, 0x3A, 0x45, 0x3F, 0xB3, 0x10, 0x4A, 0xB8, 0x0D, 0xCA, 0xEC, 0x65, 0x8B, 0x26, 0x60, 0xEA, 0x16, 0x29, 0xDD, 0x7E, 0x86, 0x79, 0x90, 0x64, 0x87, 0x16}, // TUBITAK Kamu SM SSL Kok Sertifikasi - Surum 1
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xDD, 0x69, 0x36, 0xFE, 0x21, 0xF8, 0xF0, 0x77, 0xC1, 0x23, 0xA1, 0xA5, 0x21, 0xC1, 0x22, 0x24, 0xF7, 0x22, 0x55, 0xB7, 0x3E, 0x03, 0xA7, 0x26, 0x06, 0x93, 0xE8, 0xA2, 0x4B, 0x0F, 0xA3, 0x89}, // TeliaSonera Root CA v1
+	{0x97, 0x55, 0x20, 0x15, 0xF5, 0xDD, 0xFC, 0x3C, 0x87, 0x88, 0xC0, 0x06, 0x94, 0x45, 0x55, 0x40, 0x88, 0x94, 0x45, 0x00, 0x84, 0xF1, 0x00, 0x86, 0x70, 0x86, 0xBC, 0x1A, 0x2B, 0xB5, 0x8D, 0xC8}, // Trustwave Global Certification Authority
+	{0x94, 0x5B, 0xBC, 0x82, 0x5E, 0xA5, 0x54, 0xF4, 0x89, 0xD1, 0xFD, 0x51, 0xA7, 0x3D, 0xDF, 0x2E, 0xA6, 0x24, 0xAC, 0x70, 0x19, 0xA0, 0x52, 0x05, 0x22, 0x5C, 0x22, 0xA7, 0x8C, 0xCF, 0xA8, 0xB4}, // Trustwave Global ECC P256 Certification Authority
+	{0x55, 0x90, 0x38, 0x59, 0xC8, 0xC0, 0xC3, 0xEB, 0xB8, 0x75, 0x9E, 0xCE, 0x4E, 0x25, 0x57, 0x22, 0x5F, 0xF5, 0x75, 0x8B, 0xBD, 0x38, 0xEB, 0xD4, 0x82, 0x76, 0x60, 0x1E, 0x1B, 0xD5, 0x80, 0x97}, // Trustwave Global ECC P384 Certification Authority
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0xCE, 0xCD, 0xDC, 0x90, 0x50, 0x99, 0xD8, 0xDA, 0xDF, 0xC5, 0xB1, 0xD2, 0x09, 0xB7, 0x37, 0xCB, 0xE2, 0xC1, 0x8C, 0xFB, 0x2C, 0x10, 0xC0, 0xFF, 0x0B, 0xCF, 0x0D, 0x32, 0x86, 0xFC, 0x1A, 0xA2}, // XRamp Global Certification Authority
+	{0xEA, 0xA9, 0x62, 0xC4, 0xFA, 0x4A, 0x6B, 0xAF, 0xEB, 0xE4, 0x15, 0x19, 0x6D, 0x35, 0x1C, 0xCD, 0x88, 0x8D, 0x4F, 0x53, 0xF3, 0xFA, 0x8A, 0xE6, 0xD7, 0xC4, 0x66, 0xA9, 0x4E, 0x60, 0x42, 0xBB}, // certSIGN ROOT CA
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Apple root store and capable of issuing S/MIME certificates.
+var AppleSMIME = [][sha256.Size]byte{
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x03, 0x76, 0xAB, 0x1D, 0x54, 0xC5, 0xF9, 0x80, 0x3C, 0xE4, 0xB2, 0xE2, 0x01, 0xA0, 0xEE, 0x7E, 0xEF, 0x7B, 0x57, 0xB6, 0x36, 0xE8, 0xA9, 0x3C, 0x9B, 0x8D, 0x48, 0x60, 0xC9, 0x6F, 0x5F, 0xA7}, // AffirmTrust Commercial
+	{0x0A, 0x81, 0xEC, 0x5A, 0x92, 0x97, 0x77, 0xF1, 0x45, 0x90, 0x4A, 0xF3, 0x8D, 0x5D, 0x50, 0x9F, 0x66, 0xB5, 0xE2, 0xC5, 0x8F, 0xCD, 0xB5, 0x31, 0x05, 0x8B, 0x0E, 0x17, 0xF3, 0xF0, 0xB4, 0x1B}, // AffirmTrust Networking
+	{0x70, 0xA7, 0x3F, 0x7F, 0x37, 0x6B, 0x60, 0x07, 0x42, 0x48, 0x90, 0x45, 0x34, 0xB1, 0x14, 0x82, 0xD5, 0xBF, 0x0E, 0x69, 0x8E, 0xCC, 0x49, 0x8D, 0xF5, 0x25, 0x77, 0xEB, 0xF2, 0xE9, 0x3B, 0x9A}, // AffirmTrust Premium
+	{0xBD, 0x71, 0xFD, 0xF6, 0xDA, 0x97, 0xE4, 0xCF, 0x62, 0xD1, 0x64, 0x7A, 0xDD, 0x25, 0x81, 0xB0, 0x7D, 0x79, 0xAD, 0xF8, 0x39, 0x7E, 0xB4, 0xEC, 0xBA, 0x9C, 0x5E, 0x84, 0x88, 0x82, 0x14, 0x23}, // AffirmTrust Premium ECC
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xE3, 0x86, 0x55, 0xF4, 0xB0, 0x19, 0x0C, 0x84, 0xD3, 0xB3, 0x89, 0x3D, 0x84, 0x0A, 0x68, 0x7E, 0x19, 0x0A, 0x25, 0x6D, 0x98, 0x05, 0x2F, 0x15, 0x9E, 0x6D, 0x4A, 0x39, 0xF5, 0x89, 0xA6, 0xEB}, // Atos TrustedRoot Root CA ECC G2 2020
+	{0x78, 0x83, 0x3A, 0x78, 0x3B, 0xB2, 0x98, 0x6C, 0x25, 0x4B, 0x93, 0x70, 0xD3, 0xC2, 0x0E, 0x5E, 0xBA, 0x8F, 0xA7, 0x84, 0x0C, 0xBF, 0x63, 0xFE, 0x17, 0x29, 0x7A, 0x0B, 0x01, 0x19, 0x68, 0x5E}, // Atos TrustedRoot Root CA RSA G2 2020
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x0C, 0x2C, 0xD6, 0x3D, 0xF7, 0x80, 0x6F, 0xA3, 0x99, 0xED, 0xE8, 0x09, 0x11, 0x6B, 0x57, 0x5B, 0xF8, 0x79, 0x89, 0xF0, 0x65, 0x18, 0xF9, 0x80, 0x8C, 0x86, 0x05, 0x03, 0x17, 0x8B, 0xAF, 0x66}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD8, 0xE0, 0xFE, 0xBC, 0x1D, 0xB2, 0xE3, 0x8D, 0x00, 0x94, 0x0F, 0x37, 0xD2, 0x7D, 0x41, 0x34, 0x4D, 0x99, 0x3E, 0x73, 0x4B, 0x99, 0xD5, 0x65, 0x6D, 0x97, 0x78, 0xD4, 0xD8, 0x14, 0x36, 0x24}, // Certum CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0xA1, 0xA8, 0x6D, 0x04, 0x12, 0x1E, 0xB8, 0x7F, 0x02, 0x7C, 0x66, 0xF5, 0x33, 0x03, 0xC2, 0x8E, 0x57, 0x39, 0xF9, 0x43, 0xFC, 0x84, 0xB3, 0x8A, 0xD6, 0xAF, 0x00, 0x90, 0x35, 0xDD, 0x94, 0x57}, // D-TRUST Root CA 3 2013
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0xE8, 0xE8, 0x17, 0x65, 0x36, 0xA6, 0x0C, 0xC2, 0xC4, 0xE1, 0x01, 0x87, 0xC3, 0xBE, 0xFC, 0xA2, 0x0E, 0xF2, 0x63, 0x49, 0x70, 0x18, 0xF5, 0x66, 0xD5, 0xBE, 0xA0, 0xF9, 0x4D, 0x0C, 0x11, 0x1B}, // DigiCert SMIME ECC P384 Root G5
+	{0x90, 0x37, 0x0D, 0x3E, 0xFA, 0x88, 0xBF, 0x58, 0xC3, 0x01, 0x05, 0xBA, 0x25, 0x10, 0x4A, 0x35, 0x84, 0x60, 0xA7, 0xFA, 0x52, 0xDF, 0xC2, 0x01, 0x1D, 0xF2, 0x33, 0xA0, 0xF4, 0x17, 0x91, 0x2A}, // DigiCert SMIME RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x73, 0xC1, 0x76, 0x43, 0x4F, 0x1B, 0xC6, 0xD5, 0xAD, 0xF4, 0x5B, 0x0E, 0x76, 0xE7, 0x27, 0x28, 0x7C, 0x8D, 0xE5, 0x76, 0x16, 0xC1, 0xE6, 0xE6, 0x14, 0x1A, 0x2B, 0x2C, 0xBC, 0x7D, 0x8E, 0x4C}, // Entrust Root Certification Authority
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0x2A, 0x57, 0x54, 0x71, 0xE3, 0x13, 0x40, 0xBC, 0x21, 0x58, 0x1C, 0xBD, 0x2C, 0xF1, 0x3E, 0x15, 0x84, 0x63, 0x20, 0x3E, 0xCE, 0x94, 0xBC, 0xF9, 0xD3, 0xCC, 0x19, 0x6B, 0xF0, 0x9A, 0x54, 0x72}, // GTS Root R1
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0xC4, 0x5D, 0x7B, 0xB0, 0x8E, 0x6D, 0x67, 0xE6, 0x2E, 0x42, 0x35, 0x11, 0x0B, 0x56, 0x4E, 0x5F, 0x78, 0xFD, 0x92, 0xEF, 0x05, 0x8C, 0x84, 0x0A, 0xEA, 0x4E, 0x64, 0x55, 0xD7, 0x58, 0x5C, 0x60}, // GTS Root R2
+	{0x15, 0xD5, 0xB8, 0x77, 0x46, 0x19, 0xEA, 0x7D, 0x54, 0xCE, 0x1C, 0xA6, 0xD0, 0xB0, 0xC4, 0x03, 0xE0, 0x37, 0xA9, 0x17, 0xF1, 0x31, 0xE8, 0xA0, 0x4E, 0x1E, 0x6B, 0x7A, 0x71, 0xBA, 0xBC, 0xE5}, // GTS Root R3
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x71, 0xCC, 0xA5, 0x39, 0x1F, 0x9E, 0x79, 0x4B, 0x04, 0x80, 0x25, 0x30, 0xB3, 0x63, 0xE1, 0x21, 0xDA, 0x8A, 0x30, 0x43, 0xBB, 0x26, 0x66, 0x2F, 0xEA, 0x4D, 0xCA, 0x7F, 0xC9, 0x51, 0xA4, 0xBD}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xBE, 0xC9, 0x49, 0x11, 0xC2, 0x95, 0x56, 0x76, 0xDB, 0x6C, 0x0A, 0x55, 0x09, 0x86, 0xD7, 0x6E, 0x3B, 0xA0, 0x05, 0x66, 0x7C, 0x44, 0x2C, 0x97, 0x62, 0xB4, 0xFB, 0xB7, 0x73, 0xDE, 0x22, 0x8C}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0x5C, 0xBF, 0x6F, 0xB8, 0x1F, 0xD4, 0x17, 0xEA, 0x41, 0x28, 0xCD, 0x6F, 0x81, 0x72, 0xA3, 0xC9, 0x40, 0x20, 0x94, 0xF7, 0x4A, 0xB2, 0xED, 0x3A, 0x06, 0xB4, 0x40, 0x5D, 0x04, 0xF3, 0x0B, 0x19}, // GlobalSign Secure Mail Root E45
+	{0x31, 0x9A, 0xF0, 0xA7, 0x72, 0x9E, 0x6F, 0x89, 0x26, 0x9C, 0x13, 0x1E, 0xA6, 0xA3, 0xA1, 0x6F, 0xCD, 0x86, 0x38, 0x9F, 0xDC, 0xAB, 0x3C, 0x47, 0xA4, 0xA6, 0x75, 0xC1, 0x61, 0xA3, 0xF9, 0x74}, // GlobalSign Secure Mail Root R45
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x8D, 0xD4, 0xB5, 0x37, 0x3C, 0xB0, 0xDE, 0x36, 0x76, 0x9C, 0x12, 0x33, 0x92, 0x80, 0xD8, 0x27, 0x46, 0xB3, 0xAA, 0x6C, 0xD4, 0x26, 0xE7, 0x97, 0xA3, 0x1B, 0xAB, 0xE4, 0x27, 0x9C, 0xF0, 0x0B}, // HARICA Client ECC Root CA 2021
+	{0x1B, 0xE7, 0xAB, 0xE3, 0x06, 0x86, 0xB1, 0x63, 0x48, 0xAF, 0xD1, 0xC6, 0x1B, 0x68, 0x66, 0xA0, 0xEA, 0x7F, 0x48, 0x21, 0xE6, 0x7D, 0x5E, 0x8A, 0xF9, 0x37, 0xCF, 0x80, 0x11, 0xBC, 0x75, 0x0D}, // HARICA Client RSA Root CA 2021
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x41, 0xC9, 0x23, 0x86, 0x6A, 0xB4, 0xCA, 0xD6, 0xB7, 0xAD, 0x57, 0x80, 0x81, 0x58, 0x2E, 0x02, 0x07, 0x97, 0xA6, 0xCB, 0xDF, 0x4F, 0xFF, 0x78, 0xCE, 0x83, 0x96, 0xB3, 0x89, 0x37, 0xD7, 0xF5}, // OISTE WISeKey Global Root GA CA
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x36, 0x29, 0xE7, 0x18, 0x8E, 0x00, 0xA7, 0xCB, 0x32, 0x32, 0xC4, 0x42, 0x6B, 0xC8, 0x49, 0x12, 0xF1, 0x21, 0x8B, 0x1A, 0x9A, 0xE6, 0x76, 0xC0, 0xB0, 0xAB, 0xE1, 0xDB, 0xFE, 0x21, 0x82, 0xB5}, // SECOM SMIME RSA Root CA 2024
+	{0xAD, 0x7D, 0xD5, 0x8D, 0x03, 0xAE, 0xDB, 0x22, 0xA3, 0x0B, 0x50, 0x84, 0x39, 0x49, 0x20, 0xCE, 0x12, 0x23, 0x0C, 0x2D, 0x80, 0x17, 0xAD, 0x9B, 0x81, 0xAB, 0x04, 0x07, 0x9B, 0xDD, 0x02, 0x6B}, // SSL.com Client ECC Root CA 2022
+	{0x1D, 0x4C, 0xA4, 0xA2, 0xAB, 0x21, 0xD0, 0x09, 0x36, 0x59, 0x80, 0x4F, 0xC0, 0xEB, 0x21, 0x75, 0xA6, 0x17, 0x27, 0x9B, 0x56, 0xA2, 0x47, 0x52, 0x45, 0xC9, 0x51, 0x7A, 0xFE, 0xB5, 0x91, 0x53}, // SSL.com Client RSA Root CA 2022
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0x22, 0xD9, 0x59, 0x92, 0x34, 0xD6, 0x0F, 0x1D, 0x4B, 0xC7, 0xC7, 0xE9, 0x6F, 0x43, 0xFA, 0x55, 0x5B, 0x07, 0x30, 0x1F, 0xD4, 0x75, 0x17, 0x50, 0x89, 0xDA, 0xFB, 0x8C, 0x25, 0xE4, 0x77, 0xB3}, // Sectigo Public Email Protection Root E46
+	{0xD5, 0x91, 0x7A, 0x77, 0x91, 0xEB, 0x7C, 0xF2, 0x0A, 0x2E, 0x57, 0xEB, 0x98, 0x28, 0x4A, 0x67, 0xB2, 0x8A, 0x57, 0xE8, 0x91, 0x82, 0xDA, 0x53, 0xD5, 0x46, 0x67, 0x8C, 0x9F, 0xDE, 0x2B, 0x4F}, // Sectigo Public Email Protection Root R46
+	{0xF1, 0xC1, 0xB5, 0x0A, 0xE5, 0xA2, 0x0D, 0xD8, 0x03, 0x0E, 0xC9, 0xF6, 0xBC, 0x24, 0x82, 0x3D, 0xD3, 0x67, 0xB5, 0x25, 0x57, 0x59, 0xB4, 0xE7, 0x1B, 0x61, 0xFC, 0xE9, 0xF7, 0x37, 0x5D, 0x73}, // SecureTrust CA
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0xBE, 0x6C, 0x4D, 0xA2, 0xBB, 0xB9, 0xBA, 0x59, 0xB6, 0xF3, 0x93, 0x97, 0x68, 0x37, 0x42, 0x46, 0xC3, 0xC0, 0x05, 0x99, 0x3F, 0xA9, 0x8F, 0x02, 0x0D, 0x1D, 0xED, 0xBE, 0xD4, 0x8A, 0x81, 0xD5}, // SwissSign Silver CA - G2
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xDD, 0x69, 0x36, 0xFE, 0x21, 0xF8, 0xF0, 0x77, 0xC1, 0x23, 0xA1, 0xA5, 0x21, 0xC1, 0x22, 0x24, 0xF7, 0x22, 0x55, 0xB7, 0x3E, 0x03, 0xA7, 0x26, 0x06, 0x93, 0xE8, 0xA2, 0x4B, 0x0F, 0xA3, 0x89}, // TeliaSonera Root CA v1
+	{0x97, 0x55, 0x20, 0x15, 0xF5, 0xDD, 0xFC, 0x3C, 0x87, 0x88, 0xC0, 0x06, 0x94, 0x45, 0x55, 0x40, 0x88, 0x94, 0x45, 0x00, 0x84, 0xF1, 0x00, 0x86, 0x70, 0x86, 0xBC, 0x1A, 0x2B, 0xB5, 0x8D, 0xC8}, // Trustwave Global Certification Authority
+	{0x94, 0x5B, 0xBC, 0x82, 0x5E, 0xA5, 0x54, 0xF4, 0x89, 0xD1, 0xFD, 0x51, 0xA7, 0x3D, 0xDF, 0x2E, 0xA6, 0x24, 0xAC, 0x70, 0x19, 0xA0, 0x52, 0x05, 0x22, 0x5C, 0x22, 0xA7, 0x8C, 0xCF, 0xA8, 0xB4}, // Trustwave Global ECC P256 Certification Authority
+	{0x55, 0x90, 0x38, 0x59, 0xC8, 0xC0, 0xC3, 0xEB, 0xB8, 0x75, 0x9E, 0xCE, 0x4E, 0x25, 0x57, 0x22, 0x5F, 0xF5, 0x75, 0x8B, 0xBD, 0x38, 0xEB, 0xD4, 0x82, 0x76, 0x60, 0x1E, 0x1B, 0xD5, 0x80, 0x97}, // Trustwave Global ECC P384 Certification Authority
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0xCE, 0xCD, 0xDC, 0x90, 0x50, 0x99, 0xD8, 0xDA, 0xDF, 0xC5, 0xB1, 0xD2, 0x09, 0xB7, 0x37, 0xCB, 0xE2, 0xC1, 0x8C, 0xFB, 0x2C, 0x10, 0xC0, 0xFF, 0x0B, 0xCF, 0x0D, 0x32, 0x86, 0xFC, 0x1A, 0xA2}, // XRamp Global Certification Authority
+	{0xEA, 0xA9, 0x62, 0xC4, 0xFA, 0x4A, 0x6B, 0xAF, 0xEB, 0xE4, 0x15, 0x19, 0x6D, 0x35, 0x1C, 0xCD, 0x88, 0x8D, 0x4F, 0x53, 0xF3, 0xFA, 0x8A, 0xE6, 0xD7, 0xC4, 0x66, 0xA9, 0x4E, 0x60, 0x42, 0xBB}, // certSIGN ROOT CA
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Chrome Root Store.
+var Chrome = [][sha256.Size]byte{
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x55, 0x41, 0x53, 0xB1, 0x3D, 0x2C, 0xF9, 0xDD, 0xB7, 0x53, 0xBF, 0xBE, 0x1A, 0x4E, 0x0A, 0xE0, 0x8D, 0x0A, 0xA4, 0x18, 0x70, 0x58, 0xFE, 0x60, 0xA2, 0xB8, 0x62, 0xB2, 0xE4, 0xB8, 0x7B, 0xCB}, // AC RAIZ FNMT-RCM SERVIDORES SEGUROS
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0xFB, 0x8F, 0xEC, 0x75, 0x91, 0x69, 0xB9, 0x10, 0x6B, 0x1E, 0x51, 0x16, 0x44, 0xC6, 0x18, 0xC5, 0x13, 0x04, 0x37, 0x3F, 0x6C, 0x06, 0x43, 0x08, 0x8D, 0x8B, 0xEF, 0xFD, 0x1B, 0x99, 0x75, 0x99}, // ANF Secure Server Root CA
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xB2, 0xFA, 0xE5, 0x3E, 0x14, 0xCC, 0xD7, 0xAB, 0x92, 0x12, 0x06, 0x47, 0x01, 0xAE, 0x27, 0x9C, 0x1D, 0x89, 0x88, 0xFA, 0xCB, 0x77, 0x5F, 0xA8, 0xA0, 0x08, 0x91, 0x4E, 0x66, 0x39, 0x88, 0xA8}, // Atos TrustedRoot Root CA ECC TLS 2021
+	{0x81, 0xA9, 0x08, 0x8E, 0xA5, 0x9F, 0xB3, 0x64, 0xC5, 0x48, 0xA6, 0xF8, 0x55, 0x59, 0x09, 0x9B, 0x6F, 0x04, 0x05, 0xEF, 0xBF, 0x18, 0xE5, 0x32, 0x4E, 0xC9, 0xF4, 0x57, 0xBA, 0x00, 0x11, 0x2F}, // Atos TrustedRoot Root CA RSA TLS 2021
+	{0x57, 0xDE, 0x05, 0x83, 0xEF, 0xD2, 0xB2, 0x6E, 0x03, 0x61, 0xDA, 0x99, 0xDA, 0x9D, 0xF4, 0x64, 0x8D, 0xEF, 0x7E, 0xE8, 0x44, 0x1C, 0x3B, 0x72, 0x8A, 0xFA, 0x9B, 0xCD, 0xE0, 0xF9, 0xB2, 0x6A}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0x9A, 0x11, 0x40, 0x25, 0x19, 0x7C, 0x5B, 0xB9, 0x5D, 0x94, 0xE6, 0x3D, 0x55, 0xCD, 0x43, 0x79, 0x08, 0x47, 0xB6, 0x46, 0xB2, 0x3C, 0xDF, 0x11, 0xAD, 0xA4, 0xA0, 0x0E, 0xFF, 0x15, 0xFB, 0x48}, // Buypass Class 2 Root CA
+	{0xED, 0xF7, 0xEB, 0xBC, 0xA2, 0x7A, 0x2A, 0x38, 0x4D, 0x38, 0x7B, 0x7D, 0x40, 0x10, 0xC6, 0x66, 0xE2, 0xED, 0xB4, 0x84, 0x3E, 0x4C, 0x29, 0xB4, 0xAE, 0x1D, 0x5B, 0x93, 0x32, 0xE6, 0xB2, 0x4D}, // Buypass Class 3 Root CA
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x5C, 0xC3, 0xD7, 0x8E, 0x4E, 0x1D, 0x5E, 0x45, 0x54, 0x7A, 0x04, 0xE6, 0x87, 0x3E, 0x64, 0xF9, 0x0C, 0xF9, 0x53, 0x6D, 0x1C, 0xCC, 0x2E, 0xF8, 0x00, 0xF3, 0x55, 0xC4, 0xC5, 0xFD, 0x70, 0xFD}, // CFCA EV ROOT
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xB4, 0x58, 0x5F, 0x22, 0xE4, 0xAC, 0x75, 0x6A, 0x4E, 0x86, 0x12, 0xA1, 0x36, 0x1C, 0x5D, 0x9D, 0x03, 0x1A, 0x93, 0xFD, 0x84, 0xFE, 0xBB, 0x77, 0x8F, 0xA3, 0x06, 0x8B, 0x0F, 0xC4, 0x2D, 0xC2}, // Certainly Root E1
+	{0x77, 0xB8, 0x2C, 0xD8, 0x64, 0x4C, 0x43, 0x05, 0xF7, 0xAC, 0xC5, 0xCB, 0x15, 0x6B, 0x45, 0x67, 0x50, 0x04, 0x03, 0x3D, 0x51, 0xC6, 0x0C, 0x62, 0x02, 0xA8, 0xE0, 0xC3, 0x34, 0x67, 0xD3, 0xA0}, // Certainly Root R1
+	{0xD4, 0x8D, 0x3D, 0x23, 0xEE, 0xDB, 0x50, 0xA4, 0x59, 0xE5, 0x51, 0x97, 0x60, 0x1C, 0x27, 0x77, 0x4B, 0x9D, 0x7B, 0x18, 0xC9, 0x4D, 0x5A, 0x05, 0x95, 0x11, 0xA1, 0x02, 0x50, 0xB9, 0x31, 0x68}, // Certigna Root CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0xE5, 0x9A, 0xAA, 0x81, 0x60, 0x09, 0xC2, 0x2B, 0xFF, 0x5B, 0x25, 0xBA, 0xD3, 0x7D, 0xF3, 0x06, 0xF0, 0x49, 0x79, 0x7C, 0x1F, 0x81, 0xD8, 0x5A, 0xB0, 0x89, 0xE6, 0x57, 0xBD, 0x8F, 0x00, 0x44}, // D-TRUST BR Root CA 1 2020
+	{0x05, 0x52, 0xE6, 0xF8, 0x3F, 0xDF, 0x65, 0xE8, 0xFA, 0x96, 0x70, 0xE6, 0x66, 0xDF, 0x28, 0xA4, 0xE2, 0x13, 0x40, 0xB5, 0x10, 0xCB, 0xE5, 0x25, 0x66, 0xF9, 0x7C, 0x4F, 0xB9, 0x4B, 0x2B, 0xD1}, // D-TRUST BR Root CA 2 2023
+	{0x08, 0x17, 0x0D, 0x1A, 0xA3, 0x64, 0x53, 0x90, 0x1A, 0x2F, 0x95, 0x92, 0x45, 0xE3, 0x47, 0xDB, 0x0C, 0x8D, 0x37, 0xAB, 0xAA, 0xBC, 0x56, 0xB8, 0x1A, 0xA1, 0x00, 0xDC, 0x95, 0x89, 0x70, 0xDB}, // D-TRUST EV Root CA 1 2020
+	{0x8E, 0x82, 0x21, 0xB2, 0xE7, 0xD4, 0x00, 0x78, 0x36, 0xA1, 0x67, 0x2F, 0x0D, 0xCC, 0x29, 0x9C, 0x33, 0xBC, 0x07, 0xD3, 0x16, 0xF1, 0x32, 0xFA, 0x1A, 0x20, 0x6D, 0x58, 0x71, 0x50, 0xF1, 0xCE}, // D-TRUST EV Root CA 2 2023
+	{0x49, 0xE7, 0xA4, 0x42, 0xAC, 0xF0, 0xEA, 0x62, 0x87, 0x05, 0x00, 0x54, 0xB5, 0x25, 0x64, 0xB6, 0x50, 0xE4, 0xF4, 0x9E, 0x42, 0xE3, 0x48, 0xD6, 0xAA, 0x38, 0xE0, 0x39, 0xE9, 0x57, 0xB1, 0xC1}, // D-TRUST Root Class 3 CA 2 2009
+	{0xEE, 0xC5, 0x49, 0x6B, 0x98, 0x8C, 0xE9, 0x86, 0x25, 0xB9, 0x34, 0x09, 0x2E, 0xEC, 0x29, 0x08, 0xBE, 0xD0, 0xB0, 0xF3, 0x16, 0xC2, 0xD4, 0x73, 0x0C, 0x84, 0xEA, 0xF1, 0xF3, 0xD3, 0x48, 0x81}, // D-TRUST Root Class 3 CA 2 EV 2009
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x01, 0x8E, 0x13, 0xF0, 0x77, 0x25, 0x32, 0xCF, 0x80, 0x9B, 0xD1, 0xB1, 0x72, 0x81, 0x86, 0x72, 0x83, 0xFC, 0x48, 0xC6, 0xE1, 0x3B, 0xE9, 0xC6, 0x98, 0x12, 0x85, 0x4A, 0x49, 0x0C, 0x1B, 0x05}, // DigiCert TLS ECC P384 Root G5
+	{0x37, 0x1A, 0x00, 0xDC, 0x05, 0x33, 0xB3, 0x72, 0x1A, 0x7E, 0xEB, 0x40, 0xE8, 0x41, 0x9E, 0x70, 0x79, 0x9D, 0x2B, 0x0A, 0x0F, 0x2C, 0x1D, 0x80, 0x69, 0x31, 0x65, 0xF7, 0xCE, 0xC4, 0xAD, 0x75}, // DigiCert TLS RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xCB, 0xB9, 0xC4, 0x4D, 0x84, 0xB8, 0x04, 0x3E, 0x10, 0x50, 0xEA, 0x31, 0xA6, 0x9F, 0x51, 0x49, 0x55, 0xD7, 0xBF, 0xD2, 0xE2, 0xC6, 0xB4, 0x93, 0x01, 0x01, 0x9A, 0xD6, 0x1D, 0x9F, 0x50, 0x58}, // GlobalSign Root E46
+	{0x4F, 0xA3, 0x12, 0x6D, 0x8D, 0x3A, 0x11, 0xD1, 0xC4, 0x85, 0x5A, 0x4F, 0x80, 0x7C, 0xBA, 0xD6, 0xCF, 0x91, 0x9D, 0x3A, 0x5A, 0x88, 0xB0, 0x3B, 0xEA, 0x2C, 0x63, 0x72, 0xD9, 0x3C, 0x40, 0xC9}, // GlobalSign Root R46
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x3F, 0x99, 0xCC, 0x47, 0x4A, 0xCF, 0xCE, 0x4D, 0xFE, 0xD5, 0x87, 0x94, 0x66, 0x5E, 0x47, 0x8D, 0x15, 0x47, 0x73, 0x9F, 0x2E, 0x78, 0x0F, 0x1B, 0xB4, 0xCA, 0x9B, 0x13, 0x30, 0x97, 0xD4, 0x01}, // HARICA TLS ECC Root CA 2021
+	{0xD9, 0x5D, 0x0E, 0x8E, 0xDA, 0x79, 0x52, 0x5B, 0xF9, 0xBE, 0xB1, 0x1B, 0x14, 0xD2, 0x10, 0x0D, 0x32, 0x94, 0x98, 0x5F, 0x0C, 0x62, 0xD9, 0xFA, 0xBD, 0x9C, 0xD9, 0x99, 0xEC, 0xCB, 0x7B, 0x1D}, // HARICA TLS RSA Root CA 2021
+	{0xF0, 0x15, 0xCE, 0x3C, 0xC2, 0x39, 0xBF, 0xEF, 0x06, 0x4B, 0xE9, 0xF1, 0xD2, 0xC4, 0x17, 0xE1, 0xA0, 0x26, 0x4A, 0x0A, 0x94, 0xBE, 0x1F, 0x0C, 0x8D, 0x12, 0x18, 0x64, 0xEB, 0x69, 0x49, 0xCC}, // HiPKI Root CA - G1
+	{0x5A, 0x2F, 0xC0, 0x3F, 0x0C, 0x83, 0xB0, 0x90, 0xBB, 0xFA, 0x40, 0x60, 0x4B, 0x09, 0x88, 0x44, 0x6C, 0x76, 0x36, 0x18, 0x3D, 0xF9, 0x84, 0x6E, 0x17, 0x10, 0x1A, 0x44, 0x7F, 0xB8, 0xEF, 0xD6}, // Hongkong Post Root CA 3
+	{0x96, 0xBC, 0xEC, 0x06, 0x26, 0x49, 0x76, 0xF3, 0x74, 0x60, 0x77, 0x9A, 0xCF, 0x28, 0xC5, 0xA7, 0xCF, 0xE8, 0xA3, 0xC0, 0xAA, 0xE1, 0x1A, 0x8F, 0xFC, 0xEE, 0x05, 0xC0, 0xBD, 0xDF, 0x08, 0xC6}, // ISRG Root X1
+	{0x69, 0x72, 0x9B, 0x8E, 0x15, 0xA8, 0x6E, 0xFC, 0x17, 0x7A, 0x57, 0xAF, 0xB7, 0x17, 0x1D, 0xFC, 0x64, 0xAD, 0xD2, 0x8C, 0x2F, 0xCA, 0x8C, 0xF1, 0x50, 0x7E, 0x34, 0x45, 0x3C, 0xCB, 0x14, 0x70}, // ISRG Root X2
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x25, 0x30, 0xCC, 0x8E, 0x98, 0x32, 0x15, 0x02, 0xBA, 0xD9, 0x6F, 0x9B, 0x1F, 0xBA, 0x1B, 0x09, 0x9E, 0x2D, 0x29, 0x9E, 0x0F, 0x45, 0x48, 0xBB, 0x91, 0x4F, 0x36, 0x3B, 0xC0, 0xD4, 0x53, 0x1F}, // Izenpe.com
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x35, 0x8D, 0xF3, 0x9D, 0x76, 0x4A, 0xF9, 0xE1, 0xB7, 0x66, 0xE9, 0xC9, 0x72, 0xDF, 0x35, 0x2E, 0xE1, 0x5C, 0xFA, 0xC2, 0x27, 0xAF, 0x6A, 0xD1, 0xD7, 0x0E, 0x8E, 0x4A, 0x6E, 0xDC, 0xBA, 0x02}, // Microsoft ECC Root Certificate Authority 2017
+	{0xC7, 0x41, 0xF7, 0x0F, 0x4B, 0x2A, 0x8D, 0x88, 0xBF, 0x2E, 0x71, 0xC1, 0x41, 0x22, 0xEF, 0x53, 0xEF, 0x10, 0xEB, 0xA0, 0xCF, 0xA5, 0xE6, 0x4C, 0xFA, 0x20, 0xF4, 0x18, 0x85, 0x30, 0x73, 0xE0}, // Microsoft RSA Root Certificate Authority 2017
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6C, 0x61, 0xDA, 0xC3, 0xA2, 0xDE, 0xF0, 0x31, 0x50, 0x6B, 0xE0, 0x36, 0xD2, 0xA6, 0xFE, 0x40, 0x19, 0x94, 0xFB, 0xD1, 0x3D, 0xF9, 0xC8, 0xD4, 0x66, 0x59, 0x92, 0x74, 0xC4, 0x46, 0xEC, 0x98}, // NetLock Arany (Class Gold) Főtanúsítvány
+	{0xEE, 0xC9, 0x97, 0xC0, 0xC3, 0x0F, 0x21, 0x6F, 0x7E, 0x3B, 0x8B, 0x30, 0x7D, 0x2B, 0xAE, 0x42, 0x41, 0x2D, 0x75, 0x3F, 0xC8, 0x21, 0x9D, 0xAF, 0xD1, 0x52, 0x0B, 0x25, 0x72, 0x85, 0x0F, 0x49}, // OISTE Server Root ECC G1
+	{0x9A, 0xE3, 0x62, 0x32, 0xA5, 0x18, 0x9F, 0xFD, 0xDB, 0x35, 0x3D, 0xFD, 0x26, 0x52, 0x0C, 0x01, 0x53, 0x95, 0xD2, 0x27, 0x77, 0xDA, 0xC5, 0x9D, 0xB5, 0x7B, 0x98, 0xC0, 0x89, 0xA6, 0x51, 0xE6}, // OISTE Server Root RSA G1
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x22, 0xA2, 0xC1, 0xF7, 0xBD, 0xED, 0x70, 0x4C, 0xC1, 0xE7, 0x01, 0xB5, 0xF4, 0x08, 0xC3, 0x10, 0x88, 0x0F, 0xE9, 0x56, 0xB5, 0xDE, 0x2A, 0x4A, 0x44, 0xF9, 0x9C, 0x87, 0x3A, 0x25, 0xA7, 0xC8}, // SSL.com EV Root Certification Authority ECC
+	{0x2E, 0x7B, 0xF1, 0x6C, 0xC2, 0x24, 0x85, 0xA7, 0xBB, 0xE2, 0xAA, 0x86, 0x96, 0x75, 0x07, 0x61, 0xB0, 0xAE, 0x39, 0xBE, 0x3B, 0x2F, 0xE9, 0xD0, 0xCC, 0x6D, 0x4E, 0xF7, 0x34, 0x91, 0x42, 0x5C}, // SSL.com EV Root Certification Authority RSA R2
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xC3, 0x2F, 0xFD, 0x9F, 0x46, 0xF9, 0x36, 0xD1, 0x6C, 0x36, 0x73, 0x99, 0x09, 0x59, 0x43, 0x4B, 0x9A, 0xD6, 0x0A, 0xAF, 0xBB, 0x9E, 0x7C, 0xF3, 0x36, 0x54, 0xF1, 0x44, 0xCC, 0x1B, 0xA1, 0x43}, // SSL.com TLS ECC Root CA 2022
+	{0x8F, 0xAF, 0x7D, 0x2E, 0x2C, 0xB4, 0x70, 0x9B, 0xB8, 0xE0, 0xB3, 0x36, 0x66, 0xBF, 0x75, 0xA5, 0xDD, 0x45, 0xB5, 0xDE, 0x48, 0x0F, 0x8E, 0xA8, 0xD4, 0xBF, 0xE6, 0xBE, 0xBC, 0x17, 0xF2, 0xED}, // SSL.com TLS RSA Root CA 2022
+	{0xA1, 0x33, 0x9D, 0x33, 0x28, 0x1A, 0x0B, 0x56, 0xE5, 0x57, 0xD3, 0xD3, 0x2B, 0x1C, 0xE7, 0xF9, 0x36, 0x7E, 0xB0, 0x94, 0xBD, 0x5F, 0xA7, 0x2A, 0x7E, 0x50, 0x04, 0xC8, 0xDE, 0xD7, 0xCA, 0xFE}, // SZAFIR ROOT CA2
+	{0xC9, 0x0F, 0x26, 0xF0, 0xFB, 0x1B, 0x40, 0x18, 0xB2, 0x22, 0x27, 0x51, 0x9B, 0x5C, 0xA2, 0xB5, 0x3E, 0x2C, 0xA5, 0xB3, 0xBE, 0x5C, 0xF1, 0x8E, 0xFE, 0x1B, 0xEF, 0x47, 0x38, 0x0C, 0x53, 0x83}, // Sectigo Public Server Authentication Root E46
+	{0x7B, 0xB6, 0x47, 0xA6, 0x2A, 0xEE, 0xAC, 0x88, 0xBF, 0x25, 0x7A, 0xA5, 0x22, 0xD0, 0x1F, 0xFE, 0xA3, 0x95, 0xE0, 0xAB, 0x45, 0xC7, 0x3F, 0x93, 0xF6, 0x56, 0x54, 0xEC, 0x38, 0xF2, 0x5A, 0x06}, // Sectigo Public Server Authentication Root R46
+	{0xE7, 0x4F, 0xBD, 0xA5, 0x5B, 0xD5, 0x64, 0xC4, 0x73, 0xA3, 0x6B, 0x44, 0x1A, 0xA7, 0x99, 0xC8, 0xA6, 0x8E, 0x07, 0x74, 0x40, 0xE8, 0x28, 0x8B, 0x9F, 0xA1, 0xE5, 0x0E, 0x4B, 0xBA, 0xCA, 0x11}, // Security Communication ECC RootCA1
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x2C, 0xE1, 0xCB, 0x0B, 0xF9, 0xD2, 0xF9, 0xE1, 0x02, 0x99, 0x3F, 0xBE, 0x21, 0x51, 0x52, 0xC3, 0xB2, 0xDD, 0x0C, 0xAB, 0xDE, 0x1C, 0x68, 0xE5, 0x31, 0x9B, 0x83, 0x91, 0x54, 0xDB, 0xB7, 0xF5}, // Starfield Root Certificate Authority - G2
+	{0x19, 0x31, 0x44, 0xF4, 0x31, 0xE0, 0xFD, 0xDB, 0x74, 0x07, 0x17, 0xD4, 0xDE, 0x92, 0x6A, 0x57, 0x11, 0x33, 0x88, 0x4B, 0x43, 0x60, 0xD3, 0x0E, 0x27, 0x29, 0x13, 0xCB, 0xE6, 0x60, 0xCE, 0x41}, // SwissSign RSA TLS Root CA 2022 - 1
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0xFD, 0x73, 0xDA, 0xD3, 0x1C, 0x64, 0x4F, 0xF1, 0xB4, 0x3B, 0xEF, 0x0C, 0xCD, 0xDA, 0x96, 0x71, 0x0B, 0x9C, 0xD9, 0x87, 0x5E, 0xCA, 0x7E, 0x31, 0x70, 0x7A, 0xF3, 0xE9, 0x6D, 0x52, 0x2B, 0xBD}, // T-TeleSec GlobalRoot Class 3
+	{0x46, 0xED, 0xC3, 0x68, 0x90, 0x46, 0xD5, 0x3A, 0x45, 0x3F, 0xB3, 0x10, 0x4A, 0xB8, 0x0D, 0xCA, 0xEC, 0x65, 0x8B, 0x26, 0x60, 0xEA, 0x16, 0x29, 0xDD, 0x7E, 0x86, 0x79, 0x90, 0x64, 0x87, 0x16}, // TUBITAK Kamu SM SSL Kok Sertifikasi - Surum 1
+	{0x3F, 0x63, 0xBB, 0x28, 0x14, 0xBE, 0x17, 0x4E, 0xC8, 0xB6, 0x43, 0x9C, 0xF0, 0x8D, 0x6D, 0x56, 0xF0, 0xB7, 0xC4, 0x05, 0x88, 0x3A, 0x56, 0x48, 0xA3, 0x34, 0x42, 0x4D, 0x6B, 0x3E, 0xC5, 0x58}, // TWCA CYBER Root CA
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0x57, 0x8A, 0xF4, 0xDE, 0xD0, 0x85, 0x3F, 0x4E, 0x59, 0x98, 0xDB, 0x4A, 0xEA, 0xF9, 0xCB, 0xEA, 0x8D, 0x94, 0x5F, 0x60, 0xB6, 0x20, 0xA3, 0x8D, 0x1A, 0x3C, 0x13, 0xB2, 0xBC, 0x7B, 0xA8, 0xE1}, // Telekom Security TLS ECC Root 2020
+	{0xEF, 0xC6, 0x5C, 0xAD, 0xBB, 0x59, 0xAD, 0xB6, 0xEF, 0xE8, 0x4D, 0xA2, 0x23, 0x11, 0xB3, 0x56, 0x24, 0xB7, 0x1B, 0x3B, 0x1E, 0xA0, 0xDA, 0x8B, 0x66, 0x55, 0x17, 0x4E, 0xC8, 0x97, 0x86, 0x46}, // Telekom Security TLS RSA Root 2023
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xC0, 0x07, 0x6B, 0x9E, 0xF0, 0x53, 0x1F, 0xB1, 0xA6, 0x56, 0xD6, 0x7C, 0x4E, 0xBE, 0x97, 0xCD, 0x5D, 0xBA, 0xA4, 0x1E, 0xF4, 0x45, 0x98, 0xAC, 0xC2, 0x48, 0x98, 0x78, 0xC9, 0x2D, 0x87, 0x11}, // TrustAsia TLS ECC Root CA
+	{0x06, 0xC0, 0x8D, 0x7D, 0xAF, 0xD8, 0x76, 0x97, 0x1E, 0xB1, 0x12, 0x4F, 0xE6, 0x7F, 0x84, 0x7E, 0xC0, 0xC7, 0xA1, 0x58, 0xD3, 0xEA, 0x53, 0xCB, 0xE9, 0x40, 0xE2, 0xEA, 0x97, 0x91, 0xF4, 0xC3}, // TrustAsia TLS RSA Root CA
+	{0x2E, 0x44, 0x10, 0x2A, 0xB5, 0x8C, 0xB8, 0x54, 0x19, 0x45, 0x1C, 0x8E, 0x19, 0xD9, 0xAC, 0xF3, 0x66, 0x2C, 0xAF, 0xBC, 0x61, 0x4B, 0x6A, 0x53, 0x96, 0x0A, 0x30, 0xF7, 0xD0, 0xE2, 0xEB, 0x41}, // TunTrust Root CA
+	{0xD4, 0x3A, 0xF9, 0xB3, 0x54, 0x73, 0x75, 0x5C, 0x96, 0x84, 0xFC, 0x06, 0xD7, 0xD8, 0xCB, 0x70, 0xEE, 0x5C, 0x28, 0xE7, 0x73, 0xFB, 0x29, 0x4E, 0xB4, 0x1E, 0xE7, 0x17, 0x22, 0x92, 0x4D, 0x24}, // UCA Extended Validation Root
+	{0x9B, 0xEA, 0x11, 0xC9, 0x76, 0xFE, 0x01, 0x47, 0x64, 0xC1, 0xBE, 0x56, 0xA6, 0xF9, 0x14, 0xB5, 0xA5, 0x60, 0x31, 0x7A, 0xBD, 0x99, 0x88, 0x39, 0x33, 0x82, 0xE5, 0x16, 0x1A, 0xA0, 0x49, 0x3C}, // UCA Global G2 Root
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xBE, 0xB0, 0x0B, 0x30, 0x83, 0x9B, 0x9B, 0xC3, 0x2C, 0x32, 0xE4, 0x44, 0x79, 0x05, 0x95, 0x06, 0x41, 0xF2, 0x64, 0x21, 0xB1, 0x5E, 0xD0, 0x89, 0x19, 0x8B, 0x51, 0x8A, 0xE2, 0xEA, 0x1B, 0x99}, // e-Szigno Root CA 2017
+	{0xB4, 0x91, 0x41, 0x50, 0x2D, 0x00, 0x66, 0x3D, 0x74, 0x0F, 0x2E, 0x7E, 0xC3, 0x40, 0xC5, 0x28, 0x00, 0x96, 0x26, 0x66, 0x12, 0x1A, 0x36, 0xD0, 0x9C, 0xF7, 0xDD, 0x2B, 0x90, 0x38, 0x4F, 0xB4}, // e-Szigno TLS Root CA 2023
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+	{0x30, 0xFB, 0xBA, 0x2C, 0x32, 0x23, 0x8E, 0x2A, 0x98, 0x54, 0x7A, 0xF9, 0x79, 0x31, 0xE5, 0x50, 0x42, 0x8B, 0x9B, 0x3F, 0x1C, 0x8E, 0xEB, 0x66, 0x33, 0xDC, 0xFA, 0x86, 0xC5, 0xB2, 0x7D, 0xD3}, // vTrus ECC Root CA
+	{0x8A, 0x71, 0xDE, 0x65, 0x59, 0x33, 0x6F, 0x42, 0x6C, 0x26, 0xE5, 0x38, 0x80, 0xD0, 0x0D, 0x88, 0xA1, 0x8D, 0xA4, 0xC6, 0xA9, 0x1F, 0x0D, 0xCB, 0x61, 0x94, 0xE2, 0x06, 0xC5, 0xC9, 0x63, 0x87}, // vTrus Root CA
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Microsoft root store.
+var Microsoft = [][sha256.Size]byte{
+	{0x8A, 0xC5, 0x52, 0xAD, 0x57, 0x7E, 0x37, 0xAD, 0x2C, 0x68, 0x08, 0xD7, 0x2A, 0xA3, 0x31, 0xD6, 0xA9, 0x6B, 0x4B, 0x3F, 0xEB, 0xFF, 0x34, 0xCE, 0x9B, 0xC0, 0x57, 0x8E, 0x08, 0x05, 0x5E, 0xC3}, // A-Trust-Root-07
+	{0x7A, 0x38, 0xF7, 0x08, 0xA3, 0x5A, 0x31, 0xE4, 0x2E, 0x1C, 0xF3, 0x22, 0x0F, 0x9A, 0x2D, 0x27, 0x3E, 0x76, 0x66, 0x35, 0x46, 0x18, 0xB2, 0x46, 0x46, 0x57, 0xD4, 0x3D, 0x8E, 0x77, 0xAD, 0xC2}, // A-Trust-Root-09
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x02, 0x07, 0x86, 0x53, 0x9F, 0x02, 0xB6, 0x23, 0xCF, 0xDF, 0x32, 0x5B, 0x2E, 0x4E, 0x45, 0xE0, 0xD9, 0xE3, 0xF1, 0xB8, 0xE5, 0xEC, 0x96, 0x00, 0xEB, 0xF0, 0xAC, 0x8F, 0xBF, 0x03, 0xFB, 0xE2}, // AC RAIZ FNMT-RCM G2
+	{0x55, 0x41, 0x53, 0xB1, 0x3D, 0x2C, 0xF9, 0xDD, 0xB7, 0x53, 0xBF, 0xBE, 0x1A, 0x4E, 0x0A, 0xE0, 0x8D, 0x0A, 0xA4, 0x18, 0x70, 0x58, 0xFE, 0x60, 0xA2, 0xB8, 0x62, 0xB2, 0xE4, 0xB8, 0x7B, 0xCB}, // AC RAIZ FNMT-RCM SERVIDORES SEGUROS
+	{0x97, 0xF6, 0x54, 0x85, 0x9C, 0xBD, 0xE5, 0x86, 0xFD, 0x90, 0x31, 0x1E, 0x82, 0xEC, 0x79, 0x02, 0xC2, 0x38, 0xCB, 0xA0, 0xD6, 0xE5, 0x29, 0x56, 0x4C, 0x9C, 0x88, 0xF4, 0x48, 0x95, 0xEC, 0x50}, // ACA ROOT
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0xA7, 0xDE, 0xDF, 0x5A, 0x84, 0x21, 0x67, 0xDD, 0x12, 0xFD, 0xAA, 0x0F, 0x20, 0x80, 0xE7, 0x32, 0x95, 0xB8, 0xB8, 0xBE, 0xA7, 0x1B, 0x20, 0x94, 0xEA, 0x09, 0x50, 0x94, 0x5A, 0x48, 0x2F, 0xC1}, // AffirmTrust 4K TLS Root CA - 2022
+	{0x03, 0x76, 0xAB, 0x1D, 0x54, 0xC5, 0xF9, 0x80, 0x3C, 0xE4, 0xB2, 0xE2, 0x01, 0xA0, 0xEE, 0x7E, 0xEF, 0x7B, 0x57, 0xB6, 0x36, 0xE8, 0xA9, 0x3C, 0x9B, 0x8D, 0x48, 0x60, 0xC9, 0x6F, 0x5F, 0xA7}, // AffirmTrust Commercial
+	{0x0A, 0x81, 0xEC, 0x5A, 0x92, 0x97, 0x77, 0xF1, 0x45, 0x90, 0x4A, 0xF3, 0x8D, 0x5D, 0x50, 0x9F, 0x66, 0xB5, 0xE2, 0xC5, 0x8F, 0xCD, 0xB5, 0x31, 0x05, 0x8B, 0x0E, 0x17, 0xF3, 0xF0, 0xB4, 0x1B}, // AffirmTrust Networking
+	{0x70, 0xA7, 0x3F, 0x7F, 0x37, 0x6B, 0x60, 0x07, 0x42, 0x48, 0x90, 0x45, 0x34, 0xB1, 0x14, 0x82, 0xD5, 0xBF, 0x0E, 0x69, 0x8E, 0xCC, 0x49, 0x8D, 0xF5, 0x25, 0x77, 0xEB, 0xF2, 0xE9, 0x3B, 0x9A}, // AffirmTrust Premium
+	{0xBD, 0x71, 0xFD, 0xF6, 0xDA, 0x97, 0xE4, 0xCF, 0x62, 0xD1, 0x64, 0x7A, 0xDD, 0x25, 0x81, 0xB0, 0x7D, 0x79, 0xAD, 0xF8, 0x39, 0x7E, 0xB4, 0xEC, 0xBA, 0x9C, 0x5E, 0x84, 0x88, 0x82, 0x14, 0x23}, // AffirmTrust Premium ECC
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xE3, 0x86, 0x55, 0xF4, 0xB0, 0x19, 0x0C, 0x84, 0xD3, 0xB3, 0x89, 0x3D, 0x84, 0x0A, 0x68, 0x7E, 0x19, 0x0A, 0x25, 0x6D, 0x98, 0x05, 0x2F, 0x15, 0x9E, 0x6D, 0x4A, 0x39, 0xF5, 0x89, 0xA6, 0xEB}, // Atos TrustedRoot Root CA ECC G2 2020
+	{0xB2, 0xFA, 0xE5, 0x3E, 0x14, 0xCC, 0xD7, 0xAB, 0x92, 0x12, 0x06, 0x47, 0x01, 0xAE, 0x27, 0x9C, 0x1D, 0x89, 0x88, 0xFA, 0xCB, 0x77, 0x5F, 0xA8, 0xA0, 0x08, 0x91, 0x4E, 0x66, 0x39, 0x88, 0xA8}, // Atos TrustedRoot Root CA ECC TLS 2021
+	{0x78, 0x83, 0x3A, 0x78, 0x3B, 0xB2, 0x98, 0x6C, 0x25, 0x4B, 0x93, 0x70, 0xD3, 0xC2, 0x0E, 0x5E, 0xBA, 0x8F, 0xA7, 0x84, 0x0C, 0xBF, 0x63, 0xFE, 0x17, 0x29, 0x7A, 0x0B, 0x01, 0x19, 0x68, 0x5E}, // Atos TrustedRoot Root CA RSA G2 2020
+	{0x81, 0xA9, 0x08, 0x8E, 0xA5, 0x9F, 0xB3, 0x64, 0xC5, 0x48, 0xA6, 0xF8, 0x55, 0x59, 0x09, 0x9B, 0x6F, 0x04, 0x05, 0xEF, 0xBF, 0x18, 0xE5, 0x32, 0x4E, 0xC9, 0xF4, 0x57, 0xBA, 0x00, 0x11, 0x2F}, // Atos TrustedRoot Root CA RSA TLS 2021
+	{0x20, 0x9E, 0x95, 0x6A, 0xF0, 0x4D, 0xF3, 0x99, 0x65, 0x07, 0xC8, 0x87, 0xD3, 0x56, 0x23, 0x0D, 0x6E, 0xB4, 0x9F, 0xDB, 0xDD, 0x2D, 0x8A, 0x05, 0x8F, 0xF5, 0x0B, 0x8F, 0x80, 0xF6, 0x90, 0xAA}, // Australian Defence Public Root CA
+	{0x04, 0x04, 0x80, 0x28, 0xBF, 0x1F, 0x28, 0x64, 0xD4, 0x8F, 0x9A, 0xD4, 0xD8, 0x32, 0x94, 0x36, 0x6A, 0x82, 0x88, 0x56, 0x55, 0x3F, 0x3B, 0x14, 0x30, 0x3F, 0x90, 0x14, 0x7F, 0x5D, 0x40, 0xEF}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0x57, 0xDE, 0x05, 0x83, 0xEF, 0xD2, 0xB2, 0x6E, 0x03, 0x61, 0xDA, 0x99, 0xDA, 0x9D, 0xF4, 0x64, 0x8D, 0xEF, 0x7E, 0xE8, 0x44, 0x1C, 0x3B, 0x72, 0x8A, 0xFA, 0x9B, 0xCD, 0xE0, 0xF9, 0xB2, 0x6A}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0x6E, 0x0B, 0xFF, 0x06, 0x9A, 0x26, 0x99, 0x4C, 0x15, 0xDE, 0x2C, 0x48, 0x88, 0xCC, 0x54, 0xAF, 0x84, 0x88, 0x2E, 0x54, 0x95, 0xB7, 0xFB, 0xF6, 0x6B, 0xE9, 0xCC, 0xFF, 0xEC, 0x74, 0x89, 0xF6}, // Autoridade Certificadora Raiz Brasileira v10
+	{0xCA, 0xA5, 0x3F, 0xC6, 0x09, 0x1C, 0x69, 0x51, 0x88, 0x7C, 0x97, 0x6E, 0x37, 0x8F, 0x6E, 0xF8, 0x9A, 0xA6, 0x37, 0x7C, 0x55, 0xD9, 0x7B, 0x64, 0x75, 0x42, 0x2B, 0x71, 0xED, 0x7E, 0x9B, 0x17}, // Autoridade Certificadora Raiz Brasileira v5
+	{0x16, 0xAF, 0x57, 0xA9, 0xF6, 0x76, 0xB0, 0xAB, 0x12, 0x60, 0x95, 0xAA, 0x5E, 0xBA, 0xDE, 0xF2, 0x2A, 0xB3, 0x11, 0x19, 0xD6, 0x44, 0xAC, 0x95, 0xCD, 0x4B, 0x93, 0xDB, 0xF3, 0xF2, 0x6A, 0xEB}, // Baltimore CyberTrust Root
+	{0x9A, 0x11, 0x40, 0x25, 0x19, 0x7C, 0x5B, 0xB9, 0x5D, 0x94, 0xE6, 0x3D, 0x55, 0xCD, 0x43, 0x79, 0x08, 0x47, 0xB6, 0x46, 0xB2, 0x3C, 0xDF, 0x11, 0xAD, 0xA4, 0xA0, 0x0E, 0xFF, 0x15, 0xFB, 0x48}, // Buypass Class 2 Root CA
+	{0xED, 0xF7, 0xEB, 0xBC, 0xA2, 0x7A, 0x2A, 0x38, 0x4D, 0x38, 0x7B, 0x7D, 0x40, 0x10, 0xC6, 0x66, 0xE2, 0xED, 0xB4, 0x84, 0x3E, 0x4C, 0x29, 0xB4, 0xAE, 0x1D, 0x5B, 0x93, 0x32, 0xE6, 0xB2, 0x4D}, // Buypass Class 3 Root CA
+	{0xAB, 0x93, 0x6D, 0xFD, 0x7F, 0x17, 0x1D, 0x3A, 0x3C, 0xBD, 0x08, 0xF9, 0xBE, 0xBD, 0xC3, 0xF3, 0xBA, 0xAA, 0xBA, 0xA7, 0x9D, 0xF6, 0xA9, 0x08, 0xF8, 0xA7, 0xB0, 0x12, 0x86, 0x27, 0xF1, 0x87}, // Buypass Class 3 Root CA G2 HT
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x15, 0x01, 0xF8, 0x9C, 0x5C, 0x4D, 0xCF, 0x36, 0xCF, 0x58, 0x8A, 0x17, 0xC9, 0xFD, 0x7C, 0xFC, 0xEB, 0x9E, 0xE0, 0x1E, 0x87, 0x29, 0xBE, 0x35, 0x5E, 0x25, 0xDE, 0x80, 0xEB, 0x62, 0x84, 0xB4}, // CAEDICOM Root
+	{0x9A, 0x3F, 0xD3, 0x17, 0x67, 0x98, 0xE8, 0x42, 0xDD, 0xCB, 0x12, 0xC2, 0x62, 0xF1, 0x1C, 0xFA, 0xCC, 0xA7, 0x0A, 0x8B, 0x84, 0xC6, 0xEA, 0x6F, 0xDA, 0x30, 0x84, 0x2A, 0x95, 0xA9, 0x4C, 0xD8}, // CCA India 2022
+	{0xB7, 0x24, 0x68, 0x9B, 0x79, 0xB2, 0xEF, 0x94, 0x21, 0xEF, 0x8F, 0x5C, 0xC7, 0x33, 0xEB, 0x09, 0x38, 0x51, 0xB1, 0x70, 0xEE, 0x71, 0x51, 0x77, 0x00, 0x5A, 0x09, 0xF2, 0x26, 0xD8, 0xC9, 0x1A}, // CCA India 2022 SPL
+	{0x5C, 0xC3, 0xD7, 0x8E, 0x4E, 0x1D, 0x5E, 0x45, 0x54, 0x7A, 0x04, 0xE6, 0x87, 0x3E, 0x64, 0xF9, 0x0C, 0xF9, 0x53, 0x6D, 0x1C, 0xCC, 0x2E, 0xF8, 0x00, 0xF3, 0x55, 0xC4, 0xC5, 0xFD, 0x70, 0xFD}, // CFCA EV ROOT
+	{0xFF, 0xB8, 0x5C, 0x26, 0x30, 0x8A, 0x96, 0x13, 0x51, 0x24, 0x9E, 0xA6, 0x41, 0xF6, 0x59, 0xD4, 0x9F, 0x63, 0x9E, 0x91, 0xDA, 0xED, 0x9C, 0x92, 0xD0, 0x46, 0xCC, 0xDC, 0xEC, 0xC9, 0x3D, 0x2F}, // CFCA Identity CA
+	{0x04, 0xF1, 0xBE, 0xC3, 0x69, 0x51, 0xBC, 0x14, 0x54, 0xA9, 0x04, 0xCE, 0x32, 0x89, 0x0C, 0x5D, 0xA3, 0xCD, 0xE1, 0x35, 0x6B, 0x79, 0x00, 0xF6, 0xE6, 0x2D, 0xFA, 0x20, 0x41, 0xEB, 0xAD, 0x51}, // CHAMBERS OF COMMERCE ROOT - 2016
+	{0x1A, 0x0D, 0x20, 0x44, 0x5D, 0xE5, 0xBA, 0x18, 0x62, 0xD1, 0x9E, 0xF8, 0x80, 0x85, 0x8C, 0xBC, 0xE5, 0x01, 0x02, 0xB3, 0x6E, 0x8F, 0x0A, 0x04, 0x0C, 0x3C, 0x69, 0xE7, 0x45, 0x22, 0xFE, 0x6E}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xEE, 0x0E, 0xC4, 0xA0, 0xB5, 0xE6, 0xEC, 0xB8, 0x65, 0xF4, 0x96, 0x89, 0xB5, 0x0E, 0x3D, 0xE3, 0x8D, 0x4F, 0xD9, 0x24, 0xA4, 0x04, 0x1C, 0x0C, 0x2A, 0x62, 0x63, 0x6D, 0xB0, 0xB6, 0xB3, 0x1D}, // Carillon PKI Services G2 Root CA 1
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD4, 0x8D, 0x3D, 0x23, 0xEE, 0xDB, 0x50, 0xA4, 0x59, 0xE5, 0x51, 0x97, 0x60, 0x1C, 0x27, 0x77, 0x4B, 0x9D, 0x7B, 0x18, 0xC9, 0x4D, 0x5A, 0x05, 0x95, 0x11, 0xA1, 0x02, 0x50, 0xB9, 0x31, 0x68}, // Certigna Root CA
+	{0x2A, 0x99, 0xF5, 0xBC, 0x11, 0x74, 0xB7, 0x3C, 0xBB, 0x1D, 0x62, 0x08, 0x84, 0xE0, 0x1C, 0x34, 0xE5, 0x1C, 0xCB, 0x39, 0x78, 0xDA, 0x12, 0x5F, 0x0E, 0x33, 0x26, 0x88, 0x83, 0xBF, 0x41, 0x58}, // Certinomis - Root CA
+	{0xD8, 0xE0, 0xFE, 0xBC, 0x1D, 0xB2, 0xE3, 0x8D, 0x00, 0x94, 0x0F, 0x37, 0xD2, 0x7D, 0x41, 0x34, 0x4D, 0x99, 0x3E, 0x73, 0x4B, 0x99, 0xD5, 0x65, 0x6D, 0x97, 0x78, 0xD4, 0xD8, 0x14, 0x36, 0x24}, // Certum CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0x06, 0x3E, 0x4A, 0xFA, 0xC4, 0x91, 0xDF, 0xD3, 0x32, 0xF3, 0x08, 0x9B, 0x85, 0x42, 0xE9, 0x46, 0x17, 0xD8, 0x93, 0xD7, 0xFE, 0x94, 0x4E, 0x10, 0xA7, 0x93, 0x7E, 0xE2, 0x9D, 0x96, 0x93, 0xC0}, // Chambers of Commerce Root - 2008
+	{0x26, 0x05, 0x87, 0x5A, 0xFC, 0xC1, 0x76, 0xB2, 0xD6, 0x6D, 0xD6, 0x6A, 0x99, 0x5D, 0x7F, 0x8D, 0x5E, 0xBB, 0x86, 0xCE, 0x12, 0x0D, 0x0E, 0x7E, 0x9E, 0x7C, 0x6E, 0xF2, 0x94, 0xA2, 0x7D, 0x4C}, // ComSign Global Root CA
+	{0xE9, 0x0D, 0xBE, 0xB2, 0xD3, 0x60, 0xCC, 0x6F, 0x98, 0x99, 0x4E, 0xEF, 0xC6, 0x8C, 0x41, 0x47, 0xF2, 0xDF, 0xD9, 0xC6, 0x8A, 0x3B, 0xF0, 0x63, 0xC6, 0xA9, 0x71, 0xF3, 0xE1, 0x1B, 0xAF, 0x4E}, // Cybertrust iTrust Root Certification Authority
+	{0xE5, 0x9A, 0xAA, 0x81, 0x60, 0x09, 0xC2, 0x2B, 0xFF, 0x5B, 0x25, 0xBA, 0xD3, 0x7D, 0xF3, 0x06, 0xF0, 0x49, 0x79, 0x7C, 0x1F, 0x81, 0xD8, 0x5A, 0xB0, 0x89, 0xE6, 0x57, 0xBD, 0x8F, 0x00, 0x44}, // D-TRUST BR Root CA 1 2020
+	{0x05, 0x52, 0xE6, 0xF8, 0x3F, 0xDF, 0x65, 0xE8, 0xFA, 0x96, 0x70, 0xE6, 0x66, 0xDF, 0x28, 0xA4, 0xE2, 0x13, 0x40, 0xB5, 0x10, 0xCB, 0xE5, 0x25, 0x66, 0xF9, 0x7C, 0x4F, 0xB9, 0x4B, 0x2B, 0xD1}, // D-TRUST BR Root CA 2 2023
+	{0x08, 0x17, 0x0D, 0x1A, 0xA3, 0x64, 0x53, 0x90, 0x1A, 0x2F, 0x95, 0x92, 0x45, 0xE3, 0x47, 0xDB, 0x0C, 0x8D, 0x37, 0xAB, 0xAA, 0xBC, 0x56, 0xB8, 0x1A, 0xA1, 0x00, 0xDC, 0x95, 0x89, 0x70, 0xDB}, // D-TRUST EV Root CA 1 2020
+	{0x8E, 0x82, 0x21, 0xB2, 0xE7, 0xD4, 0x00, 0x78, 0x36, 0xA1, 0x67, 0x2F, 0x0D, 0xCC, 0x29, 0x9C, 0x33, 0xBC, 0x07, 0xD3, 0x16, 0xF1, 0x32, 0xFA, 0x1A, 0x20, 0x6D, 0x58, 0x71, 0x50, 0xF1, 0xCE}, // D-TRUST EV Root CA 2 2023
+	{0xA1, 0xA8, 0x6D, 0x04, 0x12, 0x1E, 0xB8, 0x7F, 0x02, 0x7C, 0x66, 0xF5, 0x33, 0x03, 0xC2, 0x8E, 0x57, 0x39, 0xF9, 0x43, 0xFC, 0x84, 0xB3, 0x8A, 0xD6, 0xAF, 0x00, 0x90, 0x35, 0xDD, 0x94, 0x57}, // D-TRUST Root CA 3 2013
+	{0x49, 0xE7, 0xA4, 0x42, 0xAC, 0xF0, 0xEA, 0x62, 0x87, 0x05, 0x00, 0x54, 0xB5, 0x25, 0x64, 0xB6, 0x50, 0xE4, 0xF4, 0x9E, 0x42, 0xE3, 0x48, 0xD6, 0xAA, 0x38, 0xE0, 0x39, 0xE9, 0x57, 0xB1, 0xC1}, // D-TRUST Root Class 3 CA 2 2009
+	{0xEE, 0xC5, 0x49, 0x6B, 0x98, 0x8C, 0xE9, 0x86, 0x25, 0xB9, 0x34, 0x09, 0x2E, 0xEC, 0x29, 0x08, 0xBE, 0xD0, 0xB0, 0xF3, 0x16, 0xC2, 0xD4, 0x73, 0x0C, 0x84, 0xEA, 0xF1, 0xF3, 0xD3, 0x48, 0x81}, // D-TRUST Root Class 3 CA 2 EV 2009
+	{0xD9, 0x2C, 0x17, 0x1F, 0x5C, 0xF8, 0x90, 0xBA, 0x42, 0x80, 0x19, 0x29, 0x29, 0x27, 0xFE, 0x22, 0xF3, 0x20, 0x7F, 0xD2, 0xB5, 0x44, 0x49, 0xCB, 0x6F, 0x67, 0x5A, 0xF4, 0x92, 0x21, 0x46, 0xE2}, // D-Trust SBR Root CA 1 2022
+	{0xDB, 0xA8, 0x4D, 0xD7, 0xEF, 0x62, 0x2D, 0x48, 0x54, 0x63, 0xA9, 0x01, 0x37, 0xEA, 0x4D, 0x57, 0x4D, 0xF8, 0x55, 0x09, 0x28, 0xF6, 0xAF, 0xA0, 0x3B, 0x4D, 0x8B, 0x11, 0x41, 0xE6, 0x36, 0xCC}, // D-Trust SBR Root CA 2 2022
+	{0x55, 0x46, 0xA5, 0x25, 0x04, 0xFB, 0xA7, 0x4F, 0x61, 0xFF, 0xD4, 0x89, 0x00, 0x67, 0x52, 0x9A, 0xDE, 0x3B, 0x9C, 0x9D, 0x07, 0xE5, 0x02, 0x59, 0x28, 0x31, 0xCC, 0xDA, 0x9B, 0x36, 0x9F, 0xD3}, // DVV Gov. Root CA - G3 ECC
+	{0xD3, 0xED, 0x3F, 0xC4, 0x0A, 0xD2, 0x6B, 0x52, 0xE0, 0x01, 0xE1, 0xE1, 0x8F, 0x4B, 0x94, 0x49, 0x52, 0x9D, 0xEB, 0x75, 0xA8, 0x1D, 0x5E, 0xB6, 0x80, 0xD7, 0xB6, 0x2D, 0xB2, 0x3B, 0xA9, 0x6D}, // DVV Gov. Root CA - G3 RSA
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0x26, 0xC5, 0x6A, 0xD2, 0x20, 0x8D, 0x1E, 0x9B, 0x15, 0x2F, 0x66, 0x85, 0x3B, 0xF4, 0x79, 0x7C, 0xBE, 0xB7, 0x55, 0x2C, 0x1F, 0x3F, 0x47, 0x72, 0x51, 0xE8, 0xCB, 0x1A, 0xE7, 0xE7, 0x97, 0xBF}, // DigiCert CS ECC P384 Root G5
+	{0x73, 0x53, 0xB6, 0xD6, 0xC2, 0xD6, 0xDA, 0x42, 0x47, 0x77, 0x3F, 0x3F, 0x07, 0xD0, 0x75, 0xDE, 0xCB, 0x51, 0x34, 0x21, 0x2B, 0xEA, 0xD0, 0x92, 0x8E, 0xF1, 0xF4, 0x61, 0x15, 0x26, 0x09, 0x41}, // DigiCert CS RSA4096 Root G5
+	{0xCD, 0x85, 0x1C, 0x5C, 0x25, 0x46, 0x7C, 0x00, 0x95, 0xA4, 0x39, 0x27, 0xD0, 0xB2, 0x29, 0x2D, 0x93, 0x29, 0x75, 0xE8, 0x4D, 0xCE, 0x4C, 0x5E, 0x79, 0x45, 0x42, 0xC6, 0x57, 0x4C, 0x3A, 0xA4}, // DigiCert Client ECC P384 Root G5
+	{0x3A, 0xF2, 0x14, 0x66, 0x92, 0x0F, 0xA9, 0x1F, 0xA3, 0x1F, 0x43, 0x31, 0xE4, 0x45, 0xC1, 0x6B, 0xE2, 0x33, 0x00, 0x24, 0xBF, 0xD2, 0xC2, 0x39, 0xC0, 0xD8, 0x5A, 0xB5, 0x30, 0x71, 0x47, 0x66}, // DigiCert Client RSA4096 Root G5
+	{0xC1, 0x46, 0x8C, 0xF2, 0x25, 0x4E, 0x60, 0x04, 0xB2, 0x46, 0x96, 0xAB, 0xA2, 0x09, 0xD1, 0xA3, 0x0B, 0xA6, 0xE2, 0xDF, 0xF6, 0x8A, 0x9A, 0x4E, 0x32, 0xC6, 0xAB, 0x41, 0x4F, 0x90, 0xC8, 0xD9}, // DigiCert ECC P384 Root G5
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0xE4, 0x6A, 0x39, 0x22, 0x04, 0xA8, 0xDC, 0xA3, 0x42, 0xA7, 0x1C, 0x1C, 0xA9, 0xA6, 0x0C, 0x91, 0x85, 0xB9, 0xA9, 0x30, 0x37, 0x01, 0x20, 0xC3, 0xB9, 0xC7, 0xE3, 0x85, 0x6F, 0x0D, 0x8F, 0x3B}, // DigiCert RSA4096 Root G5
+	{0xE8, 0xE8, 0x17, 0x65, 0x36, 0xA6, 0x0C, 0xC2, 0xC4, 0xE1, 0x01, 0x87, 0xC3, 0xBE, 0xFC, 0xA2, 0x0E, 0xF2, 0x63, 0x49, 0x70, 0x18, 0xF5, 0x66, 0xD5, 0xBE, 0xA0, 0xF9, 0x4D, 0x0C, 0x11, 0x1B}, // DigiCert SMIME ECC P384 Root G5
+	{0x90, 0x37, 0x0D, 0x3E, 0xFA, 0x88, 0xBF, 0x58, 0xC3, 0x01, 0x05, 0xBA, 0x25, 0x10, 0x4A, 0x35, 0x84, 0x60, 0xA7, 0xFA, 0x52, 0xDF, 0xC2, 0x01, 0x1D, 0xF2, 0x33, 0xA0, 0xF4, 0x17, 0x91, 0x2A}, // DigiCert SMIME RSA4096 Root G5
+	{0x01, 0x8E, 0x13, 0xF0, 0x77, 0x25, 0x32, 0xCF, 0x80, 0x9B, 0xD1, 0xB1, 0x72, 0x81, 0x86, 0x72, 0x83, 0xFC, 0x48, 0xC6, 0xE1, 0x3B, 0xE9, 0xC6, 0x98, 0x12, 0x85, 0x4A, 0x49, 0x0C, 0x1B, 0x05}, // DigiCert TLS ECC P384 Root G5
+	{0x37, 0x1A, 0x00, 0xDC, 0x05, 0x33, 0xB3, 0x72, 0x1A, 0x7E, 0xEB, 0x40, 0xE8, 0x41, 0x9E, 0x70, 0x79, 0x9D, 0x2B, 0x0A, 0x0F, 0x2C, 0x1D, 0x80, 0x69, 0x31, 0x65, 0xF7, 0xCE, 0xC4, 0xAD, 0x75}, // DigiCert TLS RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x88, 0x49, 0x7F, 0x01, 0x60, 0x2F, 0x31, 0x54, 0x24, 0x6A, 0xE2, 0x8C, 0x4D, 0x5A, 0xEF, 0x10, 0xF1, 0xD8, 0x7E, 0xBB, 0x76, 0x62, 0x6F, 0x4A, 0xE0, 0xB7, 0xF9, 0x5B, 0xA7, 0x96, 0x87, 0x99}, // EC-ACC
+	{0x64, 0x79, 0x87, 0xD9, 0x8D, 0x52, 0x64, 0x5D, 0xA4, 0xD3, 0xDE, 0x3B, 0x80, 0x77, 0x1A, 0x0C, 0xE0, 0x2B, 0x9B, 0x92, 0x85, 0xE6, 0xE8, 0x69, 0x99, 0x88, 0x21, 0x70, 0x74, 0x4E, 0xC9, 0xAA}, // Entrust 4K EV TLS Root CA - 2022
+	{0xDD, 0x6C, 0x44, 0xB3, 0x94, 0x01, 0xB0, 0x53, 0xDB, 0xE6, 0x11, 0x20, 0x74, 0x8B, 0xBB, 0x0F, 0x60, 0x56, 0x00, 0x76, 0x65, 0xC1, 0x68, 0xE5, 0xC2, 0x86, 0x75, 0x0E, 0xDC, 0x8D, 0xF1, 0x29}, // Entrust 4K TLS Root CA - 2022
+	{0xB8, 0x08, 0x47, 0xFD, 0xA4, 0x53, 0xBF, 0x6E, 0xD8, 0x76, 0xCA, 0x7B, 0xC0, 0x46, 0xA2, 0x48, 0x19, 0x09, 0xE1, 0x5B, 0x6E, 0xD3, 0x76, 0xE6, 0x65, 0xE7, 0xAD, 0x09, 0xF3, 0x86, 0x4E, 0x71}, // Entrust Code Signing Root Certification Authority - CSBR1
+	{0xE8, 0x74, 0xFE, 0x25, 0x31, 0xEA, 0xE4, 0xA4, 0xB6, 0xB6, 0x2F, 0x37, 0x49, 0x6B, 0xBA, 0xE9, 0x0E, 0xB1, 0xD8, 0xFC, 0x8C, 0xED, 0xBE, 0xBB, 0x00, 0xA1, 0x82, 0xCF, 0xAC, 0xDC, 0x7E, 0x61}, // Entrust Digital Signing Root Certification Authority - DSR1
+	{0x93, 0x7E, 0xF8, 0xF1, 0x22, 0x76, 0xB3, 0xC7, 0xA3, 0xF5, 0x8E, 0x34, 0x5D, 0x09, 0xA6, 0xEF, 0xF0, 0x1F, 0x86, 0x2F, 0x8D, 0x27, 0x94, 0x44, 0x1C, 0xD8, 0x4D, 0x51, 0x18, 0x25, 0xFA, 0x0C}, // Entrust P384 EV TLS Root CA - 2022
+	{0x42, 0x03, 0x32, 0xEF, 0x87, 0x6E, 0xBE, 0x78, 0xF2, 0xAF, 0x5D, 0x28, 0xAA, 0xAC, 0xDE, 0x24, 0xAA, 0xD0, 0xC1, 0x0F, 0x8F, 0xFA, 0xAC, 0x46, 0x9E, 0xFD, 0x7B, 0xD9, 0x41, 0x92, 0x95, 0x68}, // Entrust P384 TLS Root CA - 2022
+	{0x73, 0xC1, 0x76, 0x43, 0x4F, 0x1B, 0xC6, 0xD5, 0xAD, 0xF4, 0x5B, 0x0E, 0x76, 0xE7, 0x27, 0x28, 0x7C, 0x8D, 0xE5, 0x76, 0x16, 0xC1, 0xE6, 0xE6, 0x14, 0x1A, 0x2B, 0x2C, 0xBC, 0x7D, 0x8E, 0x4C}, // Entrust Root Certification Authority
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0xB7, 0xA4, 0x1E, 0xD8, 0x09, 0x6D, 0x62, 0x71, 0x6B, 0xAD, 0xC7, 0xF5, 0x30, 0x94, 0x21, 0x97, 0xA9, 0xE7, 0xE3, 0x17, 0x5C, 0xE0, 0x5D, 0x11, 0xD0, 0x1E, 0x7A, 0xD6, 0xC1, 0x2D, 0xCB, 0xA7}, // Entrust SMIME Root CA - 2022
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0x5A, 0xB4, 0xFC, 0xDB, 0x18, 0x0B, 0x5B, 0x6A, 0xF0, 0xD2, 0x62, 0xA2, 0x37, 0x5A, 0x2C, 0x77, 0xD2, 0x56, 0x02, 0x01, 0x5D, 0x96, 0x64, 0x87, 0x56, 0x61, 0x1E, 0x2E, 0x78, 0xC5, 0x3A, 0xD3}, // Fina Root CA
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0xC1, 0xD8, 0x0C, 0xE4, 0x74, 0xA5, 0x11, 0x28, 0xB7, 0x7E, 0x79, 0x4A, 0x98, 0xAA, 0x2D, 0x62, 0xA0, 0x22, 0x5D, 0xA3, 0xF4, 0x19, 0xE5, 0xC7, 0xED, 0x73, 0xDF, 0xBF, 0x66, 0x0E, 0x71, 0x09}, // GLOBAL CHAMBERSIGN ROOT - 2016
+	{0x5E, 0x35, 0x71, 0xF3, 0x3F, 0x45, 0xA7, 0xDF, 0x15, 0x37, 0xA6, 0x8B, 0x5F, 0xFB, 0x9E, 0x03, 0x6A, 0xF9, 0xD2, 0xF5, 0xBC, 0x4C, 0x97, 0x17, 0x13, 0x0D, 0xC4, 0x3D, 0x71, 0x75, 0xAA, 0xC7}, // GLOBALTRUST
+	{0x41, 0x6B, 0x1F, 0x9E, 0x84, 0xE7, 0x4C, 0x1D, 0x19, 0xB2, 0x3D, 0x8D, 0x71, 0x91, 0xC6, 0xAD, 0x81, 0x24, 0x6E, 0x64, 0x16, 0x01, 0xF5, 0x99, 0x13, 0x27, 0x29, 0xF5, 0x07, 0xBE, 0xB3, 0xCC}, // GLOBALTRUST 2015
+	{0x9A, 0x29, 0x6A, 0x51, 0x82, 0xD1, 0xD4, 0x51, 0xA2, 0xE3, 0x7F, 0x43, 0x9B, 0x74, 0xDA, 0xAF, 0xA2, 0x67, 0x52, 0x33, 0x29, 0xF9, 0x0F, 0x9A, 0x0D, 0x20, 0x07, 0xC3, 0x34, 0xE2, 0x3C, 0x9A}, // GLOBALTRUST 2020
+	{0x2A, 0x57, 0x54, 0x71, 0xE3, 0x13, 0x40, 0xBC, 0x21, 0x58, 0x1C, 0xBD, 0x2C, 0xF1, 0x3E, 0x15, 0x84, 0x63, 0x20, 0x3E, 0xCE, 0x94, 0xBC, 0xF9, 0xD3, 0xCC, 0x19, 0x6B, 0xF0, 0x9A, 0x54, 0x72}, // GTS Root R1
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0xC4, 0x5D, 0x7B, 0xB0, 0x8E, 0x6D, 0x67, 0xE6, 0x2E, 0x42, 0x35, 0x11, 0x0B, 0x56, 0x4E, 0x5F, 0x78, 0xFD, 0x92, 0xEF, 0x05, 0x8C, 0x84, 0x0A, 0xEA, 0x4E, 0x64, 0x55, 0xD7, 0x58, 0x5C, 0x60}, // GTS Root R2
+	{0x15, 0xD5, 0xB8, 0x77, 0x46, 0x19, 0xEA, 0x7D, 0x54, 0xCE, 0x1C, 0xA6, 0xD0, 0xB0, 0xC4, 0x03, 0xE0, 0x37, 0xA9, 0x17, 0xF1, 0x31, 0xE8, 0xA0, 0x4E, 0x1E, 0x6B, 0x7A, 0x71, 0xBA, 0xBC, 0xE5}, // GTS Root R3
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x71, 0xCC, 0xA5, 0x39, 0x1F, 0x9E, 0x79, 0x4B, 0x04, 0x80, 0x25, 0x30, 0xB3, 0x63, 0xE1, 0x21, 0xDA, 0x8A, 0x30, 0x43, 0xBB, 0x26, 0x66, 0x2F, 0xEA, 0x4D, 0xCA, 0x7F, 0xC9, 0x51, 0xA4, 0xBD}, // GTS Root R4
+	{0x13, 0x63, 0x35, 0x43, 0x93, 0x34, 0xA7, 0x69, 0x80, 0x16, 0xA0, 0xD3, 0x24, 0xDE, 0x72, 0x28, 0x4E, 0x07, 0x9D, 0x7B, 0x52, 0x20, 0xBB, 0x8F, 0xBD, 0x74, 0x78, 0x16, 0xEE, 0xBE, 0xBA, 0xCA}, // Global Chambersign Root - 2008
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xBE, 0xC9, 0x49, 0x11, 0xC2, 0x95, 0x56, 0x76, 0xDB, 0x6C, 0x0A, 0x55, 0x09, 0x86, 0xD7, 0x6E, 0x3B, 0xA0, 0x05, 0x66, 0x7C, 0x44, 0x2C, 0x97, 0x62, 0xB4, 0xFB, 0xB7, 0x73, 0xDE, 0x22, 0x8C}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0x8B, 0x0F, 0x0F, 0xAA, 0x2C, 0x00, 0xFE, 0x05, 0x32, 0xA8, 0xA5, 0x4E, 0x7B, 0xC5, 0xFD, 0x13, 0x9C, 0x19, 0x22, 0xC4, 0xF1, 0x0F, 0x0B, 0x16, 0xE1, 0x0F, 0xB8, 0xBE, 0x1A, 0x63, 0x49, 0x64}, // GlobalSign Client Authentication Root E45
+	{0x16, 0x5C, 0x7E, 0x81, 0x0B, 0xD3, 0x7C, 0x1D, 0x57, 0xCE, 0x98, 0x49, 0xAC, 0xCD, 0x50, 0x0E, 0x5C, 0xB0, 0x1E, 0xEA, 0x37, 0xDC, 0x55, 0x0D, 0xB0, 0x7E, 0x59, 0x8A, 0xAD, 0x24, 0x74, 0xA8}, // GlobalSign Client Authentication Root R45
+	{0x26, 0xC6, 0xC5, 0xFD, 0x49, 0x28, 0xFD, 0x57, 0xA8, 0xA4, 0xC5, 0x72, 0x4F, 0xDD, 0x27, 0x97, 0x45, 0x86, 0x9C, 0x60, 0xC3, 0x38, 0xE2, 0x62, 0xFF, 0xE9, 0x01, 0xC3, 0x1B, 0xD1, 0xDB, 0x2B}, // GlobalSign Code Signing Root E45
+	{0x7B, 0x9D, 0x55, 0x3E, 0x1C, 0x92, 0xCB, 0x6E, 0x88, 0x03, 0xE1, 0x37, 0xF4, 0xF2, 0x87, 0xD4, 0x36, 0x37, 0x57, 0xF5, 0xD4, 0x4B, 0x37, 0xD5, 0x2F, 0x9F, 0xCA, 0x22, 0xFB, 0x97, 0xDF, 0x86}, // GlobalSign Code Signing Root R45
+	{0xF8, 0x69, 0x73, 0xBD, 0xD0, 0x51, 0x47, 0x35, 0xE1, 0x0C, 0x11, 0x90, 0xD0, 0x34, 0x5B, 0xF8, 0x9C, 0x77, 0xE1, 0xC4, 0xAD, 0xBD, 0x3F, 0x65, 0x96, 0x3B, 0x80, 0x3F, 0xD3, 0xC9, 0xE1, 0xFF}, // GlobalSign Document Signing Root E45
+	{0x38, 0xBE, 0x6C, 0x7E, 0xEB, 0x45, 0x47, 0xD8, 0x2B, 0x92, 0x87, 0xF2, 0x43, 0xAF, 0x32, 0xA9, 0xDE, 0xEB, 0x5D, 0xC5, 0xC9, 0xA8, 0x7A, 0x00, 0x56, 0xF9, 0x38, 0xD9, 0x1B, 0x45, 0x6A, 0x5A}, // GlobalSign Document Signing Root R45
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0xCB, 0xB9, 0xC4, 0x4D, 0x84, 0xB8, 0x04, 0x3E, 0x10, 0x50, 0xEA, 0x31, 0xA6, 0x9F, 0x51, 0x49, 0x55, 0xD7, 0xBF, 0xD2, 0xE2, 0xC6, 0xB4, 0x93, 0x01, 0x01, 0x9A, 0xD6, 0x1D, 0x9F, 0x50, 0x58}, // GlobalSign Root E46
+	{0x4F, 0xA3, 0x12, 0x6D, 0x8D, 0x3A, 0x11, 0xD1, 0xC4, 0x85, 0x5A, 0x4F, 0x80, 0x7C, 0xBA, 0xD6, 0xCF, 0x91, 0x9D, 0x3A, 0x5A, 0x88, 0xB0, 0x3B, 0xEA, 0x2C, 0x63, 0x72, 0xD9, 0x3C, 0x40, 0xC9}, // GlobalSign Root R46
+	{0x5C, 0xBF, 0x6F, 0xB8, 0x1F, 0xD4, 0x17, 0xEA, 0x41, 0x28, 0xCD, 0x6F, 0x81, 0x72, 0xA3, 0xC9, 0x40, 0x20, 0x94, 0xF7, 0x4A, 0xB2, 0xED, 0x3A, 0x06, 0xB4, 0x40, 0x5D, 0x04, 0xF3, 0x0B, 0x19}, // GlobalSign Secure Mail Root E45
+	{0x31, 0x9A, 0xF0, 0xA7, 0x72, 0x9E, 0x6F, 0x89, 0x26, 0x9C, 0x13, 0x1E, 0xA6, 0xA3, 0xA1, 0x6F, 0xCD, 0x86, 0x38, 0x9F, 0xDC, 0xAB, 0x3C, 0x47, 0xA4, 0xA6, 0x75, 0xC1, 0x61, 0xA3, 0xF9, 0x74}, // GlobalSign Secure Mail Root R45
+	{0x2B, 0xCB, 0xBF, 0xD6, 0x62, 0x82, 0xC6, 0x80, 0x49, 0x1C, 0x8C, 0xD7, 0x73, 0x5F, 0xDB, 0xBA, 0xB7, 0xA8, 0x07, 0x9B, 0x12, 0x7B, 0xEC, 0x60, 0xC5, 0x35, 0x97, 0x68, 0x34, 0x39, 0x9A, 0xF7}, // GlobalSign Timestamping Root R45
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x8D, 0xD4, 0xB5, 0x37, 0x3C, 0xB0, 0xDE, 0x36, 0x76, 0x9C, 0x12, 0x33, 0x92, 0x80, 0xD8, 0x27, 0x46, 0xB3, 0xAA, 0x6C, 0xD4, 0x26, 0xE7, 0x97, 0xA3, 0x1B, 0xAB, 0xE4, 0x27, 0x9C, 0xF0, 0x0B}, // HARICA Client ECC Root CA 2021
+	{0x1B, 0xE7, 0xAB, 0xE3, 0x06, 0x86, 0xB1, 0x63, 0x48, 0xAF, 0xD1, 0xC6, 0x1B, 0x68, 0x66, 0xA0, 0xEA, 0x7F, 0x48, 0x21, 0xE6, 0x7D, 0x5E, 0x8A, 0xF9, 0x37, 0xCF, 0x80, 0x11, 0xBC, 0x75, 0x0D}, // HARICA Client RSA Root CA 2021
+	{0x79, 0x4E, 0x77, 0x46, 0x38, 0xCE, 0x7B, 0x3C, 0xF0, 0x5E, 0xBC, 0x89, 0x67, 0xCF, 0x47, 0x31, 0x53, 0x16, 0xC3, 0x51, 0xDC, 0x26, 0x38, 0x74, 0x76, 0xE0, 0xC0, 0x99, 0xC2, 0xED, 0x47, 0xCC}, // HARICA Code Signing ECC Root CA 2021
+	{0xC4, 0x0E, 0xBD, 0xCD, 0x75, 0xA9, 0x0E, 0x4B, 0x74, 0x96, 0xAB, 0xB2, 0x3E, 0x78, 0x9A, 0x48, 0xE3, 0x3C, 0x03, 0x28, 0x4F, 0x75, 0xD9, 0x51, 0x30, 0x57, 0x5A, 0xE6, 0x86, 0x0A, 0xE1, 0x3C}, // HARICA Code Signing RSA Root CA 2021
+	{0x3F, 0x99, 0xCC, 0x47, 0x4A, 0xCF, 0xCE, 0x4D, 0xFE, 0xD5, 0x87, 0x94, 0x66, 0x5E, 0x47, 0x8D, 0x15, 0x47, 0x73, 0x9F, 0x2E, 0x78, 0x0F, 0x1B, 0xB4, 0xCA, 0x9B, 0x13, 0x30, 0x97, 0xD4, 0x01}, // HARICA TLS ECC Root CA 2021
+	{0xD9, 0x5D, 0x0E, 0x8E, 0xDA, 0x79, 0x52, 0x5B, 0xF9, 0xBE, 0xB1, 0x1B, 0x14, 0xD2, 0x10, 0x0D, 0x32, 0x94, 0x98, 0x5F, 0x0C, 0x62, 0xD9, 0xFA, 0xBD, 0x9C, 0xD9, 0x99, 0xEC, 0xCB, 0x7B, 0x1D}, // HARICA TLS RSA Root CA 2021
+	{0xD7, 0xBA, 0x3F, 0x4F, 0xF8, 0xAD, 0x05, 0x63, 0x34, 0x51, 0x47, 0x0D, 0xDA, 0x33, 0x78, 0xA3, 0x49, 0x1B, 0x90, 0x00, 0x5E, 0x5C, 0x68, 0x7D, 0x2B, 0x68, 0xD5, 0x36, 0x47, 0xCF, 0xDD, 0x66}, // Halcom Root Certificate Authority
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0xF0, 0x15, 0xCE, 0x3C, 0xC2, 0x39, 0xBF, 0xEF, 0x06, 0x4B, 0xE9, 0xF1, 0xD2, 0xC4, 0x17, 0xE1, 0xA0, 0x26, 0x4A, 0x0A, 0x94, 0xBE, 0x1F, 0x0C, 0x8D, 0x12, 0x18, 0x64, 0xEB, 0x69, 0x49, 0xCC}, // HiPKI Root CA - G1
+	{0x39, 0x45, 0xE0, 0x8A, 0x8D, 0x4A, 0x05, 0x54, 0xB7, 0x60, 0x5A, 0x7B, 0x35, 0x5B, 0x10, 0x18, 0x8E, 0x3E, 0xF8, 0x42, 0xC7, 0x6A, 0x80, 0x5C, 0x54, 0xE3, 0x65, 0x7C, 0x4D, 0x04, 0x1A, 0xAA}, // Hongkong Post Root CA 2
+	{0x5A, 0x2F, 0xC0, 0x3F, 0x0C, 0x83, 0xB0, 0x90, 0xBB, 0xFA, 0x40, 0x60, 0x4B, 0x09, 0x88, 0x44, 0x6C, 0x76, 0x36, 0x18, 0x3D, 0xF9, 0x84, 0x6E, 0x17, 0x10, 0x1A, 0x44, 0x7F, 0xB8, 0xEF, 0xD6}, // Hongkong Post Root CA 3
+	{0xA3, 0xCC, 0x68, 0x59, 0x5D, 0xFE, 0x7E, 0x86, 0xD8, 0xAD, 0x17, 0x72, 0xA8, 0xB5, 0x28, 0x4A, 0xDD, 0x54, 0xAC, 0xE3, 0xB8, 0xA7, 0x98, 0xDF, 0x47, 0xBC, 0xCA, 0xFB, 0x1F, 0xDB, 0x84, 0xDF}, // Hotspot 2.0 Trust Root CA - 03
+	{0x38, 0x08, 0xCE, 0x3E, 0x96, 0x1C, 0xA5, 0x32, 0x68, 0x2F, 0xFB, 0x87, 0x08, 0xB5, 0x44, 0xE8, 0xF1, 0x75, 0xAA, 0x06, 0x56, 0x01, 0xA4, 0x59, 0x02, 0xDF, 0x92, 0x12, 0x8F, 0xC3, 0x85, 0x32}, // I.CA Root CA/ECC 05/2022
+	{0xB8, 0x69, 0x21, 0x48, 0xFF, 0x49, 0xC3, 0x79, 0x9F, 0xA2, 0x34, 0x7A, 0xE2, 0x8B, 0xCC, 0x52, 0x89, 0x62, 0x35, 0x12, 0xB6, 0x7D, 0xC1, 0x91, 0x70, 0x45, 0x2A, 0xDE, 0x24, 0xBA, 0x51, 0xD5}, // I.CA Root CA/ECC 12/2016
+	{0xD3, 0xD6, 0x07, 0xA9, 0xFF, 0x24, 0xA1, 0x95, 0x23, 0xB6, 0xDA, 0x9D, 0x2C, 0x64, 0x94, 0x46, 0xF8, 0x78, 0x8C, 0xB9, 0x6D, 0x9F, 0xD1, 0x30, 0x97, 0x2E, 0x12, 0x0C, 0x13, 0x67, 0x77, 0x30}, // I.CA Root CA/RSA
+	{0xD2, 0x79, 0xC0, 0x1A, 0x12, 0xE8, 0xDD, 0x9A, 0x62, 0x30, 0xE4, 0x59, 0xFA, 0xA4, 0x47, 0xCE, 0xB3, 0x36, 0x99, 0x84, 0x77, 0x33, 0x8C, 0x2E, 0xE4, 0x13, 0x5C, 0x96, 0x73, 0x74, 0x18, 0xEB}, // I.CA Root CA/RSA 05/2022
+	{0xF9, 0xA1, 0x7A, 0x00, 0xE5, 0xC2, 0x94, 0xBA, 0x96, 0x14, 0xA7, 0x15, 0x81, 0x9A, 0xF5, 0x7F, 0x3F, 0xD4, 0x8C, 0xC4, 0x13, 0x45, 0x3F, 0xBB, 0x8A, 0x5F, 0xC7, 0xE9, 0x79, 0x64, 0xE2, 0xBC}, // I.CA TLS Root CA/RSA 05/2022
+	{0x96, 0xBC, 0xEC, 0x06, 0x26, 0x49, 0x76, 0xF3, 0x74, 0x60, 0x77, 0x9A, 0xCF, 0x28, 0xC5, 0xA7, 0xCF, 0xE8, 0xA3, 0xC0, 0xAA, 0xE1, 0x1A, 0x8F, 0xFC, 0xEE, 0x05, 0xC0, 0xBD, 0xDF, 0x08, 0xC6}, // ISRG Root X1
+	{0x69, 0x72, 0x9B, 0x8E, 0x15, 0xA8, 0x6E, 0xFC, 0x17, 0x7A, 0x57, 0xAF, 0xB7, 0x17, 0x1D, 0xFC, 0x64, 0xAD, 0xD2, 0x8C, 0x2F, 0xCA, 0x8C, 0xF1, 0x50, 0x7E, 0x34, 0x45, 0x3C, 0xCB, 0x14, 0x70}, // ISRG Root X2
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x25, 0x30, 0xCC, 0x8E, 0x98, 0x32, 0x15, 0x02, 0xBA, 0xD9, 0x6F, 0x9B, 0x1F, 0xBA, 0x1B, 0x09, 0x9E, 0x2D, 0x29, 0x9E, 0x0F, 0x45, 0x48, 0xBB, 0x91, 0x4F, 0x36, 0x3B, 0xC0, 0xD4, 0x53, 0x1F}, // Izenpe.com
+	{0xD7, 0xA5, 0x51, 0x4D, 0x93, 0xF7, 0xD5, 0x3B, 0xD2, 0x7D, 0xE2, 0x05, 0x1F, 0x8E, 0x1F, 0xF7, 0x1C, 0xA0, 0xAC, 0x78, 0xFA, 0xCF, 0xE4, 0x6A, 0x8B, 0xCC, 0xA4, 0x58, 0x99, 0x84, 0xAE, 0x64}, // Japanese Government Root CA
+	{0x48, 0xE1, 0xCF, 0x9E, 0x43, 0xB6, 0x88, 0xA5, 0x10, 0x44, 0x16, 0x0F, 0x46, 0xD7, 0x73, 0xB8, 0x27, 0x7F, 0xE4, 0x5B, 0xEA, 0xAD, 0x0E, 0x4D, 0xF9, 0x0D, 0x19, 0x74, 0x38, 0x2F, 0xEA, 0x99}, // LAWtrust Root CA2 (4096)
+	{0x9B, 0x14, 0xE8, 0xF5, 0xF6, 0xEA, 0x16, 0x76, 0x66, 0xE7, 0x6D, 0xCD, 0x6B, 0xEC, 0xC1, 0x90, 0x86, 0x1D, 0x5E, 0x89, 0x70, 0xB9, 0x9A, 0x94, 0x70, 0xF0, 0x23, 0x12, 0x36, 0x04, 0x97, 0x04}, // LAWtrust Root Certification Authority 2048
+	{0x1C, 0xF3, 0x41, 0xAE, 0x35, 0x34, 0x1A, 0xC3, 0xAE, 0x1D, 0xC6, 0x8D, 0x5B, 0x10, 0xDC, 0x0C, 0x9D, 0xC1, 0x30, 0x76, 0x56, 0xF7, 0x5F, 0xD9, 0x2C, 0xA2, 0xC6, 0x84, 0x89, 0xD5, 0x2E, 0x9A}, // MOIS SSL Root CA
+	{0x60, 0x4D, 0x32, 0xD0, 0x36, 0x89, 0x5A, 0xED, 0x3B, 0xFE, 0xFA, 0xEB, 0x72, 0x7C, 0x00, 0x9E, 0xC0, 0xF2, 0xB3, 0xCD, 0xFA, 0x42, 0xA1, 0xC7, 0x17, 0x30, 0xE6, 0xA7, 0x2C, 0x3B, 0xE9, 0xD4}, // MULTICERT Root Certification Authority 01
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x35, 0x8D, 0xF3, 0x9D, 0x76, 0x4A, 0xF9, 0xE1, 0xB7, 0x66, 0xE9, 0xC9, 0x72, 0xDF, 0x35, 0x2E, 0xE1, 0x5C, 0xFA, 0xC2, 0x27, 0xAF, 0x6A, 0xD1, 0xD7, 0x0E, 0x8E, 0x4A, 0x6E, 0xDC, 0xBA, 0x02}, // Microsoft ECC Root Certificate Authority 2017
+	{0x53, 0x67, 0xF2, 0x0C, 0x7A, 0xDE, 0x0E, 0x2B, 0xCA, 0x79, 0x09, 0x15, 0x05, 0x6D, 0x08, 0x6B, 0x72, 0x0C, 0x33, 0xC1, 0xFA, 0x2A, 0x26, 0x61, 0xAC, 0xF7, 0x87, 0xE3, 0x29, 0x2E, 0x12, 0x70}, // Microsoft Identity Verification Root Certificate Authority 2020
+	{0xC7, 0x41, 0xF7, 0x0F, 0x4B, 0x2A, 0x8D, 0x88, 0xBF, 0x2E, 0x71, 0xC1, 0x41, 0x22, 0xEF, 0x53, 0xEF, 0x10, 0xEB, 0xA0, 0xCF, 0xA5, 0xE6, 0x4C, 0xFA, 0x20, 0xF4, 0x18, 0x85, 0x30, 0x73, 0xE0}, // Microsoft RSA Root Certificate Authority 2017
+	{0xA7, 0xC8, 0x68, 0x10, 0x42, 0xF3, 0x67, 0x5A, 0xA8, 0x50, 0x5D, 0x3B, 0xA3, 0x13, 0xD8, 0x0F, 0x8A, 0xC3, 0x25, 0x0F, 0xDF, 0x87, 0x4A, 0xD2, 0x9B, 0x83, 0x46, 0x89, 0xC0, 0x87, 0xFB, 0x11}, // NAVER Cloud Trust Services ECC Root G1
+	{0x49, 0xA2, 0x76, 0x29, 0x87, 0x78, 0x8D, 0x48, 0x34, 0xB3, 0x23, 0x05, 0xD7, 0x67, 0x76, 0x0F, 0x24, 0x4D, 0x50, 0x77, 0x42, 0xE8, 0xC2, 0x53, 0x9F, 0xD4, 0xCA, 0x3A, 0xD5, 0x2C, 0x16, 0xEE}, // NAVER Cloud Trust Services RSA Root G1
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6C, 0x61, 0xDA, 0xC3, 0xA2, 0xDE, 0xF0, 0x31, 0x50, 0x6B, 0xE0, 0x36, 0xD2, 0xA6, 0xFE, 0x40, 0x19, 0x94, 0xFB, 0xD1, 0x3D, 0xF9, 0xC8, 0xD4, 0x66, 0x59, 0x92, 0x74, 0xC4, 0x46, 0xEC, 0x98}, // NetLock Arany (Class Gold) Főtanúsítvány
+	{0xEB, 0x7E, 0x05, 0xAA, 0x58, 0xE7, 0xBD, 0x32, 0x8A, 0x28, 0x2B, 0xF8, 0x86, 0x70, 0x33, 0xF3, 0xC0, 0x35, 0x34, 0x2B, 0x51, 0x6E, 0xE8, 0x5C, 0x01, 0x67, 0x3D, 0xFF, 0xFF, 0xBB, 0xFE, 0x58}, // NetLock Platina (Class Platinum) Főtanúsítvány
+	{0x65, 0x35, 0x38, 0x33, 0xCF, 0x23, 0x4C, 0x79, 0x56, 0x21, 0x64, 0xF9, 0x08, 0x49, 0xC0, 0xD1, 0x04, 0xDB, 0xAB, 0xF8, 0xEE, 0x41, 0x06, 0x4D, 0x83, 0xE8, 0xCB, 0xE0, 0x3B, 0xA1, 0xC5, 0xA5}, // Netrust Root CA 2
+	{0xAA, 0xA1, 0x5B, 0xDD, 0xB1, 0xC9, 0x24, 0xAD, 0x7A, 0x7F, 0x22, 0xF8, 0x9E, 0x5C, 0xD3, 0xE2, 0xC6, 0x14, 0x07, 0xA0, 0x64, 0xE0, 0x11, 0xB7, 0xCF, 0x4C, 0xB6, 0x18, 0x71, 0x64, 0x49, 0xA4}, // Notarius Root Certificate Authority
+	{0xC7, 0xB8, 0x94, 0x8F, 0xEC, 0xCA, 0xAC, 0xE5, 0xB5, 0x09, 0xA3, 0x43, 0xF3, 0x8D, 0x03, 0x01, 0xD0, 0x79, 0x01, 0x88, 0x56, 0x04, 0xB3, 0xF2, 0x67, 0x27, 0x0E, 0x1E, 0xBB, 0xEF, 0x0F, 0xE7}, // Notarius Root Certificate Authority
+	{0x7A, 0x77, 0xC6, 0xC6, 0x1E, 0xEE, 0xB9, 0xAA, 0x65, 0xC4, 0xEA, 0x41, 0x0D, 0x65, 0xD8, 0x95, 0xB2, 0x6A, 0x81, 0x12, 0x32, 0x83, 0x00, 0x9D, 0xB1, 0x04, 0xB4, 0x8D, 0xE8, 0x0B, 0x24, 0x79}, // OATI WebCARES Root CA
+	{0x41, 0xC9, 0x23, 0x86, 0x6A, 0xB4, 0xCA, 0xD6, 0xB7, 0xAD, 0x57, 0x80, 0x81, 0x58, 0x2E, 0x02, 0x07, 0x97, 0xA6, 0xCB, 0xDF, 0x4F, 0xFF, 0x78, 0xCE, 0x83, 0x96, 0xB3, 0x89, 0x37, 0xD7, 0xF5}, // OISTE WISeKey Global Root GA CA
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0xAC, 0x7F, 0x78, 0x62, 0xE6, 0x85, 0xC7, 0xA7, 0xD9, 0x82, 0x6A, 0x58, 0xEA, 0x32, 0xD1, 0x83, 0xD4, 0x89, 0x3F, 0xCC, 0x8F, 0x8F, 0xD6, 0xD9, 0x00, 0xC9, 0x76, 0x9A, 0x98, 0x7E, 0x77, 0xF0}, // PostSignum Root QCA 4
+	{0xD3, 0xC0, 0x7A, 0xC4, 0x4B, 0xD8, 0xFF, 0x19, 0x75, 0xBC, 0x62, 0xF1, 0xC7, 0xE9, 0x84, 0x0E, 0xA8, 0xE1, 0x88, 0xA4, 0xBA, 0x51, 0x13, 0x3B, 0x8C, 0x4E, 0xFF, 0x05, 0xE3, 0x4A, 0x27, 0x29}, // QuoVadis Client ECC P384 Root G4
+	{0x80, 0xAC, 0x91, 0xFA, 0x89, 0x1C, 0x79, 0x72, 0x3A, 0x61, 0xAB, 0xC7, 0x7F, 0x86, 0xE1, 0x99, 0x05, 0xA9, 0x2D, 0xA2, 0x7E, 0x51, 0x69, 0x5C, 0xA0, 0xC0, 0xB6, 0x6C, 0x1C, 0x03, 0x9B, 0xF2}, // QuoVadis Client RSA 4096 Root G4
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x83, 0xFD, 0xDB, 0x2F, 0xD9, 0xDA, 0xE3, 0xB2, 0x1E, 0xEB, 0xD3, 0x3C, 0xF4, 0x62, 0x51, 0xD7, 0x46, 0xF0, 0xD6, 0x10, 0x2B, 0x68, 0x31, 0x50, 0xDD, 0x7B, 0x98, 0xAD, 0x8E, 0x4B, 0xB9, 0xF8}, // QuoVadis SMIME ECC P384 Root G4
+	{0x52, 0x32, 0xA3, 0x04, 0xAA, 0x4A, 0x10, 0xCF, 0xE6, 0xC4, 0x78, 0x42, 0xEA, 0x33, 0x81, 0xCB, 0x31, 0xD6, 0x19, 0xE2, 0x4F, 0x58, 0x12, 0x6D, 0x53, 0x4C, 0xD5, 0x0C, 0x5C, 0xE7, 0x84, 0x5D}, // QuoVadis SMIME RSA 4096 Root G4
+	{0x77, 0x15, 0x35, 0xD4, 0x3D, 0x46, 0x33, 0xBD, 0x30, 0x7E, 0xB7, 0xB8, 0xA3, 0x96, 0x6B, 0x5D, 0xF0, 0x07, 0x07, 0xC0, 0x88, 0x08, 0x99, 0x20, 0x08, 0x0C, 0x1A, 0xE6, 0xD3, 0xCB, 0x0F, 0x68}, // QuoVadis Signing ECC P384 Root G4
+	{0x9F, 0x8E, 0x6D, 0xB3, 0x1E, 0x74, 0x02, 0x85, 0xE0, 0xC2, 0xC2, 0xDE, 0xB0, 0x9E, 0x44, 0x2B, 0xDD, 0x4E, 0x74, 0xBD, 0xEE, 0xAE, 0x29, 0x62, 0xBC, 0x82, 0xD1, 0xEC, 0xB9, 0xF3, 0x98, 0x55}, // QuoVadis Signing RSA 4096 Root G4
+	{0x6E, 0x1F, 0xD3, 0xAE, 0x0D, 0x2D, 0x47, 0x7C, 0x8F, 0x5E, 0xE5, 0xF3, 0x35, 0xCC, 0x5B, 0x63, 0x56, 0x87, 0x26, 0x54, 0xE5, 0x35, 0x6A, 0x73, 0xD8, 0xC0, 0xA3, 0x0A, 0x17, 0xC2, 0x52, 0xA2}, // QuoVadis TLS ECC P384 Root G4
+	{0xC8, 0xA2, 0xD3, 0x8A, 0x24, 0xF5, 0xAC, 0x30, 0x2D, 0x8A, 0x08, 0xEB, 0xD3, 0x89, 0x23, 0xD9, 0xA7, 0x50, 0xB4, 0x92, 0x20, 0xF0, 0x92, 0xE8, 0x2D, 0x1C, 0x53, 0x24, 0x9E, 0x15, 0x33, 0xD0}, // QuoVadis TLS RSA 4096 Root G4
+	{0x46, 0x21, 0x9B, 0xBF, 0x91, 0x48, 0xF0, 0x0E, 0x8B, 0x7A, 0x4C, 0x61, 0x9B, 0x57, 0xCF, 0x76, 0x02, 0x70, 0x1F, 0xF8, 0x13, 0x48, 0x40, 0x07, 0x18, 0x87, 0x0F, 0xAB, 0xD3, 0x1F, 0xC5, 0xBE}, // SECOM Document Signing RSA Root CA 2023
+	{0x2C, 0x15, 0x42, 0x35, 0x52, 0x8D, 0x70, 0x17, 0x90, 0xB6, 0x75, 0xAF, 0xF6, 0xE1, 0x97, 0x08, 0x27, 0xB1, 0x0E, 0xD6, 0x65, 0xE9, 0x13, 0x83, 0x5B, 0xF4, 0x6E, 0x34, 0x60, 0xFD, 0x5C, 0x84}, // SECOM RSA Root CA 2023
+	{0x36, 0x29, 0xE7, 0x18, 0x8E, 0x00, 0xA7, 0xCB, 0x32, 0x32, 0xC4, 0x42, 0x6B, 0xC8, 0x49, 0x12, 0xF1, 0x21, 0x8B, 0x1A, 0x9A, 0xE6, 0x76, 0xC0, 0xB0, 0xAB, 0xE1, 0xDB, 0xFE, 0x21, 0x82, 0xB5}, // SECOM SMIME RSA Root CA 2024
+	{0x6A, 0xB2, 0xAB, 0x75, 0xF5, 0x1C, 0xB4, 0xF4, 0xF0, 0x15, 0x62, 0x03, 0xFB, 0xF6, 0xF6, 0x46, 0x23, 0x2F, 0x51, 0x4B, 0xE0, 0x59, 0xF6, 0x28, 0x33, 0x30, 0x8B, 0x82, 0xB4, 0xD7, 0x2D, 0xB1}, // SECOM TLS ECC Root CA 2024
+	{0x14, 0x35, 0xF2, 0x25, 0xC5, 0xD2, 0x52, 0xD7, 0xA2, 0x19, 0x48, 0xCC, 0x3C, 0xE6, 0x2A, 0xEC, 0xFA, 0x88, 0x00, 0x1E, 0x3D, 0xD7, 0x2D, 0x1C, 0xC3, 0x55, 0x51, 0x00, 0xEB, 0x37, 0x2F, 0x93}, // SECOM TLS RSA Root CA 2024
+	{0xFA, 0xD5, 0x40, 0x81, 0x1A, 0xFA, 0xE0, 0xDC, 0x76, 0x7C, 0xDF, 0x65, 0x72, 0xA0, 0x88, 0xFA, 0x3C, 0xE8, 0x49, 0x3D, 0xD8, 0x2B, 0x3B, 0x86, 0x9A, 0x67, 0xD1, 0x0A, 0xAB, 0x4E, 0x81, 0x24}, // SI-TRUST Root
+	{0xAD, 0x7D, 0xD5, 0x8D, 0x03, 0xAE, 0xDB, 0x22, 0xA3, 0x0B, 0x50, 0x84, 0x39, 0x49, 0x20, 0xCE, 0x12, 0x23, 0x0C, 0x2D, 0x80, 0x17, 0xAD, 0x9B, 0x81, 0xAB, 0x04, 0x07, 0x9B, 0xDD, 0x02, 0x6B}, // SSL.com Client ECC Root CA 2022
+	{0x1D, 0x4C, 0xA4, 0xA2, 0xAB, 0x21, 0xD0, 0x09, 0x36, 0x59, 0x80, 0x4F, 0xC0, 0xEB, 0x21, 0x75, 0xA6, 0x17, 0x27, 0x9B, 0x56, 0xA2, 0x47, 0x52, 0x45, 0xC9, 0x51, 0x7A, 0xFE, 0xB5, 0x91, 0x53}, // SSL.com Client RSA Root CA 2022
+	{0xE1, 0x7D, 0xB3, 0x96, 0xAF, 0xC1, 0x36, 0xFF, 0x1D, 0x6D, 0xCD, 0xA4, 0xBB, 0x44, 0x3D, 0xA2, 0xD8, 0x17, 0x13, 0x18, 0x55, 0xBE, 0x1C, 0x4B, 0x6F, 0xEC, 0xEA, 0x22, 0x10, 0x36, 0x72, 0x2A}, // SSL.com Code Signing ECC Root CA 2022
+	{0x25, 0x3E, 0x3C, 0xA3, 0x6E, 0x37, 0xE8, 0x67, 0xEE, 0x68, 0x86, 0x7B, 0x99, 0x7B, 0x9E, 0xC7, 0x24, 0xDC, 0xC3, 0x16, 0x10, 0x63, 0xAB, 0x03, 0x93, 0xB5, 0x4F, 0x2B, 0xB7, 0xB6, 0xC3, 0x15}, // SSL.com Code Signing RSA Root CA 2022
+	{0x5E, 0xCE, 0x6A, 0xB2, 0x71, 0x31, 0xFB, 0x58, 0xA8, 0x87, 0xE9, 0x41, 0x77, 0xD5, 0x5B, 0xD9, 0xE5, 0x1A, 0xAA, 0x2D, 0xED, 0x6C, 0x08, 0x1B, 0x54, 0xA3, 0x9B, 0xE3, 0xCB, 0x84, 0xE1, 0xF1}, // SSL.com Document Signing ECC Root CA 2022
+	{0xE8, 0x07, 0xD4, 0x9A, 0x85, 0x9A, 0xBB, 0xC8, 0x61, 0xC7, 0x2C, 0xA9, 0x07, 0xD4, 0x43, 0xAD, 0x0F, 0xE8, 0x7E, 0xAB, 0x9D, 0x99, 0xB9, 0x13, 0xF2, 0xCB, 0x5B, 0x4A, 0xAD, 0xAB, 0xE6, 0x41}, // SSL.com Document Signing RSA Root CA 2022
+	{0x22, 0xA2, 0xC1, 0xF7, 0xBD, 0xED, 0x70, 0x4C, 0xC1, 0xE7, 0x01, 0xB5, 0xF4, 0x08, 0xC3, 0x10, 0x88, 0x0F, 0xE9, 0x56, 0xB5, 0xDE, 0x2A, 0x4A, 0x44, 0xF9, 0x9C, 0x87, 0x3A, 0x25, 0xA7, 0xC8}, // SSL.com EV Root Certification Authority ECC
+	{0x2E, 0x7B, 0xF1, 0x6C, 0xC2, 0x24, 0x85, 0xA7, 0xBB, 0xE2, 0xAA, 0x86, 0x96, 0x75, 0x07, 0x61, 0xB0, 0xAE, 0x39, 0xBE, 0x3B, 0x2F, 0xE9, 0xD0, 0xCC, 0x6D, 0x4E, 0xF7, 0x34, 0x91, 0x42, 0x5C}, // SSL.com EV Root Certification Authority RSA R2
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xC3, 0x2F, 0xFD, 0x9F, 0x46, 0xF9, 0x36, 0xD1, 0x6C, 0x36, 0x73, 0x99, 0x09, 0x59, 0x43, 0x4B, 0x9A, 0xD6, 0x0A, 0xAF, 0xBB, 0x9E, 0x7C, 0xF3, 0x36, 0x54, 0xF1, 0x44, 0xCC, 0x1B, 0xA1, 0x43}, // SSL.com TLS ECC Root CA 2022
+	{0x8F, 0xAF, 0x7D, 0x2E, 0x2C, 0xB4, 0x70, 0x9B, 0xB8, 0xE0, 0xB3, 0x36, 0x66, 0xBF, 0x75, 0xA5, 0xDD, 0x45, 0xB5, 0xDE, 0x48, 0x0F, 0x8E, 0xA8, 0xD4, 0xBF, 0xE6, 0xBE, 0xBC, 0x17, 0xF2, 0xED}, // SSL.com TLS RSA Root CA 2022
+	{0xA1, 0x33, 0x9D, 0x33, 0x28, 0x1A, 0x0B, 0x56, 0xE5, 0x57, 0xD3, 0xD3, 0x2B, 0x1C, 0xE7, 0xF9, 0x36, 0x7E, 0xB0, 0x94, 0xBD, 0x5F, 0xA7, 0x2A, 0x7E, 0x50, 0x04, 0xC8, 0xDE, 0xD7, 0xCA, 0xFE}, // SZAFIR ROOT CA2
+	{0x34, 0xBB, 0x34, 0xE1, 0x4F, 0xAE, 0xD0, 0xD3, 0x39, 0x2F, 0x2F, 0xC4, 0x41, 0xC0, 0xEC, 0xD5, 0xFD, 0x88, 0xAD, 0x88, 0x11, 0x8D, 0xF2, 0xD1, 0xBA, 0x76, 0xCD, 0xEC, 0x1E, 0xEA, 0x10, 0xB8}, // Saudi National Root CA
+	{0x8F, 0x63, 0x71, 0xD8, 0xCC, 0x5A, 0xA7, 0xCA, 0x14, 0x96, 0x67, 0xA9, 0x8B, 0x54, 0x96, 0x39, 0x89, 0x51, 0xE4, 0x31, 0x9F, 0x7A, 0xFB, 0xCC, 0x6A, 0x66, 0x0D, 0x67, 0x3E, 0x43, 0x8D, 0x0B}, // Sectigo Public Code Signing Root E46
+	{0x7E, 0x76, 0x26, 0x0A, 0xE6, 0x9A, 0x55, 0xD3, 0xF0, 0x60, 0xB0, 0xFD, 0x18, 0xB2, 0xA8, 0xC0, 0x14, 0x43, 0xC8, 0x7B, 0x60, 0x79, 0x10, 0x30, 0xC9, 0xFA, 0x0B, 0x05, 0x85, 0x10, 0x1A, 0x38}, // Sectigo Public Code Signing Root R46
+	{0x01, 0xA1, 0xA0, 0x3E, 0x1F, 0x3D, 0xD7, 0xED, 0x3C, 0xCA, 0xDA, 0xE4, 0xD6, 0x68, 0xB4, 0x44, 0x60, 0x65, 0xDD, 0xF3, 0xB2, 0xDC, 0xF0, 0x5D, 0x1D, 0xAF, 0xFD, 0xB2, 0x59, 0x1B, 0x38, 0x38}, // Sectigo Public Document Signing Root E46
+	{0x43, 0xA6, 0x10, 0x38, 0x59, 0x7C, 0x31, 0xF4, 0xE7, 0xD7, 0x5D, 0xEE, 0xB8, 0x31, 0xE3, 0x84, 0x62, 0xD9, 0x68, 0x30, 0x8C, 0x68, 0xB5, 0xA0, 0x78, 0xF7, 0x19, 0x13, 0x97, 0x35, 0xDA, 0x89}, // Sectigo Public Document Signing Root R46
+	{0x22, 0xD9, 0x59, 0x92, 0x34, 0xD6, 0x0F, 0x1D, 0x4B, 0xC7, 0xC7, 0xE9, 0x6F, 0x43, 0xFA, 0x55, 0x5B, 0x07, 0x30, 0x1F, 0xD4, 0x75, 0x17, 0x50, 0x89, 0xDA, 0xFB, 0x8C, 0x25, 0xE4, 0x77, 0xB3}, // Sectigo Public Email Protection Root E46
+	{0xD5, 0x91, 0x7A, 0x77, 0x91, 0xEB, 0x7C, 0xF2, 0x0A, 0x2E, 0x57, 0xEB, 0x98, 0x28, 0x4A, 0x67, 0xB2, 0x8A, 0x57, 0xE8, 0x91, 0x82, 0xDA, 0x53, 0xD5, 0x46, 0x67, 0x8C, 0x9F, 0xDE, 0x2B, 0x4F}, // Sectigo Public Email Protection Root R46
+	{0xC9, 0x0F, 0x26, 0xF0, 0xFB, 0x1B, 0x40, 0x18, 0xB2, 0x22, 0x27, 0x51, 0x9B, 0x5C, 0xA2, 0xB5, 0x3E, 0x2C, 0xA5, 0xB3, 0xBE, 0x5C, 0xF1, 0x8E, 0xFE, 0x1B, 0xEF, 0x47, 0x38, 0x0C, 0x53, 0x83}, // Sectigo Public Server Authentication Root E46
+	{0x7B, 0xB6, 0x47, 0xA6, 0x2A, 0xEE, 0xAC, 0x88, 0xBF, 0x25, 0x7A, 0xA5, 0x22, 0xD0, 0x1F, 0xFE, 0xA3, 0x95, 0xE0, 0xAB, 0x45, 0xC7, 0x3F, 0x93, 0xF6, 0x56, 0x54, 0xEC, 0x38, 0xF2, 0x5A, 0x06}, // Sectigo Public Server Authentication Root R46
+	{0xE4, 0x4D, 0xDB, 0x79, 0x52, 0x26, 0x1F, 0x15, 0x00, 0x5C, 0xD6, 0x0C, 0x1D, 0x0C, 0x38, 0xC1, 0x8C, 0xBF, 0xD1, 0x7C, 0x27, 0x3A, 0x31, 0xF8, 0xED, 0x4C, 0x8F, 0x53, 0xE2, 0x68, 0x5F, 0x32}, // Sectigo Public Time Stamping Root E46
+	{0x49, 0x41, 0xB0, 0x01, 0xB8, 0xA9, 0x7E, 0x96, 0x1B, 0x78, 0x17, 0xC9, 0xD9, 0xE9, 0x60, 0xEC, 0x4B, 0x05, 0x6B, 0xFC, 0x91, 0x5A, 0x8C, 0x1A, 0xAB, 0xF6, 0xEF, 0x6B, 0x3A, 0xC0, 0x46, 0xA5}, // Sectigo Public Time Stamping Root R46
+	{0x42, 0x00, 0xF5, 0x04, 0x3A, 0xC8, 0x59, 0x0E, 0xBB, 0x52, 0x7D, 0x20, 0x9E, 0xD1, 0x50, 0x30, 0x29, 0xFB, 0xCB, 0xD4, 0x1C, 0xA1, 0xB5, 0x06, 0xEC, 0x27, 0xF1, 0x5A, 0xDE, 0x7D, 0xAC, 0x69}, // Secure Global CA
+	{0x4B, 0x00, 0x9C, 0x10, 0x34, 0x49, 0x4F, 0x9A, 0xB5, 0x6B, 0xBA, 0x3B, 0xA1, 0xD6, 0x27, 0x31, 0xFC, 0x4D, 0x20, 0xD8, 0x95, 0x5A, 0xDC, 0xEC, 0x10, 0xA9, 0x25, 0x60, 0x72, 0x61, 0xE3, 0x38}, // SecureSign Root CA14
+	{0xE7, 0x78, 0xF0, 0xF0, 0x95, 0xFE, 0x84, 0x37, 0x29, 0xCD, 0x1A, 0x00, 0x82, 0x17, 0x9E, 0x53, 0x14, 0xA9, 0xC2, 0x91, 0x44, 0x28, 0x05, 0xE1, 0xFB, 0x1D, 0x8F, 0xB6, 0xB8, 0x88, 0x6C, 0x3A}, // SecureSign Root CA15
+	{0x4C, 0x1C, 0xCD, 0x24, 0xF1, 0x7E, 0x95, 0x0F, 0xC1, 0x85, 0x36, 0xB3, 0x3C, 0xAF, 0xE3, 0x22, 0x93, 0xCF, 0xC3, 0x3E, 0x84, 0x67, 0xB4, 0x1E, 0x1C, 0x69, 0x30, 0x55, 0xD7, 0xF5, 0x13, 0xBF}, // SecureSign Root CA16
+	{0xF1, 0xC1, 0xB5, 0x0A, 0xE5, 0xA2, 0x0D, 0xD8, 0x03, 0x0E, 0xC9, 0xF6, 0xBC, 0x24, 0x82, 0x3D, 0xD3, 0x67, 0xB5, 0x25, 0x57, 0x59, 0xB4, 0xE7, 0x1B, 0x61, 0xFC, 0xE9, 0xF7, 0x37, 0x5D, 0x73}, // SecureTrust CA
+	{0xE7, 0x4F, 0xBD, 0xA5, 0x5B, 0xD5, 0x64, 0xC4, 0x73, 0xA3, 0x6B, 0x44, 0x1A, 0xA7, 0x99, 0xC8, 0xA6, 0x8E, 0x07, 0x74, 0x40, 0xE8, 0x28, 0x8B, 0x9F, 0xA1, 0xE5, 0x0E, 0x4B, 0xBA, 0xCA, 0x11}, // Security Communication ECC RootCA1
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x24, 0xA5, 0x5C, 0x2A, 0xB0, 0x51, 0x44, 0x2D, 0x06, 0x17, 0x76, 0x65, 0x41, 0x23, 0x9A, 0x4A, 0xD0, 0x32, 0xD7, 0xC5, 0x51, 0x75, 0xAA, 0x34, 0xFF, 0xDE, 0x2F, 0xBC, 0x4F, 0x5C, 0x52, 0x94}, // Security Communication RootCA3
+	{0x3C, 0x4F, 0xB0, 0xB9, 0x5A, 0xB8, 0xB3, 0x00, 0x32, 0xF4, 0x32, 0xB8, 0x6F, 0x53, 0x5F, 0xE1, 0x72, 0xC1, 0x85, 0xD0, 0xFD, 0x39, 0x86, 0x58, 0x37, 0xCF, 0x36, 0x18, 0x7F, 0xA6, 0xF4, 0x28}, // Staat der Nederlanden Root CA - G3
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x2C, 0xE1, 0xCB, 0x0B, 0xF9, 0xD2, 0xF9, 0xE1, 0x02, 0x99, 0x3F, 0xBE, 0x21, 0x51, 0x52, 0xC3, 0xB2, 0xDD, 0x0C, 0xAB, 0xDE, 0x1C, 0x68, 0xE5, 0x31, 0x9B, 0x83, 0x91, 0x54, 0xDB, 0xB7, 0xF5}, // Starfield Root Certificate Authority - G2
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x8F, 0x9A, 0xDB, 0x6D, 0x89, 0x5D, 0xAB, 0x5A, 0xDF, 0x5C, 0x3D, 0x3F, 0xAB, 0x83, 0x92, 0x7B, 0xE0, 0xFB, 0x64, 0xEF, 0x82, 0x48, 0x5C, 0x62, 0x28, 0x0D, 0x58, 0x4E, 0x8B, 0xD5, 0x5D, 0x22}, // Swedish Government Root Authority v3
+	{0x6E, 0xC6, 0x61, 0x4E, 0x9A, 0x8E, 0xFD, 0x47, 0xD6, 0x31, 0x8F, 0xFD, 0xFD, 0x0B, 0xF6, 0x5B, 0x49, 0x3A, 0x14, 0x1F, 0x77, 0xC3, 0x8D, 0x0B, 0x31, 0x9B, 0xE1, 0xBB, 0xBC, 0x05, 0x3D, 0xD2}, // Swiss Government Root CA I
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0x9A, 0x12, 0xC3, 0x92, 0xBF, 0xE5, 0x78, 0x91, 0xA0, 0xC5, 0x45, 0x30, 0x9D, 0x4D, 0x9F, 0xD5, 0x67, 0xE4, 0x80, 0xCB, 0x61, 0x3D, 0x63, 0x42, 0x27, 0x8B, 0x19, 0x5C, 0x79, 0xA7, 0x93, 0x1F}, // SwissSign RSA SMIME Root CA 2022 - 1
+	{0x19, 0x31, 0x44, 0xF4, 0x31, 0xE0, 0xFD, 0xDB, 0x74, 0x07, 0x17, 0xD4, 0xDE, 0x92, 0x6A, 0x57, 0x11, 0x33, 0x88, 0x4B, 0x43, 0x60, 0xD3, 0x0E, 0x27, 0x29, 0x13, 0xCB, 0xE6, 0x60, 0xCE, 0x41}, // SwissSign RSA TLS Root CA 2022 - 1
+	{0x9D, 0x19, 0x0B, 0x2E, 0x31, 0x45, 0x66, 0x68, 0x5B, 0xE8, 0xA8, 0x89, 0xE2, 0x7A, 0xA8, 0xC7, 0xD7, 0xAE, 0x1D, 0x8A, 0xAD, 0xDB, 0xA3, 0xC1, 0xEC, 0xF9, 0xD2, 0x48, 0x63, 0xCD, 0x34, 0xB9}, // Symantec Class 1 Public Primary Certification Authority - G6
+	{0xCB, 0x62, 0x7D, 0x18, 0xB5, 0x8A, 0xD5, 0x6D, 0xDE, 0x33, 0x1A, 0x30, 0x45, 0x6B, 0xC6, 0x5C, 0x60, 0x1A, 0x4E, 0x9B, 0x18, 0xDE, 0xDC, 0xEA, 0x08, 0xE7, 0xDA, 0xAA, 0x07, 0x81, 0x5F, 0xF0}, // Symantec Class 2 Public Primary Certification Authority - G6
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0xFD, 0x73, 0xDA, 0xD3, 0x1C, 0x64, 0x4F, 0xF1, 0xB4, 0x3B, 0xEF, 0x0C, 0xCD, 0xDA, 0x96, 0x71, 0x0B, 0x9C, 0xD9, 0x87, 0x5E, 0xCA, 0x7E, 0x31, 0x70, 0x7A, 0xF3, 0xE9, 0x6D, 0x52, 0x2B, 0xBD}, // T-TeleSec GlobalRoot Class 3
+	{0x46, 0xED, 0xC3, 0x68, 0x90, 0x46, 0xD5, 0x3A, 0x45, 0x3F, 0xB3, 0x10, 0x4A, 0xB8, 0x0D, 0xCA, 0xEC, 0x65, 0x8B, 0x26, 0x60, 0xEA, 0x16, 0x29, 0xDD, 0x7E, 0x86, 0x79, 0x90, 0x64, 0x87, 0x16}, // TUBITAK Kamu SM SSL Kok Sertifikasi - Surum 1
+	{0x3F, 0x63, 0xBB, 0x28, 0x14, 0xBE, 0x17, 0x4E, 0xC8, 0xB6, 0x43, 0x9C, 0xF0, 0x8D, 0x6D, 0x56, 0xF0, 0xB7, 0xC4, 0x05, 0x88, 0x3A, 0x56, 0x48, 0xA3, 0x34, 0x42, 0x4D, 0x6B, 0x3E, 0xC5, 0x58}, // TWCA CYBER Root CA
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0x3A, 0x00, 0x72, 0xD4, 0x9F, 0xFC, 0x04, 0xE9, 0x96, 0xC5, 0x9A, 0xEB, 0x75, 0x99, 0x1D, 0x3C, 0x34, 0x0F, 0x36, 0x15, 0xD6, 0xFD, 0x4D, 0xCE, 0x90, 0xAC, 0x0B, 0x3D, 0x88, 0xEA, 0xD4, 0xF4}, // TWCA Global Root CA G2
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x3A, 0xE6, 0xDF, 0x7E, 0x0D, 0x63, 0x7A, 0x65, 0xA8, 0xC8, 0x16, 0x12, 0xEC, 0x6F, 0x9A, 0x14, 0x2F, 0x85, 0xA1, 0x68, 0x34, 0xC1, 0x02, 0x80, 0xD8, 0x8E, 0x70, 0x70, 0x28, 0x51, 0x87, 0x55}, // Telekom Security SMIME ECC Root 2021
+	{0x78, 0xA6, 0x56, 0x34, 0x4F, 0x94, 0x7E, 0x9C, 0xC0, 0xF7, 0x34, 0xD9, 0x05, 0x3D, 0x32, 0xF6, 0x74, 0x20, 0x86, 0xB6, 0xB9, 0xCD, 0x2C, 0xAE, 0x4F, 0xAE, 0x1A, 0x2E, 0x4E, 0xFD, 0xE0, 0x48}, // Telekom Security SMIME RSA Root 2023
+	{0x57, 0x8A, 0xF4, 0xDE, 0xD0, 0x85, 0x3F, 0x4E, 0x59, 0x98, 0xDB, 0x4A, 0xEA, 0xF9, 0xCB, 0xEA, 0x8D, 0x94, 0x5F, 0x60, 0xB6, 0x20, 0xA3, 0x8D, 0x1A, 0x3C, 0x13, 0xB2, 0xBC, 0x7B, 0xA8, 0xE1}, // Telekom Security TLS ECC Root 2020
+	{0xEF, 0xC6, 0x5C, 0xAD, 0xBB, 0x59, 0xAD, 0xB6, 0xEF, 0xE8, 0x4D, 0xA2, 0x23, 0x11, 0xB3, 0x56, 0x24, 0xB7, 0x1B, 0x3B, 0x1E, 0xA0, 0xDA, 0x8B, 0x66, 0x55, 0x17, 0x4E, 0xC8, 0x97, 0x86, 0x46}, // Telekom Security TLS RSA Root 2023
+	{0xCC, 0xF6, 0xA7, 0xC3, 0x88, 0x19, 0x28, 0xCF, 0xB1, 0xDB, 0xEB, 0xD7, 0x4E, 0xAB, 0xA5, 0x0D, 0x3B, 0xC6, 0x96, 0x33, 0xC1, 0x06, 0x27, 0x4D, 0x2C, 0x22, 0x1E, 0xCC, 0xEE, 0x69, 0x7E, 0x87}, // Telia EC Client Root CA v3
+	{0x36, 0x82, 0x22, 0x8D, 0x7D, 0x67, 0x8B, 0x57, 0x14, 0x40, 0xCF, 0x1C, 0xB3, 0x4E, 0x69, 0xFB, 0x41, 0x35, 0xFD, 0x6C, 0x2A, 0x1B, 0xE3, 0x8E, 0x14, 0x16, 0x3B, 0x71, 0x1E, 0x02, 0xAE, 0x01}, // Telia EC Email Root CA v3
+	{0x2C, 0x4E, 0x9B, 0x0F, 0x8D, 0x0F, 0xCD, 0xC3, 0x08, 0xC0, 0x77, 0xE3, 0x0D, 0xD2, 0x34, 0x9D, 0xAF, 0x87, 0x1F, 0xB3, 0xA3, 0x72, 0x14, 0x85, 0x9C, 0x48, 0x61, 0x89, 0xA9, 0xF8, 0x0A, 0xDB}, // Telia EC Signing Root CA v3
+	{0x09, 0x8E, 0x08, 0xA9, 0x1D, 0xBB, 0xF7, 0x74, 0x78, 0xB9, 0x6C, 0xCE, 0xB8, 0x9B, 0x14, 0x13, 0xA5, 0xDA, 0x37, 0xB7, 0xC8, 0x62, 0x60, 0x6A, 0x95, 0x5D, 0xEB, 0x07, 0x17, 0x9F, 0x43, 0x26}, // Telia EC TLS Root CA v3
+	{0x64, 0x85, 0xF6, 0xE0, 0x60, 0x93, 0x4A, 0xF4, 0x13, 0xEC, 0x54, 0xB9, 0xC6, 0x5F, 0x87, 0xBD, 0x23, 0x19, 0x29, 0xEC, 0xFA, 0x11, 0xDE, 0x52, 0x2B, 0xAD, 0x8D, 0xC6, 0x4F, 0x00, 0x15, 0x71}, // Telia RSA Client Root CA v3
+	{0x5B, 0x0C, 0x50, 0x2A, 0x7D, 0x96, 0x3B, 0xA5, 0x52, 0x17, 0x39, 0x6F, 0xDA, 0x9B, 0x3D, 0xC7, 0x81, 0x71, 0x00, 0x0A, 0xEE, 0xFF, 0x42, 0xCE, 0xCC, 0x3A, 0x20, 0xA7, 0x93, 0x81, 0x63, 0xE8}, // Telia RSA Email Root CA v3
+	{0xC2, 0xFB, 0x44, 0xD8, 0xDE, 0xF8, 0x51, 0x26, 0xD8, 0x4F, 0xC4, 0x1F, 0xBE, 0x66, 0x7B, 0xC8, 0x11, 0xF0, 0xD6, 0x33, 0x41, 0x1C, 0xAC, 0x26, 0xD1, 0x3F, 0xBD, 0x95, 0xEA, 0x5B, 0xE6, 0x03}, // Telia RSA Signing Root CA v3
+	{0xD1, 0x3D, 0xB1, 0x29, 0x4C, 0x45, 0xEB, 0xC6, 0xFC, 0x86, 0xC6, 0xBB, 0xF6, 0x9F, 0xA2, 0x9B, 0xDF, 0xE6, 0x92, 0xDF, 0xF7, 0xC7, 0x13, 0xC2, 0x43, 0xC7, 0xA9, 0x56, 0xC6, 0xA2, 0x28, 0x4C}, // Telia RSA TLS Root CA v3
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xDD, 0x69, 0x36, 0xFE, 0x21, 0xF8, 0xF0, 0x77, 0xC1, 0x23, 0xA1, 0xA5, 0x21, 0xC1, 0x22, 0x24, 0xF7, 0x22, 0x55, 0xB7, 0x3E, 0x03, 0xA7, 0x26, 0x06, 0x93, 0xE8, 0xA2, 0x4B, 0x0F, 0xA3, 0x89}, // TeliaSonera Root CA v1
+	{0x2A, 0x8D, 0xA2, 0xF8, 0xD2, 0x3E, 0x0C, 0xD3, 0xB5, 0x87, 0x1E, 0xCF, 0xB0, 0xF4, 0x22, 0x76, 0xCA, 0x73, 0x23, 0x06, 0x67, 0xF4, 0x74, 0xEE, 0xDE, 0x71, 0xC5, 0xEE, 0x32, 0xCC, 0x3E, 0xC6}, // Thailand National Root Certification Authority - G1
+	{0x92, 0xC4, 0xDB, 0x06, 0xC4, 0x2A, 0x13, 0x0D, 0x66, 0x35, 0x74, 0xD7, 0x74, 0x1B, 0x7F, 0x93, 0xC8, 0x06, 0xFD, 0x67, 0x14, 0xDC, 0xE8, 0x90, 0xE8, 0x4B, 0x56, 0x8F, 0xAE, 0x86, 0xE6, 0x4C}, // TrustFactory Client Root Certificate Authority
+	{0x97, 0x55, 0x20, 0x15, 0xF5, 0xDD, 0xFC, 0x3C, 0x87, 0x88, 0xC0, 0x06, 0x94, 0x45, 0x55, 0x40, 0x88, 0x94, 0x45, 0x00, 0x84, 0xF1, 0x00, 0x86, 0x70, 0x86, 0xBC, 0x1A, 0x2B, 0xB5, 0x8D, 0xC8}, // Trustwave Global Certification Authority
+	{0x94, 0x5B, 0xBC, 0x82, 0x5E, 0xA5, 0x54, 0xF4, 0x89, 0xD1, 0xFD, 0x51, 0xA7, 0x3D, 0xDF, 0x2E, 0xA6, 0x24, 0xAC, 0x70, 0x19, 0xA0, 0x52, 0x05, 0x22, 0x5C, 0x22, 0xA7, 0x8C, 0xCF, 0xA8, 0xB4}, // Trustwave Global ECC P256 Certification Authority
+	{0x55, 0x90, 0x38, 0x59, 0xC8, 0xC0, 0xC3, 0xEB, 0xB8, 0x75, 0x9E, 0xCE, 0x4E, 0x25, 0x57, 0x22, 0x5F, 0xF5, 0x75, 0x8B, 0xBD, 0x38, 0xEB, 0xD4, 0x82, 0x76, 0x60, 0x1E, 0x1B, 0xD5, 0x80, 0x97}, // Trustwave Global ECC P384 Certification Authority
+	{0x2E, 0x44, 0x10, 0x2A, 0xB5, 0x8C, 0xB8, 0x54, 0x19, 0x45, 0x1C, 0x8E, 0x19, 0xD9, 0xAC, 0xF3, 0x66, 0x2C, 0xAF, 0xBC, 0x61, 0x4B, 0x6A, 0x53, 0x96, 0x0A, 0x30, 0xF7, 0xD0, 0xE2, 0xEB, 0x41}, // TunTrust Root CA
+	{0xD4, 0x3A, 0xF9, 0xB3, 0x54, 0x73, 0x75, 0x5C, 0x96, 0x84, 0xFC, 0x06, 0xD7, 0xD8, 0xCB, 0x70, 0xEE, 0x5C, 0x28, 0xE7, 0x73, 0xFB, 0x29, 0x4E, 0xB4, 0x1E, 0xE7, 0x17, 0x22, 0x92, 0x4D, 0x24}, // UCA Extended Validation Root
+	{0x9B, 0xEA, 0x11, 0xC9, 0x76, 0xFE, 0x01, 0x47, 0x64, 0xC1, 0xBE, 0x56, 0xA6, 0xF9, 0x14, 0xB5, 0xA5, 0x60, 0x31, 0x7A, 0xBD, 0x99, 0x88, 0x39, 0x33, 0x82, 0xE5, 0x16, 0x1A, 0xA0, 0x49, 0x3C}, // UCA Global G2 Root
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0x34, 0xFF, 0x2A, 0x44, 0x09, 0xDC, 0x13, 0x83, 0xE9, 0xF8, 0x96, 0x6E, 0x8A, 0xDF, 0xE5, 0x71, 0x9E, 0xBA, 0x37, 0x3F, 0xD0, 0xAD, 0x5E, 0x2F, 0x49, 0xF9, 0x0E, 0xE0, 0x7C, 0xF5, 0xD4, 0xC1}, // VRK Gov. Root CA - G2
+	{0xCB, 0xB5, 0xAF, 0x18, 0x5E, 0x94, 0x2A, 0x24, 0x02, 0xF9, 0xEA, 0xCB, 0xC0, 0xED, 0x5B, 0xB8, 0x76, 0xEE, 0xA3, 0xC1, 0x22, 0x36, 0x23, 0xD0, 0x04, 0x47, 0xE4, 0xF3, 0xBA, 0x55, 0x4B, 0x65}, // VeriSign Class 1 Public Primary Certification Authority - G3
+	{0x92, 0xA9, 0xD9, 0x83, 0x3F, 0xE1, 0x94, 0x4D, 0xB3, 0x66, 0xE8, 0xBF, 0xAE, 0x7A, 0x95, 0xB6, 0x48, 0x0C, 0x2D, 0x6C, 0x6C, 0x2A, 0x1B, 0xE6, 0x5D, 0x42, 0x36, 0xB6, 0x08, 0xFC, 0xA1, 0xBB}, // VeriSign Class 2 Public Primary Certification Authority - G3
+	{0x23, 0x99, 0x56, 0x11, 0x27, 0xA5, 0x71, 0x25, 0xDE, 0x8C, 0xEF, 0xEA, 0x61, 0x0D, 0xDF, 0x2F, 0xA0, 0x78, 0xB5, 0xC8, 0x06, 0x7F, 0x4E, 0x82, 0x82, 0x90, 0xBF, 0xB8, 0x60, 0xE8, 0x4B, 0x3C}, // VeriSign Universal Root Certification Authority
+	{0x68, 0xAD, 0x50, 0x90, 0x9B, 0x04, 0x36, 0x3C, 0x60, 0x5E, 0xF1, 0x35, 0x81, 0xA9, 0x39, 0xFF, 0x2C, 0x96, 0x37, 0x2E, 0x3F, 0x12, 0x32, 0x5B, 0x0A, 0x68, 0x61, 0xE1, 0xD5, 0x9F, 0x66, 0x03}, // Verizon Global Root CA
+	{0xC5, 0x7A, 0x3A, 0xCB, 0xE8, 0xC0, 0x6B, 0xA1, 0x98, 0x8A, 0x83, 0x48, 0x5B, 0xF3, 0x26, 0xF2, 0x44, 0x87, 0x75, 0x37, 0x98, 0x49, 0xDE, 0x01, 0xCA, 0x43, 0x57, 0x1A, 0xF3, 0x57, 0xE7, 0x4B}, // Visa Information Delivery Root CA
+	{0xE6, 0xBE, 0x68, 0xCE, 0x06, 0xFE, 0x0D, 0xA0, 0xC1, 0x40, 0xF1, 0xAE, 0xB0, 0x0B, 0x67, 0xB6, 0x36, 0xC5, 0xEE, 0xA9, 0x42, 0x20, 0x88, 0x92, 0x93, 0x62, 0x37, 0x5C, 0xE0, 0x86, 0xDB, 0x39}, // Visa Public ECC Root CA
+	{0x07, 0xCD, 0x9A, 0xA9, 0x06, 0x4A, 0x9B, 0x94, 0xC6, 0xAE, 0xF8, 0xFB, 0x78, 0x4C, 0x1B, 0xBC, 0x1B, 0xED, 0xA0, 0x8A, 0xCB, 0xE8, 0x68, 0x78, 0xD7, 0x81, 0xA3, 0x91, 0x67, 0x62, 0x6C, 0xF8}, // Visa Public RSA Root CA
+	{0xCE, 0xCD, 0xDC, 0x90, 0x50, 0x99, 0xD8, 0xDA, 0xDF, 0xC5, 0xB1, 0xD2, 0x09, 0xB7, 0x37, 0xCB, 0xE2, 0xC1, 0x8C, 0xFB, 0x2C, 0x10, 0xC0, 0xFF, 0x0B, 0xCF, 0x0D, 0x32, 0x86, 0xFC, 0x1A, 0xA2}, // XRamp Global Certification Authority
+	{0x01, 0x6E, 0x1D, 0xCD, 0x5F, 0x78, 0x84, 0x1B, 0xBE, 0xBB, 0xAE, 0x9D, 0xDE, 0xA0, 0x8C, 0x8D, 0x7E, 0xC5, 0x4E, 0x69, 0x8E, 0x95, 0xBB, 0x77, 0x8E, 0xCD, 0xD1, 0xE1, 0x0D, 0x8B, 0xF4, 0xF9}, // ZETES TSP ROOT CA 001
+	{0xEA, 0xA9, 0x62, 0xC4, 0xFA, 0x4A, 0x6B, 0xAF, 0xEB, 0xE4, 0x15, 0x19, 0x6D, 0x35, 0x1C, 0xCD, 0x88, 0x8D, 0x4F, 0x53, 0xF3, 0xFA, 0x8A, 0xE6, 0xD7, 0xC4, 0x66, 0xA9, 0x4E, 0x60, 0x42, 0xBB}, // certSIGN ROOT CA
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xBE, 0xB0, 0x0B, 0x30, 0x83, 0x9B, 0x9B, 0xC3, 0x2C, 0x32, 0xE4, 0x44, 0x79, 0x05, 0x95, 0x06, 0x41, 0xF2, 0x64, 0x21, 0xB1, 0x5E, 0xD0, 0x89, 0x19, 0x8B, 0x51, 0x8A, 0xE2, 0xEA, 0x1B, 0x99}, // e-Szigno Root CA 2017
+	{0xB4, 0x91, 0x41, 0x50, 0x2D, 0x00, 0x66, 0x3D, 0x74, 0x0F, 0x2E, 0x7E, 0xC3, 0x40, 0xC5, 0x28, 0x00, 0x96, 0x26, 0x66, 0x12, 0x1A, 0x36, 0xD0, 0x9C, 0xF7, 0xDD, 0x2B, 0x90, 0x38, 0x4F, 0xB4}, // e-Szigno TLS Root CA 2023
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0x1E, 0x51, 0x94, 0x2B, 0x84, 0xFD, 0x46, 0x7B, 0xF7, 0x7D, 0x1C, 0x89, 0xDA, 0x24, 0x1C, 0x04, 0x25, 0x4D, 0xC8, 0xF3, 0xEF, 0x4C, 0x22, 0x45, 0x1F, 0xE7, 0xA8, 0x99, 0x78, 0xBD, 0xCD, 0x4F}, // ePKI Root Certification Authority - G2
+	{0x19, 0xA2, 0xFA, 0x09, 0x33, 0x2C, 0x6D, 0x8E, 0xAC, 0x13, 0x93, 0xD5, 0xF3, 0x03, 0x71, 0xDD, 0x8B, 0x4D, 0xD6, 0x87, 0xB0, 0xE1, 0xE5, 0x0A, 0x6B, 0x48, 0xAE, 0x76, 0x2C, 0xAB, 0xA2, 0xB5}, // ePKI Root Certification Authority - G4
+	{0x0E, 0x30, 0xD0, 0x4A, 0x94, 0xDC, 0xC4, 0x23, 0xE2, 0x6F, 0xDC, 0x4C, 0x24, 0xAF, 0xCF, 0x49, 0x23, 0xBD, 0x80, 0xD8, 0x3B, 0x66, 0x1B, 0x06, 0xA2, 0xF5, 0x85, 0x63, 0x61, 0x56, 0x04, 0x07}, // eSignTrust Root Certification Authority (G03)
+	{0xBC, 0x4D, 0x80, 0x9B, 0x15, 0x18, 0x9D, 0x78, 0xDB, 0x3E, 0x1D, 0x8C, 0xF4, 0xF9, 0x72, 0x6A, 0x79, 0x5D, 0xA1, 0x64, 0x3C, 0xA5, 0xF1, 0x35, 0x8E, 0x1D, 0xDB, 0x0E, 0xDC, 0x0D, 0x7E, 0xB3}, // emSign ECC Root CA - C3
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x12, 0x56, 0x09, 0xAA, 0x30, 0x1D, 0xA0, 0xA2, 0x49, 0xB9, 0x7A, 0x82, 0x39, 0xCB, 0x6A, 0x34, 0x21, 0x6F, 0x44, 0xDC, 0xAC, 0x9F, 0x39, 0x54, 0xB1, 0x42, 0x92, 0xF2, 0xE8, 0xC8, 0x60, 0x8F}, // emSign Root CA - C1
+	{0x46, 0xCD, 0x08, 0x3B, 0x47, 0xE8, 0x04, 0x02, 0x02, 0x8D, 0xF4, 0x93, 0x96, 0x0E, 0xA1, 0x9C, 0x85, 0xFE, 0x85, 0x19, 0x50, 0xD5, 0x16, 0x5F, 0x1C, 0x7D, 0xA4, 0xFA, 0xA9, 0x51, 0xE2, 0xF8}, // emSign Root CA - C2
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+	{0x1A, 0xA0, 0xC2, 0x70, 0x9E, 0x83, 0x1B, 0xD6, 0xE3, 0xB5, 0x12, 0x9A, 0x00, 0xBA, 0x41, 0xF7, 0xEE, 0xEF, 0x02, 0x08, 0x72, 0xF1, 0xE6, 0x50, 0x4B, 0xF0, 0xF6, 0xC3, 0xF2, 0x4F, 0x3A, 0xF3}, // emSign Root CA - G2
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Microsoft root store and capable of issuing TLS certificates.
+var MicrosoftTLS = [][sha256.Size]byte{
+	{0x8A, 0xC5, 0x52, 0xAD, 0x57, 0x7E, 0x37, 0xAD, 0x2C, 0x68, 0x08, 0xD7, 0x2A, 0xA3, 0x31, 0xD6, 0xA9, 0x6B, 0x4B, 0x3F, 0xEB, 0xFF, 0x34, 0xCE, 0x9B, 0xC0, 0x57, 0x8E, 0x08, 0x05, 0x5E, 0xC3}, // A-Trust-Root-07
+	{0x7A, 0x38, 0xF7, 0x08, 0xA3, 0x5A, 0x31, 0xE4, 0x2E, 0x1C, 0xF3, 0x22, 0x0F, 0x9A, 0x2D, 0x27, 0x3E, 0x76, 0x66, 0x35, 0x46, 0x18, 0xB2, 0x46, 0x46, 0x57, 0xD4, 0x3D, 0x8E, 0x77, 0xAD, 0xC2}, // A-Trust-Root-09
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x55, 0x41, 0x53, 0xB1, 0x3D, 0x2C, 0xF9, 0xDD, 0xB7, 0x53, 0xBF, 0xBE, 0x1A, 0x4E, 0x0A, 0xE0, 0x8D, 0x0A, 0xA4, 0x18, 0x70, 0x58, 0xFE, 0x60, 0xA2, 0xB8, 0x62, 0xB2, 0xE4, 0xB8, 0x7B, 0xCB}, // AC RAIZ FNMT-RCM SERVIDORES SEGUROS
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x03, 0x76, 0xAB, 0x1D, 0x54, 0xC5, 0xF9, 0x80, 0x3C, 0xE4, 0xB2, 0xE2, 0x01, 0xA0, 0xEE, 0x7E, 0xEF, 0x7B, 0x57, 0xB6, 0x36, 0xE8, 0xA9, 0x3C, 0x9B, 0x8D, 0x48, 0x60, 0xC9, 0x6F, 0x5F, 0xA7}, // AffirmTrust Commercial
+	{0x0A, 0x81, 0xEC, 0x5A, 0x92, 0x97, 0x77, 0xF1, 0x45, 0x90, 0x4A, 0xF3, 0x8D, 0x5D, 0x50, 0x9F, 0x66, 0xB5, 0xE2, 0xC5, 0x8F, 0xCD, 0xB5, 0x31, 0x05, 0x8B, 0x0E, 0x17, 0xF3, 0xF0, 0xB4, 0x1B}, // AffirmTrust Networking
+	{0x70, 0xA7, 0x3F, 0x7F, 0x37, 0x6B, 0x60, 0x07, 0x42, 0x48, 0x90, 0x45, 0x34, 0xB1, 0x14, 0x82, 0xD5, 0xBF, 0x0E, 0x69, 0x8E, 0xCC, 0x49, 0x8D, 0xF5, 0x25, 0x77, 0xEB, 0xF2, 0xE9, 0x3B, 0x9A}, // AffirmTrust Premium
+	{0xBD, 0x71, 0xFD, 0xF6, 0xDA, 0x97, 0xE4, 0xCF, 0x62, 0xD1, 0x64, 0x7A, 0xDD, 0x25, 0x81, 0xB0, 0x7D, 0x79, 0xAD, 0xF8, 0x39, 0x7E, 0xB4, 0xEC, 0xBA, 0x9C, 0x5E, 0x84, 0x88, 0x82, 0x14, 0x23}, // AffirmTrust Premium ECC
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xB2, 0xFA, 0xE5, 0x3E, 0x14, 0xCC, 0xD7, 0xAB, 0x92, 0x12, 0x06, 0x47, 0x01, 0xAE, 0x27, 0x9C, 0x1D, 0x89, 0x88, 0xFA, 0xCB, 0x77, 0x5F, 0xA8, 0xA0, 0x08, 0x91, 0x4E, 0x66, 0x39, 0x88, 0xA8}, // Atos TrustedRoot Root CA ECC TLS 2021
+	{0x81, 0xA9, 0x08, 0x8E, 0xA5, 0x9F, 0xB3, 0x64, 0xC5, 0x48, 0xA6, 0xF8, 0x55, 0x59, 0x09, 0x9B, 0x6F, 0x04, 0x05, 0xEF, 0xBF, 0x18, 0xE5, 0x32, 0x4E, 0xC9, 0xF4, 0x57, 0xBA, 0x00, 0x11, 0x2F}, // Atos TrustedRoot Root CA RSA TLS 2021
+	{0x20, 0x9E, 0x95, 0x6A, 0xF0, 0x4D, 0xF3, 0x99, 0x65, 0x07, 0xC8, 0x87, 0xD3, 0x56, 0x23, 0x0D, 0x6E, 0xB4, 0x9F, 0xDB, 0xDD, 0x2D, 0x8A, 0x05, 0x8F, 0xF5, 0x0B, 0x8F, 0x80, 0xF6, 0x90, 0xAA}, // Australian Defence Public Root CA
+	{0x04, 0x04, 0x80, 0x28, 0xBF, 0x1F, 0x28, 0x64, 0xD4, 0x8F, 0x9A, 0xD4, 0xD8, 0x32, 0x94, 0x36, 0x6A, 0x82, 0x88, 0x56, 0x55, 0x3F, 0x3B, 0x14, 0x30, 0x3F, 0x90, 0x14, 0x7F, 0x5D, 0x40, 0xEF}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0x57, 0xDE, 0x05, 0x83, 0xEF, 0xD2, 0xB2, 0x6E, 0x03, 0x61, 0xDA, 0x99, 0xDA, 0x9D, 0xF4, 0x64, 0x8D, 0xEF, 0x7E, 0xE8, 0x44, 0x1C, 0x3B, 0x72, 0x8A, 0xFA, 0x9B, 0xCD, 0xE0, 0xF9, 0xB2, 0x6A}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0x6E, 0x0B, 0xFF, 0x06, 0x9A, 0x26, 0x99, 0x4C, 0x15, 0xDE, 0x2C, 0x48, 0x88, 0xCC, 0x54, 0xAF, 0x84, 0x88, 0x2E, 0x54, 0x95, 0xB7, 0xFB, 0xF6, 0x6B, 0xE9, 0xCC, 0xFF, 0xEC, 0x74, 0x89, 0xF6}, // Autoridade Certificadora Raiz Brasileira v10
+	{0x16, 0xAF, 0x57, 0xA9, 0xF6, 0x76, 0xB0, 0xAB, 0x12, 0x60, 0x95, 0xAA, 0x5E, 0xBA, 0xDE, 0xF2, 0x2A, 0xB3, 0x11, 0x19, 0xD6, 0x44, 0xAC, 0x95, 0xCD, 0x4B, 0x93, 0xDB, 0xF3, 0xF2, 0x6A, 0xEB}, // Baltimore CyberTrust Root
+	{0x9A, 0x11, 0x40, 0x25, 0x19, 0x7C, 0x5B, 0xB9, 0x5D, 0x94, 0xE6, 0x3D, 0x55, 0xCD, 0x43, 0x79, 0x08, 0x47, 0xB6, 0x46, 0xB2, 0x3C, 0xDF, 0x11, 0xAD, 0xA4, 0xA0, 0x0E, 0xFF, 0x15, 0xFB, 0x48}, // Buypass Class 2 Root CA
+	{0xED, 0xF7, 0xEB, 0xBC, 0xA2, 0x7A, 0x2A, 0x38, 0x4D, 0x38, 0x7B, 0x7D, 0x40, 0x10, 0xC6, 0x66, 0xE2, 0xED, 0xB4, 0x84, 0x3E, 0x4C, 0x29, 0xB4, 0xAE, 0x1D, 0x5B, 0x93, 0x32, 0xE6, 0xB2, 0x4D}, // Buypass Class 3 Root CA
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x15, 0x01, 0xF8, 0x9C, 0x5C, 0x4D, 0xCF, 0x36, 0xCF, 0x58, 0x8A, 0x17, 0xC9, 0xFD, 0x7C, 0xFC, 0xEB, 0x9E, 0xE0, 0x1E, 0x87, 0x29, 0xBE, 0x35, 0x5E, 0x25, 0xDE, 0x80, 0xEB, 0x62, 0x84, 0xB4}, // CAEDICOM Root
+	{0xB7, 0x24, 0x68, 0x9B, 0x79, 0xB2, 0xEF, 0x94, 0x21, 0xEF, 0x8F, 0x5C, 0xC7, 0x33, 0xEB, 0x09, 0x38, 0x51, 0xB1, 0x70, 0xEE, 0x71, 0x51, 0x77, 0x00, 0x5A, 0x09, 0xF2, 0x26, 0xD8, 0xC9, 0x1A}, // CCA India 2022 SPL
+	{0x5C, 0xC3, 0xD7, 0x8E, 0x4E, 0x1D, 0x5E, 0x45, 0x54, 0x7A, 0x04, 0xE6, 0x87, 0x3E, 0x64, 0xF9, 0x0C, 0xF9, 0x53, 0x6D, 0x1C, 0xCC, 0x2E, 0xF8, 0x00, 0xF3, 0x55, 0xC4, 0xC5, 0xFD, 0x70, 0xFD}, // CFCA EV ROOT
+	{0x1A, 0x0D, 0x20, 0x44, 0x5D, 0xE5, 0xBA, 0x18, 0x62, 0xD1, 0x9E, 0xF8, 0x80, 0x85, 0x8C, 0xBC, 0xE5, 0x01, 0x02, 0xB3, 0x6E, 0x8F, 0x0A, 0x04, 0x0C, 0x3C, 0x69, 0xE7, 0x45, 0x22, 0xFE, 0x6E}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD4, 0x8D, 0x3D, 0x23, 0xEE, 0xDB, 0x50, 0xA4, 0x59, 0xE5, 0x51, 0x97, 0x60, 0x1C, 0x27, 0x77, 0x4B, 0x9D, 0x7B, 0x18, 0xC9, 0x4D, 0x5A, 0x05, 0x95, 0x11, 0xA1, 0x02, 0x50, 0xB9, 0x31, 0x68}, // Certigna Root CA
+	{0x2A, 0x99, 0xF5, 0xBC, 0x11, 0x74, 0xB7, 0x3C, 0xBB, 0x1D, 0x62, 0x08, 0x84, 0xE0, 0x1C, 0x34, 0xE5, 0x1C, 0xCB, 0x39, 0x78, 0xDA, 0x12, 0x5F, 0x0E, 0x33, 0x26, 0x88, 0x83, 0xBF, 0x41, 0x58}, // Certinomis - Root CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0x26, 0x05, 0x87, 0x5A, 0xFC, 0xC1, 0x76, 0xB2, 0xD6, 0x6D, 0xD6, 0x6A, 0x99, 0x5D, 0x7F, 0x8D, 0x5E, 0xBB, 0x86, 0xCE, 0x12, 0x0D, 0x0E, 0x7E, 0x9E, 0x7C, 0x6E, 0xF2, 0x94, 0xA2, 0x7D, 0x4C}, // ComSign Global Root CA
+	{0xE5, 0x9A, 0xAA, 0x81, 0x60, 0x09, 0xC2, 0x2B, 0xFF, 0x5B, 0x25, 0xBA, 0xD3, 0x7D, 0xF3, 0x06, 0xF0, 0x49, 0x79, 0x7C, 0x1F, 0x81, 0xD8, 0x5A, 0xB0, 0x89, 0xE6, 0x57, 0xBD, 0x8F, 0x00, 0x44}, // D-TRUST BR Root CA 1 2020
+	{0x05, 0x52, 0xE6, 0xF8, 0x3F, 0xDF, 0x65, 0xE8, 0xFA, 0x96, 0x70, 0xE6, 0x66, 0xDF, 0x28, 0xA4, 0xE2, 0x13, 0x40, 0xB5, 0x10, 0xCB, 0xE5, 0x25, 0x66, 0xF9, 0x7C, 0x4F, 0xB9, 0x4B, 0x2B, 0xD1}, // D-TRUST BR Root CA 2 2023
+	{0x08, 0x17, 0x0D, 0x1A, 0xA3, 0x64, 0x53, 0x90, 0x1A, 0x2F, 0x95, 0x92, 0x45, 0xE3, 0x47, 0xDB, 0x0C, 0x8D, 0x37, 0xAB, 0xAA, 0xBC, 0x56, 0xB8, 0x1A, 0xA1, 0x00, 0xDC, 0x95, 0x89, 0x70, 0xDB}, // D-TRUST EV Root CA 1 2020
+	{0x8E, 0x82, 0x21, 0xB2, 0xE7, 0xD4, 0x00, 0x78, 0x36, 0xA1, 0x67, 0x2F, 0x0D, 0xCC, 0x29, 0x9C, 0x33, 0xBC, 0x07, 0xD3, 0x16, 0xF1, 0x32, 0xFA, 0x1A, 0x20, 0x6D, 0x58, 0x71, 0x50, 0xF1, 0xCE}, // D-TRUST EV Root CA 2 2023
+	{0x49, 0xE7, 0xA4, 0x42, 0xAC, 0xF0, 0xEA, 0x62, 0x87, 0x05, 0x00, 0x54, 0xB5, 0x25, 0x64, 0xB6, 0x50, 0xE4, 0xF4, 0x9E, 0x42, 0xE3, 0x48, 0xD6, 0xAA, 0x38, 0xE0, 0x39, 0xE9, 0x57, 0xB1, 0xC1}, // D-TRUST Root Class 3 CA 2 2009
+	{0xEE, 0xC5, 0x49, 0x6B, 0x98, 0x8C, 0xE9, 0x86, 0x25, 0xB9, 0x34, 0x09, 0x2E, 0xEC, 0x29, 0x08, 0xBE, 0xD0, 0xB0, 0xF3, 0x16, 0xC2, 0xD4, 0x73, 0x0C, 0x84, 0xEA, 0xF1, 0xF3, 0xD3, 0x48, 0x81}, // D-TRUST Root Class 3 CA 2 EV 2009
+	{0x55, 0x46, 0xA5, 0x25, 0x04, 0xFB, 0xA7, 0x4F, 0x61, 0xFF, 0xD4, 0x89, 0x00, 0x67, 0x52, 0x9A, 0xDE, 0x3B, 0x9C, 0x9D, 0x07, 0xE5, 0x02, 0x59, 0x28, 0x31, 0xCC, 0xDA, 0x9B, 0x36, 0x9F, 0xD3}, // DVV Gov. Root CA - G3 ECC
+	{0xD3, 0xED, 0x3F, 0xC4, 0x0A, 0xD2, 0x6B, 0x52, 0xE0, 0x01, 0xE1, 0xE1, 0x8F, 0x4B, 0x94, 0x49, 0x52, 0x9D, 0xEB, 0x75, 0xA8, 0x1D, 0x5E, 0xB6, 0x80, 0xD7, 0xB6, 0x2D, 0xB2, 0x3B, 0xA9, 0x6D}, // DVV Gov. Root CA - G3 RSA
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0xC1, 0x46, 0x8C, 0xF2, 0x25, 0x4E, 0x60, 0x04, 0xB2, 0x46, 0x96, 0xAB, 0xA2, 0x09, 0xD1, 0xA3, 0x0B, 0xA6, 0xE2, 0xDF, 0xF6, 0x8A, 0x9A, 0x4E, 0x32, 0xC6, 0xAB, 0x41, 0x4F, 0x90, 0xC8, 0xD9}, // DigiCert ECC P384 Root G5
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0xE4, 0x6A, 0x39, 0x22, 0x04, 0xA8, 0xDC, 0xA3, 0x42, 0xA7, 0x1C, 0x1C, 0xA9, 0xA6, 0x0C, 0x91, 0x85, 0xB9, 0xA9, 0x30, 0x37, 0x01, 0x20, 0xC3, 0xB9, 0xC7, 0xE3, 0x85, 0x6F, 0x0D, 0x8F, 0x3B}, // DigiCert RSA4096 Root G5
+	{0x01, 0x8E, 0x13, 0xF0, 0x77, 0x25, 0x32, 0xCF, 0x80, 0x9B, 0xD1, 0xB1, 0x72, 0x81, 0x86, 0x72, 0x83, 0xFC, 0x48, 0xC6, 0xE1, 0x3B, 0xE9, 0xC6, 0x98, 0x12, 0x85, 0x4A, 0x49, 0x0C, 0x1B, 0x05}, // DigiCert TLS ECC P384 Root G5
+	{0x37, 0x1A, 0x00, 0xDC, 0x05, 0x33, 0xB3, 0x72, 0x1A, 0x7E, 0xEB, 0x40, 0xE8, 0x41, 0x9E, 0x70, 0x79, 0x9D, 0x2B, 0x0A, 0x0F, 0x2C, 0x1D, 0x80, 0x69, 0x31, 0x65, 0xF7, 0xCE, 0xC4, 0xAD, 0x75}, // DigiCert TLS RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x73, 0xC1, 0x76, 0x43, 0x4F, 0x1B, 0xC6, 0xD5, 0xAD, 0xF4, 0x5B, 0x0E, 0x76, 0xE7, 0x27, 0x28, 0x7C, 0x8D, 0xE5, 0x76, 0x16, 0xC1, 0xE6, 0xE6, 0x14, 0x1A, 0x2B, 0x2C, 0xBC, 0x7D, 0x8E, 0x4C}, // Entrust Root Certification Authority
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0x5A, 0xB4, 0xFC, 0xDB, 0x18, 0x0B, 0x5B, 0x6A, 0xF0, 0xD2, 0x62, 0xA2, 0x37, 0x5A, 0x2C, 0x77, 0xD2, 0x56, 0x02, 0x01, 0x5D, 0x96, 0x64, 0x87, 0x56, 0x61, 0x1E, 0x2E, 0x78, 0xC5, 0x3A, 0xD3}, // Fina Root CA
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0x5E, 0x35, 0x71, 0xF3, 0x3F, 0x45, 0xA7, 0xDF, 0x15, 0x37, 0xA6, 0x8B, 0x5F, 0xFB, 0x9E, 0x03, 0x6A, 0xF9, 0xD2, 0xF5, 0xBC, 0x4C, 0x97, 0x17, 0x13, 0x0D, 0xC4, 0x3D, 0x71, 0x75, 0xAA, 0xC7}, // GLOBALTRUST
+	{0x41, 0x6B, 0x1F, 0x9E, 0x84, 0xE7, 0x4C, 0x1D, 0x19, 0xB2, 0x3D, 0x8D, 0x71, 0x91, 0xC6, 0xAD, 0x81, 0x24, 0x6E, 0x64, 0x16, 0x01, 0xF5, 0x99, 0x13, 0x27, 0x29, 0xF5, 0x07, 0xBE, 0xB3, 0xCC}, // GLOBALTRUST 2015
+	{0x9A, 0x29, 0x6A, 0x51, 0x82, 0xD1, 0xD4, 0x51, 0xA2, 0xE3, 0x7F, 0x43, 0x9B, 0x74, 0xDA, 0xAF, 0xA2, 0x67, 0x52, 0x33, 0x29, 0xF9, 0x0F, 0x9A, 0x0D, 0x20, 0x07, 0xC3, 0x34, 0xE2, 0x3C, 0x9A}, // GLOBALTRUST 2020
+	{0x2A, 0x57, 0x54, 0x71, 0xE3, 0x13, 0x40, 0xBC, 0x21, 0x58, 0x1C, 0xBD, 0x2C, 0xF1, 0x3E, 0x15, 0x84, 0x63, 0x20, 0x3E, 0xCE, 0x94, 0xBC, 0xF9, 0xD3, 0xCC, 0x19, 0x6B, 0xF0, 0x9A, 0x54, 0x72}, // GTS Root R1
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0xC4, 0x5D, 0x7B, 0xB0, 0x8E, 0x6D, 0x67, 0xE6, 0x2E, 0x42, 0x35, 0x11, 0x0B, 0x56, 0x4E, 0x5F, 0x78, 0xFD, 0x92, 0xEF, 0x05, 0x8C, 0x84, 0x0A, 0xEA, 0x4E, 0x64, 0x55, 0xD7, 0x58, 0x5C, 0x60}, // GTS Root R2
+	{0x15, 0xD5, 0xB8, 0x77, 0x46, 0x19, 0xEA, 0x7D, 0x54, 0xCE, 0x1C, 0xA6, 0xD0, 0xB0, 0xC4, 0x03, 0xE0, 0x37, 0xA9, 0x17, 0xF1, 0x31, 0xE8, 0xA0, 0x4E, 0x1E, 0x6B, 0x7A, 0x71, 0xBA, 0xBC, 0xE5}, // GTS Root R3
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x71, 0xCC, 0xA5, 0x39, 0x1F, 0x9E, 0x79, 0x4B, 0x04, 0x80, 0x25, 0x30, 0xB3, 0x63, 0xE1, 0x21, 0xDA, 0x8A, 0x30, 0x43, 0xBB, 0x26, 0x66, 0x2F, 0xEA, 0x4D, 0xCA, 0x7F, 0xC9, 0x51, 0xA4, 0xBD}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xBE, 0xC9, 0x49, 0x11, 0xC2, 0x95, 0x56, 0x76, 0xDB, 0x6C, 0x0A, 0x55, 0x09, 0x86, 0xD7, 0x6E, 0x3B, 0xA0, 0x05, 0x66, 0x7C, 0x44, 0x2C, 0x97, 0x62, 0xB4, 0xFB, 0xB7, 0x73, 0xDE, 0x22, 0x8C}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0xCB, 0xB9, 0xC4, 0x4D, 0x84, 0xB8, 0x04, 0x3E, 0x10, 0x50, 0xEA, 0x31, 0xA6, 0x9F, 0x51, 0x49, 0x55, 0xD7, 0xBF, 0xD2, 0xE2, 0xC6, 0xB4, 0x93, 0x01, 0x01, 0x9A, 0xD6, 0x1D, 0x9F, 0x50, 0x58}, // GlobalSign Root E46
+	{0x4F, 0xA3, 0x12, 0x6D, 0x8D, 0x3A, 0x11, 0xD1, 0xC4, 0x85, 0x5A, 0x4F, 0x80, 0x7C, 0xBA, 0xD6, 0xCF, 0x91, 0x9D, 0x3A, 0x5A, 0x88, 0xB0, 0x3B, 0xEA, 0x2C, 0x63, 0x72, 0xD9, 0x3C, 0x40, 0xC9}, // GlobalSign Root R46
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x3F, 0x99, 0xCC, 0x47, 0x4A, 0xCF, 0xCE, 0x4D, 0xFE, 0xD5, 0x87, 0x94, 0x66, 0x5E, 0x47, 0x8D, 0x15, 0x47, 0x73, 0x9F, 0x2E, 0x78, 0x0F, 0x1B, 0xB4, 0xCA, 0x9B, 0x13, 0x30, 0x97, 0xD4, 0x01}, // HARICA TLS ECC Root CA 2021
+	{0xD9, 0x5D, 0x0E, 0x8E, 0xDA, 0x79, 0x52, 0x5B, 0xF9, 0xBE, 0xB1, 0x1B, 0x14, 0xD2, 0x10, 0x0D, 0x32, 0x94, 0x98, 0x5F, 0x0C, 0x62, 0xD9, 0xFA, 0xBD, 0x9C, 0xD9, 0x99, 0xEC, 0xCB, 0x7B, 0x1D}, // HARICA TLS RSA Root CA 2021
+	{0xD7, 0xBA, 0x3F, 0x4F, 0xF8, 0xAD, 0x05, 0x63, 0x34, 0x51, 0x47, 0x0D, 0xDA, 0x33, 0x78, 0xA3, 0x49, 0x1B, 0x90, 0x00, 0x5E, 0x5C, 0x68, 0x7D, 0x2B, 0x68, 0xD5, 0x36, 0x47, 0xCF, 0xDD, 0x66}, // Halcom Root Certificate Authority
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0xF0, 0x15, 0xCE, 0x3C, 0xC2, 0x39, 0xBF, 0xEF, 0x06, 0x4B, 0xE9, 0xF1, 0xD2, 0xC4, 0x17, 0xE1, 0xA0, 0x26, 0x4A, 0x0A, 0x94, 0xBE, 0x1F, 0x0C, 0x8D, 0x12, 0x18, 0x64, 0xEB, 0x69, 0x49, 0xCC}, // HiPKI Root CA - G1
+	{0x5A, 0x2F, 0xC0, 0x3F, 0x0C, 0x83, 0xB0, 0x90, 0xBB, 0xFA, 0x40, 0x60, 0x4B, 0x09, 0x88, 0x44, 0x6C, 0x76, 0x36, 0x18, 0x3D, 0xF9, 0x84, 0x6E, 0x17, 0x10, 0x1A, 0x44, 0x7F, 0xB8, 0xEF, 0xD6}, // Hongkong Post Root CA 3
+	{0xA3, 0xCC, 0x68, 0x59, 0x5D, 0xFE, 0x7E, 0x86, 0xD8, 0xAD, 0x17, 0x72, 0xA8, 0xB5, 0x28, 0x4A, 0xDD, 0x54, 0xAC, 0xE3, 0xB8, 0xA7, 0x98, 0xDF, 0x47, 0xBC, 0xCA, 0xFB, 0x1F, 0xDB, 0x84, 0xDF}, // Hotspot 2.0 Trust Root CA - 03
+	{0xF9, 0xA1, 0x7A, 0x00, 0xE5, 0xC2, 0x94, 0xBA, 0x96, 0x14, 0xA7, 0x15, 0x81, 0x9A, 0xF5, 0x7F, 0x3F, 0xD4, 0x8C, 0xC4, 0x13, 0x45, 0x3F, 0xBB, 0x8A, 0x5F, 0xC7, 0xE9, 0x79, 0x64, 0xE2, 0xBC}, // I.CA TLS Root CA/RSA 05/2022
+	{0x96, 0xBC, 0xEC, 0x06, 0x26, 0x49, 0x76, 0xF3, 0x74, 0x60, 0x77, 0x9A, 0xCF, 0x28, 0xC5, 0xA7, 0xCF, 0xE8, 0xA3, 0xC0, 0xAA, 0xE1, 0x1A, 0x8F, 0xFC, 0xEE, 0x05, 0xC0, 0xBD, 0xDF, 0x08, 0xC6}, // ISRG Root X1
+	{0x69, 0x72, 0x9B, 0x8E, 0x15, 0xA8, 0x6E, 0xFC, 0x17, 0x7A, 0x57, 0xAF, 0xB7, 0x17, 0x1D, 0xFC, 0x64, 0xAD, 0xD2, 0x8C, 0x2F, 0xCA, 0x8C, 0xF1, 0x50, 0x7E, 0x34, 0x45, 0x3C, 0xCB, 0x14, 0x70}, // ISRG Root X2
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x25, 0x30, 0xCC, 0x8E, 0x98, 0x32, 0x15, 0x02, 0xBA, 0xD9, 0x6F, 0x9B, 0x1F, 0xBA, 0x1B, 0x09, 0x9E, 0x2D, 0x29, 0x9E, 0x0F, 0x45, 0x48, 0xBB, 0x91, 0x4F, 0x36, 0x3B, 0xC0, 0xD4, 0x53, 0x1F}, // Izenpe.com
+	{0x1C, 0xF3, 0x41, 0xAE, 0x35, 0x34, 0x1A, 0xC3, 0xAE, 0x1D, 0xC6, 0x8D, 0x5B, 0x10, 0xDC, 0x0C, 0x9D, 0xC1, 0x30, 0x76, 0x56, 0xF7, 0x5F, 0xD9, 0x2C, 0xA2, 0xC6, 0x84, 0x89, 0xD5, 0x2E, 0x9A}, // MOIS SSL Root CA
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x35, 0x8D, 0xF3, 0x9D, 0x76, 0x4A, 0xF9, 0xE1, 0xB7, 0x66, 0xE9, 0xC9, 0x72, 0xDF, 0x35, 0x2E, 0xE1, 0x5C, 0xFA, 0xC2, 0x27, 0xAF, 0x6A, 0xD1, 0xD7, 0x0E, 0x8E, 0x4A, 0x6E, 0xDC, 0xBA, 0x02}, // Microsoft ECC Root Certificate Authority 2017
+	{0xC7, 0x41, 0xF7, 0x0F, 0x4B, 0x2A, 0x8D, 0x88, 0xBF, 0x2E, 0x71, 0xC1, 0x41, 0x22, 0xEF, 0x53, 0xEF, 0x10, 0xEB, 0xA0, 0xCF, 0xA5, 0xE6, 0x4C, 0xFA, 0x20, 0xF4, 0x18, 0x85, 0x30, 0x73, 0xE0}, // Microsoft RSA Root Certificate Authority 2017
+	{0xA7, 0xC8, 0x68, 0x10, 0x42, 0xF3, 0x67, 0x5A, 0xA8, 0x50, 0x5D, 0x3B, 0xA3, 0x13, 0xD8, 0x0F, 0x8A, 0xC3, 0x25, 0x0F, 0xDF, 0x87, 0x4A, 0xD2, 0x9B, 0x83, 0x46, 0x89, 0xC0, 0x87, 0xFB, 0x11}, // NAVER Cloud Trust Services ECC Root G1
+	{0x49, 0xA2, 0x76, 0x29, 0x87, 0x78, 0x8D, 0x48, 0x34, 0xB3, 0x23, 0x05, 0xD7, 0x67, 0x76, 0x0F, 0x24, 0x4D, 0x50, 0x77, 0x42, 0xE8, 0xC2, 0x53, 0x9F, 0xD4, 0xCA, 0x3A, 0xD5, 0x2C, 0x16, 0xEE}, // NAVER Cloud Trust Services RSA Root G1
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6C, 0x61, 0xDA, 0xC3, 0xA2, 0xDE, 0xF0, 0x31, 0x50, 0x6B, 0xE0, 0x36, 0xD2, 0xA6, 0xFE, 0x40, 0x19, 0x94, 0xFB, 0xD1, 0x3D, 0xF9, 0xC8, 0xD4, 0x66, 0x59, 0x92, 0x74, 0xC4, 0x46, 0xEC, 0x98}, // NetLock Arany (Class Gold) Főtanúsítvány
+	{0xEB, 0x7E, 0x05, 0xAA, 0x58, 0xE7, 0xBD, 0x32, 0x8A, 0x28, 0x2B, 0xF8, 0x86, 0x70, 0x33, 0xF3, 0xC0, 0x35, 0x34, 0x2B, 0x51, 0x6E, 0xE8, 0x5C, 0x01, 0x67, 0x3D, 0xFF, 0xFF, 0xBB, 0xFE, 0x58}, // NetLock Platina (Class Platinum) Főtanúsítvány
+	{0x65, 0x35, 0x38, 0x33, 0xCF, 0x23, 0x4C, 0x79, 0x56, 0x21, 0x64, 0xF9, 0x08, 0x49, 0xC0, 0xD1, 0x04, 0xDB, 0xAB, 0xF8, 0xEE, 0x41, 0x06, 0x4D, 0x83, 0xE8, 0xCB, 0xE0, 0x3B, 0xA1, 0xC5, 0xA5}, // Netrust Root CA 2
+	{0x7A, 0x77, 0xC6, 0xC6, 0x1E, 0xEE, 0xB9, 0xAA, 0x65, 0xC4, 0xEA, 0x41, 0x0D, 0x65, 0xD8, 0x95, 0xB2, 0x6A, 0x81, 0x12, 0x32, 0x83, 0x00, 0x9D, 0xB1, 0x04, 0xB4, 0x8D, 0xE8, 0x0B, 0x24, 0x79}, // OATI WebCARES Root CA
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0xAC, 0x7F, 0x78, 0x62, 0xE6, 0x85, 0xC7, 0xA7, 0xD9, 0x82, 0x6A, 0x58, 0xEA, 0x32, 0xD1, 0x83, 0xD4, 0x89, 0x3F, 0xCC, 0x8F, 0x8F, 0xD6, 0xD9, 0x00, 0xC9, 0x76, 0x9A, 0x98, 0x7E, 0x77, 0xF0}, // PostSignum Root QCA 4
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x6E, 0x1F, 0xD3, 0xAE, 0x0D, 0x2D, 0x47, 0x7C, 0x8F, 0x5E, 0xE5, 0xF3, 0x35, 0xCC, 0x5B, 0x63, 0x56, 0x87, 0x26, 0x54, 0xE5, 0x35, 0x6A, 0x73, 0xD8, 0xC0, 0xA3, 0x0A, 0x17, 0xC2, 0x52, 0xA2}, // QuoVadis TLS ECC P384 Root G4
+	{0xC8, 0xA2, 0xD3, 0x8A, 0x24, 0xF5, 0xAC, 0x30, 0x2D, 0x8A, 0x08, 0xEB, 0xD3, 0x89, 0x23, 0xD9, 0xA7, 0x50, 0xB4, 0x92, 0x20, 0xF0, 0x92, 0xE8, 0x2D, 0x1C, 0x53, 0x24, 0x9E, 0x15, 0x33, 0xD0}, // QuoVadis TLS RSA 4096 Root G4
+	{0x6A, 0xB2, 0xAB, 0x75, 0xF5, 0x1C, 0xB4, 0xF4, 0xF0, 0x15, 0x62, 0x03, 0xFB, 0xF6, 0xF6, 0x46, 0x23, 0x2F, 0x51, 0x4B, 0xE0, 0x59, 0xF6, 0x28, 0x33, 0x30, 0x8B, 0x82, 0xB4, 0xD7, 0x2D, 0xB1}, // SECOM TLS ECC Root CA 2024
+	{0x14, 0x35, 0xF2, 0x25, 0xC5, 0xD2, 0x52, 0xD7, 0xA2, 0x19, 0x48, 0xCC, 0x3C, 0xE6, 0x2A, 0xEC, 0xFA, 0x88, 0x00, 0x1E, 0x3D, 0xD7, 0x2D, 0x1C, 0xC3, 0x55, 0x51, 0x00, 0xEB, 0x37, 0x2F, 0x93}, // SECOM TLS RSA Root CA 2024
+	{0xFA, 0xD5, 0x40, 0x81, 0x1A, 0xFA, 0xE0, 0xDC, 0x76, 0x7C, 0xDF, 0x65, 0x72, 0xA0, 0x88, 0xFA, 0x3C, 0xE8, 0x49, 0x3D, 0xD8, 0x2B, 0x3B, 0x86, 0x9A, 0x67, 0xD1, 0x0A, 0xAB, 0x4E, 0x81, 0x24}, // SI-TRUST Root
+	{0x22, 0xA2, 0xC1, 0xF7, 0xBD, 0xED, 0x70, 0x4C, 0xC1, 0xE7, 0x01, 0xB5, 0xF4, 0x08, 0xC3, 0x10, 0x88, 0x0F, 0xE9, 0x56, 0xB5, 0xDE, 0x2A, 0x4A, 0x44, 0xF9, 0x9C, 0x87, 0x3A, 0x25, 0xA7, 0xC8}, // SSL.com EV Root Certification Authority ECC
+	{0x2E, 0x7B, 0xF1, 0x6C, 0xC2, 0x24, 0x85, 0xA7, 0xBB, 0xE2, 0xAA, 0x86, 0x96, 0x75, 0x07, 0x61, 0xB0, 0xAE, 0x39, 0xBE, 0x3B, 0x2F, 0xE9, 0xD0, 0xCC, 0x6D, 0x4E, 0xF7, 0x34, 0x91, 0x42, 0x5C}, // SSL.com EV Root Certification Authority RSA R2
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xC3, 0x2F, 0xFD, 0x9F, 0x46, 0xF9, 0x36, 0xD1, 0x6C, 0x36, 0x73, 0x99, 0x09, 0x59, 0x43, 0x4B, 0x9A, 0xD6, 0x0A, 0xAF, 0xBB, 0x9E, 0x7C, 0xF3, 0x36, 0x54, 0xF1, 0x44, 0xCC, 0x1B, 0xA1, 0x43}, // SSL.com TLS ECC Root CA 2022
+	{0x8F, 0xAF, 0x7D, 0x2E, 0x2C, 0xB4, 0x70, 0x9B, 0xB8, 0xE0, 0xB3, 0x36, 0x66, 0xBF, 0x75, 0xA5, 0xDD, 0x45, 0xB5, 0xDE, 0x48, 0x0F, 0x8E, 0xA8, 0xD4, 0xBF, 0xE6, 0xBE, 0xBC, 0x17, 0xF2, 0xED}, // SSL.com TLS RSA Root CA 2022
+	{0xA1, 0x33, 0x9D, 0x33, 0x28, 0x1A, 0x0B, 0x56, 0xE5, 0x57, 0xD3, 0xD3, 0x2B, 0x1C, 0xE7, 0xF9, 0x36, 0x7E, 0xB0, 0x94, 0xBD, 0x5F, 0xA7, 0x2A, 0x7E, 0x50, 0x04, 0xC8, 0xDE, 0xD7, 0xCA, 0xFE}, // SZAFIR ROOT CA2
+	{0xC9, 0x0F, 0x26, 0xF0, 0xFB, 0x1B, 0x40, 0x18, 0xB2, 0x22, 0x27, 0x51, 0x9B, 0x5C, 0xA2, 0xB5, 0x3E, 0x2C, 0xA5, 0xB3, 0xBE, 0x5C, 0xF1, 0x8E, 0xFE, 0x1B, 0xEF, 0x47, 0x38, 0x0C, 0x53, 0x83}, // Sectigo Public Server Authentication Root E46
+	{0x7B, 0xB6, 0x47, 0xA6, 0x2A, 0xEE, 0xAC, 0x88, 0xBF, 0x25, 0x7A, 0xA5, 0x22, 0xD0, 0x1F, 0xFE, 0xA3, 0x95, 0xE0, 0xAB, 0x45, 0xC7, 0x3F, 0x93, 0xF6, 0x56, 0x54, 0xEC, 0x38, 0xF2, 0x5A, 0x06}, // Sectigo Public Server Authentication Root R46
+	{0x42, 0x00, 0xF5, 0x04, 0x3A, 0xC8, 0x59, 0x0E, 0xBB, 0x52, 0x7D, 0x20, 0x9E, 0xD1, 0x50, 0x30, 0x29, 0xFB, 0xCB, 0xD4, 0x1C, 0xA1, 0xB5, 0x06, 0xEC, 0x27, 0xF1, 0x5A, 0xDE, 0x7D, 0xAC, 0x69}, // Secure Global CA
+	{0x4B, 0x00, 0x9C, 0x10, 0x34, 0x49, 0x4F, 0x9A, 0xB5, 0x6B, 0xBA, 0x3B, 0xA1, 0xD6, 0x27, 0x31, 0xFC, 0x4D, 0x20, 0xD8, 0x95, 0x5A, 0xDC, 0xEC, 0x10, 0xA9, 0x25, 0x60, 0x72, 0x61, 0xE3, 0x38}, // SecureSign Root CA14
+	{0xE7, 0x78, 0xF0, 0xF0, 0x95, 0xFE, 0x84, 0x37, 0x29, 0xCD, 0x1A, 0x00, 0x82, 0x17, 0x9E, 0x53, 0x14, 0xA9, 0xC2, 0x91, 0x44, 0x28, 0x05, 0xE1, 0xFB, 0x1D, 0x8F, 0xB6, 0xB8, 0x88, 0x6C, 0x3A}, // SecureSign Root CA15
+	{0xF1, 0xC1, 0xB5, 0x0A, 0xE5, 0xA2, 0x0D, 0xD8, 0x03, 0x0E, 0xC9, 0xF6, 0xBC, 0x24, 0x82, 0x3D, 0xD3, 0x67, 0xB5, 0x25, 0x57, 0x59, 0xB4, 0xE7, 0x1B, 0x61, 0xFC, 0xE9, 0xF7, 0x37, 0x5D, 0x73}, // SecureTrust CA
+	{0xE7, 0x4F, 0xBD, 0xA5, 0x5B, 0xD5, 0x64, 0xC4, 0x73, 0xA3, 0x6B, 0x44, 0x1A, 0xA7, 0x99, 0xC8, 0xA6, 0x8E, 0x07, 0x74, 0x40, 0xE8, 0x28, 0x8B, 0x9F, 0xA1, 0xE5, 0x0E, 0x4B, 0xBA, 0xCA, 0x11}, // Security Communication ECC RootCA1
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x2C, 0xE1, 0xCB, 0x0B, 0xF9, 0xD2, 0xF9, 0xE1, 0x02, 0x99, 0x3F, 0xBE, 0x21, 0x51, 0x52, 0xC3, 0xB2, 0xDD, 0x0C, 0xAB, 0xDE, 0x1C, 0x68, 0xE5, 0x31, 0x9B, 0x83, 0x91, 0x54, 0xDB, 0xB7, 0xF5}, // Starfield Root Certificate Authority - G2
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x8F, 0x9A, 0xDB, 0x6D, 0x89, 0x5D, 0xAB, 0x5A, 0xDF, 0x5C, 0x3D, 0x3F, 0xAB, 0x83, 0x92, 0x7B, 0xE0, 0xFB, 0x64, 0xEF, 0x82, 0x48, 0x5C, 0x62, 0x28, 0x0D, 0x58, 0x4E, 0x8B, 0xD5, 0x5D, 0x22}, // Swedish Government Root Authority v3
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0x19, 0x31, 0x44, 0xF4, 0x31, 0xE0, 0xFD, 0xDB, 0x74, 0x07, 0x17, 0xD4, 0xDE, 0x92, 0x6A, 0x57, 0x11, 0x33, 0x88, 0x4B, 0x43, 0x60, 0xD3, 0x0E, 0x27, 0x29, 0x13, 0xCB, 0xE6, 0x60, 0xCE, 0x41}, // SwissSign RSA TLS Root CA 2022 - 1
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0xFD, 0x73, 0xDA, 0xD3, 0x1C, 0x64, 0x4F, 0xF1, 0xB4, 0x3B, 0xEF, 0x0C, 0xCD, 0xDA, 0x96, 0x71, 0x0B, 0x9C, 0xD9, 0x87, 0x5E, 0xCA, 0x7E, 0x31, 0x70, 0x7A, 0xF3, 0xE9, 0x6D, 0x52, 0x2B, 0xBD}, // T-TeleSec GlobalRoot Class 3
+	{0x46, 0xED, 0xC3, 0x68, 0x90, 0x46, 0xD5, 0x3A, 0x45, 0x3F, 0xB3, 0x10, 0x4A, 0xB8, 0x0D, 0xCA, 0xEC, 0x65, 0x8B, 0x26, 0x60, 0xEA, 0x16, 0x29, 0xDD, 0x7E, 0x86, 0x79, 0x90, 0x64, 0x87, 0x16}, // TUBITAK Kamu SM SSL Kok Sertifikasi - Surum 1
+	{0x3F, 0x63, 0xBB, 0x28, 0x14, 0xBE, 0x17, 0x4E, 0xC8, 0xB6, 0x43, 0x9C, 0xF0, 0x8D, 0x6D, 0x56, 0xF0, 0xB7, 0xC4, 0x05, 0x88, 0x3A, 0x56, 0x48, 0xA3, 0x34, 0x42, 0x4D, 0x6B, 0x3E, 0xC5, 0x58}, // TWCA CYBER Root CA
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x57, 0x8A, 0xF4, 0xDE, 0xD0, 0x85, 0x3F, 0x4E, 0x59, 0x98, 0xDB, 0x4A, 0xEA, 0xF9, 0xCB, 0xEA, 0x8D, 0x94, 0x5F, 0x60, 0xB6, 0x20, 0xA3, 0x8D, 0x1A, 0x3C, 0x13, 0xB2, 0xBC, 0x7B, 0xA8, 0xE1}, // Telekom Security TLS ECC Root 2020
+	{0xEF, 0xC6, 0x5C, 0xAD, 0xBB, 0x59, 0xAD, 0xB6, 0xEF, 0xE8, 0x4D, 0xA2, 0x23, 0x11, 0xB3, 0x56, 0x24, 0xB7, 0x1B, 0x3B, 0x1E, 0xA0, 0xDA, 0x8B, 0x66, 0x55, 0x17, 0x4E, 0xC8, 0x97, 0x86, 0x46}, // Telekom Security TLS RSA Root 2023
+	{0x09, 0x8E, 0x08, 0xA9, 0x1D, 0xBB, 0xF7, 0x74, 0x78, 0xB9, 0x6C, 0xCE, 0xB8, 0x9B, 0x14, 0x13, 0xA5, 0xDA, 0x37, 0xB7, 0xC8, 0x62, 0x60, 0x6A, 0x95, 0x5D, 0xEB, 0x07, 0x17, 0x9F, 0x43, 0x26}, // Telia EC TLS Root CA v3
+	{0xD1, 0x3D, 0xB1, 0x29, 0x4C, 0x45, 0xEB, 0xC6, 0xFC, 0x86, 0xC6, 0xBB, 0xF6, 0x9F, 0xA2, 0x9B, 0xDF, 0xE6, 0x92, 0xDF, 0xF7, 0xC7, 0x13, 0xC2, 0x43, 0xC7, 0xA9, 0x56, 0xC6, 0xA2, 0x28, 0x4C}, // Telia RSA TLS Root CA v3
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xDD, 0x69, 0x36, 0xFE, 0x21, 0xF8, 0xF0, 0x77, 0xC1, 0x23, 0xA1, 0xA5, 0x21, 0xC1, 0x22, 0x24, 0xF7, 0x22, 0x55, 0xB7, 0x3E, 0x03, 0xA7, 0x26, 0x06, 0x93, 0xE8, 0xA2, 0x4B, 0x0F, 0xA3, 0x89}, // TeliaSonera Root CA v1
+	{0x2A, 0x8D, 0xA2, 0xF8, 0xD2, 0x3E, 0x0C, 0xD3, 0xB5, 0x87, 0x1E, 0xCF, 0xB0, 0xF4, 0x22, 0x76, 0xCA, 0x73, 0x23, 0x06, 0x67, 0xF4, 0x74, 0xEE, 0xDE, 0x71, 0xC5, 0xEE, 0x32, 0xCC, 0x3E, 0xC6}, // Thailand National Root Certification Authority - G1
+	{0x97, 0x55, 0x20, 0x15, 0xF5, 0xDD, 0xFC, 0x3C, 0x87, 0x88, 0xC0, 0x06, 0x94, 0x45, 0x55, 0x40, 0x88, 0x94, 0x45, 0x00, 0x84, 0xF1, 0x00, 0x86, 0x70, 0x86, 0xBC, 0x1A, 0x2B, 0xB5, 0x8D, 0xC8}, // Trustwave Global Certification Authority
+	{0x94, 0x5B, 0xBC, 0x82, 0x5E, 0xA5, 0x54, 0xF4, 0x89, 0xD1, 0xFD, 0x51, 0xA7, 0x3D, 0xDF, 0x2E, 0xA6, 0x24, 0xAC, 0x70, 0x19, 0xA0, 0x52, 0x05, 0x22, 0x5C, 0x22, 0xA7, 0x8C, 0xCF, 0xA8, 0xB4}, // Trustwave Global ECC P256 Certification Authority
+	{0x55, 0x90, 0x38, 0x59, 0xC8, 0xC0, 0xC3, 0xEB, 0xB8, 0x75, 0x9E, 0xCE, 0x4E, 0x25, 0x57, 0x22, 0x5F, 0xF5, 0x75, 0x8B, 0xBD, 0x38, 0xEB, 0xD4, 0x82, 0x76, 0x60, 0x1E, 0x1B, 0xD5, 0x80, 0x97}, // Trustwave Global ECC P384 Certification Authority
+	{0x2E, 0x44, 0x10, 0x2A, 0xB5, 0x8C, 0xB8, 0x54, 0x19, 0x45, 0x1C, 0x8E, 0x19, 0xD9, 0xAC, 0xF3, 0x66, 0x2C, 0xAF, 0xBC, 0x61, 0x4B, 0x6A, 0x53, 0x96, 0x0A, 0x30, 0xF7, 0xD0, 0xE2, 0xEB, 0x41}, // TunTrust Root CA
+	{0xD4, 0x3A, 0xF9, 0xB3, 0x54, 0x73, 0x75, 0x5C, 0x96, 0x84, 0xFC, 0x06, 0xD7, 0xD8, 0xCB, 0x70, 0xEE, 0x5C, 0x28, 0xE7, 0x73, 0xFB, 0x29, 0x4E, 0xB4, 0x1E, 0xE7, 0x17, 0x22, 0x92, 0x4D, 0x24}, // UCA Extended Validation Root
+	{0x9B, 0xEA, 0x11, 0xC9, 0x76, 0xFE, 0x01, 0x47, 0x64, 0xC1, 0xBE, 0x56, 0xA6, 0xF9, 0x14, 0xB5, 0xA5, 0x60, 0x31, 0x7A, 0xBD, 0x99, 0x88, 0x39, 0x33, 0x82, 0xE5, 0x16, 0x1A, 0xA0, 0x49, 0x3C}, // UCA Global G2 Root
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0x34, 0xFF, 0x2A, 0x44, 0x09, 0xDC, 0x13, 0x83, 0xE9, 0xF8, 0x96, 0x6E, 0x8A, 0xDF, 0xE5, 0x71, 0x9E, 0xBA, 0x37, 0x3F, 0xD0, 0xAD, 0x5E, 0x2F, 0x49, 0xF9, 0x0E, 0xE0, 0x7C, 0xF5, 0xD4, 0xC1}, // VRK Gov. Root CA - G2
+	{0x68, 0xAD, 0x50, 0x90, 0x9B, 0x04, 0x36, 0x3C, 0x60, 0x5E, 0xF1, 0x35, 0x81, 0xA9, 0x39, 0xFF, 0x2C, 0x96, 0x37, 0x2E, 0x3F, 0x12, 0x32, 0x5B, 0x0A, 0x68, 0x61, 0xE1, 0xD5, 0x9F, 0x66, 0x03}, // Verizon Global Root CA
+	{0xC5, 0x7A, 0x3A, 0xCB, 0xE8, 0xC0, 0x6B, 0xA1, 0x98, 0x8A, 0x83, 0x48, 0x5B, 0xF3, 0x26, 0xF2, 0x44, 0x87, 0x75, 0x37, 0x98, 0x49, 0xDE, 0x01, 0xCA, 0x43, 0x57, 0x1A, 0xF3, 0x57, 0xE7, 0x4B}, // Visa Information Delivery Root CA
+	{0xE6, 0xBE, 0x68, 0xCE, 0x06, 0xFE, 0x0D, 0xA0, 0xC1, 0x40, 0xF1, 0xAE, 0xB0, 0x0B, 0x67, 0xB6, 0x36, 0xC5, 0xEE, 0xA9, 0x42, 0x20, 0x88, 0x92, 0x93, 0x62, 0x37, 0x5C, 0xE0, 0x86, 0xDB, 0x39}, // Visa Public ECC Root CA
+	{0x07, 0xCD, 0x9A, 0xA9, 0x06, 0x4A, 0x9B, 0x94, 0xC6, 0xAE, 0xF8, 0xFB, 0x78, 0x4C, 0x1B, 0xBC, 0x1B, 0xED, 0xA0, 0x8A, 0xCB, 0xE8, 0x68, 0x78, 0xD7, 0x81, 0xA3, 0x91, 0x67, 0x62, 0x6C, 0xF8}, // Visa Public RSA Root CA
+	{0xCE, 0xCD, 0xDC, 0x90, 0x50, 0x99, 0xD8, 0xDA, 0xDF, 0xC5, 0xB1, 0xD2, 0x09, 0xB7, 0x37, 0xCB, 0xE2, 0xC1, 0x8C, 0xFB, 0x2C, 0x10, 0xC0, 0xFF, 0x0B, 0xCF, 0x0D, 0x32, 0x86, 0xFC, 0x1A, 0xA2}, // XRamp Global Certification Authority
+	{0xEA, 0xA9, 0x62, 0xC4, 0xFA, 0x4A, 0x6B, 0xAF, 0xEB, 0xE4, 0x15, 0x19, 0x6D, 0x35, 0x1C, 0xCD, 0x88, 0x8D, 0x4F, 0x53, 0xF3, 0xFA, 0x8A, 0xE6, 0xD7, 0xC4, 0x66, 0xA9, 0x4E, 0x60, 0x42, 0xBB}, // certSIGN ROOT CA
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xBE, 0xB0, 0x0B, 0x30, 0x83, 0x9B, 0x9B, 0xC3, 0x2C, 0x32, 0xE4, 0x44, 0x79, 0x05, 0x95, 0x06, 0x41, 0xF2, 0x64, 0x21, 0xB1, 0x5E, 0xD0, 0x89, 0x19, 0x8B, 0x51, 0x8A, 0xE2, 0xEA, 0x1B, 0x99}, // e-Szigno Root CA 2017
+	{0xB4, 0x91, 0x41, 0x50, 0x2D, 0x00, 0x66, 0x3D, 0x74, 0x0F, 0x2E, 0x7E, 0xC3, 0x40, 0xC5, 0x28, 0x00, 0x96, 0x26, 0x66, 0x12, 0x1A, 0x36, 0xD0, 0x9C, 0xF7, 0xDD, 0x2B, 0x90, 0x38, 0x4F, 0xB4}, // e-Szigno TLS Root CA 2023
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0x1E, 0x51, 0x94, 0x2B, 0x84, 0xFD, 0x46, 0x7B, 0xF7, 0x7D, 0x1C, 0x89, 0xDA, 0x24, 0x1C, 0x04, 0x25, 0x4D, 0xC8, 0xF3, 0xEF, 0x4C, 0x22, 0x45, 0x1F, 0xE7, 0xA8, 0x99, 0x78, 0xBD, 0xCD, 0x4F}, // ePKI Root Certification Authority - G2
+	{0xBC, 0x4D, 0x80, 0x9B, 0x15, 0x18, 0x9D, 0x78, 0xDB, 0x3E, 0x1D, 0x8C, 0xF4, 0xF9, 0x72, 0x6A, 0x79, 0x5D, 0xA1, 0x64, 0x3C, 0xA5, 0xF1, 0x35, 0x8E, 0x1D, 0xDB, 0x0E, 0xDC, 0x0D, 0x7E, 0xB3}, // emSign ECC Root CA - C3
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x12, 0x56, 0x09, 0xAA, 0x30, 0x1D, 0xA0, 0xA2, 0x49, 0xB9, 0x7A, 0x82, 0x39, 0xCB, 0x6A, 0x34, 0x21, 0x6F, 0x44, 0xDC, 0xAC, 0x9F, 0x39, 0x54, 0xB1, 0x42, 0x92, 0xF2, 0xE8, 0xC8, 0x60, 0x8F}, // emSign Root CA - C1
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Microsoft root store and capable of issuing S/MIME certificates.
+var MicrosoftSMIME = [][sha256.Size]byte{
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x97, 0xF6, 0x54, 0x85, 0x9C, 0xBD, 0xE5, 0x86, 0xFD, 0x90, 0x31, 0x1E, 0x82, 0xEC, 0x79, 0x02, 0xC2, 0x38, 0xCB, 0xA0, 0xD6, 0xE5, 0x29, 0x56, 0x4C, 0x9C, 0x88, 0xF4, 0x48, 0x95, 0xEC, 0x50}, // ACA ROOT
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x03, 0x76, 0xAB, 0x1D, 0x54, 0xC5, 0xF9, 0x80, 0x3C, 0xE4, 0xB2, 0xE2, 0x01, 0xA0, 0xEE, 0x7E, 0xEF, 0x7B, 0x57, 0xB6, 0x36, 0xE8, 0xA9, 0x3C, 0x9B, 0x8D, 0x48, 0x60, 0xC9, 0x6F, 0x5F, 0xA7}, // AffirmTrust Commercial
+	{0x0A, 0x81, 0xEC, 0x5A, 0x92, 0x97, 0x77, 0xF1, 0x45, 0x90, 0x4A, 0xF3, 0x8D, 0x5D, 0x50, 0x9F, 0x66, 0xB5, 0xE2, 0xC5, 0x8F, 0xCD, 0xB5, 0x31, 0x05, 0x8B, 0x0E, 0x17, 0xF3, 0xF0, 0xB4, 0x1B}, // AffirmTrust Networking
+	{0x70, 0xA7, 0x3F, 0x7F, 0x37, 0x6B, 0x60, 0x07, 0x42, 0x48, 0x90, 0x45, 0x34, 0xB1, 0x14, 0x82, 0xD5, 0xBF, 0x0E, 0x69, 0x8E, 0xCC, 0x49, 0x8D, 0xF5, 0x25, 0x77, 0xEB, 0xF2, 0xE9, 0x3B, 0x9A}, // AffirmTrust Premium
+	{0xBD, 0x71, 0xFD, 0xF6, 0xDA, 0x97, 0xE4, 0xCF, 0x62, 0xD1, 0x64, 0x7A, 0xDD, 0x25, 0x81, 0xB0, 0x7D, 0x79, 0xAD, 0xF8, 0x39, 0x7E, 0xB4, 0xEC, 0xBA, 0x9C, 0x5E, 0x84, 0x88, 0x82, 0x14, 0x23}, // AffirmTrust Premium ECC
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xE3, 0x86, 0x55, 0xF4, 0xB0, 0x19, 0x0C, 0x84, 0xD3, 0xB3, 0x89, 0x3D, 0x84, 0x0A, 0x68, 0x7E, 0x19, 0x0A, 0x25, 0x6D, 0x98, 0x05, 0x2F, 0x15, 0x9E, 0x6D, 0x4A, 0x39, 0xF5, 0x89, 0xA6, 0xEB}, // Atos TrustedRoot Root CA ECC G2 2020
+	{0x78, 0x83, 0x3A, 0x78, 0x3B, 0xB2, 0x98, 0x6C, 0x25, 0x4B, 0x93, 0x70, 0xD3, 0xC2, 0x0E, 0x5E, 0xBA, 0x8F, 0xA7, 0x84, 0x0C, 0xBF, 0x63, 0xFE, 0x17, 0x29, 0x7A, 0x0B, 0x01, 0x19, 0x68, 0x5E}, // Atos TrustedRoot Root CA RSA G2 2020
+	{0x20, 0x9E, 0x95, 0x6A, 0xF0, 0x4D, 0xF3, 0x99, 0x65, 0x07, 0xC8, 0x87, 0xD3, 0x56, 0x23, 0x0D, 0x6E, 0xB4, 0x9F, 0xDB, 0xDD, 0x2D, 0x8A, 0x05, 0x8F, 0xF5, 0x0B, 0x8F, 0x80, 0xF6, 0x90, 0xAA}, // Australian Defence Public Root CA
+	{0xCA, 0xA5, 0x3F, 0xC6, 0x09, 0x1C, 0x69, 0x51, 0x88, 0x7C, 0x97, 0x6E, 0x37, 0x8F, 0x6E, 0xF8, 0x9A, 0xA6, 0x37, 0x7C, 0x55, 0xD9, 0x7B, 0x64, 0x75, 0x42, 0x2B, 0x71, 0xED, 0x7E, 0x9B, 0x17}, // Autoridade Certificadora Raiz Brasileira v5
+	{0x16, 0xAF, 0x57, 0xA9, 0xF6, 0x76, 0xB0, 0xAB, 0x12, 0x60, 0x95, 0xAA, 0x5E, 0xBA, 0xDE, 0xF2, 0x2A, 0xB3, 0x11, 0x19, 0xD6, 0x44, 0xAC, 0x95, 0xCD, 0x4B, 0x93, 0xDB, 0xF3, 0xF2, 0x6A, 0xEB}, // Baltimore CyberTrust Root
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x9A, 0x3F, 0xD3, 0x17, 0x67, 0x98, 0xE8, 0x42, 0xDD, 0xCB, 0x12, 0xC2, 0x62, 0xF1, 0x1C, 0xFA, 0xCC, 0xA7, 0x0A, 0x8B, 0x84, 0xC6, 0xEA, 0x6F, 0xDA, 0x30, 0x84, 0x2A, 0x95, 0xA9, 0x4C, 0xD8}, // CCA India 2022
+	{0x1A, 0x0D, 0x20, 0x44, 0x5D, 0xE5, 0xBA, 0x18, 0x62, 0xD1, 0x9E, 0xF8, 0x80, 0x85, 0x8C, 0xBC, 0xE5, 0x01, 0x02, 0xB3, 0x6E, 0x8F, 0x0A, 0x04, 0x0C, 0x3C, 0x69, 0xE7, 0x45, 0x22, 0xFE, 0x6E}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xEE, 0x0E, 0xC4, 0xA0, 0xB5, 0xE6, 0xEC, 0xB8, 0x65, 0xF4, 0x96, 0x89, 0xB5, 0x0E, 0x3D, 0xE3, 0x8D, 0x4F, 0xD9, 0x24, 0xA4, 0x04, 0x1C, 0x0C, 0x2A, 0x62, 0x63, 0x6D, 0xB0, 0xB6, 0xB3, 0x1D}, // Carillon PKI Services G2 Root CA 1
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD4, 0x8D, 0x3D, 0x23, 0xEE, 0xDB, 0x50, 0xA4, 0x59, 0xE5, 0x51, 0x97, 0x60, 0x1C, 0x27, 0x77, 0x4B, 0x9D, 0x7B, 0x18, 0xC9, 0x4D, 0x5A, 0x05, 0x95, 0x11, 0xA1, 0x02, 0x50, 0xB9, 0x31, 0x68}, // Certigna Root CA
+	{0x2A, 0x99, 0xF5, 0xBC, 0x11, 0x74, 0xB7, 0x3C, 0xBB, 0x1D, 0x62, 0x08, 0x84, 0xE0, 0x1C, 0x34, 0xE5, 0x1C, 0xCB, 0x39, 0x78, 0xDA, 0x12, 0x5F, 0x0E, 0x33, 0x26, 0x88, 0x83, 0xBF, 0x41, 0x58}, // Certinomis - Root CA
+	{0xD8, 0xE0, 0xFE, 0xBC, 0x1D, 0xB2, 0xE3, 0x8D, 0x00, 0x94, 0x0F, 0x37, 0xD2, 0x7D, 0x41, 0x34, 0x4D, 0x99, 0x3E, 0x73, 0x4B, 0x99, 0xD5, 0x65, 0x6D, 0x97, 0x78, 0xD4, 0xD8, 0x14, 0x36, 0x24}, // Certum CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0x06, 0x3E, 0x4A, 0xFA, 0xC4, 0x91, 0xDF, 0xD3, 0x32, 0xF3, 0x08, 0x9B, 0x85, 0x42, 0xE9, 0x46, 0x17, 0xD8, 0x93, 0xD7, 0xFE, 0x94, 0x4E, 0x10, 0xA7, 0x93, 0x7E, 0xE2, 0x9D, 0x96, 0x93, 0xC0}, // Chambers of Commerce Root - 2008
+	{0xA1, 0xA8, 0x6D, 0x04, 0x12, 0x1E, 0xB8, 0x7F, 0x02, 0x7C, 0x66, 0xF5, 0x33, 0x03, 0xC2, 0x8E, 0x57, 0x39, 0xF9, 0x43, 0xFC, 0x84, 0xB3, 0x8A, 0xD6, 0xAF, 0x00, 0x90, 0x35, 0xDD, 0x94, 0x57}, // D-TRUST Root CA 3 2013
+	{0xD9, 0x2C, 0x17, 0x1F, 0x5C, 0xF8, 0x90, 0xBA, 0x42, 0x80, 0x19, 0x29, 0x29, 0x27, 0xFE, 0x22, 0xF3, 0x20, 0x7F, 0xD2, 0xB5, 0x44, 0x49, 0xCB, 0x6F, 0x67, 0x5A, 0xF4, 0x92, 0x21, 0x46, 0xE2}, // D-Trust SBR Root CA 1 2022
+	{0xDB, 0xA8, 0x4D, 0xD7, 0xEF, 0x62, 0x2D, 0x48, 0x54, 0x63, 0xA9, 0x01, 0x37, 0xEA, 0x4D, 0x57, 0x4D, 0xF8, 0x55, 0x09, 0x28, 0xF6, 0xAF, 0xA0, 0x3B, 0x4D, 0x8B, 0x11, 0x41, 0xE6, 0x36, 0xCC}, // D-Trust SBR Root CA 2 2022
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0xC1, 0x46, 0x8C, 0xF2, 0x25, 0x4E, 0x60, 0x04, 0xB2, 0x46, 0x96, 0xAB, 0xA2, 0x09, 0xD1, 0xA3, 0x0B, 0xA6, 0xE2, 0xDF, 0xF6, 0x8A, 0x9A, 0x4E, 0x32, 0xC6, 0xAB, 0x41, 0x4F, 0x90, 0xC8, 0xD9}, // DigiCert ECC P384 Root G5
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0xE4, 0x6A, 0x39, 0x22, 0x04, 0xA8, 0xDC, 0xA3, 0x42, 0xA7, 0x1C, 0x1C, 0xA9, 0xA6, 0x0C, 0x91, 0x85, 0xB9, 0xA9, 0x30, 0x37, 0x01, 0x20, 0xC3, 0xB9, 0xC7, 0xE3, 0x85, 0x6F, 0x0D, 0x8F, 0x3B}, // DigiCert RSA4096 Root G5
+	{0xE8, 0xE8, 0x17, 0x65, 0x36, 0xA6, 0x0C, 0xC2, 0xC4, 0xE1, 0x01, 0x87, 0xC3, 0xBE, 0xFC, 0xA2, 0x0E, 0xF2, 0x63, 0x49, 0x70, 0x18, 0xF5, 0x66, 0xD5, 0xBE, 0xA0, 0xF9, 0x4D, 0x0C, 0x11, 0x1B}, // DigiCert SMIME ECC P384 Root G5
+	{0x90, 0x37, 0x0D, 0x3E, 0xFA, 0x88, 0xBF, 0x58, 0xC3, 0x01, 0x05, 0xBA, 0x25, 0x10, 0x4A, 0x35, 0x84, 0x60, 0xA7, 0xFA, 0x52, 0xDF, 0xC2, 0x01, 0x1D, 0xF2, 0x33, 0xA0, 0xF4, 0x17, 0x91, 0x2A}, // DigiCert SMIME RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x88, 0x49, 0x7F, 0x01, 0x60, 0x2F, 0x31, 0x54, 0x24, 0x6A, 0xE2, 0x8C, 0x4D, 0x5A, 0xEF, 0x10, 0xF1, 0xD8, 0x7E, 0xBB, 0x76, 0x62, 0x6F, 0x4A, 0xE0, 0xB7, 0xF9, 0x5B, 0xA7, 0x96, 0x87, 0x99}, // EC-ACC
+	{0x73, 0xC1, 0x76, 0x43, 0x4F, 0x1B, 0xC6, 0xD5, 0xAD, 0xF4, 0x5B, 0x0E, 0x76, 0xE7, 0x27, 0x28, 0x7C, 0x8D, 0xE5, 0x76, 0x16, 0xC1, 0xE6, 0xE6, 0x14, 0x1A, 0x2B, 0x2C, 0xBC, 0x7D, 0x8E, 0x4C}, // Entrust Root Certification Authority
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0xB7, 0xA4, 0x1E, 0xD8, 0x09, 0x6D, 0x62, 0x71, 0x6B, 0xAD, 0xC7, 0xF5, 0x30, 0x94, 0x21, 0x97, 0xA9, 0xE7, 0xE3, 0x17, 0x5C, 0xE0, 0x5D, 0x11, 0xD0, 0x1E, 0x7A, 0xD6, 0xC1, 0x2D, 0xCB, 0xA7}, // Entrust SMIME Root CA - 2022
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0x5A, 0xB4, 0xFC, 0xDB, 0x18, 0x0B, 0x5B, 0x6A, 0xF0, 0xD2, 0x62, 0xA2, 0x37, 0x5A, 0x2C, 0x77, 0xD2, 0x56, 0x02, 0x01, 0x5D, 0x96, 0x64, 0x87, 0x56, 0x61, 0x1E, 0x2E, 0x78, 0xC5, 0x3A, 0xD3}, // Fina Root CA
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0x5E, 0x35, 0x71, 0xF3, 0x3F, 0x45, 0xA7, 0xDF, 0x15, 0x37, 0xA6, 0x8B, 0x5F, 0xFB, 0x9E, 0x03, 0x6A, 0xF9, 0xD2, 0xF5, 0xBC, 0x4C, 0x97, 0x17, 0x13, 0x0D, 0xC4, 0x3D, 0x71, 0x75, 0xAA, 0xC7}, // GLOBALTRUST
+	{0x41, 0x6B, 0x1F, 0x9E, 0x84, 0xE7, 0x4C, 0x1D, 0x19, 0xB2, 0x3D, 0x8D, 0x71, 0x91, 0xC6, 0xAD, 0x81, 0x24, 0x6E, 0x64, 0x16, 0x01, 0xF5, 0x99, 0x13, 0x27, 0x29, 0xF5, 0x07, 0xBE, 0xB3, 0xCC}, // GLOBALTRUST 2015
+	{0x9A, 0x29, 0x6A, 0x51, 0x82, 0xD1, 0xD4, 0x51, 0xA2, 0xE3, 0x7F, 0x43, 0x9B, 0x74, 0xDA, 0xAF, 0xA2, 0x67, 0x52, 0x33, 0x29, 0xF9, 0x0F, 0x9A, 0x0D, 0x20, 0x07, 0xC3, 0x34, 0xE2, 0x3C, 0x9A}, // GLOBALTRUST 2020
+	{0x2A, 0x57, 0x54, 0x71, 0xE3, 0x13, 0x40, 0xBC, 0x21, 0x58, 0x1C, 0xBD, 0x2C, 0xF1, 0x3E, 0x15, 0x84, 0x63, 0x20, 0x3E, 0xCE, 0x94, 0xBC, 0xF9, 0xD3, 0xCC, 0x19, 0x6B, 0xF0, 0x9A, 0x54, 0x72}, // GTS Root R1
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0xC4, 0x5D, 0x7B, 0xB0, 0x8E, 0x6D, 0x67, 0xE6, 0x2E, 0x42, 0x35, 0x11, 0x0B, 0x56, 0x4E, 0x5F, 0x78, 0xFD, 0x92, 0xEF, 0x05, 0x8C, 0x84, 0x0A, 0xEA, 0x4E, 0x64, 0x55, 0xD7, 0x58, 0x5C, 0x60}, // GTS Root R2
+	{0x15, 0xD5, 0xB8, 0x77, 0x46, 0x19, 0xEA, 0x7D, 0x54, 0xCE, 0x1C, 0xA6, 0xD0, 0xB0, 0xC4, 0x03, 0xE0, 0x37, 0xA9, 0x17, 0xF1, 0x31, 0xE8, 0xA0, 0x4E, 0x1E, 0x6B, 0x7A, 0x71, 0xBA, 0xBC, 0xE5}, // GTS Root R3
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x71, 0xCC, 0xA5, 0x39, 0x1F, 0x9E, 0x79, 0x4B, 0x04, 0x80, 0x25, 0x30, 0xB3, 0x63, 0xE1, 0x21, 0xDA, 0x8A, 0x30, 0x43, 0xBB, 0x26, 0x66, 0x2F, 0xEA, 0x4D, 0xCA, 0x7F, 0xC9, 0x51, 0xA4, 0xBD}, // GTS Root R4
+	{0x13, 0x63, 0x35, 0x43, 0x93, 0x34, 0xA7, 0x69, 0x80, 0x16, 0xA0, 0xD3, 0x24, 0xDE, 0x72, 0x28, 0x4E, 0x07, 0x9D, 0x7B, 0x52, 0x20, 0xBB, 0x8F, 0xBD, 0x74, 0x78, 0x16, 0xEE, 0xBE, 0xBA, 0xCA}, // Global Chambersign Root - 2008
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xBE, 0xC9, 0x49, 0x11, 0xC2, 0x95, 0x56, 0x76, 0xDB, 0x6C, 0x0A, 0x55, 0x09, 0x86, 0xD7, 0x6E, 0x3B, 0xA0, 0x05, 0x66, 0x7C, 0x44, 0x2C, 0x97, 0x62, 0xB4, 0xFB, 0xB7, 0x73, 0xDE, 0x22, 0x8C}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0x5C, 0xBF, 0x6F, 0xB8, 0x1F, 0xD4, 0x17, 0xEA, 0x41, 0x28, 0xCD, 0x6F, 0x81, 0x72, 0xA3, 0xC9, 0x40, 0x20, 0x94, 0xF7, 0x4A, 0xB2, 0xED, 0x3A, 0x06, 0xB4, 0x40, 0x5D, 0x04, 0xF3, 0x0B, 0x19}, // GlobalSign Secure Mail Root E45
+	{0x31, 0x9A, 0xF0, 0xA7, 0x72, 0x9E, 0x6F, 0x89, 0x26, 0x9C, 0x13, 0x1E, 0xA6, 0xA3, 0xA1, 0x6F, 0xCD, 0x86, 0x38, 0x9F, 0xDC, 0xAB, 0x3C, 0x47, 0xA4, 0xA6, 0x75, 0xC1, 0x61, 0xA3, 0xF9, 0x74}, // GlobalSign Secure Mail Root R45
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x8D, 0xD4, 0xB5, 0x37, 0x3C, 0xB0, 0xDE, 0x36, 0x76, 0x9C, 0x12, 0x33, 0x92, 0x80, 0xD8, 0x27, 0x46, 0xB3, 0xAA, 0x6C, 0xD4, 0x26, 0xE7, 0x97, 0xA3, 0x1B, 0xAB, 0xE4, 0x27, 0x9C, 0xF0, 0x0B}, // HARICA Client ECC Root CA 2021
+	{0x1B, 0xE7, 0xAB, 0xE3, 0x06, 0x86, 0xB1, 0x63, 0x48, 0xAF, 0xD1, 0xC6, 0x1B, 0x68, 0x66, 0xA0, 0xEA, 0x7F, 0x48, 0x21, 0xE6, 0x7D, 0x5E, 0x8A, 0xF9, 0x37, 0xCF, 0x80, 0x11, 0xBC, 0x75, 0x0D}, // HARICA Client RSA Root CA 2021
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0x39, 0x45, 0xE0, 0x8A, 0x8D, 0x4A, 0x05, 0x54, 0xB7, 0x60, 0x5A, 0x7B, 0x35, 0x5B, 0x10, 0x18, 0x8E, 0x3E, 0xF8, 0x42, 0xC7, 0x6A, 0x80, 0x5C, 0x54, 0xE3, 0x65, 0x7C, 0x4D, 0x04, 0x1A, 0xAA}, // Hongkong Post Root CA 2
+	{0xA3, 0xCC, 0x68, 0x59, 0x5D, 0xFE, 0x7E, 0x86, 0xD8, 0xAD, 0x17, 0x72, 0xA8, 0xB5, 0x28, 0x4A, 0xDD, 0x54, 0xAC, 0xE3, 0xB8, 0xA7, 0x98, 0xDF, 0x47, 0xBC, 0xCA, 0xFB, 0x1F, 0xDB, 0x84, 0xDF}, // Hotspot 2.0 Trust Root CA - 03
+	{0x38, 0x08, 0xCE, 0x3E, 0x96, 0x1C, 0xA5, 0x32, 0x68, 0x2F, 0xFB, 0x87, 0x08, 0xB5, 0x44, 0xE8, 0xF1, 0x75, 0xAA, 0x06, 0x56, 0x01, 0xA4, 0x59, 0x02, 0xDF, 0x92, 0x12, 0x8F, 0xC3, 0x85, 0x32}, // I.CA Root CA/ECC 05/2022
+	{0xB8, 0x69, 0x21, 0x48, 0xFF, 0x49, 0xC3, 0x79, 0x9F, 0xA2, 0x34, 0x7A, 0xE2, 0x8B, 0xCC, 0x52, 0x89, 0x62, 0x35, 0x12, 0xB6, 0x7D, 0xC1, 0x91, 0x70, 0x45, 0x2A, 0xDE, 0x24, 0xBA, 0x51, 0xD5}, // I.CA Root CA/ECC 12/2016
+	{0xD2, 0x79, 0xC0, 0x1A, 0x12, 0xE8, 0xDD, 0x9A, 0x62, 0x30, 0xE4, 0x59, 0xFA, 0xA4, 0x47, 0xCE, 0xB3, 0x36, 0x99, 0x84, 0x77, 0x33, 0x8C, 0x2E, 0xE4, 0x13, 0x5C, 0x96, 0x73, 0x74, 0x18, 0xEB}, // I.CA Root CA/RSA 05/2022
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x48, 0xE1, 0xCF, 0x9E, 0x43, 0xB6, 0x88, 0xA5, 0x10, 0x44, 0x16, 0x0F, 0x46, 0xD7, 0x73, 0xB8, 0x27, 0x7F, 0xE4, 0x5B, 0xEA, 0xAD, 0x0E, 0x4D, 0xF9, 0x0D, 0x19, 0x74, 0x38, 0x2F, 0xEA, 0x99}, // LAWtrust Root CA2 (4096)
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6C, 0x61, 0xDA, 0xC3, 0xA2, 0xDE, 0xF0, 0x31, 0x50, 0x6B, 0xE0, 0x36, 0xD2, 0xA6, 0xFE, 0x40, 0x19, 0x94, 0xFB, 0xD1, 0x3D, 0xF9, 0xC8, 0xD4, 0x66, 0x59, 0x92, 0x74, 0xC4, 0x46, 0xEC, 0x98}, // NetLock Arany (Class Gold) Főtanúsítvány
+	{0xEB, 0x7E, 0x05, 0xAA, 0x58, 0xE7, 0xBD, 0x32, 0x8A, 0x28, 0x2B, 0xF8, 0x86, 0x70, 0x33, 0xF3, 0xC0, 0x35, 0x34, 0x2B, 0x51, 0x6E, 0xE8, 0x5C, 0x01, 0x67, 0x3D, 0xFF, 0xFF, 0xBB, 0xFE, 0x58}, // NetLock Platina (Class Platinum) Főtanúsítvány
+	{0x65, 0x35, 0x38, 0x33, 0xCF, 0x23, 0x4C, 0x79, 0x56, 0x21, 0x64, 0xF9, 0x08, 0x49, 0xC0, 0xD1, 0x04, 0xDB, 0xAB, 0xF8, 0xEE, 0x41, 0x06, 0x4D, 0x83, 0xE8, 0xCB, 0xE0, 0x3B, 0xA1, 0xC5, 0xA5}, // Netrust Root CA 2
+	{0xAA, 0xA1, 0x5B, 0xDD, 0xB1, 0xC9, 0x24, 0xAD, 0x7A, 0x7F, 0x22, 0xF8, 0x9E, 0x5C, 0xD3, 0xE2, 0xC6, 0x14, 0x07, 0xA0, 0x64, 0xE0, 0x11, 0xB7, 0xCF, 0x4C, 0xB6, 0x18, 0x71, 0x64, 0x49, 0xA4}, // Notarius Root Certificate Authority
+	{0xC7, 0xB8, 0x94, 0x8F, 0xEC, 0xCA, 0xAC, 0xE5, 0xB5, 0x09, 0xA3, 0x43, 0xF3, 0x8D, 0x03, 0x01, 0xD0, 0x79, 0x01, 0x88, 0x56, 0x04, 0xB3, 0xF2, 0x67, 0x27, 0x0E, 0x1E, 0xBB, 0xEF, 0x0F, 0xE7}, // Notarius Root Certificate Authority
+	{0x41, 0xC9, 0x23, 0x86, 0x6A, 0xB4, 0xCA, 0xD6, 0xB7, 0xAD, 0x57, 0x80, 0x81, 0x58, 0x2E, 0x02, 0x07, 0x97, 0xA6, 0xCB, 0xDF, 0x4F, 0xFF, 0x78, 0xCE, 0x83, 0x96, 0xB3, 0x89, 0x37, 0xD7, 0xF5}, // OISTE WISeKey Global Root GA CA
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0xAC, 0x7F, 0x78, 0x62, 0xE6, 0x85, 0xC7, 0xA7, 0xD9, 0x82, 0x6A, 0x58, 0xEA, 0x32, 0xD1, 0x83, 0xD4, 0x89, 0x3F, 0xCC, 0x8F, 0x8F, 0xD6, 0xD9, 0x00, 0xC9, 0x76, 0x9A, 0x98, 0x7E, 0x77, 0xF0}, // PostSignum Root QCA 4
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x83, 0xFD, 0xDB, 0x2F, 0xD9, 0xDA, 0xE3, 0xB2, 0x1E, 0xEB, 0xD3, 0x3C, 0xF4, 0x62, 0x51, 0xD7, 0x46, 0xF0, 0xD6, 0x10, 0x2B, 0x68, 0x31, 0x50, 0xDD, 0x7B, 0x98, 0xAD, 0x8E, 0x4B, 0xB9, 0xF8}, // QuoVadis SMIME ECC P384 Root G4
+	{0x52, 0x32, 0xA3, 0x04, 0xAA, 0x4A, 0x10, 0xCF, 0xE6, 0xC4, 0x78, 0x42, 0xEA, 0x33, 0x81, 0xCB, 0x31, 0xD6, 0x19, 0xE2, 0x4F, 0x58, 0x12, 0x6D, 0x53, 0x4C, 0xD5, 0x0C, 0x5C, 0xE7, 0x84, 0x5D}, // QuoVadis SMIME RSA 4096 Root G4
+	{0x77, 0x15, 0x35, 0xD4, 0x3D, 0x46, 0x33, 0xBD, 0x30, 0x7E, 0xB7, 0xB8, 0xA3, 0x96, 0x6B, 0x5D, 0xF0, 0x07, 0x07, 0xC0, 0x88, 0x08, 0x99, 0x20, 0x08, 0x0C, 0x1A, 0xE6, 0xD3, 0xCB, 0x0F, 0x68}, // QuoVadis Signing ECC P384 Root G4
+	{0x9F, 0x8E, 0x6D, 0xB3, 0x1E, 0x74, 0x02, 0x85, 0xE0, 0xC2, 0xC2, 0xDE, 0xB0, 0x9E, 0x44, 0x2B, 0xDD, 0x4E, 0x74, 0xBD, 0xEE, 0xAE, 0x29, 0x62, 0xBC, 0x82, 0xD1, 0xEC, 0xB9, 0xF3, 0x98, 0x55}, // QuoVadis Signing RSA 4096 Root G4
+	{0x36, 0x29, 0xE7, 0x18, 0x8E, 0x00, 0xA7, 0xCB, 0x32, 0x32, 0xC4, 0x42, 0x6B, 0xC8, 0x49, 0x12, 0xF1, 0x21, 0x8B, 0x1A, 0x9A, 0xE6, 0x76, 0xC0, 0xB0, 0xAB, 0xE1, 0xDB, 0xFE, 0x21, 0x82, 0xB5}, // SECOM SMIME RSA Root CA 2024
+	{0xFA, 0xD5, 0x40, 0x81, 0x1A, 0xFA, 0xE0, 0xDC, 0x76, 0x7C, 0xDF, 0x65, 0x72, 0xA0, 0x88, 0xFA, 0x3C, 0xE8, 0x49, 0x3D, 0xD8, 0x2B, 0x3B, 0x86, 0x9A, 0x67, 0xD1, 0x0A, 0xAB, 0x4E, 0x81, 0x24}, // SI-TRUST Root
+	{0xAD, 0x7D, 0xD5, 0x8D, 0x03, 0xAE, 0xDB, 0x22, 0xA3, 0x0B, 0x50, 0x84, 0x39, 0x49, 0x20, 0xCE, 0x12, 0x23, 0x0C, 0x2D, 0x80, 0x17, 0xAD, 0x9B, 0x81, 0xAB, 0x04, 0x07, 0x9B, 0xDD, 0x02, 0x6B}, // SSL.com Client ECC Root CA 2022
+	{0x1D, 0x4C, 0xA4, 0xA2, 0xAB, 0x21, 0xD0, 0x09, 0x36, 0x59, 0x80, 0x4F, 0xC0, 0xEB, 0x21, 0x75, 0xA6, 0x17, 0x27, 0x9B, 0x56, 0xA2, 0x47, 0x52, 0x45, 0xC9, 0x51, 0x7A, 0xFE, 0xB5, 0x91, 0x53}, // SSL.com Client RSA Root CA 2022
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xA1, 0x33, 0x9D, 0x33, 0x28, 0x1A, 0x0B, 0x56, 0xE5, 0x57, 0xD3, 0xD3, 0x2B, 0x1C, 0xE7, 0xF9, 0x36, 0x7E, 0xB0, 0x94, 0xBD, 0x5F, 0xA7, 0x2A, 0x7E, 0x50, 0x04, 0xC8, 0xDE, 0xD7, 0xCA, 0xFE}, // SZAFIR ROOT CA2
+	{0x34, 0xBB, 0x34, 0xE1, 0x4F, 0xAE, 0xD0, 0xD3, 0x39, 0x2F, 0x2F, 0xC4, 0x41, 0xC0, 0xEC, 0xD5, 0xFD, 0x88, 0xAD, 0x88, 0x11, 0x8D, 0xF2, 0xD1, 0xBA, 0x76, 0xCD, 0xEC, 0x1E, 0xEA, 0x10, 0xB8}, // Saudi National Root CA
+	{0x22, 0xD9, 0x59, 0x92, 0x34, 0xD6, 0x0F, 0x1D, 0x4B, 0xC7, 0xC7, 0xE9, 0x6F, 0x43, 0xFA, 0x55, 0x5B, 0x07, 0x30, 0x1F, 0xD4, 0x75, 0x17, 0x50, 0x89, 0xDA, 0xFB, 0x8C, 0x25, 0xE4, 0x77, 0xB3}, // Sectigo Public Email Protection Root E46
+	{0xD5, 0x91, 0x7A, 0x77, 0x91, 0xEB, 0x7C, 0xF2, 0x0A, 0x2E, 0x57, 0xEB, 0x98, 0x28, 0x4A, 0x67, 0xB2, 0x8A, 0x57, 0xE8, 0x91, 0x82, 0xDA, 0x53, 0xD5, 0x46, 0x67, 0x8C, 0x9F, 0xDE, 0x2B, 0x4F}, // Sectigo Public Email Protection Root R46
+	{0x42, 0x00, 0xF5, 0x04, 0x3A, 0xC8, 0x59, 0x0E, 0xBB, 0x52, 0x7D, 0x20, 0x9E, 0xD1, 0x50, 0x30, 0x29, 0xFB, 0xCB, 0xD4, 0x1C, 0xA1, 0xB5, 0x06, 0xEC, 0x27, 0xF1, 0x5A, 0xDE, 0x7D, 0xAC, 0x69}, // Secure Global CA
+	{0x4C, 0x1C, 0xCD, 0x24, 0xF1, 0x7E, 0x95, 0x0F, 0xC1, 0x85, 0x36, 0xB3, 0x3C, 0xAF, 0xE3, 0x22, 0x93, 0xCF, 0xC3, 0x3E, 0x84, 0x67, 0xB4, 0x1E, 0x1C, 0x69, 0x30, 0x55, 0xD7, 0xF5, 0x13, 0xBF}, // SecureSign Root CA16
+	{0xF1, 0xC1, 0xB5, 0x0A, 0xE5, 0xA2, 0x0D, 0xD8, 0x03, 0x0E, 0xC9, 0xF6, 0xBC, 0x24, 0x82, 0x3D, 0xD3, 0x67, 0xB5, 0x25, 0x57, 0x59, 0xB4, 0xE7, 0x1B, 0x61, 0xFC, 0xE9, 0xF7, 0x37, 0x5D, 0x73}, // SecureTrust CA
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x3C, 0x4F, 0xB0, 0xB9, 0x5A, 0xB8, 0xB3, 0x00, 0x32, 0xF4, 0x32, 0xB8, 0x6F, 0x53, 0x5F, 0xE1, 0x72, 0xC1, 0x85, 0xD0, 0xFD, 0x39, 0x86, 0x58, 0x37, 0xCF, 0x36, 0x18, 0x7F, 0xA6, 0xF4, 0x28}, // Staat der Nederlanden Root CA - G3
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x8F, 0x9A, 0xDB, 0x6D, 0x89, 0x5D, 0xAB, 0x5A, 0xDF, 0x5C, 0x3D, 0x3F, 0xAB, 0x83, 0x92, 0x7B, 0xE0, 0xFB, 0x64, 0xEF, 0x82, 0x48, 0x5C, 0x62, 0x28, 0x0D, 0x58, 0x4E, 0x8B, 0xD5, 0x5D, 0x22}, // Swedish Government Root Authority v3
+	{0x6E, 0xC6, 0x61, 0x4E, 0x9A, 0x8E, 0xFD, 0x47, 0xD6, 0x31, 0x8F, 0xFD, 0xFD, 0x0B, 0xF6, 0x5B, 0x49, 0x3A, 0x14, 0x1F, 0x77, 0xC3, 0x8D, 0x0B, 0x31, 0x9B, 0xE1, 0xBB, 0xBC, 0x05, 0x3D, 0xD2}, // Swiss Government Root CA I
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0x9A, 0x12, 0xC3, 0x92, 0xBF, 0xE5, 0x78, 0x91, 0xA0, 0xC5, 0x45, 0x30, 0x9D, 0x4D, 0x9F, 0xD5, 0x67, 0xE4, 0x80, 0xCB, 0x61, 0x3D, 0x63, 0x42, 0x27, 0x8B, 0x19, 0x5C, 0x79, 0xA7, 0x93, 0x1F}, // SwissSign RSA SMIME Root CA 2022 - 1
+	{0x9D, 0x19, 0x0B, 0x2E, 0x31, 0x45, 0x66, 0x68, 0x5B, 0xE8, 0xA8, 0x89, 0xE2, 0x7A, 0xA8, 0xC7, 0xD7, 0xAE, 0x1D, 0x8A, 0xAD, 0xDB, 0xA3, 0xC1, 0xEC, 0xF9, 0xD2, 0x48, 0x63, 0xCD, 0x34, 0xB9}, // Symantec Class 1 Public Primary Certification Authority - G6
+	{0xCB, 0x62, 0x7D, 0x18, 0xB5, 0x8A, 0xD5, 0x6D, 0xDE, 0x33, 0x1A, 0x30, 0x45, 0x6B, 0xC6, 0x5C, 0x60, 0x1A, 0x4E, 0x9B, 0x18, 0xDE, 0xDC, 0xEA, 0x08, 0xE7, 0xDA, 0xAA, 0x07, 0x81, 0x5F, 0xF0}, // Symantec Class 2 Public Primary Certification Authority - G6
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0x3A, 0x00, 0x72, 0xD4, 0x9F, 0xFC, 0x04, 0xE9, 0x96, 0xC5, 0x9A, 0xEB, 0x75, 0x99, 0x1D, 0x3C, 0x34, 0x0F, 0x36, 0x15, 0xD6, 0xFD, 0x4D, 0xCE, 0x90, 0xAC, 0x0B, 0x3D, 0x88, 0xEA, 0xD4, 0xF4}, // TWCA Global Root CA G2
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x3A, 0xE6, 0xDF, 0x7E, 0x0D, 0x63, 0x7A, 0x65, 0xA8, 0xC8, 0x16, 0x12, 0xEC, 0x6F, 0x9A, 0x14, 0x2F, 0x85, 0xA1, 0x68, 0x34, 0xC1, 0x02, 0x80, 0xD8, 0x8E, 0x70, 0x70, 0x28, 0x51, 0x87, 0x55}, // Telekom Security SMIME ECC Root 2021
+	{0x78, 0xA6, 0x56, 0x34, 0x4F, 0x94, 0x7E, 0x9C, 0xC0, 0xF7, 0x34, 0xD9, 0x05, 0x3D, 0x32, 0xF6, 0x74, 0x20, 0x86, 0xB6, 0xB9, 0xCD, 0x2C, 0xAE, 0x4F, 0xAE, 0x1A, 0x2E, 0x4E, 0xFD, 0xE0, 0x48}, // Telekom Security SMIME RSA Root 2023
+	{0x36, 0x82, 0x22, 0x8D, 0x7D, 0x67, 0x8B, 0x57, 0x14, 0x40, 0xCF, 0x1C, 0xB3, 0x4E, 0x69, 0xFB, 0x41, 0x35, 0xFD, 0x6C, 0x2A, 0x1B, 0xE3, 0x8E, 0x14, 0x16, 0x3B, 0x71, 0x1E, 0x02, 0xAE, 0x01}, // Telia EC Email Root CA v3
+	{0x5B, 0x0C, 0x50, 0x2A, 0x7D, 0x96, 0x3B, 0xA5, 0x52, 0x17, 0x39, 0x6F, 0xDA, 0x9B, 0x3D, 0xC7, 0x81, 0x71, 0x00, 0x0A, 0xEE, 0xFF, 0x42, 0xCE, 0xCC, 0x3A, 0x20, 0xA7, 0x93, 0x81, 0x63, 0xE8}, // Telia RSA Email Root CA v3
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xDD, 0x69, 0x36, 0xFE, 0x21, 0xF8, 0xF0, 0x77, 0xC1, 0x23, 0xA1, 0xA5, 0x21, 0xC1, 0x22, 0x24, 0xF7, 0x22, 0x55, 0xB7, 0x3E, 0x03, 0xA7, 0x26, 0x06, 0x93, 0xE8, 0xA2, 0x4B, 0x0F, 0xA3, 0x89}, // TeliaSonera Root CA v1
+	{0x2A, 0x8D, 0xA2, 0xF8, 0xD2, 0x3E, 0x0C, 0xD3, 0xB5, 0x87, 0x1E, 0xCF, 0xB0, 0xF4, 0x22, 0x76, 0xCA, 0x73, 0x23, 0x06, 0x67, 0xF4, 0x74, 0xEE, 0xDE, 0x71, 0xC5, 0xEE, 0x32, 0xCC, 0x3E, 0xC6}, // Thailand National Root Certification Authority - G1
+	{0x97, 0x55, 0x20, 0x15, 0xF5, 0xDD, 0xFC, 0x3C, 0x87, 0x88, 0xC0, 0x06, 0x94, 0x45, 0x55, 0x40, 0x88, 0x94, 0x45, 0x00, 0x84, 0xF1, 0x00, 0x86, 0x70, 0x86, 0xBC, 0x1A, 0x2B, 0xB5, 0x8D, 0xC8}, // Trustwave Global Certification Authority
+	{0x94, 0x5B, 0xBC, 0x82, 0x5E, 0xA5, 0x54, 0xF4, 0x89, 0xD1, 0xFD, 0x51, 0xA7, 0x3D, 0xDF, 0x2E, 0xA6, 0x24, 0xAC, 0x70, 0x19, 0xA0, 0x52, 0x05, 0x22, 0x5C, 0x22, 0xA7, 0x8C, 0xCF, 0xA8, 0xB4}, // Trustwave Global ECC P256 Certification Authority
+	{0x55, 0x90, 0x38, 0x59, 0xC8, 0xC0, 0xC3, 0xEB, 0xB8, 0x75, 0x9E, 0xCE, 0x4E, 0x25, 0x57, 0x22, 0x5F, 0xF5, 0x75, 0x8B, 0xBD, 0x38, 0xEB, 0xD4, 0x82, 0x76, 0x60, 0x1E, 0x1B, 0xD5, 0x80, 0x97}, // Trustwave Global ECC P384 Certification Authority
+	{0x9B, 0xEA, 0x11, 0xC9, 0x76, 0xFE, 0x01, 0x47, 0x64, 0xC1, 0xBE, 0x56, 0xA6, 0xF9, 0x14, 0xB5, 0xA5, 0x60, 0x31, 0x7A, 0xBD, 0x99, 0x88, 0x39, 0x33, 0x82, 0xE5, 0x16, 0x1A, 0xA0, 0x49, 0x3C}, // UCA Global G2 Root
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0xCB, 0xB5, 0xAF, 0x18, 0x5E, 0x94, 0x2A, 0x24, 0x02, 0xF9, 0xEA, 0xCB, 0xC0, 0xED, 0x5B, 0xB8, 0x76, 0xEE, 0xA3, 0xC1, 0x22, 0x36, 0x23, 0xD0, 0x04, 0x47, 0xE4, 0xF3, 0xBA, 0x55, 0x4B, 0x65}, // VeriSign Class 1 Public Primary Certification Authority - G3
+	{0x92, 0xA9, 0xD9, 0x83, 0x3F, 0xE1, 0x94, 0x4D, 0xB3, 0x66, 0xE8, 0xBF, 0xAE, 0x7A, 0x95, 0xB6, 0x48, 0x0C, 0x2D, 0x6C, 0x6C, 0x2A, 0x1B, 0xE6, 0x5D, 0x42, 0x36, 0xB6, 0x08, 0xFC, 0xA1, 0xBB}, // VeriSign Class 2 Public Primary Certification Authority - G3
+	{0x23, 0x99, 0x56, 0x11, 0x27, 0xA5, 0x71, 0x25, 0xDE, 0x8C, 0xEF, 0xEA, 0x61, 0x0D, 0xDF, 0x2F, 0xA0, 0x78, 0xB5, 0xC8, 0x06, 0x7F, 0x4E, 0x82, 0x82, 0x90, 0xBF, 0xB8, 0x60, 0xE8, 0x4B, 0x3C}, // VeriSign Universal Root Certification Authority
+	{0x68, 0xAD, 0x50, 0x90, 0x9B, 0x04, 0x36, 0x3C, 0x60, 0x5E, 0xF1, 0x35, 0x81, 0xA9, 0x39, 0xFF, 0x2C, 0x96, 0x37, 0x2E, 0x3F, 0x12, 0x32, 0x5B, 0x0A, 0x68, 0x61, 0xE1, 0xD5, 0x9F, 0x66, 0x03}, // Verizon Global Root CA
+	{0xC5, 0x7A, 0x3A, 0xCB, 0xE8, 0xC0, 0x6B, 0xA1, 0x98, 0x8A, 0x83, 0x48, 0x5B, 0xF3, 0x26, 0xF2, 0x44, 0x87, 0x75, 0x37, 0x98, 0x49, 0xDE, 0x01, 0xCA, 0x43, 0x57, 0x1A, 0xF3, 0x57, 0xE7, 0x4B}, // Visa Information Delivery Root CA
+	{0xCE, 0xCD, 0xDC, 0x90, 0x50, 0x99, 0xD8, 0xDA, 0xDF, 0xC5, 0xB1, 0xD2, 0x09, 0xB7, 0x37, 0xCB, 0xE2, 0xC1, 0x8C, 0xFB, 0x2C, 0x10, 0xC0, 0xFF, 0x0B, 0xCF, 0x0D, 0x32, 0x86, 0xFC, 0x1A, 0xA2}, // XRamp Global Certification Authority
+	{0x01, 0x6E, 0x1D, 0xCD, 0x5F, 0x78, 0x84, 0x1B, 0xBE, 0xBB, 0xAE, 0x9D, 0xDE, 0xA0, 0x8C, 0x8D, 0x7E, 0xC5, 0x4E, 0x69, 0x8E, 0x95, 0xBB, 0x77, 0x8E, 0xCD, 0xD1, 0xE1, 0x0D, 0x8B, 0xF4, 0xF9}, // ZETES TSP ROOT CA 001
+	{0xEA, 0xA9, 0x62, 0xC4, 0xFA, 0x4A, 0x6B, 0xAF, 0xEB, 0xE4, 0x15, 0x19, 0x6D, 0x35, 0x1C, 0xCD, 0x88, 0x8D, 0x4F, 0x53, 0xF3, 0xFA, 0x8A, 0xE6, 0xD7, 0xC4, 0x66, 0xA9, 0x4E, 0x60, 0x42, 0xBB}, // certSIGN ROOT CA
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xBE, 0xB0, 0x0B, 0x30, 0x83, 0x9B, 0x9B, 0xC3, 0x2C, 0x32, 0xE4, 0x44, 0x79, 0x05, 0x95, 0x06, 0x41, 0xF2, 0x64, 0x21, 0xB1, 0x5E, 0xD0, 0x89, 0x19, 0x8B, 0x51, 0x8A, 0xE2, 0xEA, 0x1B, 0x99}, // e-Szigno Root CA 2017
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0x1E, 0x51, 0x94, 0x2B, 0x84, 0xFD, 0x46, 0x7B, 0xF7, 0x7D, 0x1C, 0x89, 0xDA, 0x24, 0x1C, 0x04, 0x25, 0x4D, 0xC8, 0xF3, 0xEF, 0x4C, 0x22, 0x45, 0x1F, 0xE7, 0xA8, 0x99, 0x78, 0xBD, 0xCD, 0x4F}, // ePKI Root Certification Authority - G2
+	{0x19, 0xA2, 0xFA, 0x09, 0x33, 0x2C, 0x6D, 0x8E, 0xAC, 0x13, 0x93, 0xD5, 0xF3, 0x03, 0x71, 0xDD, 0x8B, 0x4D, 0xD6, 0x87, 0xB0, 0xE1, 0xE5, 0x0A, 0x6B, 0x48, 0xAE, 0x76, 0x2C, 0xAB, 0xA2, 0xB5}, // ePKI Root Certification Authority - G4
+	{0xBC, 0x4D, 0x80, 0x9B, 0x15, 0x18, 0x9D, 0x78, 0xDB, 0x3E, 0x1D, 0x8C, 0xF4, 0xF9, 0x72, 0x6A, 0x79, 0x5D, 0xA1, 0x64, 0x3C, 0xA5, 0xF1, 0x35, 0x8E, 0x1D, 0xDB, 0x0E, 0xDC, 0x0D, 0x7E, 0xB3}, // emSign ECC Root CA - C3
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x12, 0x56, 0x09, 0xAA, 0x30, 0x1D, 0xA0, 0xA2, 0x49, 0xB9, 0x7A, 0x82, 0x39, 0xCB, 0x6A, 0x34, 0x21, 0x6F, 0x44, 0xDC, 0xAC, 0x9F, 0x39, 0x54, 0xB1, 0x42, 0x92, 0xF2, 0xE8, 0xC8, 0x60, 0x8F}, // emSign Root CA - C1
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Mozilla root store.
+var Mozilla = [][sha256.Size]byte{
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x55, 0x41, 0x53, 0xB1, 0x3D, 0x2C, 0xF9, 0xDD, 0xB7, 0x53, 0xBF, 0xBE, 0x1A, 0x4E, 0x0A, 0xE0, 0x8D, 0x0A, 0xA4, 0x18, 0x70, 0x58, 0xFE, 0x60, 0xA2, 0xB8, 0x62, 0xB2, 0xE4, 0xB8, 0x7B, 0xCB}, // AC RAIZ FNMT-RCM SERVIDORES SEGUROS
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0xFB, 0x8F, 0xEC, 0x75, 0x91, 0x69, 0xB9, 0x10, 0x6B, 0x1E, 0x51, 0x16, 0x44, 0xC6, 0x18, 0xC5, 0x13, 0x04, 0x37, 0x3F, 0x6C, 0x06, 0x43, 0x08, 0x8D, 0x8B, 0xEF, 0xFD, 0x1B, 0x99, 0x75, 0x99}, // ANF Secure Server Root CA
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xE3, 0x86, 0x55, 0xF4, 0xB0, 0x19, 0x0C, 0x84, 0xD3, 0xB3, 0x89, 0x3D, 0x84, 0x0A, 0x68, 0x7E, 0x19, 0x0A, 0x25, 0x6D, 0x98, 0x05, 0x2F, 0x15, 0x9E, 0x6D, 0x4A, 0x39, 0xF5, 0x89, 0xA6, 0xEB}, // Atos TrustedRoot Root CA ECC G2 2020
+	{0xB2, 0xFA, 0xE5, 0x3E, 0x14, 0xCC, 0xD7, 0xAB, 0x92, 0x12, 0x06, 0x47, 0x01, 0xAE, 0x27, 0x9C, 0x1D, 0x89, 0x88, 0xFA, 0xCB, 0x77, 0x5F, 0xA8, 0xA0, 0x08, 0x91, 0x4E, 0x66, 0x39, 0x88, 0xA8}, // Atos TrustedRoot Root CA ECC TLS 2021
+	{0x78, 0x83, 0x3A, 0x78, 0x3B, 0xB2, 0x98, 0x6C, 0x25, 0x4B, 0x93, 0x70, 0xD3, 0xC2, 0x0E, 0x5E, 0xBA, 0x8F, 0xA7, 0x84, 0x0C, 0xBF, 0x63, 0xFE, 0x17, 0x29, 0x7A, 0x0B, 0x01, 0x19, 0x68, 0x5E}, // Atos TrustedRoot Root CA RSA G2 2020
+	{0x81, 0xA9, 0x08, 0x8E, 0xA5, 0x9F, 0xB3, 0x64, 0xC5, 0x48, 0xA6, 0xF8, 0x55, 0x59, 0x09, 0x9B, 0x6F, 0x04, 0x05, 0xEF, 0xBF, 0x18, 0xE5, 0x32, 0x4E, 0xC9, 0xF4, 0x57, 0xBA, 0x00, 0x11, 0x2F}, // Atos TrustedRoot Root CA RSA TLS 2021
+	{0x57, 0xDE, 0x05, 0x83, 0xEF, 0xD2, 0xB2, 0x6E, 0x03, 0x61, 0xDA, 0x99, 0xDA, 0x9D, 0xF4, 0x64, 0x8D, 0xEF, 0x7E, 0xE8, 0x44, 0x1C, 0x3B, 0x72, 0x8A, 0xFA, 0x9B, 0xCD, 0xE0, 0xF9, 0xB2, 0x6A}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0xF3, 0x89, 0x6F, 0x88, 0xFE, 0x7C, 0x0A, 0x88, 0x27, 0x66, 0xA7, 0xFA, 0x6A, 0xD2, 0x74, 0x9F, 0xB5, 0x7A, 0x7F, 0x3E, 0x98, 0xFB, 0x76, 0x9C, 0x1F, 0xA7, 0xB0, 0x9C, 0x2C, 0x44, 0xD5, 0xAE}, // BJCA Global Root CA1
+	{0x57, 0x4D, 0xF6, 0x93, 0x1E, 0x27, 0x80, 0x39, 0x66, 0x7B, 0x72, 0x0A, 0xFD, 0xC1, 0x60, 0x0F, 0xC2, 0x7E, 0xB6, 0x6D, 0xD3, 0x09, 0x29, 0x79, 0xFB, 0x73, 0x85, 0x64, 0x87, 0x21, 0x28, 0x82}, // BJCA Global Root CA2
+	{0x9A, 0x11, 0x40, 0x25, 0x19, 0x7C, 0x5B, 0xB9, 0x5D, 0x94, 0xE6, 0x3D, 0x55, 0xCD, 0x43, 0x79, 0x08, 0x47, 0xB6, 0x46, 0xB2, 0x3C, 0xDF, 0x11, 0xAD, 0xA4, 0xA0, 0x0E, 0xFF, 0x15, 0xFB, 0x48}, // Buypass Class 2 Root CA
+	{0xED, 0xF7, 0xEB, 0xBC, 0xA2, 0x7A, 0x2A, 0x38, 0x4D, 0x38, 0x7B, 0x7D, 0x40, 0x10, 0xC6, 0x66, 0xE2, 0xED, 0xB4, 0x84, 0x3E, 0x4C, 0x29, 0xB4, 0xAE, 0x1D, 0x5B, 0x93, 0x32, 0xE6, 0xB2, 0x4D}, // Buypass Class 3 Root CA
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x5C, 0xC3, 0xD7, 0x8E, 0x4E, 0x1D, 0x5E, 0x45, 0x54, 0x7A, 0x04, 0xE6, 0x87, 0x3E, 0x64, 0xF9, 0x0C, 0xF9, 0x53, 0x6D, 0x1C, 0xCC, 0x2E, 0xF8, 0x00, 0xF3, 0x55, 0xC4, 0xC5, 0xFD, 0x70, 0xFD}, // CFCA EV ROOT
+	{0x0C, 0x2C, 0xD6, 0x3D, 0xF7, 0x80, 0x6F, 0xA3, 0x99, 0xED, 0xE8, 0x09, 0x11, 0x6B, 0x57, 0x5B, 0xF8, 0x79, 0x89, 0xF0, 0x65, 0x18, 0xF9, 0x80, 0x8C, 0x86, 0x05, 0x03, 0x17, 0x8B, 0xAF, 0x66}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xB4, 0x58, 0x5F, 0x22, 0xE4, 0xAC, 0x75, 0x6A, 0x4E, 0x86, 0x12, 0xA1, 0x36, 0x1C, 0x5D, 0x9D, 0x03, 0x1A, 0x93, 0xFD, 0x84, 0xFE, 0xBB, 0x77, 0x8F, 0xA3, 0x06, 0x8B, 0x0F, 0xC4, 0x2D, 0xC2}, // Certainly Root E1
+	{0x77, 0xB8, 0x2C, 0xD8, 0x64, 0x4C, 0x43, 0x05, 0xF7, 0xAC, 0xC5, 0xCB, 0x15, 0x6B, 0x45, 0x67, 0x50, 0x04, 0x03, 0x3D, 0x51, 0xC6, 0x0C, 0x62, 0x02, 0xA8, 0xE0, 0xC3, 0x34, 0x67, 0xD3, 0xA0}, // Certainly Root R1
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD4, 0x8D, 0x3D, 0x23, 0xEE, 0xDB, 0x50, 0xA4, 0x59, 0xE5, 0x51, 0x97, 0x60, 0x1C, 0x27, 0x77, 0x4B, 0x9D, 0x7B, 0x18, 0xC9, 0x4D, 0x5A, 0x05, 0x95, 0x11, 0xA1, 0x02, 0x50, 0xB9, 0x31, 0x68}, // Certigna Root CA
+	{0xD8, 0xE0, 0xFE, 0xBC, 0x1D, 0xB2, 0xE3, 0x8D, 0x00, 0x94, 0x0F, 0x37, 0xD2, 0x7D, 0x41, 0x34, 0x4D, 0x99, 0x3E, 0x73, 0x4B, 0x99, 0xD5, 0x65, 0x6D, 0x97, 0x78, 0xD4, 0xD8, 0x14, 0x36, 0x24}, // Certum CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0xE5, 0x9A, 0xAA, 0x81, 0x60, 0x09, 0xC2, 0x2B, 0xFF, 0x5B, 0x25, 0xBA, 0xD3, 0x7D, 0xF3, 0x06, 0xF0, 0x49, 0x79, 0x7C, 0x1F, 0x81, 0xD8, 0x5A, 0xB0, 0x89, 0xE6, 0x57, 0xBD, 0x8F, 0x00, 0x44}, // D-TRUST BR Root CA 1 2020
+	{0x05, 0x52, 0xE6, 0xF8, 0x3F, 0xDF, 0x65, 0xE8, 0xFA, 0x96, 0x70, 0xE6, 0x66, 0xDF, 0x28, 0xA4, 0xE2, 0x13, 0x40, 0xB5, 0x10, 0xCB, 0xE5, 0x25, 0x66, 0xF9, 0x7C, 0x4F, 0xB9, 0x4B, 0x2B, 0xD1}, // D-TRUST BR Root CA 2 2023
+	{0x08, 0x17, 0x0D, 0x1A, 0xA3, 0x64, 0x53, 0x90, 0x1A, 0x2F, 0x95, 0x92, 0x45, 0xE3, 0x47, 0xDB, 0x0C, 0x8D, 0x37, 0xAB, 0xAA, 0xBC, 0x56, 0xB8, 0x1A, 0xA1, 0x00, 0xDC, 0x95, 0x89, 0x70, 0xDB}, // D-TRUST EV Root CA 1 2020
+	{0x8E, 0x82, 0x21, 0xB2, 0xE7, 0xD4, 0x00, 0x78, 0x36, 0xA1, 0x67, 0x2F, 0x0D, 0xCC, 0x29, 0x9C, 0x33, 0xBC, 0x07, 0xD3, 0x16, 0xF1, 0x32, 0xFA, 0x1A, 0x20, 0x6D, 0x58, 0x71, 0x50, 0xF1, 0xCE}, // D-TRUST EV Root CA 2 2023
+	{0xA1, 0xA8, 0x6D, 0x04, 0x12, 0x1E, 0xB8, 0x7F, 0x02, 0x7C, 0x66, 0xF5, 0x33, 0x03, 0xC2, 0x8E, 0x57, 0x39, 0xF9, 0x43, 0xFC, 0x84, 0xB3, 0x8A, 0xD6, 0xAF, 0x00, 0x90, 0x35, 0xDD, 0x94, 0x57}, // D-TRUST Root CA 3 2013
+	{0x49, 0xE7, 0xA4, 0x42, 0xAC, 0xF0, 0xEA, 0x62, 0x87, 0x05, 0x00, 0x54, 0xB5, 0x25, 0x64, 0xB6, 0x50, 0xE4, 0xF4, 0x9E, 0x42, 0xE3, 0x48, 0xD6, 0xAA, 0x38, 0xE0, 0x39, 0xE9, 0x57, 0xB1, 0xC1}, // D-TRUST Root Class 3 CA 2 2009
+	{0xEE, 0xC5, 0x49, 0x6B, 0x98, 0x8C, 0xE9, 0x86, 0x25, 0xB9, 0x34, 0x09, 0x2E, 0xEC, 0x29, 0x08, 0xBE, 0xD0, 0xB0, 0xF3, 0x16, 0xC2, 0xD4, 0x73, 0x0C, 0x84, 0xEA, 0xF1, 0xF3, 0xD3, 0x48, 0x81}, // D-TRUST Root Class 3 CA 2 EV 2009
+	{0xD9, 0x2C, 0x17, 0x1F, 0x5C, 0xF8, 0x90, 0xBA, 0x42, 0x80, 0x19, 0x29, 0x29, 0x27, 0xFE, 0x22, 0xF3, 0x20, 0x7F, 0xD2, 0xB5, 0x44, 0x49, 0xCB, 0x6F, 0x67, 0x5A, 0xF4, 0x92, 0x21, 0x46, 0xE2}, // D-Trust SBR Root CA 1 2022
+	{0xDB, 0xA8, 0x4D, 0xD7, 0xEF, 0x62, 0x2D, 0x48, 0x54, 0x63, 0xA9, 0x01, 0x37, 0xEA, 0x4D, 0x57, 0x4D, 0xF8, 0x55, 0x09, 0x28, 0xF6, 0xAF, 0xA0, 0x3B, 0x4D, 0x8B, 0x11, 0x41, 0xE6, 0x36, 0xCC}, // D-Trust SBR Root CA 2 2022
+	{0x26, 0x1D, 0x71, 0x14, 0xAE, 0x5F, 0x8F, 0xF2, 0xD8, 0xC7, 0x20, 0x9A, 0x9D, 0xE4, 0x28, 0x9E, 0x6A, 0xFC, 0x9D, 0x71, 0x70, 0x23, 0xD8, 0x54, 0x50, 0x90, 0x91, 0x99, 0xF1, 0x85, 0x7C, 0xFE}, // DIGITALSIGN GLOBAL ROOT ECDSA CA
+	{0x82, 0xBD, 0x5D, 0x85, 0x1A, 0xCF, 0x7F, 0x6E, 0x1B, 0xA7, 0xBF, 0xCB, 0xC5, 0x30, 0x30, 0xD0, 0xE7, 0xBC, 0x3C, 0x21, 0xDF, 0x77, 0x2D, 0x85, 0x8C, 0xAB, 0x41, 0xD1, 0x99, 0xBD, 0xF5, 0x95}, // DIGITALSIGN GLOBAL ROOT RSA CA
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0xE8, 0xE8, 0x17, 0x65, 0x36, 0xA6, 0x0C, 0xC2, 0xC4, 0xE1, 0x01, 0x87, 0xC3, 0xBE, 0xFC, 0xA2, 0x0E, 0xF2, 0x63, 0x49, 0x70, 0x18, 0xF5, 0x66, 0xD5, 0xBE, 0xA0, 0xF9, 0x4D, 0x0C, 0x11, 0x1B}, // DigiCert SMIME ECC P384 Root G5
+	{0x90, 0x37, 0x0D, 0x3E, 0xFA, 0x88, 0xBF, 0x58, 0xC3, 0x01, 0x05, 0xBA, 0x25, 0x10, 0x4A, 0x35, 0x84, 0x60, 0xA7, 0xFA, 0x52, 0xDF, 0xC2, 0x01, 0x1D, 0xF2, 0x33, 0xA0, 0xF4, 0x17, 0x91, 0x2A}, // DigiCert SMIME RSA4096 Root G5
+	{0x01, 0x8E, 0x13, 0xF0, 0x77, 0x25, 0x32, 0xCF, 0x80, 0x9B, 0xD1, 0xB1, 0x72, 0x81, 0x86, 0x72, 0x83, 0xFC, 0x48, 0xC6, 0xE1, 0x3B, 0xE9, 0xC6, 0x98, 0x12, 0x85, 0x4A, 0x49, 0x0C, 0x1B, 0x05}, // DigiCert TLS ECC P384 Root G5
+	{0x37, 0x1A, 0x00, 0xDC, 0x05, 0x33, 0xB3, 0x72, 0x1A, 0x7E, 0xEB, 0x40, 0xE8, 0x41, 0x9E, 0x70, 0x79, 0x9D, 0x2B, 0x0A, 0x0F, 0x2C, 0x1D, 0x80, 0x69, 0x31, 0x65, 0xF7, 0xCE, 0xC4, 0xAD, 0x75}, // DigiCert TLS RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0xCB, 0xB9, 0xC4, 0x4D, 0x84, 0xB8, 0x04, 0x3E, 0x10, 0x50, 0xEA, 0x31, 0xA6, 0x9F, 0x51, 0x49, 0x55, 0xD7, 0xBF, 0xD2, 0xE2, 0xC6, 0xB4, 0x93, 0x01, 0x01, 0x9A, 0xD6, 0x1D, 0x9F, 0x50, 0x58}, // GlobalSign Root E46
+	{0x4F, 0xA3, 0x12, 0x6D, 0x8D, 0x3A, 0x11, 0xD1, 0xC4, 0x85, 0x5A, 0x4F, 0x80, 0x7C, 0xBA, 0xD6, 0xCF, 0x91, 0x9D, 0x3A, 0x5A, 0x88, 0xB0, 0x3B, 0xEA, 0x2C, 0x63, 0x72, 0xD9, 0x3C, 0x40, 0xC9}, // GlobalSign Root R46
+	{0x5C, 0xBF, 0x6F, 0xB8, 0x1F, 0xD4, 0x17, 0xEA, 0x41, 0x28, 0xCD, 0x6F, 0x81, 0x72, 0xA3, 0xC9, 0x40, 0x20, 0x94, 0xF7, 0x4A, 0xB2, 0xED, 0x3A, 0x06, 0xB4, 0x40, 0x5D, 0x04, 0xF3, 0x0B, 0x19}, // GlobalSign Secure Mail Root E45
+	{0x31, 0x9A, 0xF0, 0xA7, 0x72, 0x9E, 0x6F, 0x89, 0x26, 0x9C, 0x13, 0x1E, 0xA6, 0xA3, 0xA1, 0x6F, 0xCD, 0x86, 0x38, 0x9F, 0xDC, 0xAB, 0x3C, 0x47, 0xA4, 0xA6, 0x75, 0xC1, 0x61, 0xA3, 0xF9, 0x74}, // GlobalSign Secure Mail Root R45
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x8D, 0xD4, 0xB5, 0x37, 0x3C, 0xB0, 0xDE, 0x36, 0x76, 0x9C, 0x12, 0x33, 0x92, 0x80, 0xD8, 0x27, 0x46, 0xB3, 0xAA, 0x6C, 0xD4, 0x26, 0xE7, 0x97, 0xA3, 0x1B, 0xAB, 0xE4, 0x27, 0x9C, 0xF0, 0x0B}, // HARICA Client ECC Root CA 2021
+	{0x1B, 0xE7, 0xAB, 0xE3, 0x06, 0x86, 0xB1, 0x63, 0x48, 0xAF, 0xD1, 0xC6, 0x1B, 0x68, 0x66, 0xA0, 0xEA, 0x7F, 0x48, 0x21, 0xE6, 0x7D, 0x5E, 0x8A, 0xF9, 0x37, 0xCF, 0x80, 0x11, 0xBC, 0x75, 0x0D}, // HARICA Client RSA Root CA 2021
+	{0x3F, 0x99, 0xCC, 0x47, 0x4A, 0xCF, 0xCE, 0x4D, 0xFE, 0xD5, 0x87, 0x94, 0x66, 0x5E, 0x47, 0x8D, 0x15, 0x47, 0x73, 0x9F, 0x2E, 0x78, 0x0F, 0x1B, 0xB4, 0xCA, 0x9B, 0x13, 0x30, 0x97, 0xD4, 0x01}, // HARICA TLS ECC Root CA 2021
+	{0xD9, 0x5D, 0x0E, 0x8E, 0xDA, 0x79, 0x52, 0x5B, 0xF9, 0xBE, 0xB1, 0x1B, 0x14, 0xD2, 0x10, 0x0D, 0x32, 0x94, 0x98, 0x5F, 0x0C, 0x62, 0xD9, 0xFA, 0xBD, 0x9C, 0xD9, 0x99, 0xEC, 0xCB, 0x7B, 0x1D}, // HARICA TLS RSA Root CA 2021
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0xF0, 0x15, 0xCE, 0x3C, 0xC2, 0x39, 0xBF, 0xEF, 0x06, 0x4B, 0xE9, 0xF1, 0xD2, 0xC4, 0x17, 0xE1, 0xA0, 0x26, 0x4A, 0x0A, 0x94, 0xBE, 0x1F, 0x0C, 0x8D, 0x12, 0x18, 0x64, 0xEB, 0x69, 0x49, 0xCC}, // HiPKI Root CA - G1
+	{0x5A, 0x2F, 0xC0, 0x3F, 0x0C, 0x83, 0xB0, 0x90, 0xBB, 0xFA, 0x40, 0x60, 0x4B, 0x09, 0x88, 0x44, 0x6C, 0x76, 0x36, 0x18, 0x3D, 0xF9, 0x84, 0x6E, 0x17, 0x10, 0x1A, 0x44, 0x7F, 0xB8, 0xEF, 0xD6}, // Hongkong Post Root CA 3
+	{0x96, 0xBC, 0xEC, 0x06, 0x26, 0x49, 0x76, 0xF3, 0x74, 0x60, 0x77, 0x9A, 0xCF, 0x28, 0xC5, 0xA7, 0xCF, 0xE8, 0xA3, 0xC0, 0xAA, 0xE1, 0x1A, 0x8F, 0xFC, 0xEE, 0x05, 0xC0, 0xBD, 0xDF, 0x08, 0xC6}, // ISRG Root X1
+	{0x69, 0x72, 0x9B, 0x8E, 0x15, 0xA8, 0x6E, 0xFC, 0x17, 0x7A, 0x57, 0xAF, 0xB7, 0x17, 0x1D, 0xFC, 0x64, 0xAD, 0xD2, 0x8C, 0x2F, 0xCA, 0x8C, 0xF1, 0x50, 0x7E, 0x34, 0x45, 0x3C, 0xCB, 0x14, 0x70}, // ISRG Root X2
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x25, 0x30, 0xCC, 0x8E, 0x98, 0x32, 0x15, 0x02, 0xBA, 0xD9, 0x6F, 0x9B, 0x1F, 0xBA, 0x1B, 0x09, 0x9E, 0x2D, 0x29, 0x9E, 0x0F, 0x45, 0x48, 0xBB, 0x91, 0x4F, 0x36, 0x3B, 0xC0, 0xD4, 0x53, 0x1F}, // Izenpe.com
+	{0x48, 0xE1, 0xCF, 0x9E, 0x43, 0xB6, 0x88, 0xA5, 0x10, 0x44, 0x16, 0x0F, 0x46, 0xD7, 0x73, 0xB8, 0x27, 0x7F, 0xE4, 0x5B, 0xEA, 0xAD, 0x0E, 0x4D, 0xF9, 0x0D, 0x19, 0x74, 0x38, 0x2F, 0xEA, 0x99}, // LAWtrust Root CA2 (4096)
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x35, 0x8D, 0xF3, 0x9D, 0x76, 0x4A, 0xF9, 0xE1, 0xB7, 0x66, 0xE9, 0xC9, 0x72, 0xDF, 0x35, 0x2E, 0xE1, 0x5C, 0xFA, 0xC2, 0x27, 0xAF, 0x6A, 0xD1, 0xD7, 0x0E, 0x8E, 0x4A, 0x6E, 0xDC, 0xBA, 0x02}, // Microsoft ECC Root Certificate Authority 2017
+	{0xC7, 0x41, 0xF7, 0x0F, 0x4B, 0x2A, 0x8D, 0x88, 0xBF, 0x2E, 0x71, 0xC1, 0x41, 0x22, 0xEF, 0x53, 0xEF, 0x10, 0xEB, 0xA0, 0xCF, 0xA5, 0xE6, 0x4C, 0xFA, 0x20, 0xF4, 0x18, 0x85, 0x30, 0x73, 0xE0}, // Microsoft RSA Root Certificate Authority 2017
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6C, 0x61, 0xDA, 0xC3, 0xA2, 0xDE, 0xF0, 0x31, 0x50, 0x6B, 0xE0, 0x36, 0xD2, 0xA6, 0xFE, 0x40, 0x19, 0x94, 0xFB, 0xD1, 0x3D, 0xF9, 0xC8, 0xD4, 0x66, 0x59, 0x92, 0x74, 0xC4, 0x46, 0xEC, 0x98}, // NetLock Arany (Class Gold) Főtanúsítvány
+	{0xD9, 0xA3, 0x24, 0x85, 0xA8, 0xCC, 0xA8, 0x55, 0x39, 0xCE, 0xF1, 0x2F, 0xFF, 0xFF, 0x71, 0x13, 0x78, 0xA1, 0x78, 0x51, 0xD7, 0x3D, 0xA2, 0x73, 0x2A, 0xB4, 0x30, 0x2D, 0x76, 0x3B, 0xD6, 0x2B}, // OISTE Client Root ECC G1
+	{0xD0, 0x2A, 0x0F, 0x99, 0x4A, 0x86, 0x8C, 0x66, 0x39, 0x5F, 0x2E, 0x7A, 0x88, 0x0D, 0xF5, 0x09, 0xBD, 0x0C, 0x29, 0xC9, 0x6D, 0xE1, 0x60, 0x15, 0xA0, 0xFD, 0x50, 0x1E, 0xDA, 0x4F, 0x96, 0xA9}, // OISTE Client Root RSA G1
+	{0xEE, 0xC9, 0x97, 0xC0, 0xC3, 0x0F, 0x21, 0x6F, 0x7E, 0x3B, 0x8B, 0x30, 0x7D, 0x2B, 0xAE, 0x42, 0x41, 0x2D, 0x75, 0x3F, 0xC8, 0x21, 0x9D, 0xAF, 0xD1, 0x52, 0x0B, 0x25, 0x72, 0x85, 0x0F, 0x49}, // OISTE Server Root ECC G1
+	{0x9A, 0xE3, 0x62, 0x32, 0xA5, 0x18, 0x9F, 0xFD, 0xDB, 0x35, 0x3D, 0xFD, 0x26, 0x52, 0x0C, 0x01, 0x53, 0x95, 0xD2, 0x27, 0x77, 0xDA, 0xC5, 0x9D, 0xB5, 0x7B, 0x98, 0xC0, 0x89, 0xA6, 0x51, 0xE6}, // OISTE Server Root RSA G1
+	{0x41, 0xC9, 0x23, 0x86, 0x6A, 0xB4, 0xCA, 0xD6, 0xB7, 0xAD, 0x57, 0x80, 0x81, 0x58, 0x2E, 0x02, 0x07, 0x97, 0xA6, 0xCB, 0xDF, 0x4F, 0xFF, 0x78, 0xCE, 0x83, 0x96, 0xB3, 0x89, 0x37, 0xD7, 0xF5}, // OISTE WISeKey Global Root GA CA
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0xAD, 0x7D, 0xD5, 0x8D, 0x03, 0xAE, 0xDB, 0x22, 0xA3, 0x0B, 0x50, 0x84, 0x39, 0x49, 0x20, 0xCE, 0x12, 0x23, 0x0C, 0x2D, 0x80, 0x17, 0xAD, 0x9B, 0x81, 0xAB, 0x04, 0x07, 0x9B, 0xDD, 0x02, 0x6B}, // SSL.com Client ECC Root CA 2022
+	{0x1D, 0x4C, 0xA4, 0xA2, 0xAB, 0x21, 0xD0, 0x09, 0x36, 0x59, 0x80, 0x4F, 0xC0, 0xEB, 0x21, 0x75, 0xA6, 0x17, 0x27, 0x9B, 0x56, 0xA2, 0x47, 0x52, 0x45, 0xC9, 0x51, 0x7A, 0xFE, 0xB5, 0x91, 0x53}, // SSL.com Client RSA Root CA 2022
+	{0x22, 0xA2, 0xC1, 0xF7, 0xBD, 0xED, 0x70, 0x4C, 0xC1, 0xE7, 0x01, 0xB5, 0xF4, 0x08, 0xC3, 0x10, 0x88, 0x0F, 0xE9, 0x56, 0xB5, 0xDE, 0x2A, 0x4A, 0x44, 0xF9, 0x9C, 0x87, 0x3A, 0x25, 0xA7, 0xC8}, // SSL.com EV Root Certification Authority ECC
+	{0x2E, 0x7B, 0xF1, 0x6C, 0xC2, 0x24, 0x85, 0xA7, 0xBB, 0xE2, 0xAA, 0x86, 0x96, 0x75, 0x07, 0x61, 0xB0, 0xAE, 0x39, 0xBE, 0x3B, 0x2F, 0xE9, 0xD0, 0xCC, 0x6D, 0x4E, 0xF7, 0x34, 0x91, 0x42, 0x5C}, // SSL.com EV Root Certification Authority RSA R2
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xC3, 0x2F, 0xFD, 0x9F, 0x46, 0xF9, 0x36, 0xD1, 0x6C, 0x36, 0x73, 0x99, 0x09, 0x59, 0x43, 0x4B, 0x9A, 0xD6, 0x0A, 0xAF, 0xBB, 0x9E, 0x7C, 0xF3, 0x36, 0x54, 0xF1, 0x44, 0xCC, 0x1B, 0xA1, 0x43}, // SSL.com TLS ECC Root CA 2022
+	{0x8F, 0xAF, 0x7D, 0x2E, 0x2C, 0xB4, 0x70, 0x9B, 0xB8, 0xE0, 0xB3, 0x36, 0x66, 0xBF, 0x75, 0xA5, 0xDD, 0x45, 0xB5, 0xDE, 0x48, 0x0F, 0x8E, 0xA8, 0xD4, 0xBF, 0xE6, 0xBE, 0xBC, 0x17, 0xF2, 0xED}, // SSL.com TLS RSA Root CA 2022
+	{0xA1, 0x33, 0x9D, 0x33, 0x28, 0x1A, 0x0B, 0x56, 0xE5, 0x57, 0xD3, 0xD3, 0x2B, 0x1C, 0xE7, 0xF9, 0x36, 0x7E, 0xB0, 0x94, 0xBD, 0x5F, 0xA7, 0x2A, 0x7E, 0x50, 0x04, 0xC8, 0xDE, 0xD7, 0xCA, 0xFE}, // SZAFIR ROOT CA2
+	{0x22, 0xD9, 0x59, 0x92, 0x34, 0xD6, 0x0F, 0x1D, 0x4B, 0xC7, 0xC7, 0xE9, 0x6F, 0x43, 0xFA, 0x55, 0x5B, 0x07, 0x30, 0x1F, 0xD4, 0x75, 0x17, 0x50, 0x89, 0xDA, 0xFB, 0x8C, 0x25, 0xE4, 0x77, 0xB3}, // Sectigo Public Email Protection Root E46
+	{0xD5, 0x91, 0x7A, 0x77, 0x91, 0xEB, 0x7C, 0xF2, 0x0A, 0x2E, 0x57, 0xEB, 0x98, 0x28, 0x4A, 0x67, 0xB2, 0x8A, 0x57, 0xE8, 0x91, 0x82, 0xDA, 0x53, 0xD5, 0x46, 0x67, 0x8C, 0x9F, 0xDE, 0x2B, 0x4F}, // Sectigo Public Email Protection Root R46
+	{0xC9, 0x0F, 0x26, 0xF0, 0xFB, 0x1B, 0x40, 0x18, 0xB2, 0x22, 0x27, 0x51, 0x9B, 0x5C, 0xA2, 0xB5, 0x3E, 0x2C, 0xA5, 0xB3, 0xBE, 0x5C, 0xF1, 0x8E, 0xFE, 0x1B, 0xEF, 0x47, 0x38, 0x0C, 0x53, 0x83}, // Sectigo Public Server Authentication Root E46
+	{0x7B, 0xB6, 0x47, 0xA6, 0x2A, 0xEE, 0xAC, 0x88, 0xBF, 0x25, 0x7A, 0xA5, 0x22, 0xD0, 0x1F, 0xFE, 0xA3, 0x95, 0xE0, 0xAB, 0x45, 0xC7, 0x3F, 0x93, 0xF6, 0x56, 0x54, 0xEC, 0x38, 0xF2, 0x5A, 0x06}, // Sectigo Public Server Authentication Root R46
+	{0x4B, 0x00, 0x9C, 0x10, 0x34, 0x49, 0x4F, 0x9A, 0xB5, 0x6B, 0xBA, 0x3B, 0xA1, 0xD6, 0x27, 0x31, 0xFC, 0x4D, 0x20, 0xD8, 0x95, 0x5A, 0xDC, 0xEC, 0x10, 0xA9, 0x25, 0x60, 0x72, 0x61, 0xE3, 0x38}, // SecureSign Root CA14
+	{0xE7, 0x78, 0xF0, 0xF0, 0x95, 0xFE, 0x84, 0x37, 0x29, 0xCD, 0x1A, 0x00, 0x82, 0x17, 0x9E, 0x53, 0x14, 0xA9, 0xC2, 0x91, 0x44, 0x28, 0x05, 0xE1, 0xFB, 0x1D, 0x8F, 0xB6, 0xB8, 0x88, 0x6C, 0x3A}, // SecureSign Root CA15
+	{0x4C, 0x1C, 0xCD, 0x24, 0xF1, 0x7E, 0x95, 0x0F, 0xC1, 0x85, 0x36, 0xB3, 0x3C, 0xAF, 0xE3, 0x22, 0x93, 0xCF, 0xC3, 0x3E, 0x84, 0x67, 0xB4, 0x1E, 0x1C, 0x69, 0x30, 0x55, 0xD7, 0xF5, 0x13, 0xBF}, // SecureSign Root CA16
+	{0xE7, 0x4F, 0xBD, 0xA5, 0x5B, 0xD5, 0x64, 0xC4, 0x73, 0xA3, 0x6B, 0x44, 0x1A, 0xA7, 0x99, 0xC8, 0xA6, 0x8E, 0x07, 0x74, 0x40, 0xE8, 0x28, 0x8B, 0x9F, 0xA1, 0xE5, 0x0E, 0x4B, 0xBA, 0xCA, 0x11}, // Security Communication ECC RootCA1
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x3C, 0x4F, 0xB0, 0xB9, 0x5A, 0xB8, 0xB3, 0x00, 0x32, 0xF4, 0x32, 0xB8, 0x6F, 0x53, 0x5F, 0xE1, 0x72, 0xC1, 0x85, 0xD0, 0xFD, 0x39, 0x86, 0x58, 0x37, 0xCF, 0x36, 0x18, 0x7F, 0xA6, 0xF4, 0x28}, // Staat der Nederlanden Root CA - G3
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x2C, 0xE1, 0xCB, 0x0B, 0xF9, 0xD2, 0xF9, 0xE1, 0x02, 0x99, 0x3F, 0xBE, 0x21, 0x51, 0x52, 0xC3, 0xB2, 0xDD, 0x0C, 0xAB, 0xDE, 0x1C, 0x68, 0xE5, 0x31, 0x9B, 0x83, 0x91, 0x54, 0xDB, 0xB7, 0xF5}, // Starfield Root Certificate Authority - G2
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0x9A, 0x12, 0xC3, 0x92, 0xBF, 0xE5, 0x78, 0x91, 0xA0, 0xC5, 0x45, 0x30, 0x9D, 0x4D, 0x9F, 0xD5, 0x67, 0xE4, 0x80, 0xCB, 0x61, 0x3D, 0x63, 0x42, 0x27, 0x8B, 0x19, 0x5C, 0x79, 0xA7, 0x93, 0x1F}, // SwissSign RSA SMIME Root CA 2022 - 1
+	{0x19, 0x31, 0x44, 0xF4, 0x31, 0xE0, 0xFD, 0xDB, 0x74, 0x07, 0x17, 0xD4, 0xDE, 0x92, 0x6A, 0x57, 0x11, 0x33, 0x88, 0x4B, 0x43, 0x60, 0xD3, 0x0E, 0x27, 0x29, 0x13, 0xCB, 0xE6, 0x60, 0xCE, 0x41}, // SwissSign RSA TLS Root CA 2022 - 1
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0xFD, 0x73, 0xDA, 0xD3, 0x1C, 0x64, 0x4F, 0xF1, 0xB4, 0x3B, 0xEF, 0x0C, 0xCD, 0xDA, 0x96, 0x71, 0x0B, 0x9C, 0xD9, 0x87, 0x5E, 0xCA, 0x7E, 0x31, 0x70, 0x7A, 0xF3, 0xE9, 0x6D, 0x52, 0x2B, 0xBD}, // T-TeleSec GlobalRoot Class 3
+	{0x46, 0xED, 0xC3, 0x68, 0x90, 0x46, 0xD5, 0x3A, 0x45, 0x3F, 0xB3, 0x10, 0x4A, 0xB8, 0x0D, 0xCA, 0xEC, 0x65, 0x8B, 0x26, 0x60, 0xEA, 0x16, 0x29, 0xDD, 0x7E, 0x86, 0x79, 0x90, 0x64, 0x87, 0x16}, // TUBITAK Kamu SM SSL Kok Sertifikasi - Surum 1
+	{0x3F, 0x63, 0xBB, 0x28, 0x14, 0xBE, 0x17, 0x4E, 0xC8, 0xB6, 0x43, 0x9C, 0xF0, 0x8D, 0x6D, 0x56, 0xF0, 0xB7, 0xC4, 0x05, 0x88, 0x3A, 0x56, 0x48, 0xA3, 0x34, 0x42, 0x4D, 0x6B, 0x3E, 0xC5, 0x58}, // TWCA CYBER Root CA
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0x3A, 0x00, 0x72, 0xD4, 0x9F, 0xFC, 0x04, 0xE9, 0x96, 0xC5, 0x9A, 0xEB, 0x75, 0x99, 0x1D, 0x3C, 0x34, 0x0F, 0x36, 0x15, 0xD6, 0xFD, 0x4D, 0xCE, 0x90, 0xAC, 0x0B, 0x3D, 0x88, 0xEA, 0xD4, 0xF4}, // TWCA Global Root CA G2
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x3A, 0xE6, 0xDF, 0x7E, 0x0D, 0x63, 0x7A, 0x65, 0xA8, 0xC8, 0x16, 0x12, 0xEC, 0x6F, 0x9A, 0x14, 0x2F, 0x85, 0xA1, 0x68, 0x34, 0xC1, 0x02, 0x80, 0xD8, 0x8E, 0x70, 0x70, 0x28, 0x51, 0x87, 0x55}, // Telekom Security SMIME ECC Root 2021
+	{0x78, 0xA6, 0x56, 0x34, 0x4F, 0x94, 0x7E, 0x9C, 0xC0, 0xF7, 0x34, 0xD9, 0x05, 0x3D, 0x32, 0xF6, 0x74, 0x20, 0x86, 0xB6, 0xB9, 0xCD, 0x2C, 0xAE, 0x4F, 0xAE, 0x1A, 0x2E, 0x4E, 0xFD, 0xE0, 0x48}, // Telekom Security SMIME RSA Root 2023
+	{0x57, 0x8A, 0xF4, 0xDE, 0xD0, 0x85, 0x3F, 0x4E, 0x59, 0x98, 0xDB, 0x4A, 0xEA, 0xF9, 0xCB, 0xEA, 0x8D, 0x94, 0x5F, 0x60, 0xB6, 0x20, 0xA3, 0x8D, 0x1A, 0x3C, 0x13, 0xB2, 0xBC, 0x7B, 0xA8, 0xE1}, // Telekom Security TLS ECC Root 2020
+	{0xEF, 0xC6, 0x5C, 0xAD, 0xBB, 0x59, 0xAD, 0xB6, 0xEF, 0xE8, 0x4D, 0xA2, 0x23, 0x11, 0xB3, 0x56, 0x24, 0xB7, 0x1B, 0x3B, 0x1E, 0xA0, 0xDA, 0x8B, 0x66, 0x55, 0x17, 0x4E, 0xC8, 0x97, 0x86, 0x46}, // Telekom Security TLS RSA Root 2023
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xE0, 0xD3, 0x22, 0x6A, 0xEB, 0x11, 0x63, 0xC2, 0xE4, 0x8F, 0xF9, 0xBE, 0x3B, 0x50, 0xB4, 0xC6, 0x43, 0x1B, 0xE7, 0xBB, 0x1E, 0xAC, 0xC5, 0xC3, 0x6B, 0x5D, 0x5E, 0xC5, 0x09, 0x03, 0x9A, 0x08}, // TrustAsia Global Root CA G3
+	{0xBE, 0x4B, 0x56, 0xCB, 0x50, 0x56, 0xC0, 0x13, 0x6A, 0x52, 0x6D, 0xF4, 0x44, 0x50, 0x8D, 0xAA, 0x36, 0xA0, 0xB5, 0x4F, 0x42, 0xE4, 0xAC, 0x38, 0xF7, 0x2A, 0xF4, 0x70, 0xE4, 0x79, 0x65, 0x4C}, // TrustAsia Global Root CA G4
+	{0x43, 0x64, 0x72, 0xC1, 0x00, 0x9A, 0x32, 0x5C, 0x54, 0xF1, 0xA5, 0xBB, 0xB5, 0x46, 0x8A, 0x7B, 0xAE, 0xEC, 0xCB, 0xE0, 0x5D, 0xE5, 0xF0, 0x99, 0xCB, 0x70, 0xD3, 0xFE, 0x41, 0xE1, 0x3C, 0x16}, // TrustAsia SMIME ECC Root CA
+	{0xC7, 0x79, 0x6B, 0xEB, 0x62, 0xC1, 0x01, 0xBB, 0x14, 0x3D, 0x26, 0x2A, 0x7C, 0x96, 0xA0, 0xC6, 0x16, 0x81, 0x83, 0x22, 0x3E, 0xF5, 0x0D, 0x69, 0x96, 0x32, 0xD8, 0x6E, 0x03, 0xB8, 0xCC, 0x9B}, // TrustAsia SMIME RSA Root CA
+	{0xC0, 0x07, 0x6B, 0x9E, 0xF0, 0x53, 0x1F, 0xB1, 0xA6, 0x56, 0xD6, 0x7C, 0x4E, 0xBE, 0x97, 0xCD, 0x5D, 0xBA, 0xA4, 0x1E, 0xF4, 0x45, 0x98, 0xAC, 0xC2, 0x48, 0x98, 0x78, 0xC9, 0x2D, 0x87, 0x11}, // TrustAsia TLS ECC Root CA
+	{0x06, 0xC0, 0x8D, 0x7D, 0xAF, 0xD8, 0x76, 0x97, 0x1E, 0xB1, 0x12, 0x4F, 0xE6, 0x7F, 0x84, 0x7E, 0xC0, 0xC7, 0xA1, 0x58, 0xD3, 0xEA, 0x53, 0xCB, 0xE9, 0x40, 0xE2, 0xEA, 0x97, 0x91, 0xF4, 0xC3}, // TrustAsia TLS RSA Root CA
+	{0x2E, 0x44, 0x10, 0x2A, 0xB5, 0x8C, 0xB8, 0x54, 0x19, 0x45, 0x1C, 0x8E, 0x19, 0xD9, 0xAC, 0xF3, 0x66, 0x2C, 0xAF, 0xBC, 0x61, 0x4B, 0x6A, 0x53, 0x96, 0x0A, 0x30, 0xF7, 0xD0, 0xE2, 0xEB, 0x41}, // TunTrust Root CA
+	{0xD4, 0x3A, 0xF9, 0xB3, 0x54, 0x73, 0x75, 0x5C, 0x96, 0x84, 0xFC, 0x06, 0xD7, 0xD8, 0xCB, 0x70, 0xEE, 0x5C, 0x28, 0xE7, 0x73, 0xFB, 0x29, 0x4E, 0xB4, 0x1E, 0xE7, 0x17, 0x22, 0x92, 0x4D, 0x24}, // UCA Extended Validation Root
+	{0x9B, 0xEA, 0x11, 0xC9, 0x76, 0xFE, 0x01, 0x47, 0x64, 0xC1, 0xBE, 0x56, 0xA6, 0xF9, 0x14, 0xB5, 0xA5, 0x60, 0x31, 0x7A, 0xBD, 0x99, 0x88, 0x39, 0x33, 0x82, 0xE5, 0x16, 0x1A, 0xA0, 0x49, 0x3C}, // UCA Global G2 Root
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xBE, 0xB0, 0x0B, 0x30, 0x83, 0x9B, 0x9B, 0xC3, 0x2C, 0x32, 0xE4, 0x44, 0x79, 0x05, 0x95, 0x06, 0x41, 0xF2, 0x64, 0x21, 0xB1, 0x5E, 0xD0, 0x89, 0x19, 0x8B, 0x51, 0x8A, 0xE2, 0xEA, 0x1B, 0x99}, // e-Szigno Root CA 2017
+	{0xB4, 0x91, 0x41, 0x50, 0x2D, 0x00, 0x66, 0x3D, 0x74, 0x0F, 0x2E, 0x7E, 0xC3, 0x40, 0xC5, 0x28, 0x00, 0x96, 0x26, 0x66, 0x12, 0x1A, 0x36, 0xD0, 0x9C, 0xF7, 0xDD, 0x2B, 0x90, 0x38, 0x4F, 0xB4}, // e-Szigno TLS Root CA 2023
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0xBC, 0x4D, 0x80, 0x9B, 0x15, 0x18, 0x9D, 0x78, 0xDB, 0x3E, 0x1D, 0x8C, 0xF4, 0xF9, 0x72, 0x6A, 0x79, 0x5D, 0xA1, 0x64, 0x3C, 0xA5, 0xF1, 0x35, 0x8E, 0x1D, 0xDB, 0x0E, 0xDC, 0x0D, 0x7E, 0xB3}, // emSign ECC Root CA - C3
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x12, 0x56, 0x09, 0xAA, 0x30, 0x1D, 0xA0, 0xA2, 0x49, 0xB9, 0x7A, 0x82, 0x39, 0xCB, 0x6A, 0x34, 0x21, 0x6F, 0x44, 0xDC, 0xAC, 0x9F, 0x39, 0x54, 0xB1, 0x42, 0x92, 0xF2, 0xE8, 0xC8, 0x60, 0x8F}, // emSign Root CA - C1
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+	{0x30, 0xFB, 0xBA, 0x2C, 0x32, 0x23, 0x8E, 0x2A, 0x98, 0x54, 0x7A, 0xF9, 0x79, 0x31, 0xE5, 0x50, 0x42, 0x8B, 0x9B, 0x3F, 0x1C, 0x8E, 0xEB, 0x66, 0x33, 0xDC, 0xFA, 0x86, 0xC5, 0xB2, 0x7D, 0xD3}, // vTrus ECC Root CA
+	{0x8A, 0x71, 0xDE, 0x65, 0x59, 0x33, 0x6F, 0x42, 0x6C, 0x26, 0xE5, 0x38, 0x80, 0xD0, 0x0D, 0x88, 0xA1, 0x8D, 0xA4, 0xC6, 0xA9, 0x1F, 0x0D, 0xCB, 0x61, 0x94, 0xE2, 0x06, 0xC5, 0xC9, 0x63, 0x87}, // vTrus Root CA
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Mozilla root store and capable of issuing TLS certificates.
+var MozillaTLS = [][sha256.Size]byte{
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x55, 0x41, 0x53, 0xB1, 0x3D, 0x2C, 0xF9, 0xDD, 0xB7, 0x53, 0xBF, 0xBE, 0x1A, 0x4E, 0x0A, 0xE0, 0x8D, 0x0A, 0xA4, 0x18, 0x70, 0x58, 0xFE, 0x60, 0xA2, 0xB8, 0x62, 0xB2, 0xE4, 0xB8, 0x7B, 0xCB}, // AC RAIZ FNMT-RCM SERVIDORES SEGUROS
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0xFB, 0x8F, 0xEC, 0x75, 0x91, 0x69, 0xB9, 0x10, 0x6B, 0x1E, 0x51, 0x16, 0x44, 0xC6, 0x18, 0xC5, 0x13, 0x04, 0x37, 0x3F, 0x6C, 0x06, 0x43, 0x08, 0x8D, 0x8B, 0xEF, 0xFD, 0x1B, 0x99, 0x75, 0x99}, // ANF Secure Server Root CA
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xB2, 0xFA, 0xE5, 0x3E, 0x14, 0xCC, 0xD7, 0xAB, 0x92, 0x12, 0x06, 0x47, 0x01, 0xAE, 0x27, 0x9C, 0x1D, 0x89, 0x88, 0xFA, 0xCB, 0x77, 0x5F, 0xA8, 0xA0, 0x08, 0x91, 0x4E, 0x66, 0x39, 0x88, 0xA8}, // Atos TrustedRoot Root CA ECC TLS 2021
+	{0x81, 0xA9, 0x08, 0x8E, 0xA5, 0x9F, 0xB3, 0x64, 0xC5, 0x48, 0xA6, 0xF8, 0x55, 0x59, 0x09, 0x9B, 0x6F, 0x04, 0x05, 0xEF, 0xBF, 0x18, 0xE5, 0x32, 0x4E, 0xC9, 0xF4, 0x57, 0xBA, 0x00, 0x11, 0x2F}, // Atos TrustedRoot Root CA RSA TLS 2021
+	{0x57, 0xDE, 0x05, 0x83, 0xEF, 0xD2, 0xB2, 0x6E, 0x03, 0x61, 0xDA, 0x99, 0xDA, 0x9D, 0xF4, 0x64, 0x8D, 0xEF, 0x7E, 0xE8, 0x44, 0x1C, 0x3B, 0x72, 0x8A, 0xFA, 0x9B, 0xCD, 0xE0, 0xF9, 0xB2, 0x6A}, // Autoridad de Certificacion Firmaprofesional CIF A62634068
+	{0xF3, 0x89, 0x6F, 0x88, 0xFE, 0x7C, 0x0A, 0x88, 0x27, 0x66, 0xA7, 0xFA, 0x6A, 0xD2, 0x74, 0x9F, 0xB5, 0x7A, 0x7F, 0x3E, 0x98, 0xFB, 0x76, 0x9C, 0x1F, 0xA7, 0xB0, 0x9C, 0x2C, 0x44, 0xD5, 0xAE}, // BJCA Global Root CA1
+	{0x57, 0x4D, 0xF6, 0x93, 0x1E, 0x27, 0x80, 0x39, 0x66, 0x7B, 0x72, 0x0A, 0xFD, 0xC1, 0x60, 0x0F, 0xC2, 0x7E, 0xB6, 0x6D, 0xD3, 0x09, 0x29, 0x79, 0xFB, 0x73, 0x85, 0x64, 0x87, 0x21, 0x28, 0x82}, // BJCA Global Root CA2
+	{0x9A, 0x11, 0x40, 0x25, 0x19, 0x7C, 0x5B, 0xB9, 0x5D, 0x94, 0xE6, 0x3D, 0x55, 0xCD, 0x43, 0x79, 0x08, 0x47, 0xB6, 0x46, 0xB2, 0x3C, 0xDF, 0x11, 0xAD, 0xA4, 0xA0, 0x0E, 0xFF, 0x15, 0xFB, 0x48}, // Buypass Class 2 Root CA
+	{0xED, 0xF7, 0xEB, 0xBC, 0xA2, 0x7A, 0x2A, 0x38, 0x4D, 0x38, 0x7B, 0x7D, 0x40, 0x10, 0xC6, 0x66, 0xE2, 0xED, 0xB4, 0x84, 0x3E, 0x4C, 0x29, 0xB4, 0xAE, 0x1D, 0x5B, 0x93, 0x32, 0xE6, 0xB2, 0x4D}, // Buypass Class 3 Root CA
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x5C, 0xC3, 0xD7, 0x8E, 0x4E, 0x1D, 0x5E, 0x45, 0x54, 0x7A, 0x04, 0xE6, 0x87, 0x3E, 0x64, 0xF9, 0x0C, 0xF9, 0x53, 0x6D, 0x1C, 0xCC, 0x2E, 0xF8, 0x00, 0xF3, 0x55, 0xC4, 0xC5, 0xFD, 0x70, 0xFD}, // CFCA EV ROOT
+	{0x0C, 0x2C, 0xD6, 0x3D, 0xF7, 0x80, 0x6F, 0xA3, 0x99, 0xED, 0xE8, 0x09, 0x11, 0x6B, 0x57, 0x5B, 0xF8, 0x79, 0x89, 0xF0, 0x65, 0x18, 0xF9, 0x80, 0x8C, 0x86, 0x05, 0x03, 0x17, 0x8B, 0xAF, 0x66}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xB4, 0x58, 0x5F, 0x22, 0xE4, 0xAC, 0x75, 0x6A, 0x4E, 0x86, 0x12, 0xA1, 0x36, 0x1C, 0x5D, 0x9D, 0x03, 0x1A, 0x93, 0xFD, 0x84, 0xFE, 0xBB, 0x77, 0x8F, 0xA3, 0x06, 0x8B, 0x0F, 0xC4, 0x2D, 0xC2}, // Certainly Root E1
+	{0x77, 0xB8, 0x2C, 0xD8, 0x64, 0x4C, 0x43, 0x05, 0xF7, 0xAC, 0xC5, 0xCB, 0x15, 0x6B, 0x45, 0x67, 0x50, 0x04, 0x03, 0x3D, 0x51, 0xC6, 0x0C, 0x62, 0x02, 0xA8, 0xE0, 0xC3, 0x34, 0x67, 0xD3, 0xA0}, // Certainly Root R1
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD4, 0x8D, 0x3D, 0x23, 0xEE, 0xDB, 0x50, 0xA4, 0x59, 0xE5, 0x51, 0x97, 0x60, 0x1C, 0x27, 0x77, 0x4B, 0x9D, 0x7B, 0x18, 0xC9, 0x4D, 0x5A, 0x05, 0x95, 0x11, 0xA1, 0x02, 0x50, 0xB9, 0x31, 0x68}, // Certigna Root CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0xE5, 0x9A, 0xAA, 0x81, 0x60, 0x09, 0xC2, 0x2B, 0xFF, 0x5B, 0x25, 0xBA, 0xD3, 0x7D, 0xF3, 0x06, 0xF0, 0x49, 0x79, 0x7C, 0x1F, 0x81, 0xD8, 0x5A, 0xB0, 0x89, 0xE6, 0x57, 0xBD, 0x8F, 0x00, 0x44}, // D-TRUST BR Root CA 1 2020
+	{0x05, 0x52, 0xE6, 0xF8, 0x3F, 0xDF, 0x65, 0xE8, 0xFA, 0x96, 0x70, 0xE6, 0x66, 0xDF, 0x28, 0xA4, 0xE2, 0x13, 0x40, 0xB5, 0x10, 0xCB, 0xE5, 0x25, 0x66, 0xF9, 0x7C, 0x4F, 0xB9, 0x4B, 0x2B, 0xD1}, // D-TRUST BR Root CA 2 2023
+	{0x08, 0x17, 0x0D, 0x1A, 0xA3, 0x64, 0x53, 0x90, 0x1A, 0x2F, 0x95, 0x92, 0x45, 0xE3, 0x47, 0xDB, 0x0C, 0x8D, 0x37, 0xAB, 0xAA, 0xBC, 0x56, 0xB8, 0x1A, 0xA1, 0x00, 0xDC, 0x95, 0x89, 0x70, 0xDB}, // D-TRUST EV Root CA 1 2020
+	{0x8E, 0x82, 0x21, 0xB2, 0xE7, 0xD4, 0x00, 0x78, 0x36, 0xA1, 0x67, 0x2F, 0x0D, 0xCC, 0x29, 0x9C, 0x33, 0xBC, 0x07, 0xD3, 0x16, 0xF1, 0x32, 0xFA, 0x1A, 0x20, 0x6D, 0x58, 0x71, 0x50, 0xF1, 0xCE}, // D-TRUST EV Root CA 2 2023
+	{0x49, 0xE7, 0xA4, 0x42, 0xAC, 0xF0, 0xEA, 0x62, 0x87, 0x05, 0x00, 0x54, 0xB5, 0x25, 0x64, 0xB6, 0x50, 0xE4, 0xF4, 0x9E, 0x42, 0xE3, 0x48, 0xD6, 0xAA, 0x38, 0xE0, 0x39, 0xE9, 0x57, 0xB1, 0xC1}, // D-TRUST Root Class 3 CA 2 2009
+	{0xEE, 0xC5, 0x49, 0x6B, 0x98, 0x8C, 0xE9, 0x86, 0x25, 0xB9, 0x34, 0x09, 0x2E, 0xEC, 0x29, 0x08, 0xBE, 0xD0, 0xB0, 0xF3, 0x16, 0xC2, 0xD4, 0x73, 0x0C, 0x84, 0xEA, 0xF1, 0xF3, 0xD3, 0x48, 0x81}, // D-TRUST Root Class 3 CA 2 EV 2009
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0x01, 0x8E, 0x13, 0xF0, 0x77, 0x25, 0x32, 0xCF, 0x80, 0x9B, 0xD1, 0xB1, 0x72, 0x81, 0x86, 0x72, 0x83, 0xFC, 0x48, 0xC6, 0xE1, 0x3B, 0xE9, 0xC6, 0x98, 0x12, 0x85, 0x4A, 0x49, 0x0C, 0x1B, 0x05}, // DigiCert TLS ECC P384 Root G5
+	{0x37, 0x1A, 0x00, 0xDC, 0x05, 0x33, 0xB3, 0x72, 0x1A, 0x7E, 0xEB, 0x40, 0xE8, 0x41, 0x9E, 0x70, 0x79, 0x9D, 0x2B, 0x0A, 0x0F, 0x2C, 0x1D, 0x80, 0x69, 0x31, 0x65, 0xF7, 0xCE, 0xC4, 0xAD, 0x75}, // DigiCert TLS RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0xCB, 0xB9, 0xC4, 0x4D, 0x84, 0xB8, 0x04, 0x3E, 0x10, 0x50, 0xEA, 0x31, 0xA6, 0x9F, 0x51, 0x49, 0x55, 0xD7, 0xBF, 0xD2, 0xE2, 0xC6, 0xB4, 0x93, 0x01, 0x01, 0x9A, 0xD6, 0x1D, 0x9F, 0x50, 0x58}, // GlobalSign Root E46
+	{0x4F, 0xA3, 0x12, 0x6D, 0x8D, 0x3A, 0x11, 0xD1, 0xC4, 0x85, 0x5A, 0x4F, 0x80, 0x7C, 0xBA, 0xD6, 0xCF, 0x91, 0x9D, 0x3A, 0x5A, 0x88, 0xB0, 0x3B, 0xEA, 0x2C, 0x63, 0x72, 0xD9, 0x3C, 0x40, 0xC9}, // GlobalSign Root R46
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x3F, 0x99, 0xCC, 0x47, 0x4A, 0xCF, 0xCE, 0x4D, 0xFE, 0xD5, 0x87, 0x94, 0x66, 0x5E, 0x47, 0x8D, 0x15, 0x47, 0x73, 0x9F, 0x2E, 0x78, 0x0F, 0x1B, 0xB4, 0xCA, 0x9B, 0x13, 0x30, 0x97, 0xD4, 0x01}, // HARICA TLS ECC Root CA 2021
+	{0xD9, 0x5D, 0x0E, 0x8E, 0xDA, 0x79, 0x52, 0x5B, 0xF9, 0xBE, 0xB1, 0x1B, 0x14, 0xD2, 0x10, 0x0D, 0x32, 0x94, 0x98, 0x5F, 0x0C, 0x62, 0xD9, 0xFA, 0xBD, 0x9C, 0xD9, 0x99, 0xEC, 0xCB, 0x7B, 0x1D}, // HARICA TLS RSA Root CA 2021
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0xF0, 0x15, 0xCE, 0x3C, 0xC2, 0x39, 0xBF, 0xEF, 0x06, 0x4B, 0xE9, 0xF1, 0xD2, 0xC4, 0x17, 0xE1, 0xA0, 0x26, 0x4A, 0x0A, 0x94, 0xBE, 0x1F, 0x0C, 0x8D, 0x12, 0x18, 0x64, 0xEB, 0x69, 0x49, 0xCC}, // HiPKI Root CA - G1
+	{0x5A, 0x2F, 0xC0, 0x3F, 0x0C, 0x83, 0xB0, 0x90, 0xBB, 0xFA, 0x40, 0x60, 0x4B, 0x09, 0x88, 0x44, 0x6C, 0x76, 0x36, 0x18, 0x3D, 0xF9, 0x84, 0x6E, 0x17, 0x10, 0x1A, 0x44, 0x7F, 0xB8, 0xEF, 0xD6}, // Hongkong Post Root CA 3
+	{0x96, 0xBC, 0xEC, 0x06, 0x26, 0x49, 0x76, 0xF3, 0x74, 0x60, 0x77, 0x9A, 0xCF, 0x28, 0xC5, 0xA7, 0xCF, 0xE8, 0xA3, 0xC0, 0xAA, 0xE1, 0x1A, 0x8F, 0xFC, 0xEE, 0x05, 0xC0, 0xBD, 0xDF, 0x08, 0xC6}, // ISRG Root X1
+	{0x69, 0x72, 0x9B, 0x8E, 0x15, 0xA8, 0x6E, 0xFC, 0x17, 0x7A, 0x57, 0xAF, 0xB7, 0x17, 0x1D, 0xFC, 0x64, 0xAD, 0xD2, 0x8C, 0x2F, 0xCA, 0x8C, 0xF1, 0x50, 0x7E, 0x34, 0x45, 0x3C, 0xCB, 0x14, 0x70}, // ISRG Root X2
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x25, 0x30, 0xCC, 0x8E, 0x98, 0x32, 0x15, 0x02, 0xBA, 0xD9, 0x6F, 0x9B, 0x1F, 0xBA, 0x1B, 0x09, 0x9E, 0x2D, 0x29, 0x9E, 0x0F, 0x45, 0x48, 0xBB, 0x91, 0x4F, 0x36, 0x3B, 0xC0, 0xD4, 0x53, 0x1F}, // Izenpe.com
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x35, 0x8D, 0xF3, 0x9D, 0x76, 0x4A, 0xF9, 0xE1, 0xB7, 0x66, 0xE9, 0xC9, 0x72, 0xDF, 0x35, 0x2E, 0xE1, 0x5C, 0xFA, 0xC2, 0x27, 0xAF, 0x6A, 0xD1, 0xD7, 0x0E, 0x8E, 0x4A, 0x6E, 0xDC, 0xBA, 0x02}, // Microsoft ECC Root Certificate Authority 2017
+	{0xC7, 0x41, 0xF7, 0x0F, 0x4B, 0x2A, 0x8D, 0x88, 0xBF, 0x2E, 0x71, 0xC1, 0x41, 0x22, 0xEF, 0x53, 0xEF, 0x10, 0xEB, 0xA0, 0xCF, 0xA5, 0xE6, 0x4C, 0xFA, 0x20, 0xF4, 0x18, 0x85, 0x30, 0x73, 0xE0}, // Microsoft RSA Root Certificate Authority 2017
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6C, 0x61, 0xDA, 0xC3, 0xA2, 0xDE, 0xF0, 0x31, 0x50, 0x6B, 0xE0, 0x36, 0xD2, 0xA6, 0xFE, 0x40, 0x19, 0x94, 0xFB, 0xD1, 0x3D, 0xF9, 0xC8, 0xD4, 0x66, 0x59, 0x92, 0x74, 0xC4, 0x46, 0xEC, 0x98}, // NetLock Arany (Class Gold) Főtanúsítvány
+	{0xEE, 0xC9, 0x97, 0xC0, 0xC3, 0x0F, 0x21, 0x6F, 0x7E, 0x3B, 0x8B, 0x30, 0x7D, 0x2B, 0xAE, 0x42, 0x41, 0x2D, 0x75, 0x3F, 0xC8, 0x21, 0x9D, 0xAF, 0xD1, 0x52, 0x0B, 0x25, 0x72, 0x85, 0x0F, 0x49}, // OISTE Server Root ECC G1
+	{0x9A, 0xE3, 0x62, 0x32, 0xA5, 0x18, 0x9F, 0xFD, 0xDB, 0x35, 0x3D, 0xFD, 0x26, 0x52, 0x0C, 0x01, 0x53, 0x95, 0xD2, 0x27, 0x77, 0xDA, 0xC5, 0x9D, 0xB5, 0x7B, 0x98, 0xC0, 0x89, 0xA6, 0x51, 0xE6}, // OISTE Server Root RSA G1
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0x22, 0xA2, 0xC1, 0xF7, 0xBD, 0xED, 0x70, 0x4C, 0xC1, 0xE7, 0x01, 0xB5, 0xF4, 0x08, 0xC3, 0x10, 0x88, 0x0F, 0xE9, 0x56, 0xB5, 0xDE, 0x2A, 0x4A, 0x44, 0xF9, 0x9C, 0x87, 0x3A, 0x25, 0xA7, 0xC8}, // SSL.com EV Root Certification Authority ECC
+	{0x2E, 0x7B, 0xF1, 0x6C, 0xC2, 0x24, 0x85, 0xA7, 0xBB, 0xE2, 0xAA, 0x86, 0x96, 0x75, 0x07, 0x61, 0xB0, 0xAE, 0x39, 0xBE, 0x3B, 0x2F, 0xE9, 0xD0, 0xCC, 0x6D, 0x4E, 0xF7, 0x34, 0x91, 0x42, 0x5C}, // SSL.com EV Root Certification Authority RSA R2
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xC3, 0x2F, 0xFD, 0x9F, 0x46, 0xF9, 0x36, 0xD1, 0x6C, 0x36, 0x73, 0x99, 0x09, 0x59, 0x43, 0x4B, 0x9A, 0xD6, 0x0A, 0xAF, 0xBB, 0x9E, 0x7C, 0xF3, 0x36, 0x54, 0xF1, 0x44, 0xCC, 0x1B, 0xA1, 0x43}, // SSL.com TLS ECC Root CA 2022
+	{0x8F, 0xAF, 0x7D, 0x2E, 0x2C, 0xB4, 0x70, 0x9B, 0xB8, 0xE0, 0xB3, 0x36, 0x66, 0xBF, 0x75, 0xA5, 0xDD, 0x45, 0xB5, 0xDE, 0x48, 0x0F, 0x8E, 0xA8, 0xD4, 0xBF, 0xE6, 0xBE, 0xBC, 0x17, 0xF2, 0xED}, // SSL.com TLS RSA Root CA 2022
+	{0xA1, 0x33, 0x9D, 0x33, 0x28, 0x1A, 0x0B, 0x56, 0xE5, 0x57, 0xD3, 0xD3, 0x2B, 0x1C, 0xE7, 0xF9, 0x36, 0x7E, 0xB0, 0x94, 0xBD, 0x5F, 0xA7, 0x2A, 0x7E, 0x50, 0x04, 0xC8, 0xDE, 0xD7, 0xCA, 0xFE}, // SZAFIR ROOT CA2
+	{0xC9, 0x0F, 0x26, 0xF0, 0xFB, 0x1B, 0x40, 0x18, 0xB2, 0x22, 0x27, 0x51, 0x9B, 0x5C, 0xA2, 0xB5, 0x3E, 0x2C, 0xA5, 0xB3, 0xBE, 0x5C, 0xF1, 0x8E, 0xFE, 0x1B, 0xEF, 0x47, 0x38, 0x0C, 0x53, 0x83}, // Sectigo Public Server Authentication Root E46
+	{0x7B, 0xB6, 0x47, 0xA6, 0x2A, 0xEE, 0xAC, 0x88, 0xBF, 0x25, 0x7A, 0xA5, 0x22, 0xD0, 0x1F, 0xFE, 0xA3, 0x95, 0xE0, 0xAB, 0x45, 0xC7, 0x3F, 0x93, 0xF6, 0x56, 0x54, 0xEC, 0x38, 0xF2, 0x5A, 0x06}, // Sectigo Public Server Authentication Root R46
+	{0x4B, 0x00, 0x9C, 0x10, 0x34, 0x49, 0x4F, 0x9A, 0xB5, 0x6B, 0xBA, 0x3B, 0xA1, 0xD6, 0x27, 0x31, 0xFC, 0x4D, 0x20, 0xD8, 0x95, 0x5A, 0xDC, 0xEC, 0x10, 0xA9, 0x25, 0x60, 0x72, 0x61, 0xE3, 0x38}, // SecureSign Root CA14
+	{0xE7, 0x78, 0xF0, 0xF0, 0x95, 0xFE, 0x84, 0x37, 0x29, 0xCD, 0x1A, 0x00, 0x82, 0x17, 0x9E, 0x53, 0x14, 0xA9, 0xC2, 0x91, 0x44, 0x28, 0x05, 0xE1, 0xFB, 0x1D, 0x8F, 0xB6, 0xB8, 0x88, 0x6C, 0x3A}, // SecureSign Root CA15
+	{0xE7, 0x4F, 0xBD, 0xA5, 0x5B, 0xD5, 0x64, 0xC4, 0x73, 0xA3, 0x6B, 0x44, 0x1A, 0xA7, 0x99, 0xC8, 0xA6, 0x8E, 0x07, 0x74, 0x40, 0xE8, 0x28, 0x8B, 0x9F, 0xA1, 0xE5, 0x0E, 0x4B, 0xBA, 0xCA, 0x11}, // Security Communication ECC RootCA1
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x2C, 0xE1, 0xCB, 0x0B, 0xF9, 0xD2, 0xF9, 0xE1, 0x02, 0x99, 0x3F, 0xBE, 0x21, 0x51, 0x52, 0xC3, 0xB2, 0xDD, 0x0C, 0xAB, 0xDE, 0x1C, 0x68, 0xE5, 0x31, 0x9B, 0x83, 0x91, 0x54, 0xDB, 0xB7, 0xF5}, // Starfield Root Certificate Authority - G2
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0x19, 0x31, 0x44, 0xF4, 0x31, 0xE0, 0xFD, 0xDB, 0x74, 0x07, 0x17, 0xD4, 0xDE, 0x92, 0x6A, 0x57, 0x11, 0x33, 0x88, 0x4B, 0x43, 0x60, 0xD3, 0x0E, 0x27, 0x29, 0x13, 0xCB, 0xE6, 0x60, 0xCE, 0x41}, // SwissSign RSA TLS Root CA 2022 - 1
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0xFD, 0x73, 0xDA, 0xD3, 0x1C, 0x64, 0x4F, 0xF1, 0xB4, 0x3B, 0xEF, 0x0C, 0xCD, 0xDA, 0x96, 0x71, 0x0B, 0x9C, 0xD9, 0x87, 0x5E, 0xCA, 0x7E, 0x31, 0x70, 0x7A, 0xF3, 0xE9, 0x6D, 0x52, 0x2B, 0xBD}, // T-TeleSec GlobalRoot Class 3
+	{0x46, 0xED, 0xC3, 0x68, 0x90, 0x46, 0xD5, 0x3A, 0x45, 0x3F, 0xB3, 0x10, 0x4A, 0xB8, 0x0D, 0xCA, 0xEC, 0x65, 0x8B, 0x26, 0x60, 0xEA, 0x16, 0x29, 0xDD, 0x7E, 0x86, 0x79, 0x90, 0x64, 0x87, 0x16}, // TUBITAK Kamu SM SSL Kok Sertifikasi - Surum 1
+	{0x3F, 0x63, 0xBB, 0x28, 0x14, 0xBE, 0x17, 0x4E, 0xC8, 0xB6, 0x43, 0x9C, 0xF0, 0x8D, 0x6D, 0x56, 0xF0, 0xB7, 0xC4, 0x05, 0x88, 0x3A, 0x56, 0x48, 0xA3, 0x34, 0x42, 0x4D, 0x6B, 0x3E, 0xC5, 0x58}, // TWCA CYBER Root CA
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x57, 0x8A, 0xF4, 0xDE, 0xD0, 0x85, 0x3F, 0x4E, 0x59, 0x98, 0xDB, 0x4A, 0xEA, 0xF9, 0xCB, 0xEA, 0x8D, 0x94, 0x5F, 0x60, 0xB6, 0x20, 0xA3, 0x8D, 0x1A, 0x3C, 0x13, 0xB2, 0xBC, 0x7B, 0xA8, 0xE1}, // Telekom Security TLS ECC Root 2020
+	{0xEF, 0xC6, 0x5C, 0xAD, 0xBB, 0x59, 0xAD, 0xB6, 0xEF, 0xE8, 0x4D, 0xA2, 0x23, 0x11, 0xB3, 0x56, 0x24, 0xB7, 0x1B, 0x3B, 0x1E, 0xA0, 0xDA, 0x8B, 0x66, 0x55, 0x17, 0x4E, 0xC8, 0x97, 0x86, 0x46}, // Telekom Security TLS RSA Root 2023
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0xE0, 0xD3, 0x22, 0x6A, 0xEB, 0x11, 0x63, 0xC2, 0xE4, 0x8F, 0xF9, 0xBE, 0x3B, 0x50, 0xB4, 0xC6, 0x43, 0x1B, 0xE7, 0xBB, 0x1E, 0xAC, 0xC5, 0xC3, 0x6B, 0x5D, 0x5E, 0xC5, 0x09, 0x03, 0x9A, 0x08}, // TrustAsia Global Root CA G3
+	{0xBE, 0x4B, 0x56, 0xCB, 0x50, 0x56, 0xC0, 0x13, 0x6A, 0x52, 0x6D, 0xF4, 0x44, 0x50, 0x8D, 0xAA, 0x36, 0xA0, 0xB5, 0x4F, 0x42, 0xE4, 0xAC, 0x38, 0xF7, 0x2A, 0xF4, 0x70, 0xE4, 0x79, 0x65, 0x4C}, // TrustAsia Global Root CA G4
+	{0xC0, 0x07, 0x6B, 0x9E, 0xF0, 0x53, 0x1F, 0xB1, 0xA6, 0x56, 0xD6, 0x7C, 0x4E, 0xBE, 0x97, 0xCD, 0x5D, 0xBA, 0xA4, 0x1E, 0xF4, 0x45, 0x98, 0xAC, 0xC2, 0x48, 0x98, 0x78, 0xC9, 0x2D, 0x87, 0x11}, // TrustAsia TLS ECC Root CA
+	{0x06, 0xC0, 0x8D, 0x7D, 0xAF, 0xD8, 0x76, 0x97, 0x1E, 0xB1, 0x12, 0x4F, 0xE6, 0x7F, 0x84, 0x7E, 0xC0, 0xC7, 0xA1, 0x58, 0xD3, 0xEA, 0x53, 0xCB, 0xE9, 0x40, 0xE2, 0xEA, 0x97, 0x91, 0xF4, 0xC3}, // TrustAsia TLS RSA Root CA
+	{0x2E, 0x44, 0x10, 0x2A, 0xB5, 0x8C, 0xB8, 0x54, 0x19, 0x45, 0x1C, 0x8E, 0x19, 0xD9, 0xAC, 0xF3, 0x66, 0x2C, 0xAF, 0xBC, 0x61, 0x4B, 0x6A, 0x53, 0x96, 0x0A, 0x30, 0xF7, 0xD0, 0xE2, 0xEB, 0x41}, // TunTrust Root CA
+	{0xD4, 0x3A, 0xF9, 0xB3, 0x54, 0x73, 0x75, 0x5C, 0x96, 0x84, 0xFC, 0x06, 0xD7, 0xD8, 0xCB, 0x70, 0xEE, 0x5C, 0x28, 0xE7, 0x73, 0xFB, 0x29, 0x4E, 0xB4, 0x1E, 0xE7, 0x17, 0x22, 0x92, 0x4D, 0x24}, // UCA Extended Validation Root
+	{0x9B, 0xEA, 0x11, 0xC9, 0x76, 0xFE, 0x01, 0x47, 0x64, 0xC1, 0xBE, 0x56, 0xA6, 0xF9, 0x14, 0xB5, 0xA5, 0x60, 0x31, 0x7A, 0xBD, 0x99, 0x88, 0x39, 0x33, 0x82, 0xE5, 0x16, 0x1A, 0xA0, 0x49, 0x3C}, // UCA Global G2 Root
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xBE, 0xB0, 0x0B, 0x30, 0x83, 0x9B, 0x9B, 0xC3, 0x2C, 0x32, 0xE4, 0x44, 0x79, 0x05, 0x95, 0x06, 0x41, 0xF2, 0x64, 0x21, 0xB1, 0x5E, 0xD0, 0x89, 0x19, 0x8B, 0x51, 0x8A, 0xE2, 0xEA, 0x1B, 0x99}, // e-Szigno Root CA 2017
+	{0xB4, 0x91, 0x41, 0x50, 0x2D, 0x00, 0x66, 0x3D, 0x74, 0x0F, 0x2E, 0x7E, 0xC3, 0x40, 0xC5, 0x28, 0x00, 0x96, 0x26, 0x66, 0x12, 0x1A, 0x36, 0xD0, 0x9C, 0xF7, 0xDD, 0x2B, 0x90, 0x38, 0x4F, 0xB4}, // e-Szigno TLS Root CA 2023
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0xBC, 0x4D, 0x80, 0x9B, 0x15, 0x18, 0x9D, 0x78, 0xDB, 0x3E, 0x1D, 0x8C, 0xF4, 0xF9, 0x72, 0x6A, 0x79, 0x5D, 0xA1, 0x64, 0x3C, 0xA5, 0xF1, 0x35, 0x8E, 0x1D, 0xDB, 0x0E, 0xDC, 0x0D, 0x7E, 0xB3}, // emSign ECC Root CA - C3
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x12, 0x56, 0x09, 0xAA, 0x30, 0x1D, 0xA0, 0xA2, 0x49, 0xB9, 0x7A, 0x82, 0x39, 0xCB, 0x6A, 0x34, 0x21, 0x6F, 0x44, 0xDC, 0xAC, 0x9F, 0x39, 0x54, 0xB1, 0x42, 0x92, 0xF2, 0xE8, 0xC8, 0x60, 0x8F}, // emSign Root CA - C1
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+	{0x30, 0xFB, 0xBA, 0x2C, 0x32, 0x23, 0x8E, 0x2A, 0x98, 0x54, 0x7A, 0xF9, 0x79, 0x31, 0xE5, 0x50, 0x42, 0x8B, 0x9B, 0x3F, 0x1C, 0x8E, 0xEB, 0x66, 0x33, 0xDC, 0xFA, 0x86, 0xC5, 0xB2, 0x7D, 0xD3}, // vTrus ECC Root CA
+	{0x8A, 0x71, 0xDE, 0x65, 0x59, 0x33, 0x6F, 0x42, 0x6C, 0x26, 0xE5, 0x38, 0x80, 0xD0, 0x0D, 0x88, 0xA1, 0x8D, 0xA4, 0xC6, 0xA9, 0x1F, 0x0D, 0xCB, 0x61, 0x94, 0xE2, 0x06, 0xC5, 0xC9, 0x63, 0x87}, // vTrus Root CA
+}
+
+// SHA-256 fingerprints of the root certificates that are included in the Mozilla root store and capable of issuing S/MIME certificates.
+var MozillaSMIME = [][sha256.Size]byte{
+	{0xD7, 0xA7, 0xA0, 0xFB, 0x5D, 0x7E, 0x27, 0x31, 0xD7, 0x71, 0xE9, 0x48, 0x4E, 0xBC, 0xDE, 0xF7, 0x1D, 0x5F, 0x0C, 0x3E, 0x0A, 0x29, 0x48, 0x78, 0x2B, 0xC8, 0x3E, 0xE0, 0xEA, 0x69, 0x9E, 0xF4}, // AAA Certificate Services
+	{0xEB, 0xC5, 0x57, 0x0C, 0x29, 0x01, 0x8C, 0x4D, 0x67, 0xB1, 0xAA, 0x12, 0x7B, 0xAF, 0x12, 0xF7, 0x03, 0xB4, 0x61, 0x1E, 0xBC, 0x17, 0xB7, 0xDA, 0xB5, 0x57, 0x38, 0x94, 0x17, 0x9B, 0x93, 0xFA}, // AC RAIZ FNMT-RCM
+	{0x9A, 0x6E, 0xC0, 0x12, 0xE1, 0xA7, 0xDA, 0x9D, 0xBE, 0x34, 0x19, 0x4D, 0x47, 0x8A, 0xD7, 0xC0, 0xDB, 0x18, 0x22, 0xFB, 0x07, 0x1D, 0xF1, 0x29, 0x81, 0x49, 0x6E, 0xD1, 0x04, 0x38, 0x41, 0x13}, // ACCVRAIZ1
+	{0x55, 0x92, 0x60, 0x84, 0xEC, 0x96, 0x3A, 0x64, 0xB9, 0x6E, 0x2A, 0xBE, 0x01, 0xCE, 0x0B, 0xA8, 0x6A, 0x64, 0xFB, 0xFE, 0xBC, 0xC7, 0xAA, 0xB5, 0xAF, 0xC1, 0x55, 0xB3, 0x7F, 0xD7, 0x60, 0x66}, // Actalis Authentication Root CA
+	{0x8E, 0xCD, 0xE6, 0x88, 0x4F, 0x3D, 0x87, 0xB1, 0x12, 0x5B, 0xA3, 0x1A, 0xC3, 0xFC, 0xB1, 0x3D, 0x70, 0x16, 0xDE, 0x7F, 0x57, 0xCC, 0x90, 0x4F, 0xE1, 0xCB, 0x97, 0xC6, 0xAE, 0x98, 0x19, 0x6E}, // Amazon Root CA 1
+	{0x1B, 0xA5, 0xB2, 0xAA, 0x8C, 0x65, 0x40, 0x1A, 0x82, 0x96, 0x01, 0x18, 0xF8, 0x0B, 0xEC, 0x4F, 0x62, 0x30, 0x4D, 0x83, 0xCE, 0xC4, 0x71, 0x3A, 0x19, 0xC3, 0x9C, 0x01, 0x1E, 0xA4, 0x6D, 0xB4}, // Amazon Root CA 2
+	{0x18, 0xCE, 0x6C, 0xFE, 0x7B, 0xF1, 0x4E, 0x60, 0xB2, 0xE3, 0x47, 0xB8, 0xDF, 0xE8, 0x68, 0xCB, 0x31, 0xD0, 0x2E, 0xBB, 0x3A, 0xDA, 0x27, 0x15, 0x69, 0xF5, 0x03, 0x43, 0xB4, 0x6D, 0xB3, 0xA4}, // Amazon Root CA 3
+	{0xE3, 0x5D, 0x28, 0x41, 0x9E, 0xD0, 0x20, 0x25, 0xCF, 0xA6, 0x90, 0x38, 0xCD, 0x62, 0x39, 0x62, 0x45, 0x8D, 0xA5, 0xC6, 0x95, 0xFB, 0xDE, 0xA3, 0xC2, 0x2B, 0x0B, 0xFB, 0x25, 0x89, 0x70, 0x92}, // Amazon Root CA 4
+	{0xF3, 0x56, 0xBE, 0xA2, 0x44, 0xB7, 0xA9, 0x1E, 0xB3, 0x5D, 0x53, 0xCA, 0x9A, 0xD7, 0x86, 0x4A, 0xCE, 0x01, 0x8E, 0x2D, 0x35, 0xD5, 0xF8, 0xF9, 0x6D, 0xDF, 0x68, 0xA6, 0xF4, 0x1A, 0xA4, 0x74}, // Atos TrustedRoot 2011
+	{0xE3, 0x86, 0x55, 0xF4, 0xB0, 0x19, 0x0C, 0x84, 0xD3, 0xB3, 0x89, 0x3D, 0x84, 0x0A, 0x68, 0x7E, 0x19, 0x0A, 0x25, 0x6D, 0x98, 0x05, 0x2F, 0x15, 0x9E, 0x6D, 0x4A, 0x39, 0xF5, 0x89, 0xA6, 0xEB}, // Atos TrustedRoot Root CA ECC G2 2020
+	{0x78, 0x83, 0x3A, 0x78, 0x3B, 0xB2, 0x98, 0x6C, 0x25, 0x4B, 0x93, 0x70, 0xD3, 0xC2, 0x0E, 0x5E, 0xBA, 0x8F, 0xA7, 0x84, 0x0C, 0xBF, 0x63, 0xFE, 0x17, 0x29, 0x7A, 0x0B, 0x01, 0x19, 0x68, 0x5E}, // Atos TrustedRoot Root CA RSA G2 2020
+	{0xE2, 0x3D, 0x4A, 0x03, 0x6D, 0x7B, 0x70, 0xE9, 0xF5, 0x95, 0xB1, 0x42, 0x20, 0x79, 0xD2, 0xB9, 0x1E, 0xDF, 0xBB, 0x1F, 0xB6, 0x51, 0xA0, 0x63, 0x3E, 0xAA, 0x8A, 0x9D, 0xC5, 0xF8, 0x07, 0x03}, // CA Disig Root R2
+	{0x0C, 0x2C, 0xD6, 0x3D, 0xF7, 0x80, 0x6F, 0xA3, 0x99, 0xED, 0xE8, 0x09, 0x11, 0x6B, 0x57, 0x5B, 0xF8, 0x79, 0x89, 0xF0, 0x65, 0x18, 0xF9, 0x80, 0x8C, 0x86, 0x05, 0x03, 0x17, 0x8B, 0xAF, 0x66}, // COMODO Certification Authority
+	{0x17, 0x93, 0x92, 0x7A, 0x06, 0x14, 0x54, 0x97, 0x89, 0xAD, 0xCE, 0x2F, 0x8F, 0x34, 0xF7, 0xF0, 0xB6, 0x6D, 0x0F, 0x3A, 0xE3, 0xA3, 0xB8, 0x4D, 0x21, 0xEC, 0x15, 0xDB, 0xBA, 0x4F, 0xAD, 0xC7}, // COMODO ECC Certification Authority
+	{0x52, 0xF0, 0xE1, 0xC4, 0xE5, 0x8E, 0xC6, 0x29, 0x29, 0x1B, 0x60, 0x31, 0x7F, 0x07, 0x46, 0x71, 0xB8, 0x5D, 0x7E, 0xA8, 0x0D, 0x5B, 0x07, 0x27, 0x34, 0x63, 0x53, 0x4B, 0x32, 0xB4, 0x02, 0x34}, // COMODO RSA Certification Authority
+	{0xE3, 0xB6, 0xA2, 0xDB, 0x2E, 0xD7, 0xCE, 0x48, 0x84, 0x2F, 0x7A, 0xC5, 0x32, 0x41, 0xC7, 0xB7, 0x1D, 0x54, 0x14, 0x4B, 0xFB, 0x40, 0xC1, 0x1F, 0x3F, 0x1D, 0x0B, 0x42, 0xF5, 0xEE, 0xA1, 0x2D}, // Certigna
+	{0xD4, 0x8D, 0x3D, 0x23, 0xEE, 0xDB, 0x50, 0xA4, 0x59, 0xE5, 0x51, 0x97, 0x60, 0x1C, 0x27, 0x77, 0x4B, 0x9D, 0x7B, 0x18, 0xC9, 0x4D, 0x5A, 0x05, 0x95, 0x11, 0xA1, 0x02, 0x50, 0xB9, 0x31, 0x68}, // Certigna Root CA
+	{0xD8, 0xE0, 0xFE, 0xBC, 0x1D, 0xB2, 0xE3, 0x8D, 0x00, 0x94, 0x0F, 0x37, 0xD2, 0x7D, 0x41, 0x34, 0x4D, 0x99, 0x3E, 0x73, 0x4B, 0x99, 0xD5, 0x65, 0x6D, 0x97, 0x78, 0xD4, 0xD8, 0x14, 0x36, 0x24}, // Certum CA
+	{0x6B, 0x32, 0x80, 0x85, 0x62, 0x53, 0x18, 0xAA, 0x50, 0xD1, 0x73, 0xC9, 0x8D, 0x8B, 0xDA, 0x09, 0xD5, 0x7E, 0x27, 0x41, 0x3D, 0x11, 0x4C, 0xF7, 0x87, 0xA0, 0xF5, 0xD0, 0x6C, 0x03, 0x0C, 0xF6}, // Certum EC-384 CA
+	{0x5C, 0x58, 0x46, 0x8D, 0x55, 0xF5, 0x8E, 0x49, 0x7E, 0x74, 0x39, 0x82, 0xD2, 0xB5, 0x00, 0x10, 0xB6, 0xD1, 0x65, 0x37, 0x4A, 0xCF, 0x83, 0xA7, 0xD4, 0xA3, 0x2D, 0xB7, 0x68, 0xC4, 0x40, 0x8E}, // Certum Trusted Network CA
+	{0xB6, 0x76, 0xF2, 0xED, 0xDA, 0xE8, 0x77, 0x5C, 0xD3, 0x6C, 0xB0, 0xF6, 0x3C, 0xD1, 0xD4, 0x60, 0x39, 0x61, 0xF4, 0x9E, 0x62, 0x65, 0xBA, 0x01, 0x3A, 0x2F, 0x03, 0x07, 0xB6, 0xD0, 0xB8, 0x04}, // Certum Trusted Network CA 2
+	{0xFE, 0x76, 0x96, 0x57, 0x38, 0x55, 0x77, 0x3E, 0x37, 0xA9, 0x5E, 0x7A, 0xD4, 0xD9, 0xCC, 0x96, 0xC3, 0x01, 0x57, 0xC1, 0x5D, 0x31, 0x76, 0x5B, 0xA9, 0xB1, 0x57, 0x04, 0xE1, 0xAE, 0x78, 0xFD}, // Certum Trusted Root CA
+	{0xA1, 0xA8, 0x6D, 0x04, 0x12, 0x1E, 0xB8, 0x7F, 0x02, 0x7C, 0x66, 0xF5, 0x33, 0x03, 0xC2, 0x8E, 0x57, 0x39, 0xF9, 0x43, 0xFC, 0x84, 0xB3, 0x8A, 0xD6, 0xAF, 0x00, 0x90, 0x35, 0xDD, 0x94, 0x57}, // D-TRUST Root CA 3 2013
+	{0xD9, 0x2C, 0x17, 0x1F, 0x5C, 0xF8, 0x90, 0xBA, 0x42, 0x80, 0x19, 0x29, 0x29, 0x27, 0xFE, 0x22, 0xF3, 0x20, 0x7F, 0xD2, 0xB5, 0x44, 0x49, 0xCB, 0x6F, 0x67, 0x5A, 0xF4, 0x92, 0x21, 0x46, 0xE2}, // D-Trust SBR Root CA 1 2022
+	{0xDB, 0xA8, 0x4D, 0xD7, 0xEF, 0x62, 0x2D, 0x48, 0x54, 0x63, 0xA9, 0x01, 0x37, 0xEA, 0x4D, 0x57, 0x4D, 0xF8, 0x55, 0x09, 0x28, 0xF6, 0xAF, 0xA0, 0x3B, 0x4D, 0x8B, 0x11, 0x41, 0xE6, 0x36, 0xCC}, // D-Trust SBR Root CA 2 2022
+	{0x26, 0x1D, 0x71, 0x14, 0xAE, 0x5F, 0x8F, 0xF2, 0xD8, 0xC7, 0x20, 0x9A, 0x9D, 0xE4, 0x28, 0x9E, 0x6A, 0xFC, 0x9D, 0x71, 0x70, 0x23, 0xD8, 0x54, 0x50, 0x90, 0x91, 0x99, 0xF1, 0x85, 0x7C, 0xFE}, // DIGITALSIGN GLOBAL ROOT ECDSA CA
+	{0x82, 0xBD, 0x5D, 0x85, 0x1A, 0xCF, 0x7F, 0x6E, 0x1B, 0xA7, 0xBF, 0xCB, 0xC5, 0x30, 0x30, 0xD0, 0xE7, 0xBC, 0x3C, 0x21, 0xDF, 0x77, 0x2D, 0x85, 0x8C, 0xAB, 0x41, 0xD1, 0x99, 0xBD, 0xF5, 0x95}, // DIGITALSIGN GLOBAL ROOT RSA CA
+	{0x3E, 0x90, 0x99, 0xB5, 0x01, 0x5E, 0x8F, 0x48, 0x6C, 0x00, 0xBC, 0xEA, 0x9D, 0x11, 0x1E, 0xE7, 0x21, 0xFA, 0xBA, 0x35, 0x5A, 0x89, 0xBC, 0xF1, 0xDF, 0x69, 0x56, 0x1E, 0x3D, 0xC6, 0x32, 0x5C}, // DigiCert Assured ID Root CA
+	{0x7D, 0x05, 0xEB, 0xB6, 0x82, 0x33, 0x9F, 0x8C, 0x94, 0x51, 0xEE, 0x09, 0x4E, 0xEB, 0xFE, 0xFA, 0x79, 0x53, 0xA1, 0x14, 0xED, 0xB2, 0xF4, 0x49, 0x49, 0x45, 0x2F, 0xAB, 0x7D, 0x2F, 0xC1, 0x85}, // DigiCert Assured ID Root G2
+	{0x7E, 0x37, 0xCB, 0x8B, 0x4C, 0x47, 0x09, 0x0C, 0xAB, 0x36, 0x55, 0x1B, 0xA6, 0xF4, 0x5D, 0xB8, 0x40, 0x68, 0x0F, 0xBA, 0x16, 0x6A, 0x95, 0x2D, 0xB1, 0x00, 0x71, 0x7F, 0x43, 0x05, 0x3F, 0xC2}, // DigiCert Assured ID Root G3
+	{0x43, 0x48, 0xA0, 0xE9, 0x44, 0x4C, 0x78, 0xCB, 0x26, 0x5E, 0x05, 0x8D, 0x5E, 0x89, 0x44, 0xB4, 0xD8, 0x4F, 0x96, 0x62, 0xBD, 0x26, 0xDB, 0x25, 0x7F, 0x89, 0x34, 0xA4, 0x43, 0xC7, 0x01, 0x61}, // DigiCert Global Root CA
+	{0xCB, 0x3C, 0xCB, 0xB7, 0x60, 0x31, 0xE5, 0xE0, 0x13, 0x8F, 0x8D, 0xD3, 0x9A, 0x23, 0xF9, 0xDE, 0x47, 0xFF, 0xC3, 0x5E, 0x43, 0xC1, 0x14, 0x4C, 0xEA, 0x27, 0xD4, 0x6A, 0x5A, 0xB1, 0xCB, 0x5F}, // DigiCert Global Root G2
+	{0x31, 0xAD, 0x66, 0x48, 0xF8, 0x10, 0x41, 0x38, 0xC7, 0x38, 0xF3, 0x9E, 0xA4, 0x32, 0x01, 0x33, 0x39, 0x3E, 0x3A, 0x18, 0xCC, 0x02, 0x29, 0x6E, 0xF9, 0x7C, 0x2A, 0xC9, 0xEF, 0x67, 0x31, 0xD0}, // DigiCert Global Root G3
+	{0x74, 0x31, 0xE5, 0xF4, 0xC3, 0xC1, 0xCE, 0x46, 0x90, 0x77, 0x4F, 0x0B, 0x61, 0xE0, 0x54, 0x40, 0x88, 0x3B, 0xA9, 0xA0, 0x1E, 0xD0, 0x0B, 0xA6, 0xAB, 0xD7, 0x80, 0x6E, 0xD3, 0xB1, 0x18, 0xCF}, // DigiCert High Assurance EV Root CA
+	{0xE8, 0xE8, 0x17, 0x65, 0x36, 0xA6, 0x0C, 0xC2, 0xC4, 0xE1, 0x01, 0x87, 0xC3, 0xBE, 0xFC, 0xA2, 0x0E, 0xF2, 0x63, 0x49, 0x70, 0x18, 0xF5, 0x66, 0xD5, 0xBE, 0xA0, 0xF9, 0x4D, 0x0C, 0x11, 0x1B}, // DigiCert SMIME ECC P384 Root G5
+	{0x90, 0x37, 0x0D, 0x3E, 0xFA, 0x88, 0xBF, 0x58, 0xC3, 0x01, 0x05, 0xBA, 0x25, 0x10, 0x4A, 0x35, 0x84, 0x60, 0xA7, 0xFA, 0x52, 0xDF, 0xC2, 0x01, 0x1D, 0xF2, 0x33, 0xA0, 0xF4, 0x17, 0x91, 0x2A}, // DigiCert SMIME RSA4096 Root G5
+	{0x55, 0x2F, 0x7B, 0xDC, 0xF1, 0xA7, 0xAF, 0x9E, 0x6C, 0xE6, 0x72, 0x01, 0x7F, 0x4F, 0x12, 0xAB, 0xF7, 0x72, 0x40, 0xC7, 0x8E, 0x76, 0x1A, 0xC2, 0x03, 0xD1, 0xD9, 0xD2, 0x0A, 0xC8, 0x99, 0x88}, // DigiCert Trusted Root G4
+	{0x02, 0xED, 0x0E, 0xB2, 0x8C, 0x14, 0xDA, 0x45, 0x16, 0x5C, 0x56, 0x67, 0x91, 0x70, 0x0D, 0x64, 0x51, 0xD7, 0xFB, 0x56, 0xF0, 0xB2, 0xAB, 0x1D, 0x3B, 0x8E, 0xB0, 0x70, 0xE5, 0x6E, 0xDF, 0xF5}, // Entrust Root Certification Authority - EC1
+	{0x43, 0xDF, 0x57, 0x74, 0xB0, 0x3E, 0x7F, 0xEF, 0x5F, 0xE4, 0x0D, 0x93, 0x1A, 0x7B, 0xED, 0xF1, 0xBB, 0x2E, 0x6B, 0x42, 0x73, 0x8C, 0x4E, 0x6D, 0x38, 0x41, 0x10, 0x3D, 0x3A, 0xA7, 0xF3, 0x39}, // Entrust Root Certification Authority - G2
+	{0xDB, 0x35, 0x17, 0xD1, 0xF6, 0x73, 0x2A, 0x2D, 0x5A, 0xB9, 0x7C, 0x53, 0x3E, 0xC7, 0x07, 0x79, 0xEE, 0x32, 0x70, 0xA6, 0x2F, 0xB4, 0xAC, 0x42, 0x38, 0x37, 0x24, 0x60, 0xE6, 0xF0, 0x1E, 0x88}, // Entrust Root Certification Authority - G4
+	{0x6D, 0xC4, 0x71, 0x72, 0xE0, 0x1C, 0xBC, 0xB0, 0xBF, 0x62, 0x58, 0x0D, 0x89, 0x5F, 0xE2, 0xB8, 0xAC, 0x9A, 0xD4, 0xF8, 0x73, 0x80, 0x1E, 0x0C, 0x10, 0xB9, 0xC8, 0x37, 0xD2, 0x1E, 0xB1, 0x77}, // Entrust.net Certification Authority (2048)
+	{0xBF, 0xFF, 0x8F, 0xD0, 0x44, 0x33, 0x48, 0x7D, 0x6A, 0x8A, 0xA6, 0x0C, 0x1A, 0x29, 0x76, 0x7A, 0x9F, 0xC2, 0xBB, 0xB0, 0x5E, 0x42, 0x0F, 0x71, 0x3A, 0x13, 0xB9, 0x92, 0x89, 0x1D, 0x38, 0x93}, // GDCA TrustAUTH R5 ROOT
+	{0xD9, 0x47, 0x43, 0x2A, 0xBD, 0xE7, 0xB7, 0xFA, 0x90, 0xFC, 0x2E, 0x6B, 0x59, 0x10, 0x1B, 0x12, 0x80, 0xE0, 0xE1, 0xC7, 0xE4, 0xE4, 0x0F, 0xA3, 0xC6, 0x88, 0x7F, 0xFF, 0x57, 0xA7, 0xF4, 0xCF}, // GTS Root R1
+	{0x8D, 0x25, 0xCD, 0x97, 0x22, 0x9D, 0xBF, 0x70, 0x35, 0x6B, 0xDA, 0x4E, 0xB3, 0xCC, 0x73, 0x40, 0x31, 0xE2, 0x4C, 0xF0, 0x0F, 0xAF, 0xCF, 0xD3, 0x2D, 0xC7, 0x6E, 0xB5, 0x84, 0x1C, 0x7E, 0xA8}, // GTS Root R2
+	{0x34, 0xD8, 0xA7, 0x3E, 0xE2, 0x08, 0xD9, 0xBC, 0xDB, 0x0D, 0x95, 0x65, 0x20, 0x93, 0x4B, 0x4E, 0x40, 0xE6, 0x94, 0x82, 0x59, 0x6E, 0x8B, 0x6F, 0x73, 0xC8, 0x42, 0x6B, 0x01, 0x0A, 0x6F, 0x48}, // GTS Root R3
+	{0x34, 0x9D, 0xFA, 0x40, 0x58, 0xC5, 0xE2, 0x63, 0x12, 0x3B, 0x39, 0x8A, 0xE7, 0x95, 0x57, 0x3C, 0x4E, 0x13, 0x13, 0xC8, 0x3F, 0xE6, 0x8F, 0x93, 0x55, 0x6C, 0xD5, 0xE8, 0x03, 0x1B, 0x3C, 0x7D}, // GTS Root R4
+	{0x17, 0x9F, 0xBC, 0x14, 0x8A, 0x3D, 0xD0, 0x0F, 0xD2, 0x4E, 0xA1, 0x34, 0x58, 0xCC, 0x43, 0xBF, 0xA7, 0xF5, 0x9C, 0x81, 0x82, 0xD7, 0x83, 0xA5, 0x13, 0xF6, 0xEB, 0xEC, 0x10, 0x0C, 0x89, 0x24}, // GlobalSign
+	{0x2C, 0xAB, 0xEA, 0xFE, 0x37, 0xD0, 0x6C, 0xA2, 0x2A, 0xBA, 0x73, 0x91, 0xC0, 0x03, 0x3D, 0x25, 0x98, 0x29, 0x52, 0xC4, 0x53, 0x64, 0x73, 0x49, 0x76, 0x3A, 0x3A, 0xB5, 0xAD, 0x6C, 0xCF, 0x69}, // GlobalSign
+	{0xB0, 0x85, 0xD7, 0x0B, 0x96, 0x4F, 0x19, 0x1A, 0x73, 0xE4, 0xAF, 0x0D, 0x54, 0xAE, 0x7A, 0x0E, 0x07, 0xAA, 0xFD, 0xAF, 0x9B, 0x71, 0xDD, 0x08, 0x62, 0x13, 0x8A, 0xB7, 0x32, 0x5A, 0x24, 0xA2}, // GlobalSign
+	{0xCB, 0xB5, 0x22, 0xD7, 0xB7, 0xF1, 0x27, 0xAD, 0x6A, 0x01, 0x13, 0x86, 0x5B, 0xDF, 0x1C, 0xD4, 0x10, 0x2E, 0x7D, 0x07, 0x59, 0xAF, 0x63, 0x5A, 0x7C, 0xF4, 0x72, 0x0D, 0xC9, 0x63, 0xC5, 0x3B}, // GlobalSign
+	{0xEB, 0xD4, 0x10, 0x40, 0xE4, 0xBB, 0x3E, 0xC7, 0x42, 0xC9, 0xE3, 0x81, 0xD3, 0x1E, 0xF2, 0xA4, 0x1A, 0x48, 0xB6, 0x68, 0x5C, 0x96, 0xE7, 0xCE, 0xF3, 0xC1, 0xDF, 0x6C, 0xD4, 0x33, 0x1C, 0x99}, // GlobalSign Root CA
+	{0x5C, 0xBF, 0x6F, 0xB8, 0x1F, 0xD4, 0x17, 0xEA, 0x41, 0x28, 0xCD, 0x6F, 0x81, 0x72, 0xA3, 0xC9, 0x40, 0x20, 0x94, 0xF7, 0x4A, 0xB2, 0xED, 0x3A, 0x06, 0xB4, 0x40, 0x5D, 0x04, 0xF3, 0x0B, 0x19}, // GlobalSign Secure Mail Root E45
+	{0x31, 0x9A, 0xF0, 0xA7, 0x72, 0x9E, 0x6F, 0x89, 0x26, 0x9C, 0x13, 0x1E, 0xA6, 0xA3, 0xA1, 0x6F, 0xCD, 0x86, 0x38, 0x9F, 0xDC, 0xAB, 0x3C, 0x47, 0xA4, 0xA6, 0x75, 0xC1, 0x61, 0xA3, 0xF9, 0x74}, // GlobalSign Secure Mail Root R45
+	{0xC3, 0x84, 0x6B, 0xF2, 0x4B, 0x9E, 0x93, 0xCA, 0x64, 0x27, 0x4C, 0x0E, 0xC6, 0x7C, 0x1E, 0xCC, 0x5E, 0x02, 0x4F, 0xFC, 0xAC, 0xD2, 0xD7, 0x40, 0x19, 0x35, 0x0E, 0x81, 0xFE, 0x54, 0x6A, 0xE4}, // Go Daddy Class 2 Certification Authority
+	{0x45, 0x14, 0x0B, 0x32, 0x47, 0xEB, 0x9C, 0xC8, 0xC5, 0xB4, 0xF0, 0xD7, 0xB5, 0x30, 0x91, 0xF7, 0x32, 0x92, 0x08, 0x9E, 0x6E, 0x5A, 0x63, 0xE2, 0x74, 0x9D, 0xD3, 0xAC, 0xA9, 0x19, 0x8E, 0xDA}, // Go Daddy Root Certificate Authority - G2
+	{0x8D, 0xD4, 0xB5, 0x37, 0x3C, 0xB0, 0xDE, 0x36, 0x76, 0x9C, 0x12, 0x33, 0x92, 0x80, 0xD8, 0x27, 0x46, 0xB3, 0xAA, 0x6C, 0xD4, 0x26, 0xE7, 0x97, 0xA3, 0x1B, 0xAB, 0xE4, 0x27, 0x9C, 0xF0, 0x0B}, // HARICA Client ECC Root CA 2021
+	{0x1B, 0xE7, 0xAB, 0xE3, 0x06, 0x86, 0xB1, 0x63, 0x48, 0xAF, 0xD1, 0xC6, 0x1B, 0x68, 0x66, 0xA0, 0xEA, 0x7F, 0x48, 0x21, 0xE6, 0x7D, 0x5E, 0x8A, 0xF9, 0x37, 0xCF, 0x80, 0x11, 0xBC, 0x75, 0x0D}, // HARICA Client RSA Root CA 2021
+	{0x44, 0xB5, 0x45, 0xAA, 0x8A, 0x25, 0xE6, 0x5A, 0x73, 0xCA, 0x15, 0xDC, 0x27, 0xFC, 0x36, 0xD2, 0x4C, 0x1C, 0xB9, 0x95, 0x3A, 0x06, 0x65, 0x39, 0xB1, 0x15, 0x82, 0xDC, 0x48, 0x7B, 0x48, 0x33}, // Hellenic Academic and Research Institutions ECC RootCA 2015
+	{0xA0, 0x40, 0x92, 0x9A, 0x02, 0xCE, 0x53, 0xB4, 0xAC, 0xF4, 0xF2, 0xFF, 0xC6, 0x98, 0x1C, 0xE4, 0x49, 0x6F, 0x75, 0x5E, 0x6D, 0x45, 0xFE, 0x0B, 0x2A, 0x69, 0x2B, 0xCD, 0x52, 0x52, 0x3F, 0x36}, // Hellenic Academic and Research Institutions RootCA 2015
+	{0x5D, 0x56, 0x49, 0x9B, 0xE4, 0xD2, 0xE0, 0x8B, 0xCF, 0xCA, 0xD0, 0x8A, 0x3E, 0x38, 0x72, 0x3D, 0x50, 0x50, 0x3B, 0xDE, 0x70, 0x69, 0x48, 0xE4, 0x2F, 0x55, 0x60, 0x30, 0x19, 0xE5, 0x28, 0xAE}, // IdenTrust Commercial Root CA 1
+	{0x30, 0xD0, 0x89, 0x5A, 0x9A, 0x44, 0x8A, 0x26, 0x20, 0x91, 0x63, 0x55, 0x22, 0xD1, 0xF5, 0x20, 0x10, 0xB5, 0x86, 0x7A, 0xCA, 0xE1, 0x2C, 0x78, 0xEF, 0x95, 0x8F, 0xD4, 0xF4, 0x38, 0x9F, 0x2F}, // IdenTrust Public Sector Root CA 1
+	{0x48, 0xE1, 0xCF, 0x9E, 0x43, 0xB6, 0x88, 0xA5, 0x10, 0x44, 0x16, 0x0F, 0x46, 0xD7, 0x73, 0xB8, 0x27, 0x7F, 0xE4, 0x5B, 0xEA, 0xAD, 0x0E, 0x4D, 0xF9, 0x0D, 0x19, 0x74, 0x38, 0x2F, 0xEA, 0x99}, // LAWtrust Root CA2 (4096)
+	{0x3C, 0x5F, 0x81, 0xFE, 0xA5, 0xFA, 0xB8, 0x2C, 0x64, 0xBF, 0xA2, 0xEA, 0xEC, 0xAF, 0xCD, 0xE8, 0xE0, 0x77, 0xFC, 0x86, 0x20, 0xA7, 0xCA, 0xE5, 0x37, 0x16, 0x3D, 0xF3, 0x6E, 0xDB, 0xF3, 0x78}, // Microsec e-Szigno Root CA 2009
+	{0x88, 0xF4, 0x38, 0xDC, 0xF8, 0xFF, 0xD1, 0xFA, 0x8F, 0x42, 0x91, 0x15, 0xFF, 0xE5, 0xF8, 0x2A, 0xE1, 0xE0, 0x6E, 0x0C, 0x70, 0xC3, 0x75, 0xFA, 0xAD, 0x71, 0x7B, 0x34, 0xA4, 0x9E, 0x72, 0x65}, // NAVER Global Root Certification Authority
+	{0x6C, 0x61, 0xDA, 0xC3, 0xA2, 0xDE, 0xF0, 0x31, 0x50, 0x6B, 0xE0, 0x36, 0xD2, 0xA6, 0xFE, 0x40, 0x19, 0x94, 0xFB, 0xD1, 0x3D, 0xF9, 0xC8, 0xD4, 0x66, 0x59, 0x92, 0x74, 0xC4, 0x46, 0xEC, 0x98}, // NetLock Arany (Class Gold) Főtanúsítvány
+	{0xD9, 0xA3, 0x24, 0x85, 0xA8, 0xCC, 0xA8, 0x55, 0x39, 0xCE, 0xF1, 0x2F, 0xFF, 0xFF, 0x71, 0x13, 0x78, 0xA1, 0x78, 0x51, 0xD7, 0x3D, 0xA2, 0x73, 0x2A, 0xB4, 0x30, 0x2D, 0x76, 0x3B, 0xD6, 0x2B}, // OISTE Client Root ECC G1
+	{0xD0, 0x2A, 0x0F, 0x99, 0x4A, 0x86, 0x8C, 0x66, 0x39, 0x5F, 0x2E, 0x7A, 0x88, 0x0D, 0xF5, 0x09, 0xBD, 0x0C, 0x29, 0xC9, 0x6D, 0xE1, 0x60, 0x15, 0xA0, 0xFD, 0x50, 0x1E, 0xDA, 0x4F, 0x96, 0xA9}, // OISTE Client Root RSA G1
+	{0x41, 0xC9, 0x23, 0x86, 0x6A, 0xB4, 0xCA, 0xD6, 0xB7, 0xAD, 0x57, 0x80, 0x81, 0x58, 0x2E, 0x02, 0x07, 0x97, 0xA6, 0xCB, 0xDF, 0x4F, 0xFF, 0x78, 0xCE, 0x83, 0x96, 0xB3, 0x89, 0x37, 0xD7, 0xF5}, // OISTE WISeKey Global Root GA CA
+	{0x6B, 0x9C, 0x08, 0xE8, 0x6E, 0xB0, 0xF7, 0x67, 0xCF, 0xAD, 0x65, 0xCD, 0x98, 0xB6, 0x21, 0x49, 0xE5, 0x49, 0x4A, 0x67, 0xF5, 0x84, 0x5E, 0x7B, 0xD1, 0xED, 0x01, 0x9F, 0x27, 0xB8, 0x6B, 0xD6}, // OISTE WISeKey Global Root GB CA
+	{0x85, 0x60, 0xF9, 0x1C, 0x36, 0x24, 0xDA, 0xBA, 0x95, 0x70, 0xB5, 0xFE, 0xA0, 0xDB, 0xE3, 0x6F, 0xF1, 0x1A, 0x83, 0x23, 0xBE, 0x94, 0x86, 0x85, 0x4F, 0xB3, 0xF3, 0x4A, 0x55, 0x71, 0x19, 0x8D}, // OISTE WISeKey Global Root GC CA
+	{0x8A, 0x86, 0x6F, 0xD1, 0xB2, 0x76, 0xB5, 0x7E, 0x57, 0x8E, 0x92, 0x1C, 0x65, 0x82, 0x8A, 0x2B, 0xED, 0x58, 0xE9, 0xF2, 0xF2, 0x88, 0x05, 0x41, 0x34, 0xB7, 0xF1, 0xF4, 0xBF, 0xC9, 0xCC, 0x74}, // QuoVadis Root CA 1 G3
+	{0x85, 0xA0, 0xDD, 0x7D, 0xD7, 0x20, 0xAD, 0xB7, 0xFF, 0x05, 0xF8, 0x3D, 0x54, 0x2B, 0x20, 0x9D, 0xC7, 0xFF, 0x45, 0x28, 0xF7, 0xD6, 0x77, 0xB1, 0x83, 0x89, 0xFE, 0xA5, 0xE5, 0xC4, 0x9E, 0x86}, // QuoVadis Root CA 2
+	{0x8F, 0xE4, 0xFB, 0x0A, 0xF9, 0x3A, 0x4D, 0x0D, 0x67, 0xDB, 0x0B, 0xEB, 0xB2, 0x3E, 0x37, 0xC7, 0x1B, 0xF3, 0x25, 0xDC, 0xBC, 0xDD, 0x24, 0x0E, 0xA0, 0x4D, 0xAF, 0x58, 0xB4, 0x7E, 0x18, 0x40}, // QuoVadis Root CA 2 G3
+	{0x18, 0xF1, 0xFC, 0x7F, 0x20, 0x5D, 0xF8, 0xAD, 0xDD, 0xEB, 0x7F, 0xE0, 0x07, 0xDD, 0x57, 0xE3, 0xAF, 0x37, 0x5A, 0x9C, 0x4D, 0x8D, 0x73, 0x54, 0x6B, 0xF4, 0xF1, 0xFE, 0xD1, 0xE1, 0x8D, 0x35}, // QuoVadis Root CA 3
+	{0x88, 0xEF, 0x81, 0xDE, 0x20, 0x2E, 0xB0, 0x18, 0x45, 0x2E, 0x43, 0xF8, 0x64, 0x72, 0x5C, 0xEA, 0x5F, 0xBD, 0x1F, 0xC2, 0xD9, 0xD2, 0x05, 0x73, 0x07, 0x09, 0xC5, 0xD8, 0xB8, 0x69, 0x0F, 0x46}, // QuoVadis Root CA 3 G3
+	{0xAD, 0x7D, 0xD5, 0x8D, 0x03, 0xAE, 0xDB, 0x22, 0xA3, 0x0B, 0x50, 0x84, 0x39, 0x49, 0x20, 0xCE, 0x12, 0x23, 0x0C, 0x2D, 0x80, 0x17, 0xAD, 0x9B, 0x81, 0xAB, 0x04, 0x07, 0x9B, 0xDD, 0x02, 0x6B}, // SSL.com Client ECC Root CA 2022
+	{0x1D, 0x4C, 0xA4, 0xA2, 0xAB, 0x21, 0xD0, 0x09, 0x36, 0x59, 0x80, 0x4F, 0xC0, 0xEB, 0x21, 0x75, 0xA6, 0x17, 0x27, 0x9B, 0x56, 0xA2, 0x47, 0x52, 0x45, 0xC9, 0x51, 0x7A, 0xFE, 0xB5, 0x91, 0x53}, // SSL.com Client RSA Root CA 2022
+	{0x34, 0x17, 0xBB, 0x06, 0xCC, 0x60, 0x07, 0xDA, 0x1B, 0x96, 0x1C, 0x92, 0x0B, 0x8A, 0xB4, 0xCE, 0x3F, 0xAD, 0x82, 0x0E, 0x4A, 0xA3, 0x0B, 0x9A, 0xCB, 0xC4, 0xA7, 0x4E, 0xBD, 0xCE, 0xBC, 0x65}, // SSL.com Root Certification Authority ECC
+	{0x85, 0x66, 0x6A, 0x56, 0x2E, 0xE0, 0xBE, 0x5C, 0xE9, 0x25, 0xC1, 0xD8, 0x89, 0x0A, 0x6F, 0x76, 0xA8, 0x7E, 0xC1, 0x6D, 0x4D, 0x7D, 0x5F, 0x29, 0xEA, 0x74, 0x19, 0xCF, 0x20, 0x12, 0x3B, 0x69}, // SSL.com Root Certification Authority RSA
+	{0xA1, 0x33, 0x9D, 0x33, 0x28, 0x1A, 0x0B, 0x56, 0xE5, 0x57, 0xD3, 0xD3, 0x2B, 0x1C, 0xE7, 0xF9, 0x36, 0x7E, 0xB0, 0x94, 0xBD, 0x5F, 0xA7, 0x2A, 0x7E, 0x50, 0x04, 0xC8, 0xDE, 0xD7, 0xCA, 0xFE}, // SZAFIR ROOT CA2
+	{0x22, 0xD9, 0x59, 0x92, 0x34, 0xD6, 0x0F, 0x1D, 0x4B, 0xC7, 0xC7, 0xE9, 0x6F, 0x43, 0xFA, 0x55, 0x5B, 0x07, 0x30, 0x1F, 0xD4, 0x75, 0x17, 0x50, 0x89, 0xDA, 0xFB, 0x8C, 0x25, 0xE4, 0x77, 0xB3}, // Sectigo Public Email Protection Root E46
+	{0xD5, 0x91, 0x7A, 0x77, 0x91, 0xEB, 0x7C, 0xF2, 0x0A, 0x2E, 0x57, 0xEB, 0x98, 0x28, 0x4A, 0x67, 0xB2, 0x8A, 0x57, 0xE8, 0x91, 0x82, 0xDA, 0x53, 0xD5, 0x46, 0x67, 0x8C, 0x9F, 0xDE, 0x2B, 0x4F}, // Sectigo Public Email Protection Root R46
+	{0x4C, 0x1C, 0xCD, 0x24, 0xF1, 0x7E, 0x95, 0x0F, 0xC1, 0x85, 0x36, 0xB3, 0x3C, 0xAF, 0xE3, 0x22, 0x93, 0xCF, 0xC3, 0x3E, 0x84, 0x67, 0xB4, 0x1E, 0x1C, 0x69, 0x30, 0x55, 0xD7, 0xF5, 0x13, 0xBF}, // SecureSign Root CA16
+	{0x51, 0x3B, 0x2C, 0xEC, 0xB8, 0x10, 0xD4, 0xCD, 0xE5, 0xDD, 0x85, 0x39, 0x1A, 0xDF, 0xC6, 0xC2, 0xDD, 0x60, 0xD8, 0x7B, 0xB7, 0x36, 0xD2, 0xB5, 0x21, 0x48, 0x4A, 0xA4, 0x7A, 0x0E, 0xBE, 0xF6}, // Security Communication RootCA2
+	{0x3C, 0x4F, 0xB0, 0xB9, 0x5A, 0xB8, 0xB3, 0x00, 0x32, 0xF4, 0x32, 0xB8, 0x6F, 0x53, 0x5F, 0xE1, 0x72, 0xC1, 0x85, 0xD0, 0xFD, 0x39, 0x86, 0x58, 0x37, 0xCF, 0x36, 0x18, 0x7F, 0xA6, 0xF4, 0x28}, // Staat der Nederlanden Root CA - G3
+	{0x14, 0x65, 0xFA, 0x20, 0x53, 0x97, 0xB8, 0x76, 0xFA, 0xA6, 0xF0, 0xA9, 0x95, 0x8E, 0x55, 0x90, 0xE4, 0x0F, 0xCC, 0x7F, 0xAA, 0x4F, 0xB7, 0xC2, 0xC8, 0x67, 0x75, 0x21, 0xFB, 0x5F, 0xB6, 0x58}, // Starfield Class 2 Certification Authority
+	{0x56, 0x8D, 0x69, 0x05, 0xA2, 0xC8, 0x87, 0x08, 0xA4, 0xB3, 0x02, 0x51, 0x90, 0xED, 0xCF, 0xED, 0xB1, 0x97, 0x4A, 0x60, 0x6A, 0x13, 0xC6, 0xE5, 0x29, 0x0F, 0xCB, 0x2A, 0xE6, 0x3E, 0xDA, 0xB5}, // Starfield Services Root Certificate Authority - G2
+	{0x62, 0xDD, 0x0B, 0xE9, 0xB9, 0xF5, 0x0A, 0x16, 0x3E, 0xA0, 0xF8, 0xE7, 0x5C, 0x05, 0x3B, 0x1E, 0xCA, 0x57, 0xEA, 0x55, 0xC8, 0x68, 0x8F, 0x64, 0x7C, 0x68, 0x81, 0xF2, 0xC8, 0x35, 0x7B, 0x95}, // SwissSign Gold CA - G2
+	{0x9A, 0x12, 0xC3, 0x92, 0xBF, 0xE5, 0x78, 0x91, 0xA0, 0xC5, 0x45, 0x30, 0x9D, 0x4D, 0x9F, 0xD5, 0x67, 0xE4, 0x80, 0xCB, 0x61, 0x3D, 0x63, 0x42, 0x27, 0x8B, 0x19, 0x5C, 0x79, 0xA7, 0x93, 0x1F}, // SwissSign RSA SMIME Root CA 2022 - 1
+	{0x91, 0xE2, 0xF5, 0x78, 0x8D, 0x58, 0x10, 0xEB, 0xA7, 0xBA, 0x58, 0x73, 0x7D, 0xE1, 0x54, 0x8A, 0x8E, 0xCA, 0xCD, 0x01, 0x45, 0x98, 0xBC, 0x0B, 0x14, 0x3E, 0x04, 0x1B, 0x17, 0x05, 0x25, 0x52}, // T-TeleSec GlobalRoot Class 2
+	{0x59, 0x76, 0x90, 0x07, 0xF7, 0x68, 0x5D, 0x0F, 0xCD, 0x50, 0x87, 0x2F, 0x9F, 0x95, 0xD5, 0x75, 0x5A, 0x5B, 0x2B, 0x45, 0x7D, 0x81, 0xF3, 0x69, 0x2B, 0x61, 0x0A, 0x98, 0x67, 0x2F, 0x0E, 0x1B}, // TWCA Global Root CA
+	{0x3A, 0x00, 0x72, 0xD4, 0x9F, 0xFC, 0x04, 0xE9, 0x96, 0xC5, 0x9A, 0xEB, 0x75, 0x99, 0x1D, 0x3C, 0x34, 0x0F, 0x36, 0x15, 0xD6, 0xFD, 0x4D, 0xCE, 0x90, 0xAC, 0x0B, 0x3D, 0x88, 0xEA, 0xD4, 0xF4}, // TWCA Global Root CA G2
+	{0xBF, 0xD8, 0x8F, 0xE1, 0x10, 0x1C, 0x41, 0xAE, 0x3E, 0x80, 0x1B, 0xF8, 0xBE, 0x56, 0x35, 0x0E, 0xE9, 0xBA, 0xD1, 0xA6, 0xB9, 0xBD, 0x51, 0x5E, 0xDC, 0x5C, 0x6D, 0x5B, 0x87, 0x11, 0xAC, 0x44}, // TWCA Root Certification Authority
+	{0x3A, 0xE6, 0xDF, 0x7E, 0x0D, 0x63, 0x7A, 0x65, 0xA8, 0xC8, 0x16, 0x12, 0xEC, 0x6F, 0x9A, 0x14, 0x2F, 0x85, 0xA1, 0x68, 0x34, 0xC1, 0x02, 0x80, 0xD8, 0x8E, 0x70, 0x70, 0x28, 0x51, 0x87, 0x55}, // Telekom Security SMIME ECC Root 2021
+	{0x78, 0xA6, 0x56, 0x34, 0x4F, 0x94, 0x7E, 0x9C, 0xC0, 0xF7, 0x34, 0xD9, 0x05, 0x3D, 0x32, 0xF6, 0x74, 0x20, 0x86, 0xB6, 0xB9, 0xCD, 0x2C, 0xAE, 0x4F, 0xAE, 0x1A, 0x2E, 0x4E, 0xFD, 0xE0, 0x48}, // Telekom Security SMIME RSA Root 2023
+	{0x24, 0x2B, 0x69, 0x74, 0x2F, 0xCB, 0x1E, 0x5B, 0x2A, 0xBF, 0x98, 0x89, 0x8B, 0x94, 0x57, 0x21, 0x87, 0x54, 0x4E, 0x5B, 0x4D, 0x99, 0x11, 0x78, 0x65, 0x73, 0x62, 0x1F, 0x6A, 0x74, 0xB8, 0x2C}, // Telia Root CA v2
+	{0x43, 0x64, 0x72, 0xC1, 0x00, 0x9A, 0x32, 0x5C, 0x54, 0xF1, 0xA5, 0xBB, 0xB5, 0x46, 0x8A, 0x7B, 0xAE, 0xEC, 0xCB, 0xE0, 0x5D, 0xE5, 0xF0, 0x99, 0xCB, 0x70, 0xD3, 0xFE, 0x41, 0xE1, 0x3C, 0x16}, // TrustAsia SMIME ECC Root CA
+	{0xC7, 0x79, 0x6B, 0xEB, 0x62, 0xC1, 0x01, 0xBB, 0x14, 0x3D, 0x26, 0x2A, 0x7C, 0x96, 0xA0, 0xC6, 0x16, 0x81, 0x83, 0x22, 0x3E, 0xF5, 0x0D, 0x69, 0x96, 0x32, 0xD8, 0x6E, 0x03, 0xB8, 0xCC, 0x9B}, // TrustAsia SMIME RSA Root CA
+	{0x9B, 0xEA, 0x11, 0xC9, 0x76, 0xFE, 0x01, 0x47, 0x64, 0xC1, 0xBE, 0x56, 0xA6, 0xF9, 0x14, 0xB5, 0xA5, 0x60, 0x31, 0x7A, 0xBD, 0x99, 0x88, 0x39, 0x33, 0x82, 0xE5, 0x16, 0x1A, 0xA0, 0x49, 0x3C}, // UCA Global G2 Root
+	{0x4F, 0xF4, 0x60, 0xD5, 0x4B, 0x9C, 0x86, 0xDA, 0xBF, 0xBC, 0xFC, 0x57, 0x12, 0xE0, 0x40, 0x0D, 0x2B, 0xED, 0x3F, 0xBC, 0x4D, 0x4F, 0xBD, 0xAA, 0x86, 0xE0, 0x6A, 0xDC, 0xD2, 0xA9, 0xAD, 0x7A}, // USERTrust ECC Certification Authority
+	{0xE7, 0x93, 0xC9, 0xB0, 0x2F, 0xD8, 0xAA, 0x13, 0xE2, 0x1C, 0x31, 0x22, 0x8A, 0xCC, 0xB0, 0x81, 0x19, 0x64, 0x3B, 0x74, 0x9C, 0x89, 0x89, 0x64, 0xB1, 0x74, 0x6D, 0x46, 0xC3, 0xD4, 0xCB, 0xD2}, // USERTrust RSA Certification Authority
+	{0x65, 0x7C, 0xFE, 0x2F, 0xA7, 0x3F, 0xAA, 0x38, 0x46, 0x25, 0x71, 0xF3, 0x32, 0xA2, 0x36, 0x3A, 0x46, 0xFC, 0xE7, 0x02, 0x09, 0x51, 0x71, 0x07, 0x02, 0xCD, 0xFB, 0xB6, 0xEE, 0xDA, 0x33, 0x05}, // certSIGN ROOT CA G2
+	{0xBE, 0xB0, 0x0B, 0x30, 0x83, 0x9B, 0x9B, 0xC3, 0x2C, 0x32, 0xE4, 0x44, 0x79, 0x05, 0x95, 0x06, 0x41, 0xF2, 0x64, 0x21, 0xB1, 0x5E, 0xD0, 0x89, 0x19, 0x8B, 0x51, 0x8A, 0xE2, 0xEA, 0x1B, 0x99}, // e-Szigno Root CA 2017
+	{0xC0, 0xA6, 0xF4, 0xDC, 0x63, 0xA2, 0x4B, 0xFD, 0xCF, 0x54, 0xEF, 0x2A, 0x6A, 0x08, 0x2A, 0x0A, 0x72, 0xDE, 0x35, 0x80, 0x3E, 0x2F, 0xF5, 0xFF, 0x52, 0x7A, 0xE5, 0xD8, 0x72, 0x06, 0xDF, 0xD5}, // ePKI Root Certification Authority
+	{0xBC, 0x4D, 0x80, 0x9B, 0x15, 0x18, 0x9D, 0x78, 0xDB, 0x3E, 0x1D, 0x8C, 0xF4, 0xF9, 0x72, 0x6A, 0x79, 0x5D, 0xA1, 0x64, 0x3C, 0xA5, 0xF1, 0x35, 0x8E, 0x1D, 0xDB, 0x0E, 0xDC, 0x0D, 0x7E, 0xB3}, // emSign ECC Root CA - C3
+	{0x86, 0xA1, 0xEC, 0xBA, 0x08, 0x9C, 0x4A, 0x8D, 0x3B, 0xBE, 0x27, 0x34, 0xC6, 0x12, 0xBA, 0x34, 0x1D, 0x81, 0x3E, 0x04, 0x3C, 0xF9, 0xE8, 0xA8, 0x62, 0xCD, 0x5C, 0x57, 0xA3, 0x6B, 0xBE, 0x6B}, // emSign ECC Root CA - G3
+	{0x12, 0x56, 0x09, 0xAA, 0x30, 0x1D, 0xA0, 0xA2, 0x49, 0xB9, 0x7A, 0x82, 0x39, 0xCB, 0x6A, 0x34, 0x21, 0x6F, 0x44, 0xDC, 0xAC, 0x9F, 0x39, 0x54, 0xB1, 0x42, 0x92, 0xF2, 0xE8, 0xC8, 0x60, 0x8F}, // emSign Root CA - C1
+	{0x40, 0xF6, 0xAF, 0x03, 0x46, 0xA9, 0x9A, 0xA1, 0xCD, 0x1D, 0x55, 0x5A, 0x4E, 0x9C, 0xCE, 0x62, 0xC7, 0xF9, 0x63, 0x46, 0x03, 0xEE, 0x40, 0x66, 0x15, 0x83, 0x3D, 0xC8, 0xC8, 0xD0, 0x03, 0x67}, // emSign Root CA - G1
+}
@@ -0,0 +1,43 @@
+package ccadb_data
+
+import "slices"
+
+// CSVColumn describes a column in the header of a CCADB "All Certificate Records" CSV report.
+type CSVColumn struct {
+	Name string
+	// Whether this package parses the column into the typed lookup API, rather than only retaining it in the raw
+	// records.
+	Parsed bool
+	// Whether this package knows about the column. Unknown columns have been added to the report since this package
+	// was last updated.
+	Known bool
+}
+
+// CSVSchema describes the header of a CCADB "All Certificate Records" CSV report.
+type CSVSchema struct {
+	FormatVersion int
+	Columns       []CSVColumn
+	// Columns that this package parses, but which are missing from the header.
+	Missing []string
+}
+
+// DescribeCSVHeader compares the header of a CCADB "All Certificate Records" CSV report with the columns that this
+// package knows about and parses, so that maintainers can tell when CCADB has added, renamed, or removed columns.
+func DescribeCSVHeader(header []string) *CSVSchema {
+	format := detectCSVFormat(header)
+	schema := &CSVSchema{FormatVersion: format.Version}
+	for _, name := range header {
+		latestName := format.latestName(name)
+		schema.Columns = append(schema.Columns, CSVColumn{
+			Name:   name,
+			Parsed: slices.Contains(csvHeaders[:], latestName),
+			Known:  slices.Contains(latestCSVHeaders, latestName),
+		})
+	}
+	for _, latestName := range csvHeaders {
+		if format.headerIndex(header, latestName) == -1 && !format.isAbsent(latestName) {
+			schema.Missing = append(schema.Missing, format.headerName(latestName))
+		}
+	}
+	return schema
+}
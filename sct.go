@@ -0,0 +1,64 @@
+package ccadb_data
+
+import (
+	"crypto/sha256"
+	"slices"
+	"time"
+)
+
+// An SCT embedded in a precertificate, identified by the log that issued it and its timestamp.
+type EmbeddedSCT struct {
+	LogID     [sha256.Size]byte
+	Timestamp time.Time
+}
+
+// SCTCheck is the result of checking a precertificate's issuer and embedded SCTs, as returned by CheckEmbeddedSCTs.
+type SCTCheck struct {
+	// Whether the issuer's public key belongs to a CA certificate disclosed in CCADB.
+	IssuerDisclosed bool
+	// The SHA-256 fingerprints of the disclosed CA certificates that have the issuer's public key.
+	IssuerSHA256Fingerprints [][sha256.Size]byte
+	// One result per SCT, in the same order as the SCTs.
+	SCTs []*SCTLogCheck
+}
+
+// SCTLogCheck is the result of checking one SCT, as held in SCTCheck.SCTs.
+type SCTLogCheck struct {
+	EmbeddedSCT
+	// The log that issued the SCT, or nil if it is not in the CT log list.
+	Log *CTLog
+	// Whether the log was acceptable (qualified, usable, or read-only, or retired after the SCT's timestamp) at the
+	// SCT's timestamp.
+	Acceptable bool
+}
+
+// AllSCTsAcceptable reports whether there is at least one SCT and every SCT is from an acceptable log.
+func (sc *SCTCheck) AllSCTsAcceptable() bool {
+	return len(sc.SCTs) > 0 && !slices.ContainsFunc(sc.SCTs, func(slc *SCTLogCheck) bool { return !slc.Acceptable })
+}
+
+// WasAcceptableAt reports whether an SCT issued by the log at t counts towards CT compliance, according to the log's
+// current state: SCTs from qualified, usable, and read-only logs are accepted, as are SCTs from a retired log that were
+// issued before it was retired. Pending and rejected logs are never acceptable.
+func (cl *CTLog) WasAcceptableAt(t time.Time) bool {
+	switch cl.State {
+	case CTLogStateQualified, CTLogStateUsable, CTLogStateReadOnly:
+		return true
+	case CTLogStateRetired:
+		return t.Before(cl.StateTimestamp)
+	default:
+		return false
+	}
+}
+
+// indexSPKISHA256s indexes the key identifiers of the disclosed CA certificates by the SHA-256 hash of their public
+// key, so that an issuer can be found from the issuer key hash in a precertificate's SCTs.
+func (s *Store) indexSPKISHA256s() error {
+	for keyIdentifier, spkiSHA256 := range s.issuerSPKISHA256Map {
+		s.keyIdentifiersBySPKISHA256[spkiSHA256] = append(s.keyIdentifiersBySPKISHA256[spkiSHA256], keyIdentifier)
+	}
+	for _, keyIdentifiers := range s.keyIdentifiersBySPKISHA256 {
+		slices.Sort(keyIdentifiers)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package ccadb_data
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Names of the fields that SearchRecords matches.
+const (
+	SEARCH_FIELD_CERTIFICATE_NAME     = "Certificate Name"
+	SEARCH_FIELD_CA_OWNER             = "CA Owner"
+	SEARCH_FIELD_SUBORDINATE_CA_OWNER = "Subordinate CA Owner"
+	SEARCH_FIELD_SUBJECT_CN           = "Subject CN"
+	SEARCH_FIELD_SUBJECT_O            = "Subject O"
+)
+
+// SearchResult is a CA certificate record that matches a search, as returned by SearchRecords.
+type SearchResult struct {
+	SHA256Fingerprint  [sha256.Size]byte
+	CertificateName    string
+	CAOwner            string
+	SubordinateCAOwner string
+	// The fields that matched, e.g. "Certificate Name" or "Subject O".
+	MatchedFields []string
+}
+
+// A name by which a CA certificate can be found.
+type searchField struct {
+	name string
+	// The value as it is matched against the query.
+	key string
+}
+
+// searchKey returns the form of a name or query in which it is matched: NFKC normalized and case folded, so that e.g.
+// "ＴÜＲＫＴＲＵＳＴ" (in fullwidth letters), "TU\u0308RKTRUST" (with a combining diaeresis), and "türktrust" all match,
+// as do "STRASSE" and "straße".
+func searchKey(s string) string {
+	// Case folding can decompose a character (e.g. "İ" to "i̇"), so the result is normalized again.
+	return norm.NFKC.String(cases.Fold().String(norm.NFKC.String(s)))
+}
+
+// newSearchField returns a field to search, or false if the value is empty.
+func newSearchField(name, value string) (searchField, bool) {
+	return searchField{name: name, key: searchKey(value)}, value != ""
+}
+
+// searchIndex returns the names by which each CA certificate can be found, indexed by SHA-256(Certificate), building
+// the index on first use.
+func (s *Store) searchIndex() map[[sha256.Size]byte][]searchField {
+	s.indexSearchOnce.Do(func() {
+		s.searchIndexMap = make(map[[sha256.Size]byte][]searchField)
+		for sha256Fingerprint, cr := range s.certificateRecords() {
+			for _, name := range [][2]string{
+				{SEARCH_FIELD_CERTIFICATE_NAME, cr.CertificateName},
+				{SEARCH_FIELD_CA_OWNER, cr.CAOwner},
+				{SEARCH_FIELD_SUBORDINATE_CA_OWNER, cr.SubordinateCAOwner},
+			} {
+				if sf, ok := newSearchField(name[0], name[1]); ok {
+					s.searchIndexMap[sha256Fingerprint] = append(s.searchIndexMap[sha256Fingerprint], sf)
+				}
+			}
+		}
+	})
+	return s.searchIndexMap
+}
+
+// subjectSearchIndex returns the subject Common Names and Organizations by which each CA certificate can be found,
+// indexed by SHA-256(Certificate), building the index on first use. LoadAllCACertificates must have been called first.
+func (s *Store) subjectSearchIndex() map[[sha256.Size]byte][]searchField {
+	s.indexSubjectSearchOnce.Do(func() {
+		s.subjectSearchIndexMap = make(map[[sha256.Size]byte][]searchField)
+		for sha256Fingerprint, der := range s.certificateDERMap {
+			cert := parseCertificate(sha256Fingerprint, der)
+			if cert == nil {
+				continue
+			}
+			var fields []searchField
+			if sf, ok := newSearchField(SEARCH_FIELD_SUBJECT_CN, cert.Subject.CommonName); ok {
+				fields = append(fields, sf)
+			}
+			for _, organization := range cert.Subject.Organization {
+				if sf, ok := newSearchField(SEARCH_FIELD_SUBJECT_O, organization); ok {
+					fields = append(fields, sf)
+				}
+			}
+			s.subjectSearchIndexMap[sha256Fingerprint] = fields
+		}
+	})
+	return s.subjectSearchIndexMap
+}
+
+// compileSearchQuery returns a function that reports whether a search key matches the query. A query enclosed in
+// slashes (e.g. "/^isrg root x[0-9]$/") is a regular expression, and any other query is a substring. Both are case
+// insensitive. A substring is converted to a search key, whereas a regular expression is only NFKC normalized, since
+// case folding would change the meaning of escapes such as "\S".
+func compileSearchQuery(query string) (func(key string) bool, error) {
+	if len(query) >= 2 && strings.HasPrefix(query, "/") && strings.HasSuffix(query, "/") {
+		re, err := regexp.Compile("(?i)" + norm.NFKC.String(query[1:len(query)-1]))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid search query: %w", err)
+		}
+		return re.MatchString, nil
+	}
+
+	substring := searchKey(strings.TrimSpace(query))
+	if substring == "" {
+		return nil, errors.New("Invalid search query: Query is empty")
+	}
+	return func(key string) bool { return strings.Contains(key, substring) }, nil
+}
+
+// searchRecords returns the CA certificates with a name that matches the query, ordered by CA Owner, Certificate Name,
+// and SHA-256 fingerprint.
+func (s *Store) searchRecords(matches func(key string) bool) []*SearchResult {
+	subjects := map[[sha256.Size]byte][]searchField{}
+	if s.certificatesLoaded.Load() {
+		subjects = s.subjectSearchIndex()
+	}
+
+	var results []*SearchResult
+	for sha256Fingerprint, fields := range s.searchIndex() {
+		var matchedFields []string
+		for _, sf := range slices.Concat(fields, subjects[sha256Fingerprint]) {
+			if !slices.Contains(matchedFields, sf.name) && matches(sf.key) {
+				matchedFields = append(matchedFields, sf.name)
+			}
+		}
+		if len(matchedFields) == 0 {
+			continue
+		}
+		cr := s.certificateRecord(sha256Fingerprint)
+		results = append(results, &SearchResult{
+			SHA256Fingerprint:  sha256Fingerprint,
+			CertificateName:    cr.CertificateName,
+			CAOwner:            cr.CAOwner,
+			SubordinateCAOwner: cr.SubordinateCAOwner,
+			MatchedFields:      matchedFields,
+		})
+	}
+
+	slices.SortFunc(results, func(a, b *SearchResult) int {
+		return cmp.Or(cmp.Compare(a.CAOwner, b.CAOwner), cmp.Compare(a.CertificateName, b.CertificateName), compareSHA256Fingerprints(a.SHA256Fingerprint, b.SHA256Fingerprint))
+	})
+	return results
+}
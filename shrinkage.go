@@ -0,0 +1,82 @@
+package ccadb_data
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"go.uber.org/zap"
+)
+
+// The default maximum fraction by which the number of CA certificate records, or of certificates, may shrink between
+// two downloads of the reports.
+const DEFAULT_MAX_REPORT_SHRINKAGE = 0.1
+
+// WithMaxReportShrinkage changes the maximum fraction (by default, DEFAULT_MAX_REPORT_SHRINKAGE) by which the number of
+// CA certificate records in the All Certificate Records report, or of certificates in the PEM reports, may shrink since
+// the reports previously downloaded by FetchReports, before it rejects the download with an error that wraps
+// ErrReportShrank. Pass 1 to accept any download, e.g. to force a download after CCADB has deliberately removed records.
+func WithMaxReportShrinkage(fraction float64) FetchOption {
+	return func(o *fetchOptions) {
+		o.maxReportShrinkage = fraction
+	}
+}
+
+// checkReportShrinkage returns an error if the newly downloaded reports have shrunk by more than maxShrinkage since the
+// previously downloaded reports. There is nothing to compare with if no reports were previously downloaded.
+func checkReportShrinkage(previous, current fs.FS, maxShrinkage float64) error {
+	for _, count := range []struct {
+		name  string
+		count func(fsys fs.FS) (int, error)
+	}{
+		{"CA certificate records", countCertificateRecords},
+		{"certificates", countPEMCertificates},
+	} {
+		previousCount, err := count.count(previous)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		currentCount, err := count.count(current)
+		if err != nil {
+			return err
+		}
+		if float64(currentCount) < float64(previousCount)*(1-maxShrinkage) {
+			return fmt.Errorf("%w: The number of %s fell from %d to %d", ErrReportShrank, count.name, previousCount, currentCount)
+		} else if currentCount < previousCount {
+			logger.Info("CCADB report shrank", zap.String("count", count.name), zap.Int("previous_count", previousCount), zap.Int("current_count", currentCount))
+		}
+	}
+	return nil
+}
+
+// countCertificateRecords returns the number of records in the All Certificate Records report.
+func countCertificateRecords(fsys fs.FS) (int, error) {
+	data, err := fs.ReadFile(fsys, CCADB_CSV_PATH)
+	if err != nil {
+		return 0, err
+	}
+	return max(len(readCSVRecords(data, CCADB_CSV_PATH, 0))-1, 0), nil
+}
+
+// countPEMCertificates returns the number of records in the PEM reports.
+func countPEMCertificates(fsys fs.FS) (int, error) {
+	entries, err := fs.ReadDir(fsys, PEM_CSV_DIR)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		filePath := PEM_CSV_DIR + "/" + entry.Name()
+		data, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return 0, err
+		}
+		n += max(len(readCSVRecords(data, filePath, 2))-1, 0)
+	}
+	return n, nil
+}
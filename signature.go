@@ -0,0 +1,56 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// DATASET_SIGNING_PUBLIC_KEY is the Base64-encoded Ed25519 public key that the release workflow signs the data files
+// with. The corresponding private key is held in the DATASET_SIGNING_KEY repository secret. Until that secret has been
+// configured, this is empty and VerifyDataset reports ErrNoSigningKey.
+const DATASET_SIGNING_PUBLIC_KEY = ""
+
+var (
+	// ErrNoSigningKey indicates that no dataset signing public key is configured.
+	ErrNoSigningKey = errors.New("No dataset signing public key is configured")
+	// ErrInvalidSignature indicates that a data file's signature does not verify.
+	ErrInvalidSignature = errors.New("Dataset signature is invalid")
+)
+
+// VerifyDataset verifies that the data file at path was signed by this repository's release workflow, using the
+// detached signature at sigPath. It returns nil only if the signature is valid.
+func VerifyDataset(path, sigPath string) error {
+	if DATASET_SIGNING_PUBLIC_KEY == "" {
+		return ErrNoSigningKey
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(DATASET_SIGNING_PUBLIC_KEY)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("%w: Embedded public key is malformed", ErrNoSigningKey)
+	}
+	return VerifyDatasetWithKey(path, sigPath, publicKey)
+}
+
+// VerifyDatasetWithKey is like VerifyDataset, but verifies the signature against publicKey instead of the embedded
+// public key. This is for consumers that run their own copy of the release workflow.
+func VerifyDatasetWithKey(path, sigPath string, publicKey ed25519.PublicKey) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	sigData, err := os.ReadFile(sigPath)
+	if err != nil {
+		return err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(sigData)))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return fmt.Errorf("%w: %s is not a Base64 Ed25519 signature", ErrInvalidSignature, sigPath)
+	} else if !ed25519.Verify(publicKey, data, signature) {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, path)
+	}
+	return nil
+}
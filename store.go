@@ -0,0 +1,247 @@
+package ccadb_data
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"io/fs"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Store holds a loaded CCADB dataset. The package-level lookup functions use the default Store, which is loaded from
+// the embedded data when it is first used and can be replaced by Refresh. Apart from the optional data loaded on demand by
+// LoadAllCACertificates, LoadRawRecords, LoadAPIRecords, LoadALVResultsCSV, and LoadALVResults, a Store is not modified
+// once it has been loaded.
+//
+// A Store, and the package-level functions, are safe for concurrent use by multiple goroutines, including while one of
+// those functions is loading data and while Refresh or SetDefaultStore replaces the default Store. A package-level
+// lookup that races with a replacement uses either the old or the new Store, so callers that need several lookups to be
+// consistent with each other should call GetDefaultStore once and use its methods. The values returned by lookups are
+// shared between callers and must not be modified.
+type Store struct {
+	src     DataSource
+	loadErr error
+
+	// The CA certificates' capabilities and records, allocated contiguously rather than one by one, and indexed by
+	// SHA-256 fingerprint by caIndex. If WithCompactIndex is used, compactCAIndex replaces caIndex once loading has
+	// finished.
+	caCapabilities        []CACertCapabilities
+	caRecords             []CertificateRecord
+	caIndex               map[[sha256.Size]byte]uint32
+	compactCAIndex        *compactCAIndex
+	sha256FingerprintsMap map[string][][sha256.Size]byte
+	issuerCapabilitiesMap map[string]*IssuerCapabilities
+	issuerSPKISHA256Map   map[string][sha256.Size]byte
+	// Key identifiers indexed by the SHA-256 hash of the public key that they identify.
+	keyIdentifiersBySPKISHA256 map[[sha256.Size]byte][]string
+
+	rootStoreConstraintsMap map[[sha256.Size]byte][]*RootStoreConstraints
+	ctLogMap                map[[sha256.Size]byte]*CTLog
+
+	// When the dataset was fetched, as recorded in its manifest, or zero if it isn't recorded.
+	datasetDate time.Time
+	// The SHA-256 checksums in the dataset's manifest, or nil if it has none, and the outcome of verifying each data file
+	// that has been read.
+	manifestChecksums map[string][sha256.Size]byte
+	manifestMu        sync.Mutex
+	manifestResults   map[string]manifestResult
+
+	// Loaded on demand by LoadAllCACertificates.
+	readAllCACertificatePEMsCSVOnce sync.Once
+	certificatesLoaded              atomic.Bool
+	certificatesErr                 error
+	certificateDERMap               map[[sha256.Size]byte][]byte
+	// Parsed on demand, and kept in an LRU cache unless PreloadParsedCACertificates has parsed every certificate.
+	certificateCache                *certificateCache
+	preloadParsedCACertificatesOnce sync.Once
+	preloadedCertificateMap         atomic.Pointer[map[[sha256.Size]byte]*x509.Certificate]
+	indexCrossSignsOnce             sync.Once
+	crossSignsMap                   map[[sha256.Size]byte][]*CrossSign
+
+	// Built on demand by SearchRecords.
+	indexSearchOnce        sync.Once
+	searchIndexMap         map[[sha256.Size]byte][]searchField
+	indexSubjectSearchOnce sync.Once
+	subjectSearchIndexMap  map[[sha256.Size]byte][]searchField
+
+	// Loaded on demand by LoadRawRecords.
+	readAllCertificateRecordsCSVRawOnce sync.Once
+	rawRecordsLoaded                    atomic.Bool
+	rawRecordMap                        map[[sha256.Size]byte][]*RawRecord
+
+	// Loaded on demand by LoadAPIRecords.
+	apiRecordsMu sync.RWMutex
+	apiRecordMap map[[sha256.Size]byte][]APIRecord
+
+	// Read from the All Certificate Records report, if it has ALV columns, and replaced by LoadALVResultsCSV or
+	// LoadALVResults, along with the audit types whose results were loaded.
+	alvMu         sync.RWMutex
+	alvResultMap  map[[sha256.Size]byte]map[string]ALVResult
+	alvAuditTypes []string
+
+	// Subscribers to the changes made when this Store is replaced as the default Store, and its replacement.
+	subscriptionsMu sync.Mutex
+	subscriptions   []*subscription
+	replacedBy      *Store
+}
+
+var (
+	defaultStore     atomic.Pointer[Store]
+	defaultStoreOnce sync.Once
+	// The dataset that the default Store is loaded from, unless one has already been set.
+	defaultStoreSource DataSource = fsDataSource{f}
+	// Serializes replacements of the default Store, so that subscriptions are handed over in order.
+	replaceDefaultStoreMu sync.Mutex
+)
+
+// NewStore loads a CCADB dataset from fsys, configured by opts. fsys must use the dataset layout that EmbeddedFS and
+// the full subpackage's FS use, in which each data file's path is the one listed in the manifest (e.g.
+// data/AllCertificateRecordsSlim.csv, or cmd/ski_spki/data/AllCertificatePEMsCSVFormat_NotBeforeYear_2024). If an error
+// is returned, the Store contains whatever data could be loaded, or is nil with LoadPolicyStrict.
+func NewStore(fsys fs.FS, opts ...StoreOption) (*Store, error) {
+	return NewStoreFromSource(NewFSDataSource(fsys), opts...)
+}
+
+// NewStoreFromSource loads a CCADB dataset from src, configured by opts. If an error is returned, the Store contains
+// whatever data could be loaded, or is nil with LoadPolicyStrict.
+func NewStoreFromSource(src DataSource, opts ...StoreOption) (*Store, error) {
+	options := newStoreOptions(opts)
+	s := &Store{
+		src:                   src,
+		caIndex:               make(map[[sha256.Size]byte]uint32),
+		sha256FingerprintsMap: make(map[string][][sha256.Size]byte),
+		issuerCapabilitiesMap: make(map[string]*IssuerCapabilities),
+		issuerSPKISHA256Map:   make(map[string][sha256.Size]byte),
+
+		keyIdentifiersBySPKISHA256: make(map[[sha256.Size]byte][]string),
+
+		rootStoreConstraintsMap: make(map[[sha256.Size]byte][]*RootStoreConstraints),
+
+		manifestResults: make(map[string]manifestResult),
+	}
+
+	// Read CSV data, stopping at the first error.
+	for _, read := range []func() error{
+		s.readManifest,
+		s.readAllCertificateRecordsCSV,
+		func() error { return s.readSKIAndSHA256HashCSV(s.issuerSPKISHA256Map, SKI_SPKISHA256_PATH) },
+		s.readDerivedSKICSV,
+		s.indexSPKISHA256s,
+		s.readConstraintsReports,
+		s.readCTLogList,
+	} {
+		if s.loadErr = read(); s.loadErr != nil {
+			break
+		}
+	}
+	if s.loadErr == nil {
+		s.loadErr = s.checkPlausibility(options)
+	}
+	if options.compactIndex {
+		s.compactIndex()
+	}
+
+	if s.loadErr != nil && options.loadPolicy == LoadPolicyStrict {
+		return nil, s.loadErr
+	}
+	return s, s.loadErr
+}
+
+// Err returns the error, if any, that occurred while loading the Store. The error wraps ErrDatasetNotLoaded,
+// ErrMalformedDataset, or ErrImplausibleDataset.
+func (s *Store) Err() error {
+	return s.loadErr
+}
+
+// FetchStore downloads the latest CCADB CSV reports into dir (see FetchReports), configured by fetchOpts, and loads them
+// into a new Store, configured by opts.
+func FetchStore(ctx context.Context, client *http.Client, dir string, fetchOpts []FetchOption, opts ...StoreOption) (*Store, error) {
+	if err := FetchReports(ctx, client, dir, fetchOpts...); err != nil {
+		return nil, err
+	}
+	return NewStoreFromSource(NewDirDataSource(dir), opts...)
+}
+
+// Refresh downloads the latest CCADB CSV reports into dir, configured by opts, and, if they load successfully, replaces
+// the default Store. Any optional data that had been loaded into the previous default Store is also loaded into the new
+// one, and the number of CA certificates that were added, removed, or changed (see Compare) is logged and sent to
+// subscribers (see Subscribe).
+func Refresh(ctx context.Context, client *http.Client, dir string, opts ...FetchOption) error {
+	s, err := FetchStore(ctx, client, dir, opts, defaultStoreOptions...)
+	if err != nil {
+		return err
+	}
+
+	var dc *DatasetComparison
+	old := defaultStore.Load()
+	if old != nil {
+		dc = Compare(old, s)
+		logger.Info("Default Store refreshed", zap.Int("added_count", len(dc.Added)), zap.Int("removed_count", len(dc.Removed)), zap.Int("changed_count", len(dc.Changed)))
+		if old.preloadedCertificateMap.Load() != nil {
+			s.PreloadParsedCACertificates()
+		} else if old.certificatesLoaded.Load() {
+			s.LoadAllCACertificates()
+		}
+		if old.rawRecordsLoaded.Load() {
+			s.LoadRawRecords()
+		}
+	}
+	replaceDefaultStoreMu.Lock()
+	defer replaceDefaultStoreMu.Unlock()
+	if replaced := defaultStore.Swap(s); replaced != nil {
+		// Another replacement may have raced with this one, in which case the comparison is of the wrong Store.
+		if replaced != old {
+			dc = nil
+		}
+		replaced.handOverSubscriptions(s, dc)
+	}
+	return nil
+}
+
+// GetDefaultStore returns the Store used by the package-level lookup functions. If no Store has been set, the default
+// Store is loaded from the embedded data (or the dataset passed to SetDefaultFS or SetDefaultDataSource) by the first
+// call. If it fails to load, the first call panics with LoadPolicyStrict (see SetDefaultStoreOptions), and otherwise
+// logs the error, after which the Lookup functions return the error and the Get functions use whatever data could be
+// loaded.
+func GetDefaultStore() *Store {
+	defaultStoreOnce.Do(func() {
+		if defaultStore.Load() == nil {
+			s, err := NewStoreFromSource(defaultStoreSource, defaultStoreOptions...)
+			if err != nil {
+				if s == nil {
+					panic("Default CCADB Store could not be loaded: " + err.Error())
+				}
+				logger.Error("Default Store could not be loaded, so lookups will return the error", zap.Error(err))
+			}
+			defaultStore.CompareAndSwap(nil, s)
+		}
+	})
+	return defaultStore.Load()
+}
+
+// SetDefaultFS replaces the dataset that the default Store is loaded from when it is first used. It must be called
+// before the default Store is first used, e.g. from an init function, as the full subpackage does.
+func SetDefaultFS(fsys fs.FS) {
+	defaultStoreSource = NewFSDataSource(fsys)
+}
+
+// SetDefaultDataSource replaces the dataset that the default Store is loaded from when it is first used with src. Like
+// SetDefaultFS, it must be called before the default Store is first used.
+func SetDefaultDataSource(src DataSource) {
+	defaultStoreSource = src
+}
+
+// SetDefaultStore replaces the Store used by the package-level lookup functions, sending the changes to subscribers
+// (see Subscribe).
+func SetDefaultStore(s *Store) {
+	replaceDefaultStoreMu.Lock()
+	defer replaceDefaultStoreMu.Unlock()
+	if replaced := defaultStore.Swap(s); replaced != nil {
+		replaced.handOverSubscriptions(s, nil)
+	}
+}
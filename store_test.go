@@ -0,0 +1,480 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// fixtureTransport serves a ccadbtest fixture from the URLs that FetchReports downloads the reports from.
+type fixtureTransport struct {
+	responses map[string][]byte
+}
+
+func newFixtureTransport(t testing.TB, name string) *fixtureTransport {
+	fsys := fixtureMapFS(t, name)
+	ft := &fixtureTransport{responses: map[string][]byte{
+		CCADB_REPORT_BASE_URL + CCADB_CSV_REPORT: fsys[CCADB_CSV_PATH].Data,
+		CT_LOG_LIST_URL:                          fsys[CT_LOG_LIST_PATH].Data,
+	}}
+	for year := PEM_CSV_FIRST_YEAR; year <= time.Now().UTC().Year(); year++ {
+		yearStr := strconv.Itoa(year)
+		var data []byte
+		if f := fsys[PEM_CSV_DIR+"/"+PEM_CSV_FILENAME_PREFIX+yearStr]; f != nil {
+			data = f.Data
+		}
+		ft.responses[CCADB_REPORT_BASE_URL+PEM_CSV_REPORT+"?NotBeforeYear="+yearStr] = data
+	}
+	for _, report := range constraintsReports {
+		data := fsys[report.filePath].Data
+		if report.decode != nil {
+			// The only encoding is Gitiles' Base64.
+			data = base64.StdEncoding.AppendEncode(nil, data)
+		}
+		ft.responses[report.url] = data
+	}
+	return ft
+}
+
+func (ft *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	data, ok := ft.responses[req.URL.String()]
+	if !ok {
+		return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody, Request: req}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(data)), ContentLength: int64(len(data)), Request: req}, nil
+}
+
+// TestConcurrentUse runs lookups on the default Store while optional data is being loaded into it, and while it is
+// being replaced by SetDefaultStore and Refresh. It is only useful with -race.
+func TestConcurrentUse(t *testing.T) {
+	previous := defaultStore.Load()
+	t.Cleanup(func() { defaultStore.Store(previous) })
+
+	newFixtureStore := func() *Store {
+		s, err := NewStore(fixtureMapFS(t, "v5"))
+		if err != nil {
+			t.Fatalf("NewStore() returned %v", err)
+		}
+		return s
+	}
+	first := newFixtureStore()
+	SetDefaultStore(first)
+	client := &http.Client{Transport: newFixtureTransport(t, "v5")}
+
+	isrgRootX1, _ := HexFingerprintToArray(TEST_ISRG_ROOT_X1_SHA256)
+	r10, _ := HexFingerprintToArray(TEST_R10_SHA256)
+	spkiSHA256 := testBase64SHA256(t, TEST_ISRG_ROOT_X1_SPKI_SHA256)
+	scts := []EmbeddedSCT{{LogID: testBase64SHA256(t, TEST_ICARUS_LOG_ID), Timestamp: time.Now()}}
+	const isrgRootX1KeyIdentifier = "ebRZ5nu25eQBc4AIiMgaWPbpm24="
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 50 {
+				for _, sha256Fingerprint := range [][32]byte{isrgRootX1, r10} {
+					GetCACertCapabilitiesBySHA256(sha256Fingerprint)
+					GetCertificateRecordBySHA256(sha256Fingerprint)
+					GetCACertificateBySHA256(sha256Fingerprint)
+					GetParsedCACertificateBySHA256(sha256Fingerprint)
+					GetRawRecordBySHA256(sha256Fingerprint)
+					WasIncludedInRootProgram(sha256Fingerprint, ROOT_PROGRAM_MOZILLA, time.Now())
+					IsDistrustedForTLSAfter(sha256Fingerprint, time.Now())
+				}
+				GetIssuerCapabilitiesByKeyIdentifier(isrgRootX1KeyIdentifier)
+				GetSHA256FingerprintsByKeyIdentifier(isrgRootX1KeyIdentifier)
+				GetCAOwnersByKeyIdentifier(isrgRootX1KeyIdentifier)
+				GetCrossSignsBySPKISHA256(spkiSHA256)
+				CheckEmbeddedSCTs(spkiSHA256, scts)
+				ListFingerprints(CapabilityFilter{})
+				SearchRecords("ISRG")
+			}
+		})
+	}
+	wg.Go(LoadAllCACertificates)
+	wg.Go(PreloadParsedCACertificates)
+	wg.Go(LoadRawRecords)
+	wg.Go(first.LoadAllCACertificates)
+	wg.Go(first.LoadRawRecords)
+	wg.Go(func() {
+		for range 5 {
+			SetDefaultStore(newFixtureStore())
+		}
+	})
+	for range 2 {
+		dir := t.TempDir()
+		wg.Go(func() {
+			if err := Refresh(context.Background(), client, dir); err != nil {
+				t.Errorf("Refresh() returned %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if GetCertificateRecordBySHA256(isrgRootX1) == nil {
+		t.Error("ISRG Root X1 is missing from the default Store")
+	}
+}
+
+// TestDatasetDate checks that each Store's dataset date is read from its manifest, and that GetDatasetAge uses the
+// default Store's.
+func TestDatasetDate(t *testing.T) {
+	s, err := NewStore(EmbeddedFS())
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	} else if datasetDate, ok := s.DatasetDate(); !ok || datasetDate.Format(time.RFC3339) != DatasetDate {
+		t.Errorf("DatasetDate() of the embedded data = %s, %t, want %s", datasetDate, ok, DatasetDate)
+	}
+
+	if s, err = NewStore(fixtureMapFS(t, "v5"), WithMinimumRecords(1)); err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	} else if _, ok := s.DatasetAge(); ok {
+		t.Error("DatasetAge() of a dataset without a manifest is known")
+	}
+
+	dir := t.TempDir()
+	before := time.Now().Truncate(time.Second)
+	if s, err = FetchStore(context.Background(), &http.Client{Transport: newFixtureTransport(t, "v5")}, dir, nil, WithMinimumRecords(1)); err != nil {
+		t.Fatalf("FetchStore() returned %v", err)
+	} else if datasetDate, ok := s.DatasetDate(); !ok || datasetDate.Before(before) || datasetDate.After(time.Now()) {
+		t.Errorf("DatasetDate() of a fetched dataset = %s, %t, want the fetch time", datasetDate, ok)
+	}
+	SetDefaultStore(s)
+	if age, ok := GetDatasetAge(); !ok || age > time.Since(before) {
+		t.Errorf("GetDatasetAge() = %s, %t, want the age of the fetched dataset", age, ok)
+	}
+}
+
+// editedFixtureMapFS returns a copy of a ccadbtest fixture in which edit has been applied to each CA certificate record
+// in the CCADB report. A record is removed if edit returns nil.
+func editedFixtureMapFS(t testing.TB, name string, edit func(header, record []string) []string) fstest.MapFS {
+	t.Helper()
+	fsys := fixtureMapFS(t, name)
+	records, err := csv.NewReader(bytes.NewReader(fsys[CCADB_CSV_PATH].Data)).ReadAll()
+	if err != nil {
+		t.Fatalf("Fixture %q's CCADB report could not be read: %v", name, err)
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(records[0])
+	for _, record := range records[1:] {
+		if record = edit(records[0], record); record != nil {
+			w.Write(record)
+		}
+	}
+	w.Flush()
+	fsys[CCADB_CSV_PATH] = &fstest.MapFile{Data: buf.Bytes()}
+	return fsys
+}
+
+// TestCompare checks that Compare reports the CA certificates added to, removed from, and changed in a dataset, and the
+// fields that changed.
+func TestCompare(t *testing.T) {
+	const TEST_E5_SHA256 = "5DFDB3CF31B26F23D87C09F3A0CEF642F64069A9FB7CFE29270BB5DC0F1E16BB"
+	const TEST_R11_SHA256 = "591E9CE6C863D3A079E9FABE1478C7339A26B21269DDE795211361024AE31A44"
+	old, err := NewStore(editedFixtureMapFS(t, "v5", func(header, record []string) []string {
+		if record[slices.Index(header, "SHA-256 Fingerprint")] == TEST_E5_SHA256 {
+			return nil
+		}
+		return record
+	}), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	new, err := NewStore(editedFixtureMapFS(t, "v5", func(header, record []string) []string {
+		switch record[slices.Index(header, "SHA-256 Fingerprint")] {
+		case TEST_R10_SHA256:
+			return nil
+		case TEST_R11_SHA256:
+			record[slices.Index(header, "Certificate Name")] = "R11 (renamed)"
+		}
+		return record
+	}), WithMinimumRecords(1))
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+
+	dc := Compare(old, new)
+	hexFingerprints := func(sha256Fingerprints [][sha256.Size]byte) []string {
+		var hexFingerprints []string
+		for _, sha256Fingerprint := range sha256Fingerprints {
+			hexFingerprints = append(hexFingerprints, fmt.Sprintf("%X", sha256Fingerprint))
+		}
+		return hexFingerprints
+	}
+	if got := hexFingerprints(dc.Added); !slices.Equal(got, []string{TEST_E5_SHA256}) {
+		t.Errorf("Added = %q, want E5", got)
+	}
+	if got := hexFingerprints(dc.Removed); !slices.Equal(got, []string{TEST_R10_SHA256}) {
+		t.Errorf("Removed = %q, want R10", got)
+	}
+	if len(dc.Changed) != 1 || fmt.Sprintf("%X", dc.Changed[0].SHA256Fingerprint) != TEST_R11_SHA256 {
+		t.Fatalf("Changed = %+v, want R11", dc.Changed)
+	} else if fields := dc.Changed[0].Fields; len(fields) != 1 || *fields[0] != (FieldChange{Name: "CertificateName", Old: "R11", New: "R11 (renamed)"}) {
+		t.Errorf("R11's changed fields = %+v, want only its CertificateName", fields)
+	}
+	if dc.Count() != 3 {
+		t.Errorf("Count() = %d, want 3", dc.Count())
+	}
+	if dc := Compare(old, old); dc.Count() != 0 {
+		t.Errorf("Compare() of a Store with itself = %+v, want no differences", dc)
+	}
+}
+
+// receiveChangeEvents receives n events from a subscription, and then checks that no more are sent.
+func receiveChangeEvents(t *testing.T, events <-chan ChangeEvent, n int) []ChangeEvent {
+	t.Helper()
+	var received []ChangeEvent
+	for len(received) < n {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				t.Fatalf("Subscription was closed after %d of %d events", len(received), n)
+			}
+			received = append(received, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out after %d of %d events", len(received), n)
+		}
+	}
+	select {
+	case event, ok := <-events:
+		if ok {
+			t.Fatalf("Unexpected event %+v after %d events", event, n)
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+	return received
+}
+
+// TestSubscribe checks that subscribers receive the changes of successive SetDefaultStore calls, that a subscription
+// ends once its context is done, and that subscribing to a replaced Store subscribes to its replacement.
+func TestSubscribe(t *testing.T) {
+	r10SHA256Fingerprint, _ := HexFingerprintToArray(TEST_R10_SHA256)
+	withoutR10 := func(header, record []string) []string {
+		if record[slices.Index(header, "SHA-256 Fingerprint")] == TEST_R10_SHA256 {
+			return nil
+		}
+		return record
+	}
+	renamedR10 := func(header, record []string) []string {
+		if record[slices.Index(header, "SHA-256 Fingerprint")] == TEST_R10_SHA256 {
+			record[slices.Index(header, "Certificate Name")] = "R10 (renamed)"
+		}
+		return record
+	}
+	var stores []*Store
+	for _, fsys := range []fstest.MapFS{
+		fixtureMapFS(t, "v5"),
+		editedFixtureMapFS(t, "v5", withoutR10),
+		fixtureMapFS(t, "v5"),
+		editedFixtureMapFS(t, "v5", renamedR10),
+	} {
+		s, err := NewStore(fsys, WithMinimumRecords(1))
+		if err != nil {
+			t.Fatalf("NewStore() returned %v", err)
+		}
+		stores = append(stores, s)
+	}
+
+	SetDefaultStore(stores[0])
+	kept := GetDefaultStore().Subscribe(t.Context())
+	ctx, cancel := context.WithCancel(t.Context())
+	unsubscribed := GetDefaultStore().Subscribe(ctx)
+
+	SetDefaultStore(stores[1])
+	want := ChangeEvent{Type: ChangeRemoved, SHA256Fingerprint: r10SHA256Fingerprint}
+	for _, events := range []<-chan ChangeEvent{kept, unsubscribed} {
+		if got := receiveChangeEvents(t, events, 1); got[0].Type != want.Type || got[0].SHA256Fingerprint != want.SHA256Fingerprint {
+			t.Errorf("First replacement sent %+v, want %+v", got[0], want)
+		}
+	}
+
+	cancel()
+	select {
+	case event, ok := <-unsubscribed:
+		if ok {
+			t.Errorf("Unsubscribed channel received %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Unsubscribed channel was not closed")
+	}
+
+	SetDefaultStore(stores[2])
+	want = ChangeEvent{Type: ChangeAdded, SHA256Fingerprint: r10SHA256Fingerprint}
+	if got := receiveChangeEvents(t, kept, 1); got[0].Type != want.Type || got[0].SHA256Fingerprint != want.SHA256Fingerprint {
+		t.Errorf("Second replacement sent %+v, want %+v", got[0], want)
+	}
+
+	// stores[0] has been replaced, so this subscribes to stores[2].
+	late := stores[0].Subscribe(t.Context())
+	SetDefaultStore(stores[3])
+	for _, events := range []<-chan ChangeEvent{kept, late} {
+		got := receiveChangeEvents(t, events, 1)
+		if got[0].Type != ChangeModified || got[0].SHA256Fingerprint != r10SHA256Fingerprint || len(got[0].Fields) == 0 {
+			t.Errorf("Third replacement sent %+v, want R10 to be modified", got[0])
+		}
+	}
+}
+
+// etagTransport serves a report with an ETag, and reports that it hasn't changed when it is revalidated.
+type etagTransport struct {
+	revalidations int
+}
+
+func (et *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("If-None-Match") == `"1"` {
+		et.revalidations++
+		return &http.Response{StatusCode: http.StatusNotModified, Body: http.NoBody, Request: req}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Etag": {`"1"`}}, Body: io.NopCloser(strings.NewReader("report")), Request: req}, nil
+}
+
+// TestFetchReportCache checks that FetchReport only revalidates cached reports when WithReportCacheDir is used.
+func TestFetchReportCache(t *testing.T) {
+	et := &etagTransport{}
+	client := &http.Client{Transport: et}
+	dir := t.TempDir()
+	for _, test := range []struct {
+		opts              []FetchOption
+		wantRevalidations int
+	}{
+		{[]FetchOption{WithReportCacheDir(dir)}, 0},
+		{[]FetchOption{WithReportCacheDir(dir)}, 1},
+		{nil, 1},
+	} {
+		if data, err := FetchReport(context.Background(), client, CCADB_REPORT_BASE_URL+CCADB_CSV_REPORT, test.opts...); err != nil || string(data) != "report" {
+			t.Errorf("FetchReport() returned %q, %v", data, err)
+		} else if et.revalidations != test.wantRevalidations {
+			t.Errorf("Report was revalidated %d times, want %d", et.revalidations, test.wantRevalidations)
+		}
+	}
+}
+
+// urlRecordingTransport serves an empty report from every URL, and records the URLs that were requested.
+type urlRecordingTransport struct {
+	urls []string
+}
+
+func (ut *urlRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ut.urls = append(ut.urls, req.URL.String())
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+// TestFetchReportProxy checks that WithCCADBProxyURL only downloads the reports on the CCADB site via the proxy.
+func TestFetchReportProxy(t *testing.T) {
+	for _, test := range []struct {
+		url, proxyURL, wantURL string
+	}{
+		{CCADB_REPORT_BASE_URL + CCADB_CSV_REPORT, "", CCADB_REPORT_BASE_URL + CCADB_CSV_REPORT},
+		{CCADB_REPORT_BASE_URL + CCADB_CSV_REPORT, "http://ccadb-server:8080/reports", "http://ccadb-server:8080/reports/ccadb/" + CCADB_CSV_REPORT},
+		{CCADB_REPORT_BASE_URL + PEM_CSV_REPORT + "?NotBeforeYear=2024", "http://ccadb-server:8080/reports/", "http://ccadb-server:8080/reports/ccadb/" + PEM_CSV_REPORT + "?NotBeforeYear=2024"},
+		{CT_LOG_LIST_URL, "http://ccadb-server:8080/reports/", CT_LOG_LIST_URL},
+	} {
+		ut := &urlRecordingTransport{}
+		if _, err := FetchReport(context.Background(), &http.Client{Transport: ut}, test.url, WithCCADBProxyURL(test.proxyURL)); err != nil {
+			t.Errorf("FetchReport(%q) returned %v", test.url, err)
+		} else if !slices.Equal(ut.urls, []string{test.wantURL}) {
+			t.Errorf("FetchReport(%q) with proxy %q requested %q, want %q", test.url, test.proxyURL, ut.urls, test.wantURL)
+		}
+	}
+}
+
+// TestFetchReportsShrinkage checks that FetchReports rejects reports that have shrunk by more than the maximum fraction
+// since the previous download, unless WithMaxReportShrinkage allows it.
+func TestFetchReportsShrinkage(t *testing.T) {
+	client := &http.Client{Transport: newFixtureTransport(t, "v5")}
+	// The previous download had twice as many records.
+	records, err := csv.NewReader(bytes.NewReader(fixtureMapFS(t, "v5")[CCADB_CSV_PATH].Data)).ReadAll()
+	if err != nil {
+		t.Fatalf("Fixture could not be parsed: %v", err)
+	}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.WriteAll(slices.Concat(records, records[1:]))
+	dir := t.TempDir()
+	if err = writeFileAtomically(filepath.Join(dir, filepath.FromSlash(CCADB_CSV_PATH)), buf.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = FetchReports(context.Background(), client, dir); !errors.Is(err, ErrReportShrank) {
+		t.Errorf("FetchReports() returned %v, want ErrReportShrank", err)
+	}
+	if err = FetchReports(context.Background(), client, dir, WithMaxReportShrinkage(0.5)); err != nil {
+		t.Errorf("FetchReports(WithMaxReportShrinkage(0.5)) returned %v", err)
+	}
+}
+
+// TestCompactIndex checks that a Store with a compact index returns the same CA certificates, records, and capabilities
+// as one indexed by a map.
+func TestCompactIndex(t *testing.T) {
+	s, err := NewStore(EmbeddedFS())
+	if err != nil {
+		t.Fatalf("NewStore() returned %v", err)
+	}
+	compact, err := NewStore(EmbeddedFS(), WithCompactIndex())
+	if err != nil {
+		t.Fatalf("NewStore() with WithCompactIndex returned %v", err)
+	}
+
+	sha256Fingerprints := s.ListFingerprints(CapabilityFilter{})
+	if got := compact.ListFingerprints(CapabilityFilter{}); !slices.Equal(got, sha256Fingerprints) {
+		t.Fatalf("Compact index lists %d CA certificates, want %d", len(got), len(sha256Fingerprints))
+	}
+	for _, sha256Fingerprint := range sha256Fingerprints {
+		if got, want := compact.GetCACertCapabilitiesBySHA256(sha256Fingerprint), s.GetCACertCapabilitiesBySHA256(sha256Fingerprint); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Compact index has capabilities %+v for %X, want %+v", got, sha256Fingerprint, want)
+		} else if got, want := compact.GetCertificateRecordBySHA256(sha256Fingerprint), s.GetCertificateRecordBySHA256(sha256Fingerprint); !reflect.DeepEqual(got, want) {
+			t.Fatalf("Compact index has record %+v for %X, want %+v", got, sha256Fingerprint, want)
+		}
+	}
+	if ccc := compact.GetCACertCapabilitiesBySHA256(sha256.Sum256(nil)); ccc != nil {
+		t.Errorf("Compact index has capabilities %+v for an unknown CA certificate, want nil", ccc)
+	}
+}
+
+// TestCARecordPositions checks that each CA certificate's record and capabilities are found at its position in the
+// contiguous slices that hold them, whether they are indexed by a map or by a compact index.
+func TestCARecordPositions(t *testing.T) {
+	fsys := fixtureMapFS(t, "v5")
+	records, err := csv.NewReader(bytes.NewReader(fsys[CCADB_CSV_PATH].Data)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := records[0]
+	for name, opts := range map[string][]StoreOption{
+		"Map":          {WithMinimumRecords(1)},
+		"CompactIndex": {WithMinimumRecords(1), WithCompactIndex()},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, err := NewStore(fsys, opts...)
+			if err != nil {
+				t.Fatalf("NewStore() returned %v", err)
+			}
+			for _, record := range records[1:] {
+				sha256Fingerprint, _ := HexFingerprintToArray(record[slices.Index(header, "SHA-256 Fingerprint")])
+				cr, ccc := s.GetCertificateRecordBySHA256(sha256Fingerprint), s.GetCACertCapabilitiesBySHA256(sha256Fingerprint)
+				if cr == nil || ccc == nil {
+					t.Fatalf("CA certificate %X is missing", sha256Fingerprint)
+				} else if cr.CertificateName != record[slices.Index(header, "Certificate Name")] || cr.CAOwner != record[slices.Index(header, "CA Owner")] {
+					t.Errorf("CA certificate %X has the record of %q owned by %q", sha256Fingerprint, cr.CertificateName, cr.CAOwner)
+				} else if ccc.CertificateRecordType.String() != record[slices.Index(header, "Certificate Record Type")] {
+					t.Errorf("CA certificate %X has the capabilities of a %s", sha256Fingerprint, ccc.CertificateRecordType)
+				}
+			}
+		})
+	}
+}
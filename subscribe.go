@@ -0,0 +1,177 @@
+package ccadb_data
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+)
+
+// ChangeType is the kind of change to a CA certificate that a ChangeEvent reports.
+type ChangeType uint8
+
+const (
+	ChangeAdded ChangeType = iota
+	ChangeRemoved
+	ChangeModified
+)
+
+// String returns "added", "removed", or "modified".
+func (ct ChangeType) String() string {
+	switch ct {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeModified:
+		return "modified"
+	default:
+		return ""
+	}
+}
+
+func (ct ChangeType) MarshalText() ([]byte, error) {
+	return []byte(ct.String()), nil
+}
+
+// ChangeEvent reports that a CA certificate was added to, removed from, or modified in the default Store when it was
+// replaced.
+type ChangeEvent struct {
+	Type              ChangeType
+	SHA256Fingerprint [sha256.Size]byte
+	// The fields of the CA certificate's record or capabilities that differ, if it was modified (see Compare).
+	Fields []*FieldChange
+}
+
+// A subscriber to a Store's changes. Events are queued by notify and delivered by run, so that replacing the default
+// Store never waits for a slow subscriber.
+type subscription struct {
+	ctx     context.Context
+	events  chan ChangeEvent
+	mu      sync.Mutex
+	pending [][]ChangeEvent
+	wake    chan struct{}
+}
+
+// Subscribe returns a channel on which a ChangeEvent is sent for every CA certificate that is added, removed, or
+// modified each time Refresh or SetDefaultStore replaces this Store as the default Store. The subscription carries over
+// to the replacement, so the channel keeps receiving events across refreshes; subscribing to a Store that has already
+// been replaced subscribes to its replacement. The events of each replacement are sent together, ordered as by Compare
+// (additions, then removals, then modifications), and are never interleaved with those of another. The channel is
+// closed once ctx is done.
+func (s *Store) Subscribe(ctx context.Context) <-chan ChangeEvent {
+	sub := &subscription{
+		ctx:    ctx,
+		events: make(chan ChangeEvent),
+		wake:   make(chan struct{}, 1),
+	}
+	go sub.run()
+
+	s.subscriptionsMu.Lock()
+	for s.replacedBy != nil {
+		next := s.replacedBy
+		s.subscriptionsMu.Unlock()
+		s = next
+		s.subscriptionsMu.Lock()
+	}
+	s.subscriptions = append(s.subscriptions, sub)
+	s.subscriptionsMu.Unlock()
+	return sub.events
+}
+
+// handOverSubscriptions moves s's subscriptions to its replacement as the default Store, sending each subscriber the
+// changes between them. dc may be nil, in which case the changes are only compared if there are any subscribers.
+func (s *Store) handOverSubscriptions(replacement *Store, dc *DatasetComparison) {
+	if s == replacement {
+		return
+	}
+	s.subscriptionsMu.Lock()
+	subscriptions := s.subscriptions
+	s.subscriptions = nil
+	s.replacedBy = replacement
+	s.subscriptionsMu.Unlock()
+
+	subscriptions = activeSubscriptions(subscriptions)
+	if len(subscriptions) > 0 {
+		if dc == nil {
+			dc = Compare(s, replacement)
+		}
+		events := changeEvents(dc)
+		for _, sub := range subscriptions {
+			sub.notify(events)
+		}
+	}
+
+	replacement.subscriptionsMu.Lock()
+	replacement.subscriptions = append(replacement.subscriptions, subscriptions...)
+	replacement.subscriptionsMu.Unlock()
+}
+
+// activeSubscriptions removes the subscriptions whose context is done.
+func activeSubscriptions(subscriptions []*subscription) []*subscription {
+	n := 0
+	for _, sub := range subscriptions {
+		if sub.ctx.Err() == nil {
+			subscriptions[n] = sub
+			n++
+		}
+	}
+	return subscriptions[:n]
+}
+
+// changeEvents returns the events that report a comparison's changes.
+func changeEvents(dc *DatasetComparison) []ChangeEvent {
+	events := make([]ChangeEvent, 0, dc.Count())
+	for _, sha256Fingerprint := range dc.Added {
+		events = append(events, ChangeEvent{Type: ChangeAdded, SHA256Fingerprint: sha256Fingerprint})
+	}
+	for _, sha256Fingerprint := range dc.Removed {
+		events = append(events, ChangeEvent{Type: ChangeRemoved, SHA256Fingerprint: sha256Fingerprint})
+	}
+	for _, rc := range dc.Changed {
+		events = append(events, ChangeEvent{Type: ChangeModified, SHA256Fingerprint: rc.SHA256Fingerprint, Fields: rc.Fields})
+	}
+	return events
+}
+
+// notify queues events for delivery to the subscriber.
+func (sub *subscription) notify(events []ChangeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	sub.mu.Lock()
+	sub.pending = append(sub.pending, events)
+	sub.mu.Unlock()
+	select {
+	case sub.wake <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers queued events to the subscriber until its context is done, then closes its channel.
+func (sub *subscription) run() {
+	defer close(sub.events)
+	for {
+		select {
+		case <-sub.ctx.Done():
+			return
+		case <-sub.wake:
+		}
+		for {
+			sub.mu.Lock()
+			if len(sub.pending) == 0 {
+				sub.mu.Unlock()
+				break
+			}
+			events := sub.pending[0]
+			sub.pending = sub.pending[1:]
+			sub.mu.Unlock()
+			for _, event := range events {
+				select {
+				case <-sub.ctx.Done():
+					return
+				case sub.events <- event:
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package ccadb_data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	GITHUB_LATEST_RELEASE_URL = "https://api.github.com/repos/crtsh/ccadb_data/releases/latest"
+	// Releases are published by the same workflow run that fetches the data, shortly after the dataset date.
+	RELEASE_DATE_TOLERANCE = time.Hour
+)
+
+// A newer release of this package, with newer CCADB data than the embedded data.
+type DatasetUpdate struct {
+	Version     string
+	PublishedAt time.Time
+	URL         string
+}
+
+// CheckForNewerDataset queries this repository's GitHub Releases, and returns the latest release if it contains newer
+// CCADB data than the embedded data. It returns nil if the embedded data is up to date.
+func CheckForNewerDataset(ctx context.Context) (*DatasetUpdate, error) {
+	return CheckForNewerDatasetWithClient(ctx, nil)
+}
+
+// CheckForNewerDatasetWithClient is like CheckForNewerDataset, but uses client (if not nil) to query GitHub, e.g. to
+// use a proxy or custom roots.
+func CheckForNewerDatasetWithClient(ctx context.Context, client *http.Client) (*DatasetUpdate, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	datasetTime, ok := getDatasetTime()
+	if !ok {
+		return nil, fmt.Errorf("Embedded dataset date is invalid: %q", DatasetDate)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, GITHUB_LATEST_RELEASE_URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: HTTP %d", GITHUB_LATEST_RELEASE_URL, resp.StatusCode)
+	}
+
+	var release struct {
+		TagName     string    `json:"tag_name"`
+		PublishedAt time.Time `json:"published_at"`
+		HTMLURL     string    `json:"html_url"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, fmt.Errorf("%s: %w", GITHUB_LATEST_RELEASE_URL, err)
+	} else if release.PublishedAt.After(datasetTime.Add(RELEASE_DATE_TOLERANCE)) {
+		return &DatasetUpdate{
+			Version:     release.TagName,
+			PublishedAt: release.PublishedAt,
+			URL:         release.HTMLURL,
+		}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,165 @@
+package ccadb_data
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// RecordURL is a URL disclosed in a CA certificate record, as returned by ExtractURLs.
+type RecordURL struct {
+	Column string
+	URL    string
+}
+
+// How the URLs in a column are written.
+type urlColumnFormat uint8
+
+const (
+	// One or more URLs, separated by whitespace or semicolons, optionally with a label (e.g. "CPS: https://...").
+	urlColumnList urlColumnFormat = iota
+	// A JSON array of URLs.
+	urlColumnJSONArray
+)
+
+// The columns that contain URLs. Free-text columns (e.g. "Policy Documentation") are deliberately excluded, since URLs
+// mentioned in comments are not disclosures.
+var urlColumns = map[string]urlColumnFormat{
+	"JSON Array of All Full CRL URLs":          urlColumnJSONArray,
+	"JSON Array of Partitioned CRLs":           urlColumnJSONArray,
+	"DV ACME Directory URL(s)":                 urlColumnList,
+	"OV ACME Directory URL(s)":                 urlColumnList,
+	"EV ACME Directory URL(s)":                 urlColumnList,
+	"IV ACME Directory URL(s)":                 urlColumnList,
+	"Standard Audit URL":                       urlColumnList,
+	"NetSec Audit URL":                         urlColumnList,
+	"TLS BR Audit URL":                         urlColumnList,
+	"TLS EVG Audit URL":                        urlColumnList,
+	"Code Signing Audit URL":                   urlColumnList,
+	"S/MIME BR Audit URL":                      urlColumnList,
+	"VMC Audit URL":                            urlColumnList,
+	"CA Document Repository":                   urlColumnList,
+	"Certificate Policy (CP) URL":              urlColumnList,
+	"Certificate Practice Statement (CPS) URL": urlColumnList,
+	"Certificate Practice & Policy Statement":  urlColumnList,
+	"MD/AsciiDoc CP/CPS URL":                   urlColumnList,
+	"Test Website URL - Valid":                 urlColumnList,
+	"Test Website URL - Expired":               urlColumnList,
+	"Test Website URL - Revoked":               urlColumnList,
+}
+
+// ExtractURLs returns the http and https URLs found in the URL-bearing columns (CRL URLs, ACME directories, audit
+// statements, CP/CPS documents, and test websites) of a CCADB CSV record, such as the Header and Fields of a raw record,
+// along with the name of the column that each was found in. Each column is parsed according to its format, and other
+// columns are ignored. URLs are returned in column order, and a URL that appears more than once in the same column is
+// only returned once.
+func ExtractURLs(header, fields []string) []RecordURL {
+	var urls []RecordURL
+	for i, field := range fields {
+		if i >= len(header) {
+			break
+		}
+		format, ok := urlColumns[header[i]]
+		if !ok || field == "" {
+			continue
+		}
+		for _, u := range parseURLColumn(field, format) {
+			ru := RecordURL{Column: header[i], URL: u}
+			if !slices.Contains(urls, ru) {
+				urls = append(urls, ru)
+			}
+		}
+	}
+	return urls
+}
+
+// parseURLColumn returns the URLs in a field, according to the column's format.
+func parseURLColumn(field string, format urlColumnFormat) []string {
+	var candidates []string
+	if format == urlColumnJSONArray {
+		if err := json.Unmarshal([]byte(field), &candidates); err != nil {
+			// Fall back to treating the field as a list, with the JSON punctuation as separators.
+			candidates = strings.FieldsFunc(field, func(r rune) bool {
+				return unicode.IsSpace(r) || strings.ContainsRune(`[]",;`, r)
+			})
+		}
+	} else {
+		candidates = strings.FieldsFunc(field, func(r rune) bool {
+			return unicode.IsSpace(r) || r == ';'
+		})
+	}
+
+	var urls []string
+	for _, candidate := range candidates {
+		if u, ok := parseDisclosedURL(candidate); ok {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// parseDisclosedURL returns the http or https URL in s, ignoring any label before it (e.g. "CPS:https://...") and
+// trailing punctuation.
+func parseDisclosedURL(s string) (string, bool) {
+	// Only ASCII letters are folded, since strings.ToLower replaces invalid UTF-8, which would move the indexes.
+	lower := []byte(s)
+	for i, c := range lower {
+		if 'A' <= c && c <= 'Z' {
+			lower[i] = c + 'a' - 'A'
+		}
+	}
+	start := bytes.Index(lower, []byte("https://"))
+	if httpStart := bytes.Index(lower, []byte("http://")); httpStart != -1 && (start == -1 || httpStart < start) {
+		start = httpStart
+	}
+	if start == -1 {
+		return "", false
+	}
+	s = strings.TrimRight(strings.TrimSpace(s[start:]), ".,)]>\"'")
+
+	if u, err := url.Parse(s); err != nil || u.Host == "" {
+		return "", false
+	}
+	return s, true
+}
+
+// parseCRLURLs parses one of the CRL disclosure columns. CCADB writes these as JSON arrays of URLs, but the V3 report's
+// "Full CRL Issued By This CA" column contains a single URL, and some records contain an empty JSON string. If the JSON
+// is invalid, the URLs are extracted leniently and the error is also returned.
+func parseCRLURLs(field string, si stringInterner) ([]string, error) {
+	field = strings.TrimSpace(field)
+	var urls []string
+	var err error
+	switch {
+	case strings.HasPrefix(field, "["):
+		if err = json.Unmarshal([]byte(field), &urls); err != nil {
+			urls = parseURLColumn(field, urlColumnJSONArray)
+		}
+	case strings.HasPrefix(field, `"`):
+		var u string
+		if err = json.Unmarshal([]byte(field), &u); err == nil {
+			urls = []string{u}
+		}
+	case field != "":
+		urls = []string{field}
+	}
+
+	crlURLs := urls[:0]
+	for _, u := range urls {
+		if u = strings.TrimSpace(u); u != "" {
+			crlURLs = append(crlURLs, si.intern(u))
+		}
+	}
+	if len(crlURLs) == 0 {
+		return nil, err
+	}
+	return slices.Clip(crlURLs), err
+}
+
+// HasCRLDisclosure reports whether any full or partitioned CRL URLs are disclosed for the CA certificate.
+func (cr *CertificateRecord) HasCRLDisclosure() bool {
+	return len(cr.FullCRLURLs) > 0 || len(cr.PartitionedCRLURLs) > 0
+}
@@ -0,0 +1,27 @@
+package ccadb_data
+
+import (
+	"slices"
+	"testing"
+)
+
+// FuzzParseCRLURLs parses a JSON array of CRL URLs, which must not yield empty URLs.
+func FuzzParseCRLURLs(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		`[]`,
+		`[""]`,
+		`["http://crl.example.com/1.crl", "http://crl.example.com/2.crl"]`,
+		`"http://crl.example.com/ca.crl"`,
+		`http://crl.example.com/ca.crl`,
+		`["http://crl.example.com/1.crl", `,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, field string) {
+		if urls, _ := parseCRLURLs(field, make(stringInterner)); slices.Contains(urls, "") {
+			t.Fatalf("parseCRLURLs(%q) = %q, which contains an empty URL", field, urls)
+		}
+	})
+}